@@ -0,0 +1,21 @@
+// Package foundationaction contains the business logic for planning and
+// applying a declarative description of orgs, spaces, quotas, roles, security
+// group bindings and isolation segments.
+package foundationaction
+
+// Warnings is a list of warnings returned back from the cloud controller
+type Warnings []string
+
+// Actor handles all business logic for applying foundation configuration.
+type Actor struct {
+	V2Actor V2Actor
+	V3Actor V3Actor
+}
+
+// NewActor returns a new actor.
+func NewActor(v2Actor V2Actor, v3Actor V3Actor) *Actor {
+	return &Actor{
+		V2Actor: v2Actor,
+		V3Actor: v3Actor,
+	}
+}
@@ -0,0 +1,77 @@
+package foundationaction
+
+import "fmt"
+
+// UnknownChangeTypeError is returned when a change of an unknown type is
+// applied.
+type UnknownChangeTypeError struct {
+	Type ChangeType
+}
+
+func (e UnknownChangeTypeError) Error() string {
+	return fmt.Sprintf("Unknown change type '%s'", e.Type)
+}
+
+// ApplyChange makes the provided change. Orgs, spaces, quotas and security
+// groups are looked up by name when the change is applied, so a change can
+// depend on an org or space created by an earlier change in the same plan.
+func (actor Actor) ApplyChange(change Change) (Warnings, error) {
+	switch change.Type {
+	case CreateOrganization:
+		_, warnings, err := actor.V2Actor.CreateOrganization(change.Organization)
+		return Warnings(warnings), err
+	case EnableOrganizationIsolation:
+		warnings, err := actor.V3Actor.EntitleIsolationSegmentToOrganizationByName(change.IsolationSegment, change.Organization)
+		return Warnings(warnings), err
+	}
+
+	var allWarnings Warnings
+
+	org, warnings, err := actor.V2Actor.GetOrganizationByName(change.Organization)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	switch change.Type {
+	case SetOrganizationQuota:
+		quota, warnings, err := actor.V2Actor.GetOrganizationQuotaByName(change.Quota)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+		warnings, err = actor.V2Actor.UpdateOrganizationQuota(org.GUID, quota.GUID)
+		return append(allWarnings, warnings...), err
+	case SetOrganizationRole:
+		warnings, err := actor.V2Actor.SetOrganizationRole(change.OrganizationRole, org.GUID, change.Username)
+		return append(allWarnings, warnings...), err
+	case CreateSpace:
+		_, warnings, err := actor.V2Actor.CreateSpace(change.Space, org.GUID)
+		return append(allWarnings, warnings...), err
+	}
+
+	space, warnings, err := actor.V2Actor.GetSpaceByOrganizationAndName(org.GUID, change.Space)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	switch change.Type {
+	case SetSpaceIsolationSegment:
+		warnings, err := actor.V3Actor.AssignIsolationSegmentToSpaceByNameAndSpace(change.IsolationSegment, space.GUID)
+		return append(allWarnings, warnings...), err
+	case SetSpaceRole:
+		warnings, err := actor.V2Actor.SetSpaceRole(change.SpaceRole, org.GUID, space.GUID, change.Username)
+		return append(allWarnings, warnings...), err
+	case BindSecurityGroup:
+		securityGroup, warnings, err := actor.V2Actor.GetSecurityGroupByName(change.SecurityGroup)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+		warnings, err = actor.V2Actor.BindSecurityGroupToSpace(securityGroup.GUID, space.GUID)
+		return append(allWarnings, warnings...), err
+	}
+
+	return allWarnings, UnknownChangeTypeError{Type: change.Type}
+}
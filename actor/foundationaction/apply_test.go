@@ -0,0 +1,131 @@
+package foundationaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/foundationaction/foundationactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ApplyChange", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *foundationactionfakes.FakeV2Actor
+		fakeV3Actor *foundationactionfakes.FakeV3Actor
+
+		change   Change
+		warnings Warnings
+		err      error
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(foundationactionfakes.FakeV2Actor)
+		fakeV3Actor = new(foundationactionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+
+		fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{GUID: "some-org-guid"}, v2action.Warnings{"org-warning"}, nil)
+		fakeV2Actor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "some-space-guid"}, v2action.Warnings{"space-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		warnings, err = actor.ApplyChange(change)
+	})
+
+	Context("when creating an org", func() {
+		BeforeEach(func() {
+			change = Change{Type: CreateOrganization, Organization: "some-org"}
+			fakeV2Actor.CreateOrganizationReturns(v2action.Organization{}, v2action.Warnings{"create-warning"}, nil)
+		})
+
+		It("creates the org", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("create-warning"))
+			Expect(fakeV2Actor.CreateOrganizationArgsForCall(0)).To(Equal("some-org"))
+		})
+	})
+
+	Context("when setting an org quota", func() {
+		BeforeEach(func() {
+			change = Change{Type: SetOrganizationQuota, Organization: "some-org", Quota: "some-quota"}
+			fakeV2Actor.GetOrganizationQuotaByNameReturns(v2action.OrganizationQuota{GUID: "some-quota-guid"}, v2action.Warnings{"quota-warning"}, nil)
+			fakeV2Actor.UpdateOrganizationQuotaReturns(v2action.Warnings{"update-warning"}, nil)
+		})
+
+		It("looks up the org and quota and sets the quota", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("org-warning", "quota-warning", "update-warning"))
+			orgGUID, quotaGUID := fakeV2Actor.UpdateOrganizationQuotaArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(quotaGUID).To(Equal("some-quota-guid"))
+		})
+	})
+
+	Context("when entitling an org to an isolation segment", func() {
+		BeforeEach(func() {
+			change = Change{Type: EnableOrganizationIsolation, Organization: "some-org", IsolationSegment: "some-segment"}
+			fakeV3Actor.EntitleIsolationSegmentToOrganizationByNameReturns(v3action.Warnings{"entitle-warning"}, nil)
+		})
+
+		It("entitles the org", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("entitle-warning"))
+			segmentName, orgName := fakeV3Actor.EntitleIsolationSegmentToOrganizationByNameArgsForCall(0)
+			Expect(segmentName).To(Equal("some-segment"))
+			Expect(orgName).To(Equal("some-org"))
+		})
+	})
+
+	Context("when setting a space role", func() {
+		BeforeEach(func() {
+			change = Change{Type: SetSpaceRole, Organization: "some-org", Space: "some-space", Username: "bob", SpaceRole: v2action.SpaceDeveloperRole}
+			fakeV2Actor.SetSpaceRoleReturns(v2action.Warnings{"role-warning"}, nil)
+		})
+
+		It("looks up the org and space and sets the role", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("org-warning", "space-warning", "role-warning"))
+			role, orgGUID, spaceGUID, username := fakeV2Actor.SetSpaceRoleArgsForCall(0)
+			Expect(role).To(Equal(v2action.SpaceDeveloperRole))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(username).To(Equal("bob"))
+		})
+	})
+
+	Context("when binding a security group", func() {
+		BeforeEach(func() {
+			change = Change{Type: BindSecurityGroup, Organization: "some-org", Space: "some-space", SecurityGroup: "some-security-group"}
+			fakeV2Actor.GetSecurityGroupByNameReturns(v2action.SecurityGroup{GUID: "some-security-group-guid"}, v2action.Warnings{"security-group-warning"}, nil)
+			fakeV2Actor.BindSecurityGroupToSpaceReturns(v2action.Warnings{"bind-warning"}, nil)
+		})
+
+		It("binds the security group to the space", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("org-warning", "space-warning", "security-group-warning", "bind-warning"))
+			securityGroupGUID, spaceGUID := fakeV2Actor.BindSecurityGroupToSpaceArgsForCall(0)
+			Expect(securityGroupGUID).To(Equal("some-security-group-guid"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	Context("when the space cannot be found", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			change = Change{Type: SetSpaceIsolationSegment, Organization: "some-org", Space: "some-space", IsolationSegment: "some-segment"}
+			expectedErr = errors.New("space error")
+			fakeV2Actor.GetSpaceByOrganizationAndNameReturns(v2action.Space{}, v2action.Warnings{"space-warning"}, expectedErr)
+		})
+
+		It("returns the error and all warnings", func() {
+			Expect(err).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("org-warning", "space-warning"))
+			Expect(fakeV3Actor.AssignIsolationSegmentToSpaceByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+})
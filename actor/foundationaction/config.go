@@ -0,0 +1,84 @@
+package foundationaction
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Config is the desired state of a foundation's orgs and spaces. Anything not
+// mentioned in the config is left untouched.
+type Config struct {
+	Organizations []OrganizationConfig `yaml:"orgs"`
+}
+
+// OrganizationConfig is the desired state of an organization.
+type OrganizationConfig struct {
+	Name              string        `yaml:"name"`
+	Quota             string        `yaml:"quota"`
+	IsolationSegments []string      `yaml:"isolation_segments"`
+	Managers          []string      `yaml:"managers"`
+	BillingManagers   []string      `yaml:"billing_managers"`
+	Auditors          []string      `yaml:"auditors"`
+	Spaces            []SpaceConfig `yaml:"spaces"`
+}
+
+// SpaceConfig is the desired state of a space.
+type SpaceConfig struct {
+	Name             string   `yaml:"name"`
+	IsolationSegment string   `yaml:"isolation_segment"`
+	Managers         []string `yaml:"managers"`
+	Developers       []string `yaml:"developers"`
+	Auditors         []string `yaml:"auditors"`
+	SecurityGroups   []string `yaml:"security_groups"`
+}
+
+// InvalidConfigError is returned when the config file cannot be parsed or
+// describes an impossible state.
+type InvalidConfigError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidConfigError) Error() string {
+	return fmt.Sprintf("Invalid foundation config %s: %s", e.Path, e.Message)
+}
+
+// ReadConfig reads and validates the foundation config at the provided path.
+func ReadConfig(path string) (Config, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var config Config
+	err = yaml.Unmarshal(raw, &config)
+	if err != nil {
+		return Config{}, InvalidConfigError{Path: path, Message: err.Error()}
+	}
+
+	orgNames := map[string]bool{}
+	for _, org := range config.Organizations {
+		if org.Name == "" {
+			return Config{}, InvalidConfigError{Path: path, Message: "every org must have a name"}
+		}
+		if orgNames[org.Name] {
+			return Config{}, InvalidConfigError{Path: path, Message: fmt.Sprintf("org %s is listed more than once", org.Name)}
+		}
+		orgNames[org.Name] = true
+
+		spaceNames := map[string]bool{}
+		for _, space := range org.Spaces {
+			if space.Name == "" {
+				return Config{}, InvalidConfigError{Path: path, Message: fmt.Sprintf("every space in org %s must have a name", org.Name)}
+			}
+			if spaceNames[space.Name] {
+				return Config{}, InvalidConfigError{Path: path, Message: fmt.Sprintf("space %s is listed more than once in org %s", space.Name, org.Name)}
+			}
+			spaceNames[space.Name] = true
+		}
+	}
+
+	return config, nil
+}
@@ -0,0 +1,114 @@
+package foundationaction_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "code.cloudfoundry.org/cli/actor/foundationaction"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadConfig", func() {
+	var (
+		path string
+		raw  string
+	)
+
+	JustBeforeEach(func() {
+		file, err := ioutil.TempFile("", "foundation-config")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = file.WriteString(raw)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(file.Close()).To(Succeed())
+		path = file.Name()
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(path)).To(Succeed())
+	})
+
+	Context("when the config is valid", func() {
+		BeforeEach(func() {
+			raw = `---
+orgs:
+- name: org-1
+  quota: small
+  isolation_segments: [segment-1]
+  managers: [alice]
+  billing_managers: [bob]
+  auditors: [carol]
+  spaces:
+  - name: dev
+    isolation_segment: segment-1
+    managers: [alice]
+    developers: [dave, erin]
+    auditors: [carol]
+    security_groups: [public_networks]
+- name: org-2
+`
+		})
+
+		It("returns the parsed config", func() {
+			config, err := ReadConfig(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config).To(Equal(Config{
+				Organizations: []OrganizationConfig{
+					{
+						Name:              "org-1",
+						Quota:             "small",
+						IsolationSegments: []string{"segment-1"},
+						Managers:          []string{"alice"},
+						BillingManagers:   []string{"bob"},
+						Auditors:          []string{"carol"},
+						Spaces: []SpaceConfig{
+							{
+								Name:             "dev",
+								IsolationSegment: "segment-1",
+								Managers:         []string{"alice"},
+								Developers:       []string{"dave", "erin"},
+								Auditors:         []string{"carol"},
+								SecurityGroups:   []string{"public_networks"},
+							},
+						},
+					},
+					{Name: "org-2"},
+				},
+			}))
+		})
+	})
+
+	Context("when the file is not YAML", func() {
+		BeforeEach(func() {
+			raw = "orgs: ["
+		})
+
+		It("returns an InvalidConfigError", func() {
+			_, err := ReadConfig(path)
+			Expect(err).To(BeAssignableToTypeOf(InvalidConfigError{}))
+		})
+	})
+
+	Context("when an org is listed twice", func() {
+		BeforeEach(func() {
+			raw = "orgs:\n- name: org-1\n- name: org-1\n"
+		})
+
+		It("returns an InvalidConfigError", func() {
+			_, err := ReadConfig(path)
+			Expect(err).To(MatchError(InvalidConfigError{Path: path, Message: "org org-1 is listed more than once"}))
+		})
+	})
+
+	Context("when a space has no name", func() {
+		BeforeEach(func() {
+			raw = "orgs:\n- name: org-1\n  spaces:\n  - developers: [dave]\n"
+		})
+
+		It("returns an InvalidConfigError", func() {
+			_, err := ReadConfig(path)
+			Expect(err).To(MatchError(InvalidConfigError{Path: path, Message: "every space in org org-1 must have a name"}))
+		})
+	})
+})
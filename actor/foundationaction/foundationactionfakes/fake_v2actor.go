@@ -0,0 +1,959 @@
+// This file was generated by counterfeiter
+package foundationactionfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+)
+
+type FakeV2Actor struct {
+	BindSecurityGroupToSpaceStub        func(securityGroupGUID string, spaceGUID string) (v2action.Warnings, error)
+	bindSecurityGroupToSpaceMutex       sync.RWMutex
+	bindSecurityGroupToSpaceArgsForCall []struct {
+		securityGroupGUID string
+		spaceGUID         string
+	}
+	bindSecurityGroupToSpaceReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	bindSecurityGroupToSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	CreateOrganizationStub        func(orgName string) (v2action.Organization, v2action.Warnings, error)
+	createOrganizationMutex       sync.RWMutex
+	createOrganizationArgsForCall []struct {
+		orgName string
+	}
+	createOrganizationReturns struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	createOrganizationReturnsOnCall map[int]struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	CreateSpaceStub        func(spaceName string, orgGUID string) (v2action.Space, v2action.Warnings, error)
+	createSpaceMutex       sync.RWMutex
+	createSpaceArgsForCall []struct {
+		spaceName string
+		orgGUID   string
+	}
+	createSpaceReturns struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	createSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrganizationByNameStub        func(orgName string) (v2action.Organization, v2action.Warnings, error)
+	getOrganizationByNameMutex       sync.RWMutex
+	getOrganizationByNameArgsForCall []struct {
+		orgName string
+	}
+	getOrganizationByNameReturns struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationByNameReturnsOnCall map[int]struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrganizationQuotaByNameStub        func(name string) (v2action.OrganizationQuota, v2action.Warnings, error)
+	getOrganizationQuotaByNameMutex       sync.RWMutex
+	getOrganizationQuotaByNameArgsForCall []struct {
+		name string
+	}
+	getOrganizationQuotaByNameReturns struct {
+		result1 v2action.OrganizationQuota
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationQuotaByNameReturnsOnCall map[int]struct {
+		result1 v2action.OrganizationQuota
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrganizationUsersByRoleStub        func(role v2action.OrganizationRole, orgGUID string) ([]v2action.User, v2action.Warnings, error)
+	getOrganizationUsersByRoleMutex       sync.RWMutex
+	getOrganizationUsersByRoleArgsForCall []struct {
+		role    v2action.OrganizationRole
+		orgGUID string
+	}
+	getOrganizationUsersByRoleReturns struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationUsersByRoleReturnsOnCall map[int]struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSecurityGroupByNameStub        func(securityGroupName string) (v2action.SecurityGroup, v2action.Warnings, error)
+	getSecurityGroupByNameMutex       sync.RWMutex
+	getSecurityGroupByNameArgsForCall []struct {
+		securityGroupName string
+	}
+	getSecurityGroupByNameReturns struct {
+		result1 v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSecurityGroupByNameReturnsOnCall map[int]struct {
+		result1 v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSpaceByOrganizationAndNameStub        func(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	getSpaceByOrganizationAndNameMutex       sync.RWMutex
+	getSpaceByOrganizationAndNameArgsForCall []struct {
+		orgGUID   string
+		spaceName string
+	}
+	getSpaceByOrganizationAndNameReturns struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceByOrganizationAndNameReturnsOnCall map[int]struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSpaceRunningSecurityGroupsBySpaceStub        func(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error)
+	getSpaceRunningSecurityGroupsBySpaceMutex       sync.RWMutex
+	getSpaceRunningSecurityGroupsBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getSpaceRunningSecurityGroupsBySpaceReturns struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceRunningSecurityGroupsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSpaceUsersByRoleStub        func(role v2action.SpaceRole, spaceGUID string) ([]v2action.User, v2action.Warnings, error)
+	getSpaceUsersByRoleMutex       sync.RWMutex
+	getSpaceUsersByRoleArgsForCall []struct {
+		role      v2action.SpaceRole
+		spaceGUID string
+	}
+	getSpaceUsersByRoleReturns struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceUsersByRoleReturnsOnCall map[int]struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}
+	SetOrganizationRoleStub        func(role v2action.OrganizationRole, orgGUID string, username string) (v2action.Warnings, error)
+	setOrganizationRoleMutex       sync.RWMutex
+	setOrganizationRoleArgsForCall []struct {
+		role     v2action.OrganizationRole
+		orgGUID  string
+		username string
+	}
+	setOrganizationRoleReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	setOrganizationRoleReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	SetSpaceRoleStub        func(role v2action.SpaceRole, orgGUID string, spaceGUID string, username string) (v2action.Warnings, error)
+	setSpaceRoleMutex       sync.RWMutex
+	setSpaceRoleArgsForCall []struct {
+		role      v2action.SpaceRole
+		orgGUID   string
+		spaceGUID string
+		username  string
+	}
+	setSpaceRoleReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	setSpaceRoleReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	UpdateOrganizationQuotaStub        func(orgGUID string, quotaGUID string) (v2action.Warnings, error)
+	updateOrganizationQuotaMutex       sync.RWMutex
+	updateOrganizationQuotaArgsForCall []struct {
+		orgGUID   string
+		quotaGUID string
+	}
+	updateOrganizationQuotaReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	updateOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV2Actor) BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string) (v2action.Warnings, error) {
+	fake.bindSecurityGroupToSpaceMutex.Lock()
+	ret, specificReturn := fake.bindSecurityGroupToSpaceReturnsOnCall[len(fake.bindSecurityGroupToSpaceArgsForCall)]
+	fake.bindSecurityGroupToSpaceArgsForCall = append(fake.bindSecurityGroupToSpaceArgsForCall, struct {
+		securityGroupGUID string
+		spaceGUID         string
+	}{securityGroupGUID, spaceGUID})
+	fake.recordInvocation("BindSecurityGroupToSpace", []interface{}{securityGroupGUID, spaceGUID})
+	fake.bindSecurityGroupToSpaceMutex.Unlock()
+	if fake.BindSecurityGroupToSpaceStub != nil {
+		return fake.BindSecurityGroupToSpaceStub(securityGroupGUID, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.bindSecurityGroupToSpaceReturns.result1, fake.bindSecurityGroupToSpaceReturns.result2
+}
+
+func (fake *FakeV2Actor) BindSecurityGroupToSpaceCallCount() int {
+	fake.bindSecurityGroupToSpaceMutex.RLock()
+	defer fake.bindSecurityGroupToSpaceMutex.RUnlock()
+	return len(fake.bindSecurityGroupToSpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) BindSecurityGroupToSpaceArgsForCall(i int) (string, string) {
+	fake.bindSecurityGroupToSpaceMutex.RLock()
+	defer fake.bindSecurityGroupToSpaceMutex.RUnlock()
+	return fake.bindSecurityGroupToSpaceArgsForCall[i].securityGroupGUID, fake.bindSecurityGroupToSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) BindSecurityGroupToSpaceReturns(result1 v2action.Warnings, result2 error) {
+	fake.BindSecurityGroupToSpaceStub = nil
+	fake.bindSecurityGroupToSpaceReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) BindSecurityGroupToSpaceReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.BindSecurityGroupToSpaceStub = nil
+	if fake.bindSecurityGroupToSpaceReturnsOnCall == nil {
+		fake.bindSecurityGroupToSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.bindSecurityGroupToSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) CreateOrganization(orgName string) (v2action.Organization, v2action.Warnings, error) {
+	fake.createOrganizationMutex.Lock()
+	ret, specificReturn := fake.createOrganizationReturnsOnCall[len(fake.createOrganizationArgsForCall)]
+	fake.createOrganizationArgsForCall = append(fake.createOrganizationArgsForCall, struct {
+		orgName string
+	}{orgName})
+	fake.recordInvocation("CreateOrganization", []interface{}{orgName})
+	fake.createOrganizationMutex.Unlock()
+	if fake.CreateOrganizationStub != nil {
+		return fake.CreateOrganizationStub(orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createOrganizationReturns.result1, fake.createOrganizationReturns.result2, fake.createOrganizationReturns.result3
+}
+
+func (fake *FakeV2Actor) CreateOrganizationCallCount() int {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return len(fake.createOrganizationArgsForCall)
+}
+
+func (fake *FakeV2Actor) CreateOrganizationArgsForCall(i int) string {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return fake.createOrganizationArgsForCall[i].orgName
+}
+
+func (fake *FakeV2Actor) CreateOrganizationReturns(result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	fake.createOrganizationReturns = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) CreateOrganizationReturnsOnCall(i int, result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	if fake.createOrganizationReturnsOnCall == nil {
+		fake.createOrganizationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Organization
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.createOrganizationReturnsOnCall[i] = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) CreateSpace(spaceName string, orgGUID string) (v2action.Space, v2action.Warnings, error) {
+	fake.createSpaceMutex.Lock()
+	ret, specificReturn := fake.createSpaceReturnsOnCall[len(fake.createSpaceArgsForCall)]
+	fake.createSpaceArgsForCall = append(fake.createSpaceArgsForCall, struct {
+		spaceName string
+		orgGUID   string
+	}{spaceName, orgGUID})
+	fake.recordInvocation("CreateSpace", []interface{}{spaceName, orgGUID})
+	fake.createSpaceMutex.Unlock()
+	if fake.CreateSpaceStub != nil {
+		return fake.CreateSpaceStub(spaceName, orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSpaceReturns.result1, fake.createSpaceReturns.result2, fake.createSpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) CreateSpaceCallCount() int {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return len(fake.createSpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) CreateSpaceArgsForCall(i int) (string, string) {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return fake.createSpaceArgsForCall[i].spaceName, fake.createSpaceArgsForCall[i].orgGUID
+}
+
+func (fake *FakeV2Actor) CreateSpaceReturns(result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	fake.createSpaceReturns = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) CreateSpaceReturnsOnCall(i int, result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	if fake.createSpaceReturnsOnCall == nil {
+		fake.createSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Space
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.createSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error) {
+	fake.getOrganizationByNameMutex.Lock()
+	ret, specificReturn := fake.getOrganizationByNameReturnsOnCall[len(fake.getOrganizationByNameArgsForCall)]
+	fake.getOrganizationByNameArgsForCall = append(fake.getOrganizationByNameArgsForCall, struct {
+		orgName string
+	}{orgName})
+	fake.recordInvocation("GetOrganizationByName", []interface{}{orgName})
+	fake.getOrganizationByNameMutex.Unlock()
+	if fake.GetOrganizationByNameStub != nil {
+		return fake.GetOrganizationByNameStub(orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationByNameReturns.result1, fake.getOrganizationByNameReturns.result2, fake.getOrganizationByNameReturns.result3
+}
+
+func (fake *FakeV2Actor) GetOrganizationByNameCallCount() int {
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	return len(fake.getOrganizationByNameArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetOrganizationByNameArgsForCall(i int) string {
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	return fake.getOrganizationByNameArgsForCall[i].orgName
+}
+
+func (fake *FakeV2Actor) GetOrganizationByNameReturns(result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationByNameStub = nil
+	fake.getOrganizationByNameReturns = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationByNameReturnsOnCall(i int, result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationByNameStub = nil
+	if fake.getOrganizationByNameReturnsOnCall == nil {
+		fake.getOrganizationByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Organization
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationByNameReturnsOnCall[i] = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationQuotaByName(name string) (v2action.OrganizationQuota, v2action.Warnings, error) {
+	fake.getOrganizationQuotaByNameMutex.Lock()
+	ret, specificReturn := fake.getOrganizationQuotaByNameReturnsOnCall[len(fake.getOrganizationQuotaByNameArgsForCall)]
+	fake.getOrganizationQuotaByNameArgsForCall = append(fake.getOrganizationQuotaByNameArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetOrganizationQuotaByName", []interface{}{name})
+	fake.getOrganizationQuotaByNameMutex.Unlock()
+	if fake.GetOrganizationQuotaByNameStub != nil {
+		return fake.GetOrganizationQuotaByNameStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationQuotaByNameReturns.result1, fake.getOrganizationQuotaByNameReturns.result2, fake.getOrganizationQuotaByNameReturns.result3
+}
+
+func (fake *FakeV2Actor) GetOrganizationQuotaByNameCallCount() int {
+	fake.getOrganizationQuotaByNameMutex.RLock()
+	defer fake.getOrganizationQuotaByNameMutex.RUnlock()
+	return len(fake.getOrganizationQuotaByNameArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetOrganizationQuotaByNameArgsForCall(i int) string {
+	fake.getOrganizationQuotaByNameMutex.RLock()
+	defer fake.getOrganizationQuotaByNameMutex.RUnlock()
+	return fake.getOrganizationQuotaByNameArgsForCall[i].name
+}
+
+func (fake *FakeV2Actor) GetOrganizationQuotaByNameReturns(result1 v2action.OrganizationQuota, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationQuotaByNameStub = nil
+	fake.getOrganizationQuotaByNameReturns = struct {
+		result1 v2action.OrganizationQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationQuotaByNameReturnsOnCall(i int, result1 v2action.OrganizationQuota, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationQuotaByNameStub = nil
+	if fake.getOrganizationQuotaByNameReturnsOnCall == nil {
+		fake.getOrganizationQuotaByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.OrganizationQuota
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationQuotaByNameReturnsOnCall[i] = struct {
+		result1 v2action.OrganizationQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationUsersByRole(role v2action.OrganizationRole, orgGUID string) ([]v2action.User, v2action.Warnings, error) {
+	fake.getOrganizationUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getOrganizationUsersByRoleReturnsOnCall[len(fake.getOrganizationUsersByRoleArgsForCall)]
+	fake.getOrganizationUsersByRoleArgsForCall = append(fake.getOrganizationUsersByRoleArgsForCall, struct {
+		role    v2action.OrganizationRole
+		orgGUID string
+	}{role, orgGUID})
+	fake.recordInvocation("GetOrganizationUsersByRole", []interface{}{role, orgGUID})
+	fake.getOrganizationUsersByRoleMutex.Unlock()
+	if fake.GetOrganizationUsersByRoleStub != nil {
+		return fake.GetOrganizationUsersByRoleStub(role, orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationUsersByRoleReturns.result1, fake.getOrganizationUsersByRoleReturns.result2, fake.getOrganizationUsersByRoleReturns.result3
+}
+
+func (fake *FakeV2Actor) GetOrganizationUsersByRoleCallCount() int {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return len(fake.getOrganizationUsersByRoleArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetOrganizationUsersByRoleArgsForCall(i int) (v2action.OrganizationRole, string) {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return fake.getOrganizationUsersByRoleArgsForCall[i].role, fake.getOrganizationUsersByRoleArgsForCall[i].orgGUID
+}
+
+func (fake *FakeV2Actor) GetOrganizationUsersByRoleReturns(result1 []v2action.User, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	fake.getOrganizationUsersByRoleReturns = struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationUsersByRoleReturnsOnCall(i int, result1 []v2action.User, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	if fake.getOrganizationUsersByRoleReturnsOnCall == nil {
+		fake.getOrganizationUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []v2action.User
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationUsersByRoleReturnsOnCall[i] = struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSecurityGroupByName(securityGroupName string) (v2action.SecurityGroup, v2action.Warnings, error) {
+	fake.getSecurityGroupByNameMutex.Lock()
+	ret, specificReturn := fake.getSecurityGroupByNameReturnsOnCall[len(fake.getSecurityGroupByNameArgsForCall)]
+	fake.getSecurityGroupByNameArgsForCall = append(fake.getSecurityGroupByNameArgsForCall, struct {
+		securityGroupName string
+	}{securityGroupName})
+	fake.recordInvocation("GetSecurityGroupByName", []interface{}{securityGroupName})
+	fake.getSecurityGroupByNameMutex.Unlock()
+	if fake.GetSecurityGroupByNameStub != nil {
+		return fake.GetSecurityGroupByNameStub(securityGroupName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSecurityGroupByNameReturns.result1, fake.getSecurityGroupByNameReturns.result2, fake.getSecurityGroupByNameReturns.result3
+}
+
+func (fake *FakeV2Actor) GetSecurityGroupByNameCallCount() int {
+	fake.getSecurityGroupByNameMutex.RLock()
+	defer fake.getSecurityGroupByNameMutex.RUnlock()
+	return len(fake.getSecurityGroupByNameArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetSecurityGroupByNameArgsForCall(i int) string {
+	fake.getSecurityGroupByNameMutex.RLock()
+	defer fake.getSecurityGroupByNameMutex.RUnlock()
+	return fake.getSecurityGroupByNameArgsForCall[i].securityGroupName
+}
+
+func (fake *FakeV2Actor) GetSecurityGroupByNameReturns(result1 v2action.SecurityGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetSecurityGroupByNameStub = nil
+	fake.getSecurityGroupByNameReturns = struct {
+		result1 v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSecurityGroupByNameReturnsOnCall(i int, result1 v2action.SecurityGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetSecurityGroupByNameStub = nil
+	if fake.getSecurityGroupByNameReturnsOnCall == nil {
+		fake.getSecurityGroupByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.SecurityGroup
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSecurityGroupByNameReturnsOnCall[i] = struct {
+		result1 v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error) {
+	fake.getSpaceByOrganizationAndNameMutex.Lock()
+	ret, specificReturn := fake.getSpaceByOrganizationAndNameReturnsOnCall[len(fake.getSpaceByOrganizationAndNameArgsForCall)]
+	fake.getSpaceByOrganizationAndNameArgsForCall = append(fake.getSpaceByOrganizationAndNameArgsForCall, struct {
+		orgGUID   string
+		spaceName string
+	}{orgGUID, spaceName})
+	fake.recordInvocation("GetSpaceByOrganizationAndName", []interface{}{orgGUID, spaceName})
+	fake.getSpaceByOrganizationAndNameMutex.Unlock()
+	if fake.GetSpaceByOrganizationAndNameStub != nil {
+		return fake.GetSpaceByOrganizationAndNameStub(orgGUID, spaceName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceByOrganizationAndNameReturns.result1, fake.getSpaceByOrganizationAndNameReturns.result2, fake.getSpaceByOrganizationAndNameReturns.result3
+}
+
+func (fake *FakeV2Actor) GetSpaceByOrganizationAndNameCallCount() int {
+	fake.getSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	return len(fake.getSpaceByOrganizationAndNameArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetSpaceByOrganizationAndNameArgsForCall(i int) (string, string) {
+	fake.getSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	return fake.getSpaceByOrganizationAndNameArgsForCall[i].orgGUID, fake.getSpaceByOrganizationAndNameArgsForCall[i].spaceName
+}
+
+func (fake *FakeV2Actor) GetSpaceByOrganizationAndNameReturns(result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceByOrganizationAndNameStub = nil
+	fake.getSpaceByOrganizationAndNameReturns = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceByOrganizationAndNameReturnsOnCall(i int, result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceByOrganizationAndNameStub = nil
+	if fake.getSpaceByOrganizationAndNameReturnsOnCall == nil {
+		fake.getSpaceByOrganizationAndNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Space
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceByOrganizationAndNameReturnsOnCall[i] = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceRunningSecurityGroupsBySpace(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error) {
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[len(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall)]
+	fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall = append(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceRunningSecurityGroupsBySpace", []interface{}{spaceGUID})
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.Unlock()
+	if fake.GetSpaceRunningSecurityGroupsBySpaceStub != nil {
+		return fake.GetSpaceRunningSecurityGroupsBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceRunningSecurityGroupsBySpaceReturns.result1, fake.getSpaceRunningSecurityGroupsBySpaceReturns.result2, fake.getSpaceRunningSecurityGroupsBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetSpaceRunningSecurityGroupsBySpaceCallCount() int {
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
+	return len(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetSpaceRunningSecurityGroupsBySpaceArgsForCall(i int) string {
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
+	return fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetSpaceRunningSecurityGroupsBySpaceReturns(result1 []v2action.SecurityGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
+	fake.getSpaceRunningSecurityGroupsBySpaceReturns = struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceRunningSecurityGroupsBySpaceReturnsOnCall(i int, result1 []v2action.SecurityGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
+	if fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall == nil {
+		fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.SecurityGroup
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceUsersByRole(role v2action.SpaceRole, spaceGUID string) ([]v2action.User, v2action.Warnings, error) {
+	fake.getSpaceUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsersByRoleReturnsOnCall[len(fake.getSpaceUsersByRoleArgsForCall)]
+	fake.getSpaceUsersByRoleArgsForCall = append(fake.getSpaceUsersByRoleArgsForCall, struct {
+		role      v2action.SpaceRole
+		spaceGUID string
+	}{role, spaceGUID})
+	fake.recordInvocation("GetSpaceUsersByRole", []interface{}{role, spaceGUID})
+	fake.getSpaceUsersByRoleMutex.Unlock()
+	if fake.GetSpaceUsersByRoleStub != nil {
+		return fake.GetSpaceUsersByRoleStub(role, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceUsersByRoleReturns.result1, fake.getSpaceUsersByRoleReturns.result2, fake.getSpaceUsersByRoleReturns.result3
+}
+
+func (fake *FakeV2Actor) GetSpaceUsersByRoleCallCount() int {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return len(fake.getSpaceUsersByRoleArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetSpaceUsersByRoleArgsForCall(i int) (v2action.SpaceRole, string) {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return fake.getSpaceUsersByRoleArgsForCall[i].role, fake.getSpaceUsersByRoleArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetSpaceUsersByRoleReturns(result1 []v2action.User, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	fake.getSpaceUsersByRoleReturns = struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceUsersByRoleReturnsOnCall(i int, result1 []v2action.User, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	if fake.getSpaceUsersByRoleReturnsOnCall == nil {
+		fake.getSpaceUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []v2action.User
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceUsersByRoleReturnsOnCall[i] = struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) SetOrganizationRole(role v2action.OrganizationRole, orgGUID string, username string) (v2action.Warnings, error) {
+	fake.setOrganizationRoleMutex.Lock()
+	ret, specificReturn := fake.setOrganizationRoleReturnsOnCall[len(fake.setOrganizationRoleArgsForCall)]
+	fake.setOrganizationRoleArgsForCall = append(fake.setOrganizationRoleArgsForCall, struct {
+		role     v2action.OrganizationRole
+		orgGUID  string
+		username string
+	}{role, orgGUID, username})
+	fake.recordInvocation("SetOrganizationRole", []interface{}{role, orgGUID, username})
+	fake.setOrganizationRoleMutex.Unlock()
+	if fake.SetOrganizationRoleStub != nil {
+		return fake.SetOrganizationRoleStub(role, orgGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setOrganizationRoleReturns.result1, fake.setOrganizationRoleReturns.result2
+}
+
+func (fake *FakeV2Actor) SetOrganizationRoleCallCount() int {
+	fake.setOrganizationRoleMutex.RLock()
+	defer fake.setOrganizationRoleMutex.RUnlock()
+	return len(fake.setOrganizationRoleArgsForCall)
+}
+
+func (fake *FakeV2Actor) SetOrganizationRoleArgsForCall(i int) (v2action.OrganizationRole, string, string) {
+	fake.setOrganizationRoleMutex.RLock()
+	defer fake.setOrganizationRoleMutex.RUnlock()
+	return fake.setOrganizationRoleArgsForCall[i].role, fake.setOrganizationRoleArgsForCall[i].orgGUID, fake.setOrganizationRoleArgsForCall[i].username
+}
+
+func (fake *FakeV2Actor) SetOrganizationRoleReturns(result1 v2action.Warnings, result2 error) {
+	fake.SetOrganizationRoleStub = nil
+	fake.setOrganizationRoleReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) SetOrganizationRoleReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.SetOrganizationRoleStub = nil
+	if fake.setOrganizationRoleReturnsOnCall == nil {
+		fake.setOrganizationRoleReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.setOrganizationRoleReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) SetSpaceRole(role v2action.SpaceRole, orgGUID string, spaceGUID string, username string) (v2action.Warnings, error) {
+	fake.setSpaceRoleMutex.Lock()
+	ret, specificReturn := fake.setSpaceRoleReturnsOnCall[len(fake.setSpaceRoleArgsForCall)]
+	fake.setSpaceRoleArgsForCall = append(fake.setSpaceRoleArgsForCall, struct {
+		role      v2action.SpaceRole
+		orgGUID   string
+		spaceGUID string
+		username  string
+	}{role, orgGUID, spaceGUID, username})
+	fake.recordInvocation("SetSpaceRole", []interface{}{role, orgGUID, spaceGUID, username})
+	fake.setSpaceRoleMutex.Unlock()
+	if fake.SetSpaceRoleStub != nil {
+		return fake.SetSpaceRoleStub(role, orgGUID, spaceGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setSpaceRoleReturns.result1, fake.setSpaceRoleReturns.result2
+}
+
+func (fake *FakeV2Actor) SetSpaceRoleCallCount() int {
+	fake.setSpaceRoleMutex.RLock()
+	defer fake.setSpaceRoleMutex.RUnlock()
+	return len(fake.setSpaceRoleArgsForCall)
+}
+
+func (fake *FakeV2Actor) SetSpaceRoleArgsForCall(i int) (v2action.SpaceRole, string, string, string) {
+	fake.setSpaceRoleMutex.RLock()
+	defer fake.setSpaceRoleMutex.RUnlock()
+	return fake.setSpaceRoleArgsForCall[i].role, fake.setSpaceRoleArgsForCall[i].orgGUID, fake.setSpaceRoleArgsForCall[i].spaceGUID, fake.setSpaceRoleArgsForCall[i].username
+}
+
+func (fake *FakeV2Actor) SetSpaceRoleReturns(result1 v2action.Warnings, result2 error) {
+	fake.SetSpaceRoleStub = nil
+	fake.setSpaceRoleReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) SetSpaceRoleReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.SetSpaceRoleStub = nil
+	if fake.setSpaceRoleReturnsOnCall == nil {
+		fake.setSpaceRoleReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.setSpaceRoleReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) UpdateOrganizationQuota(orgGUID string, quotaGUID string) (v2action.Warnings, error) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationQuotaReturnsOnCall[len(fake.updateOrganizationQuotaArgsForCall)]
+	fake.updateOrganizationQuotaArgsForCall = append(fake.updateOrganizationQuotaArgsForCall, struct {
+		orgGUID   string
+		quotaGUID string
+	}{orgGUID, quotaGUID})
+	fake.recordInvocation("UpdateOrganizationQuota", []interface{}{orgGUID, quotaGUID})
+	fake.updateOrganizationQuotaMutex.Unlock()
+	if fake.UpdateOrganizationQuotaStub != nil {
+		return fake.UpdateOrganizationQuotaStub(orgGUID, quotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationQuotaReturns.result1, fake.updateOrganizationQuotaReturns.result2
+}
+
+func (fake *FakeV2Actor) UpdateOrganizationQuotaCallCount() int {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return len(fake.updateOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeV2Actor) UpdateOrganizationQuotaArgsForCall(i int) (string, string) {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return fake.updateOrganizationQuotaArgsForCall[i].orgGUID, fake.updateOrganizationQuotaArgsForCall[i].quotaGUID
+}
+
+func (fake *FakeV2Actor) UpdateOrganizationQuotaReturns(result1 v2action.Warnings, result2 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	fake.updateOrganizationQuotaReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) UpdateOrganizationQuotaReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	if fake.updateOrganizationQuotaReturnsOnCall == nil {
+		fake.updateOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.bindSecurityGroupToSpaceMutex.RLock()
+	defer fake.bindSecurityGroupToSpaceMutex.RUnlock()
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	fake.getOrganizationQuotaByNameMutex.RLock()
+	defer fake.getOrganizationQuotaByNameMutex.RUnlock()
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	fake.getSecurityGroupByNameMutex.RLock()
+	defer fake.getSecurityGroupByNameMutex.RUnlock()
+	fake.getSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	fake.setOrganizationRoleMutex.RLock()
+	defer fake.setOrganizationRoleMutex.RUnlock()
+	fake.setSpaceRoleMutex.RLock()
+	defer fake.setSpaceRoleMutex.RUnlock()
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeV2Actor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ foundationaction.V2Actor = new(FakeV2Actor)
@@ -0,0 +1,385 @@
+// This file was generated by counterfeiter
+package foundationactionfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+)
+
+type FakeV3Actor struct {
+	AssignIsolationSegmentToSpaceByNameAndSpaceStub        func(isolationSegmentName string, spaceGUID string) (v3action.Warnings, error)
+	assignIsolationSegmentToSpaceByNameAndSpaceMutex       sync.RWMutex
+	assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall []struct {
+		isolationSegmentName string
+		spaceGUID            string
+	}
+	assignIsolationSegmentToSpaceByNameAndSpaceReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	EntitleIsolationSegmentToOrganizationByNameStub        func(isolationSegmentName string, orgName string) (v3action.Warnings, error)
+	entitleIsolationSegmentToOrganizationByNameMutex       sync.RWMutex
+	entitleIsolationSegmentToOrganizationByNameArgsForCall []struct {
+		isolationSegmentName string
+		orgName              string
+	}
+	entitleIsolationSegmentToOrganizationByNameReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	entitleIsolationSegmentToOrganizationByNameReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	GetEffectiveIsolationSegmentBySpaceStub        func(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.IsolationSegment, v3action.Warnings, error)
+	getEffectiveIsolationSegmentBySpaceMutex       sync.RWMutex
+	getEffectiveIsolationSegmentBySpaceArgsForCall []struct {
+		spaceGUID                      string
+		orgDefaultIsolationSegmentGUID string
+	}
+	getEffectiveIsolationSegmentBySpaceReturns struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getEffectiveIsolationSegmentBySpaceReturnsOnCall map[int]struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetIsolationSegmentByNameStub        func(name string) (v3action.IsolationSegment, v3action.Warnings, error)
+	getIsolationSegmentByNameMutex       sync.RWMutex
+	getIsolationSegmentByNameArgsForCall []struct {
+		name string
+	}
+	getIsolationSegmentByNameReturns struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getIsolationSegmentByNameReturnsOnCall map[int]struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetIsolationSegmentsByOrganizationStub        func(orgGUID string) ([]v3action.IsolationSegment, v3action.Warnings, error)
+	getIsolationSegmentsByOrganizationMutex       sync.RWMutex
+	getIsolationSegmentsByOrganizationArgsForCall []struct {
+		orgGUID string
+	}
+	getIsolationSegmentsByOrganizationReturns struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getIsolationSegmentsByOrganizationReturnsOnCall map[int]struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV3Actor) AssignIsolationSegmentToSpaceByNameAndSpace(isolationSegmentName string, spaceGUID string) (v3action.Warnings, error) {
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall[len(fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall)]
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall = append(fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall, struct {
+		isolationSegmentName string
+		spaceGUID            string
+	}{isolationSegmentName, spaceGUID})
+	fake.recordInvocation("AssignIsolationSegmentToSpaceByNameAndSpace", []interface{}{isolationSegmentName, spaceGUID})
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.Unlock()
+	if fake.AssignIsolationSegmentToSpaceByNameAndSpaceStub != nil {
+		return fake.AssignIsolationSegmentToSpaceByNameAndSpaceStub(isolationSegmentName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.assignIsolationSegmentToSpaceByNameAndSpaceReturns.result1, fake.assignIsolationSegmentToSpaceByNameAndSpaceReturns.result2
+}
+
+func (fake *FakeV3Actor) AssignIsolationSegmentToSpaceByNameAndSpaceCallCount() int {
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RLock()
+	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RUnlock()
+	return len(fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3Actor) AssignIsolationSegmentToSpaceByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RLock()
+	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RUnlock()
+	return fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall[i].isolationSegmentName, fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV3Actor) AssignIsolationSegmentToSpaceByNameAndSpaceReturns(result1 v3action.Warnings, result2 error) {
+	fake.AssignIsolationSegmentToSpaceByNameAndSpaceStub = nil
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) AssignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.AssignIsolationSegmentToSpaceByNameAndSpaceStub = nil
+	if fake.assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall == nil {
+		fake.assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) EntitleIsolationSegmentToOrganizationByName(isolationSegmentName string, orgName string) (v3action.Warnings, error) {
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.Lock()
+	ret, specificReturn := fake.entitleIsolationSegmentToOrganizationByNameReturnsOnCall[len(fake.entitleIsolationSegmentToOrganizationByNameArgsForCall)]
+	fake.entitleIsolationSegmentToOrganizationByNameArgsForCall = append(fake.entitleIsolationSegmentToOrganizationByNameArgsForCall, struct {
+		isolationSegmentName string
+		orgName              string
+	}{isolationSegmentName, orgName})
+	fake.recordInvocation("EntitleIsolationSegmentToOrganizationByName", []interface{}{isolationSegmentName, orgName})
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.Unlock()
+	if fake.EntitleIsolationSegmentToOrganizationByNameStub != nil {
+		return fake.EntitleIsolationSegmentToOrganizationByNameStub(isolationSegmentName, orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.entitleIsolationSegmentToOrganizationByNameReturns.result1, fake.entitleIsolationSegmentToOrganizationByNameReturns.result2
+}
+
+func (fake *FakeV3Actor) EntitleIsolationSegmentToOrganizationByNameCallCount() int {
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.RLock()
+	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.RUnlock()
+	return len(fake.entitleIsolationSegmentToOrganizationByNameArgsForCall)
+}
+
+func (fake *FakeV3Actor) EntitleIsolationSegmentToOrganizationByNameArgsForCall(i int) (string, string) {
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.RLock()
+	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.RUnlock()
+	return fake.entitleIsolationSegmentToOrganizationByNameArgsForCall[i].isolationSegmentName, fake.entitleIsolationSegmentToOrganizationByNameArgsForCall[i].orgName
+}
+
+func (fake *FakeV3Actor) EntitleIsolationSegmentToOrganizationByNameReturns(result1 v3action.Warnings, result2 error) {
+	fake.EntitleIsolationSegmentToOrganizationByNameStub = nil
+	fake.entitleIsolationSegmentToOrganizationByNameReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) EntitleIsolationSegmentToOrganizationByNameReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.EntitleIsolationSegmentToOrganizationByNameStub = nil
+	if fake.entitleIsolationSegmentToOrganizationByNameReturnsOnCall == nil {
+		fake.entitleIsolationSegmentToOrganizationByNameReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.entitleIsolationSegmentToOrganizationByNameReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.Lock()
+	ret, specificReturn := fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall[len(fake.getEffectiveIsolationSegmentBySpaceArgsForCall)]
+	fake.getEffectiveIsolationSegmentBySpaceArgsForCall = append(fake.getEffectiveIsolationSegmentBySpaceArgsForCall, struct {
+		spaceGUID                      string
+		orgDefaultIsolationSegmentGUID string
+	}{spaceGUID, orgDefaultIsolationSegmentGUID})
+	fake.recordInvocation("GetEffectiveIsolationSegmentBySpace", []interface{}{spaceGUID, orgDefaultIsolationSegmentGUID})
+	fake.getEffectiveIsolationSegmentBySpaceMutex.Unlock()
+	if fake.GetEffectiveIsolationSegmentBySpaceStub != nil {
+		return fake.GetEffectiveIsolationSegmentBySpaceStub(spaceGUID, orgDefaultIsolationSegmentGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getEffectiveIsolationSegmentBySpaceReturns.result1, fake.getEffectiveIsolationSegmentBySpaceReturns.result2, fake.getEffectiveIsolationSegmentBySpaceReturns.result3
+}
+
+func (fake *FakeV3Actor) GetEffectiveIsolationSegmentBySpaceCallCount() int {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
+	return len(fake.getEffectiveIsolationSegmentBySpaceArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetEffectiveIsolationSegmentBySpaceArgsForCall(i int) (string, string) {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
+	return fake.getEffectiveIsolationSegmentBySpaceArgsForCall[i].spaceGUID, fake.getEffectiveIsolationSegmentBySpaceArgsForCall[i].orgDefaultIsolationSegmentGUID
+}
+
+func (fake *FakeV3Actor) GetEffectiveIsolationSegmentBySpaceReturns(result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetEffectiveIsolationSegmentBySpaceStub = nil
+	fake.getEffectiveIsolationSegmentBySpaceReturns = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetEffectiveIsolationSegmentBySpaceReturnsOnCall(i int, result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetEffectiveIsolationSegmentBySpaceStub = nil
+	if fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall == nil {
+		fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall[i] = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentByName(name string) (v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getIsolationSegmentByNameMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentByNameReturnsOnCall[len(fake.getIsolationSegmentByNameArgsForCall)]
+	fake.getIsolationSegmentByNameArgsForCall = append(fake.getIsolationSegmentByNameArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetIsolationSegmentByName", []interface{}{name})
+	fake.getIsolationSegmentByNameMutex.Unlock()
+	if fake.GetIsolationSegmentByNameStub != nil {
+		return fake.GetIsolationSegmentByNameStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getIsolationSegmentByNameReturns.result1, fake.getIsolationSegmentByNameReturns.result2, fake.getIsolationSegmentByNameReturns.result3
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentByNameCallCount() int {
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	return len(fake.getIsolationSegmentByNameArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentByNameArgsForCall(i int) string {
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	return fake.getIsolationSegmentByNameArgsForCall[i].name
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentByNameReturns(result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentByNameStub = nil
+	fake.getIsolationSegmentByNameReturns = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentByNameReturnsOnCall(i int, result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentByNameStub = nil
+	if fake.getIsolationSegmentByNameReturnsOnCall == nil {
+		fake.getIsolationSegmentByNameReturnsOnCall = make(map[int]struct {
+			result1 v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentByNameReturnsOnCall[i] = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentsByOrganization(orgGUID string) ([]v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getIsolationSegmentsByOrganizationMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentsByOrganizationReturnsOnCall[len(fake.getIsolationSegmentsByOrganizationArgsForCall)]
+	fake.getIsolationSegmentsByOrganizationArgsForCall = append(fake.getIsolationSegmentsByOrganizationArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetIsolationSegmentsByOrganization", []interface{}{orgGUID})
+	fake.getIsolationSegmentsByOrganizationMutex.Unlock()
+	if fake.GetIsolationSegmentsByOrganizationStub != nil {
+		return fake.GetIsolationSegmentsByOrganizationStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getIsolationSegmentsByOrganizationReturns.result1, fake.getIsolationSegmentsByOrganizationReturns.result2, fake.getIsolationSegmentsByOrganizationReturns.result3
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentsByOrganizationCallCount() int {
+	fake.getIsolationSegmentsByOrganizationMutex.RLock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.RUnlock()
+	return len(fake.getIsolationSegmentsByOrganizationArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentsByOrganizationArgsForCall(i int) string {
+	fake.getIsolationSegmentsByOrganizationMutex.RLock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.RUnlock()
+	return fake.getIsolationSegmentsByOrganizationArgsForCall[i].orgGUID
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentsByOrganizationReturns(result1 []v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentsByOrganizationStub = nil
+	fake.getIsolationSegmentsByOrganizationReturns = struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentsByOrganizationReturnsOnCall(i int, result1 []v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentsByOrganizationStub = nil
+	if fake.getIsolationSegmentsByOrganizationReturnsOnCall == nil {
+		fake.getIsolationSegmentsByOrganizationReturnsOnCall = make(map[int]struct {
+			result1 []v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentsByOrganizationReturnsOnCall[i] = struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RLock()
+	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RUnlock()
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.RLock()
+	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.RUnlock()
+	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	fake.getIsolationSegmentsByOrganizationMutex.RLock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeV3Actor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ foundationaction.V3Actor = new(FakeV3Actor)
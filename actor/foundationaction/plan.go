@@ -0,0 +1,276 @@
+package foundationaction
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+)
+
+// ChangeType is the kind of change needed to bring a foundation in line with
+// its config. The values match the cf command that makes the same change.
+type ChangeType string
+
+const (
+	CreateOrganization          ChangeType = "create-org"
+	SetOrganizationQuota        ChangeType = "set-quota"
+	EnableOrganizationIsolation ChangeType = "enable-org-isolation"
+	SetOrganizationRole         ChangeType = "set-org-role"
+	CreateSpace                 ChangeType = "create-space"
+	SetSpaceIsolationSegment    ChangeType = "set-space-isolation-segment"
+	SetSpaceRole                ChangeType = "set-space-role"
+	BindSecurityGroup           ChangeType = "bind-security-group"
+)
+
+// Change is a single difference between a foundation and its config. Only the
+// fields relevant to the Type are set.
+type Change struct {
+	Type             ChangeType
+	Organization     string
+	Space            string
+	Quota            string
+	IsolationSegment string
+	SecurityGroup    string
+	Username         string
+	OrganizationRole v2action.OrganizationRole
+	SpaceRole        v2action.SpaceRole
+}
+
+// CreatePlan compares the config with the live state of the foundation and
+// returns the changes needed to apply it, in the order they must be applied.
+// Quotas, isolation segments and security groups referenced by the config
+// must already exist.
+func (actor Actor) CreatePlan(config Config) ([]Change, Warnings, error) {
+	var (
+		changes     []Change
+		allWarnings Warnings
+	)
+
+	for _, orgConfig := range config.Organizations {
+		orgChanges, warnings, err := actor.planOrganization(orgConfig)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		changes = append(changes, orgChanges...)
+	}
+
+	return changes, allWarnings, nil
+}
+
+func (actor Actor) planOrganization(orgConfig OrganizationConfig) ([]Change, Warnings, error) {
+	var (
+		changes     []Change
+		allWarnings Warnings
+	)
+
+	org, warnings, err := actor.V2Actor.GetOrganizationByName(orgConfig.Name)
+	allWarnings = append(allWarnings, warnings...)
+	orgExists := true
+	if _, ok := err.(v2action.OrganizationNotFoundError); ok {
+		orgExists = false
+		changes = append(changes, Change{Type: CreateOrganization, Organization: orgConfig.Name})
+	} else if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if orgConfig.Quota != "" {
+		quota, warnings, err := actor.V2Actor.GetOrganizationQuotaByName(orgConfig.Quota)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		if !orgExists || org.QuotaDefinitionGUID != quota.GUID {
+			changes = append(changes, Change{Type: SetOrganizationQuota, Organization: orgConfig.Name, Quota: orgConfig.Quota})
+		}
+	}
+
+	if len(orgConfig.IsolationSegments) > 0 {
+		var entitled []v3action.IsolationSegment
+		if orgExists {
+			var v3Warnings v3action.Warnings
+			entitled, v3Warnings, err = actor.V3Actor.GetIsolationSegmentsByOrganization(org.GUID)
+			allWarnings = append(allWarnings, v3Warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+		}
+
+		for _, name := range orgConfig.IsolationSegments {
+			_, v3Warnings, err := actor.V3Actor.GetIsolationSegmentByName(name)
+			allWarnings = append(allWarnings, v3Warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			if !containsIsolationSegment(entitled, name) {
+				changes = append(changes, Change{Type: EnableOrganizationIsolation, Organization: orgConfig.Name, IsolationSegment: name})
+			}
+		}
+	}
+
+	for _, assignment := range []struct {
+		role      v2action.OrganizationRole
+		usernames []string
+	}{
+		{v2action.OrganizationManagerRole, orgConfig.Managers},
+		{v2action.OrganizationBillingManagerRole, orgConfig.BillingManagers},
+		{v2action.OrganizationAuditorRole, orgConfig.Auditors},
+	} {
+		if len(assignment.usernames) == 0 {
+			continue
+		}
+
+		var current []v2action.User
+		if orgExists {
+			current, warnings, err = actor.V2Actor.GetOrganizationUsersByRole(assignment.role, org.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+		}
+
+		for _, username := range assignment.usernames {
+			if !containsUser(current, username) {
+				changes = append(changes, Change{Type: SetOrganizationRole, Organization: orgConfig.Name, Username: username, OrganizationRole: assignment.role})
+			}
+		}
+	}
+
+	for _, spaceConfig := range orgConfig.Spaces {
+		spaceChanges, warnings, err := actor.planSpace(org, orgExists, orgConfig.Name, spaceConfig)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		changes = append(changes, spaceChanges...)
+	}
+
+	return changes, allWarnings, nil
+}
+
+func (actor Actor) planSpace(org v2action.Organization, orgExists bool, orgName string, spaceConfig SpaceConfig) ([]Change, Warnings, error) {
+	var (
+		changes     []Change
+		allWarnings Warnings
+		space       v2action.Space
+	)
+
+	spaceExists := false
+	if orgExists {
+		var (
+			warnings v2action.Warnings
+			err      error
+		)
+		space, warnings, err = actor.V2Actor.GetSpaceByOrganizationAndName(org.GUID, spaceConfig.Name)
+		allWarnings = append(allWarnings, warnings...)
+		if err == nil {
+			spaceExists = true
+		} else if _, ok := err.(v2action.SpaceNotFoundError); !ok {
+			return nil, allWarnings, err
+		}
+	}
+	if !spaceExists {
+		changes = append(changes, Change{Type: CreateSpace, Organization: orgName, Space: spaceConfig.Name})
+	}
+
+	if spaceConfig.IsolationSegment != "" {
+		_, warnings, err := actor.V3Actor.GetIsolationSegmentByName(spaceConfig.IsolationSegment)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		var current v3action.IsolationSegment
+		if spaceExists {
+			current, warnings, err = actor.V3Actor.GetEffectiveIsolationSegmentBySpace(space.GUID, "")
+			allWarnings = append(allWarnings, warnings...)
+			if _, ok := err.(v3action.NoRelationshipError); !ok && err != nil {
+				return nil, allWarnings, err
+			}
+		}
+		if current.Name != spaceConfig.IsolationSegment {
+			changes = append(changes, Change{Type: SetSpaceIsolationSegment, Organization: orgName, Space: spaceConfig.Name, IsolationSegment: spaceConfig.IsolationSegment})
+		}
+	}
+
+	for _, assignment := range []struct {
+		role      v2action.SpaceRole
+		usernames []string
+	}{
+		{v2action.SpaceManagerRole, spaceConfig.Managers},
+		{v2action.SpaceDeveloperRole, spaceConfig.Developers},
+		{v2action.SpaceAuditorRole, spaceConfig.Auditors},
+	} {
+		if len(assignment.usernames) == 0 {
+			continue
+		}
+
+		var current []v2action.User
+		if spaceExists {
+			users, warnings, err := actor.V2Actor.GetSpaceUsersByRole(assignment.role, space.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			current = users
+		}
+
+		for _, username := range assignment.usernames {
+			if !containsUser(current, username) {
+				changes = append(changes, Change{Type: SetSpaceRole, Organization: orgName, Space: spaceConfig.Name, Username: username, SpaceRole: assignment.role})
+			}
+		}
+	}
+
+	if len(spaceConfig.SecurityGroups) > 0 {
+		var bound []v2action.SecurityGroup
+		if spaceExists {
+			securityGroups, warnings, err := actor.V2Actor.GetSpaceRunningSecurityGroupsBySpace(space.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			bound = securityGroups
+		}
+
+		for _, name := range spaceConfig.SecurityGroups {
+			_, warnings, err := actor.V2Actor.GetSecurityGroupByName(name)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			if !containsSecurityGroup(bound, name) {
+				changes = append(changes, Change{Type: BindSecurityGroup, Organization: orgName, Space: spaceConfig.Name, SecurityGroup: name})
+			}
+		}
+	}
+
+	return changes, allWarnings, nil
+}
+
+func containsIsolationSegment(isolationSegments []v3action.IsolationSegment, name string) bool {
+	for _, isolationSegment := range isolationSegments {
+		if isolationSegment.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsUser(users []v2action.User, username string) bool {
+	for _, user := range users {
+		if strings.EqualFold(user.Username, username) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsSecurityGroup(securityGroups []v2action.SecurityGroup, name string) bool {
+	for _, securityGroup := range securityGroups {
+		if securityGroup.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package foundationaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/foundationaction/foundationactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CreatePlan", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *foundationactionfakes.FakeV2Actor
+		fakeV3Actor *foundationactionfakes.FakeV3Actor
+
+		config   Config
+		changes  []Change
+		warnings Warnings
+		err      error
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(foundationactionfakes.FakeV2Actor)
+		fakeV3Actor = new(foundationactionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+
+		config = Config{
+			Organizations: []OrganizationConfig{
+				{
+					Name:              "some-org",
+					Quota:             "some-quota",
+					IsolationSegments: []string{"some-segment"},
+					Managers:          []string{"alice"},
+					Spaces: []SpaceConfig{
+						{
+							Name:             "some-space",
+							IsolationSegment: "some-segment",
+							Developers:       []string{"bob", "carol"},
+							SecurityGroups:   []string{"some-security-group"},
+						},
+					},
+				},
+			},
+		}
+
+		fakeV2Actor.GetOrganizationQuotaByNameReturns(v2action.OrganizationQuota{GUID: "some-quota-guid", Name: "some-quota"}, v2action.Warnings{"quota-warning"}, nil)
+		fakeV3Actor.GetIsolationSegmentByNameReturns(v3action.IsolationSegment{GUID: "some-segment-guid", Name: "some-segment"}, v3action.Warnings{"segment-warning"}, nil)
+		fakeV2Actor.GetSecurityGroupByNameReturns(v2action.SecurityGroup{GUID: "some-security-group-guid", Name: "some-security-group"}, v2action.Warnings{"security-group-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		changes, warnings, err = actor.CreatePlan(config)
+	})
+
+	Context("when the org does not exist", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{}, v2action.Warnings{"org-warning"}, v2action.OrganizationNotFoundError{Name: "some-org"})
+		})
+
+		It("plans to create everything in the config", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(Equal([]Change{
+				{Type: CreateOrganization, Organization: "some-org"},
+				{Type: SetOrganizationQuota, Organization: "some-org", Quota: "some-quota"},
+				{Type: EnableOrganizationIsolation, Organization: "some-org", IsolationSegment: "some-segment"},
+				{Type: SetOrganizationRole, Organization: "some-org", Username: "alice", OrganizationRole: v2action.OrganizationManagerRole},
+				{Type: CreateSpace, Organization: "some-org", Space: "some-space"},
+				{Type: SetSpaceIsolationSegment, Organization: "some-org", Space: "some-space", IsolationSegment: "some-segment"},
+				{Type: SetSpaceRole, Organization: "some-org", Space: "some-space", Username: "bob", SpaceRole: v2action.SpaceDeveloperRole},
+				{Type: SetSpaceRole, Organization: "some-org", Space: "some-space", Username: "carol", SpaceRole: v2action.SpaceDeveloperRole},
+				{Type: BindSecurityGroup, Organization: "some-org", Space: "some-space", SecurityGroup: "some-security-group"},
+			}))
+			Expect(warnings).To(ConsistOf("org-warning", "quota-warning", "segment-warning", "segment-warning", "security-group-warning"))
+
+			Expect(fakeV2Actor.GetSpaceByOrganizationAndNameCallCount()).To(Equal(0))
+			Expect(fakeV2Actor.GetOrganizationUsersByRoleCallCount()).To(Equal(0))
+			Expect(fakeV2Actor.GetSpaceUsersByRoleCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the org and space exist", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "some-quota-guid"}, v2action.Warnings{"org-warning"}, nil)
+			fakeV3Actor.GetIsolationSegmentsByOrganizationReturns([]v3action.IsolationSegment{{Name: "some-segment"}}, v3action.Warnings{"entitlement-warning"}, nil)
+			fakeV2Actor.GetOrganizationUsersByRoleReturns([]v2action.User{{Username: "Alice"}}, v2action.Warnings{"org-users-warning"}, nil)
+			fakeV2Actor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "some-space-guid", Name: "some-space"}, v2action.Warnings{"space-warning"}, nil)
+			fakeV3Actor.GetEffectiveIsolationSegmentBySpaceReturns(v3action.IsolationSegment{}, v3action.Warnings{"space-segment-warning"}, v3action.NoRelationshipError{})
+			fakeV2Actor.GetSpaceUsersByRoleReturns([]v2action.User{{Username: "bob"}}, v2action.Warnings{"space-users-warning"}, nil)
+			fakeV2Actor.GetSpaceRunningSecurityGroupsBySpaceReturns([]v2action.SecurityGroup{{Name: "some-security-group"}}, v2action.Warnings{"bound-warning"}, nil)
+		})
+
+		It("plans only the differences", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(Equal([]Change{
+				{Type: SetSpaceIsolationSegment, Organization: "some-org", Space: "some-space", IsolationSegment: "some-segment"},
+				{Type: SetSpaceRole, Organization: "some-org", Space: "some-space", Username: "carol", SpaceRole: v2action.SpaceDeveloperRole},
+			}))
+			Expect(warnings).To(ContainElement("space-segment-warning"))
+
+			Expect(fakeV3Actor.GetIsolationSegmentsByOrganizationArgsForCall(0)).To(Equal("some-org-guid"))
+			role, orgGUID := fakeV2Actor.GetOrganizationUsersByRoleArgsForCall(0)
+			Expect(role).To(Equal(v2action.OrganizationManagerRole))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			spaceGUID, orgDefault := fakeV3Actor.GetEffectiveIsolationSegmentBySpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(orgDefault).To(BeEmpty())
+		})
+	})
+
+	Context("when a referenced quota does not exist", func() {
+		BeforeEach(func() {
+			fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{GUID: "some-org-guid"}, nil, nil)
+			fakeV2Actor.GetOrganizationQuotaByNameReturns(v2action.OrganizationQuota{}, v2action.Warnings{"quota-warning"}, v2action.OrganizationQuotaNotFoundError{Name: "some-quota"})
+		})
+
+		It("returns the error and all warnings", func() {
+			Expect(err).To(MatchError(v2action.OrganizationQuotaNotFoundError{Name: "some-quota"}))
+			Expect(warnings).To(ConsistOf("quota-warning"))
+		})
+	})
+
+	Context("when looking up the org fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{}, v2action.Warnings{"org-warning"}, expectedErr)
+		})
+
+		It("returns the error and all warnings", func() {
+			Expect(err).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("org-warning"))
+		})
+	})
+})
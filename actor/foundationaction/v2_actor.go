@@ -0,0 +1,21 @@
+package foundationaction
+
+import "code.cloudfoundry.org/cli/actor/v2action"
+
+//go:generate counterfeiter . V2Actor
+
+type V2Actor interface {
+	BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string) (v2action.Warnings, error)
+	CreateOrganization(orgName string) (v2action.Organization, v2action.Warnings, error)
+	CreateSpace(spaceName string, orgGUID string) (v2action.Space, v2action.Warnings, error)
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetOrganizationQuotaByName(name string) (v2action.OrganizationQuota, v2action.Warnings, error)
+	GetOrganizationUsersByRole(role v2action.OrganizationRole, orgGUID string) ([]v2action.User, v2action.Warnings, error)
+	GetSecurityGroupByName(securityGroupName string) (v2action.SecurityGroup, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	GetSpaceRunningSecurityGroupsBySpace(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error)
+	GetSpaceUsersByRole(role v2action.SpaceRole, spaceGUID string) ([]v2action.User, v2action.Warnings, error)
+	SetOrganizationRole(role v2action.OrganizationRole, orgGUID string, username string) (v2action.Warnings, error)
+	SetSpaceRole(role v2action.SpaceRole, orgGUID string, spaceGUID string, username string) (v2action.Warnings, error)
+	UpdateOrganizationQuota(orgGUID string, quotaGUID string) (v2action.Warnings, error)
+}
@@ -0,0 +1,13 @@
+package foundationaction
+
+import "code.cloudfoundry.org/cli/actor/v3action"
+
+//go:generate counterfeiter . V3Actor
+
+type V3Actor interface {
+	AssignIsolationSegmentToSpaceByNameAndSpace(isolationSegmentName string, spaceGUID string) (v3action.Warnings, error)
+	EntitleIsolationSegmentToOrganizationByName(isolationSegmentName string, orgName string) (v3action.Warnings, error)
+	GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.IsolationSegment, v3action.Warnings, error)
+	GetIsolationSegmentByName(name string) (v3action.IsolationSegment, v3action.Warnings, error)
+	GetIsolationSegmentsByOrganization(orgGUID string) ([]v3action.IsolationSegment, v3action.Warnings, error)
+}
@@ -2,17 +2,21 @@
 // push.
 package pushaction
 
+import "code.cloudfoundry.org/cli/util/words/generator"
+
 // Warnings is a list of warnings returned back from the cloud controller
 type Warnings []string
 
 // Actor handles all business logic for Cloud Controller v2 operations.
 type Actor struct {
-	V2Actor V2Actor
+	V2Actor       V2Actor
+	WordGenerator generator.WordGenerator
 }
 
 // NewActor returns a new actor.
 func NewActor(v2Actor V2Actor) *Actor {
 	return &Actor{
-		V2Actor: v2Actor,
+		V2Actor:       v2Actor,
+		WordGenerator: generator.NewWordGenerator(),
 	}
 }
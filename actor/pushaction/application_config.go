@@ -15,6 +15,10 @@ type ApplicationConfig struct {
 
 	TargetedSpaceGUID string
 	Path              string
+
+	// NoRoute is set when the application should not have any routes. The
+	// current routes are unbound when the config is applied.
+	NoRoute bool
 }
 
 func (actor Actor) ConvertToApplicationConfig(orgGUID string, spaceGUID string, apps []manifest.Application) ([]ApplicationConfig, Warnings, error) {
@@ -57,13 +61,22 @@ func (actor Actor) ConvertToApplicationConfig(orgGUID string, spaceGUID string,
 			config.DesiredApplication.SpaceGUID = spaceGUID
 		}
 
-		defaultRoute, routeWarnings, err := actor.GetRouteWithDefaultDomain(app.Name, orgGUID, spaceGUID)
-		warnings = append(warnings, routeWarnings...)
-		if err != nil {
-			log.Errorln("getting default route:", err)
-			return nil, warnings, err
+		switch {
+		case app.NoRoute:
+			log.Debug("no-route set, removing all routes")
+			config.NoRoute = true
+		case app.RandomRoute && len(config.CurrentRoutes) > 0:
+			log.Debug("random-route set and app has routes, keeping existing routes")
+			config.DesiredRoutes = config.CurrentRoutes
+		default:
+			route, routeWarnings, err := actor.GetRouteForApplication(app, orgGUID, spaceGUID, config.CurrentRoutes)
+			warnings = append(warnings, routeWarnings...)
+			if err != nil {
+				log.Errorln("getting route:", err)
+				return nil, warnings, err
+			}
+			config.DesiredRoutes = []v2action.Route{route}
 		}
-		config.DesiredRoutes = []v2action.Route{defaultRoute}
 
 		configs = append(configs, config)
 	}
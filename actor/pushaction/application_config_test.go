@@ -196,5 +196,35 @@ var _ = Describe("Application Config", func() {
 				Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings", "get-route-warnings"))
 			})
 		})
+
+		Context("when no-route is set", func() {
+			BeforeEach(func() {
+				manifestApps[0].NoRoute = true
+			})
+
+			It("does not look up a route and marks the config to remove routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(firstConfig.NoRoute).To(BeTrue())
+				Expect(firstConfig.DesiredRoutes).To(BeEmpty())
+				Expect(fakeV2Actor.GetOrganizationDomainsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when random-route is set and the application has routes", func() {
+			var existingRoutes []v2action.Route
+
+			BeforeEach(func() {
+				manifestApps[0].RandomRoute = true
+				existingRoutes = []v2action.Route{{GUID: "some-route-guid", Host: "some-app-striped-llama", Domain: domain}}
+				fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid"}, nil, nil)
+				fakeV2Actor.GetApplicationRoutesReturns(existingRoutes, nil, nil)
+			})
+
+			It("keeps the existing routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(firstConfig.DesiredRoutes).To(Equal(existingRoutes))
+				Expect(fakeV2Actor.CheckRouteCallCount()).To(Equal(0))
+			})
+		})
 	})
 })
@@ -18,7 +18,7 @@ func (actor Actor) Apply(config ApplicationConfig) (<-chan Event, <-chan Warning
 
 		if config.DesiredApplication.GUID != "" {
 			log.Debugf("updating application: %#v", config.DesiredApplication)
-			app, warnings, err := actor.V2Actor.UpdateApplication(config.DesiredApplication)
+			app, warnings, err := actor.V2Actor.UpdateApplication(applicationUpdate(config))
 			warningsStream <- Warnings(warnings)
 			if err != nil {
 				log.Errorln("updating application:", err)
@@ -47,7 +47,8 @@ func (actor Actor) Apply(config ApplicationConfig) (<-chan Event, <-chan Warning
 		for _, route := range config.DesiredRoutes {
 			if route.GUID == "" {
 				log.Debugf("creating route: %#v", route)
-				createdRoute, warnings, err := actor.V2Actor.CreateRoute(route, false)
+				generatePort := route.Domain.IsTCP() && route.Port == 0
+				createdRoute, warnings, err := actor.V2Actor.CreateRoute(route, generatePort)
 				warningsStream <- Warnings(warnings)
 				if err != nil {
 					log.Errorln("creating route:", err)
@@ -86,12 +87,27 @@ func (actor Actor) Apply(config ApplicationConfig) (<-chan Event, <-chan Warning
 			}
 		}
 		log.Debug("binding routes complete")
-		config.CurrentRoutes = config.DesiredRoutes
 
 		if boundRoutesMessage {
 			eventStream <- RouteBound
 		}
 
+		if config.NoRoute && len(config.CurrentRoutes) > 0 {
+			log.Info("unbinding routes")
+			for _, route := range config.CurrentRoutes {
+				log.Debugf("unbinding route: %#v", route)
+				warnings, err := actor.V2Actor.UnbindRouteFromApplication(route.GUID, config.DesiredApplication.GUID)
+				warningsStream <- Warnings(warnings)
+				if err != nil {
+					log.Errorln("unbinding route:", err)
+					errorStream <- err
+					return
+				}
+			}
+			eventStream <- RoutesUnbound
+		}
+		config.CurrentRoutes = config.DesiredRoutes
+
 		log.Debug("completed apply")
 		eventStream <- Complete
 	}()
@@ -105,3 +121,18 @@ func (actor Actor) bindRouteToApp(route v2action.Route, appGUID string) (v2actio
 	}
 	return warnings, err
 }
+
+// applicationUpdate returns the desired application without the settings that
+// are unchanged from the current application, so that values read from the
+// Cloud Controller, such as the number of instances or the stack, are not sent
+// back with the update.
+func applicationUpdate(config ApplicationConfig) v2action.Application {
+	app := config.DesiredApplication
+	if app.Instances == config.CurrentApplication.Instances {
+		app.Instances = 0
+	}
+	if app.StackGUID == config.CurrentApplication.StackGUID {
+		app.StackGUID = ""
+	}
+	return app
+}
@@ -6,6 +6,7 @@ import (
 	. "code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -52,12 +53,16 @@ var _ = Describe("Apply", func() {
 				GUID:      "some-app-guid",
 				SpaceGUID: "some-space-guid",
 				Buildpack: "java",
+				Instances: 2,
+				StackGUID: "some-stack-guid",
 			}
 			config.DesiredApplication = v2action.Application{
 				Name:      "some-app-name",
 				GUID:      "some-app-guid",
 				SpaceGUID: "some-space-guid",
 				Buildpack: "ruby",
+				Instances: 2,
+				StackGUID: "some-stack-guid",
 			}
 		})
 
@@ -79,6 +84,34 @@ var _ = Describe("Apply", func() {
 					Buildpack: "ruby",
 				}))
 			})
+
+			Context("when the number of instances changes", func() {
+				BeforeEach(func() {
+					config.DesiredApplication.Instances = 3
+				})
+
+				It("sends the number of instances", func() {
+					Eventually(warningsStream).Should(Receive(ConsistOf("update-warning")))
+					Eventually(eventStream).Should(Receive(Equal(ApplicationUpdated)))
+					Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+					Expect(fakeV2Actor.UpdateApplicationArgsForCall(0).Instances).To(Equal(3))
+				})
+			})
+
+			Context("when the stack changes", func() {
+				BeforeEach(func() {
+					config.DesiredApplication.StackGUID = "some-other-stack-guid"
+				})
+
+				It("sends the stack", func() {
+					Eventually(warningsStream).Should(Receive(ConsistOf("update-warning")))
+					Eventually(eventStream).Should(Receive(Equal(ApplicationUpdated)))
+					Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+					Expect(fakeV2Actor.UpdateApplicationArgsForCall(0).StackGUID).To(Equal("some-other-stack-guid"))
+				})
+			})
 		})
 
 		Context("when the update errors", func() {
@@ -294,6 +327,81 @@ var _ = Describe("Apply", func() {
 		})
 	})
 
+	Context("when a route on a TCP domain has no port", func() {
+		BeforeEach(func() {
+			// This will skip the binding step
+			config.CurrentRoutes = []v2action.Route{{GUID: ""}}
+			config.DesiredRoutes = []v2action.Route{
+				{Domain: v2action.Domain{Name: "tcp.domain.com", RouterGroupType: ccv2.TCPRouterGroup}},
+				{Host: "some-route", Domain: v2action.Domain{Name: "some-domain.com"}},
+			}
+			fakeV2Actor.CreateRouteReturns(v2action.Route{}, nil, nil)
+		})
+
+		It("creates it with a generated port", func() {
+			Eventually(warningsStream).Should(Receive())
+			Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
+			Eventually(warningsStream).Should(Receive())
+			Eventually(warningsStream).Should(Receive())
+			Eventually(eventStream).Should(Receive(Equal(RouteCreated)))
+			Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+			Expect(fakeV2Actor.CreateRouteCallCount()).To(Equal(2))
+			_, generatePort := fakeV2Actor.CreateRouteArgsForCall(0)
+			Expect(generatePort).To(BeTrue())
+			_, generatePort = fakeV2Actor.CreateRouteArgsForCall(1)
+			Expect(generatePort).To(BeFalse())
+		})
+	})
+
+	Context("when the application should not have routes", func() {
+		BeforeEach(func() {
+			config.NoRoute = true
+			config.DesiredApplication.GUID = "some-app-guid"
+			config.CurrentRoutes = []v2action.Route{
+				{GUID: "some-route-guid-1"},
+				{GUID: "some-route-guid-2"},
+			}
+
+			fakeV2Actor.UpdateApplicationReturns(v2action.Application{GUID: "some-app-guid"}, nil, nil)
+		})
+
+		Context("when the unbinding is successful", func() {
+			BeforeEach(func() {
+				fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-route-warning"}, nil)
+			})
+
+			It("unbinds the current routes", func() {
+				Eventually(warningsStream).Should(Receive())
+				Eventually(eventStream).Should(Receive(Equal(ApplicationUpdated)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("unbind-route-warning")))
+				Eventually(warningsStream).Should(Receive(ConsistOf("unbind-route-warning")))
+				Eventually(eventStream).Should(Receive(Equal(RoutesUnbound)))
+				Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+				Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(0))
+				Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(2))
+				routeGUID, appGUID := fakeV2Actor.UnbindRouteFromApplicationArgsForCall(1)
+				Expect(routeGUID).To(Equal("some-route-guid-2"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the unbinding errors", func() {
+			BeforeEach(func() {
+				fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-route-warning"}, errors.New("oh my"))
+			})
+
+			It("returns warnings and error and stops", func() {
+				Eventually(warningsStream).Should(Receive())
+				Eventually(eventStream).Should(Receive(Equal(ApplicationUpdated)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("unbind-route-warning")))
+				Eventually(errorStream).Should(Receive(MatchError("oh my")))
+				Consistently(eventStream).ShouldNot(Receive(Equal(RoutesUnbound)))
+			})
+		})
+	})
+
 	Context("when no routes need to be bound", func() {
 		It("returns warnings and error and stops", func() {
 			Eventually(warningsStream).Should(Receive())
@@ -0,0 +1,283 @@
+package pushaction
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	log "github.com/Sirupsen/logrus"
+)
+
+// CanaryConfig describes a canary release, in which traffic is moved from the
+// current application to the canary application by mapping the current
+// application's routes to the canary and shifting instances between the two.
+type CanaryConfig struct {
+	Current ApplicationConfig
+	Canary  ApplicationConfig
+
+	// TotalInstances is the number of instances shared by both applications
+	// while the release is in progress.
+	TotalInstances int
+
+	// OriginalInstances is the number of instances the current application
+	// had before the release, which it is scaled back to on abort.
+	OriginalInstances int
+}
+
+// CanaryStep is the split of instances between the current and canary
+// applications at a step of a canary release.
+type CanaryStep struct {
+	Percentage       int
+	CurrentInstances int
+	CanaryInstances  int
+}
+
+// CanaryHealth is the state of the canary application's instances.
+type CanaryHealth struct {
+	Running  int
+	Starting int
+	Crashed  int
+}
+
+// Failed returns true when any of the canary's instances have crashed.
+func (health CanaryHealth) Failed() bool {
+	return health.Crashed > 0
+}
+
+// ConvertToCanaryConfig looks up the current and canary applications and
+// their routes. The canary's desired routes are its own routes plus the
+// current application's routes. When totalInstances is 0, the current
+// application's instance count is used.
+func (actor Actor) ConvertToCanaryConfig(currentAppName string, canaryAppName string, spaceGUID string, totalInstances int) (CanaryConfig, Warnings, error) {
+	var allWarnings Warnings
+
+	current, warnings, err := actor.existingApplicationConfig(currentAppName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return CanaryConfig{}, allWarnings, err
+	}
+
+	canary, warnings, err := actor.existingApplicationConfig(canaryAppName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return CanaryConfig{}, allWarnings, err
+	}
+
+	canary.DesiredRoutes = append([]v2action.Route{}, canary.CurrentRoutes...)
+	for _, route := range current.CurrentRoutes {
+		if !actor.routeInList(route, canary.DesiredRoutes) {
+			canary.DesiredRoutes = append(canary.DesiredRoutes, route)
+		}
+	}
+
+	if totalInstances == 0 {
+		totalInstances = current.CurrentApplication.Instances
+	}
+
+	return CanaryConfig{
+		Current:           current,
+		Canary:            canary,
+		TotalInstances:    totalInstances,
+		OriginalInstances: current.CurrentApplication.Instances,
+	}, allWarnings, nil
+}
+
+func (actor Actor) existingApplicationConfig(appName string, spaceGUID string) (ApplicationConfig, Warnings, error) {
+	log.Infoln("searching for app", appName)
+	app, warnings, err := actor.V2Actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		log.Errorln("app lookup:", err)
+		return ApplicationConfig{}, Warnings(warnings), err
+	}
+
+	log.Info("looking up application routes")
+	routes, routeWarnings, err := actor.V2Actor.GetApplicationRoutes(app.GUID)
+	allWarnings := append(Warnings(warnings), routeWarnings...)
+	if err != nil {
+		log.Errorln("existing routes lookup:", err)
+		return ApplicationConfig{}, allWarnings, err
+	}
+
+	return ApplicationConfig{
+		CurrentApplication: app,
+		DesiredApplication: app,
+		CurrentRoutes:      routes,
+		DesiredRoutes:      routes,
+		TargetedSpaceGUID:  spaceGUID,
+	}, allWarnings, nil
+}
+
+// MapCanaryRoutes binds the current application's routes to the canary
+// application.
+func (actor Actor) MapCanaryRoutes(config CanaryConfig) (Warnings, error) {
+	var allWarnings Warnings
+	for _, route := range config.Canary.DesiredRoutes {
+		if actor.routeInList(route, config.Canary.CurrentRoutes) {
+			log.Debugf("route %s already bound to canary", route)
+			continue
+		}
+
+		log.Debugf("binding route: %#v", route)
+		warnings, err := actor.bindRouteToApp(route, config.Canary.DesiredApplication.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("binding route:", err)
+			return allWarnings, err
+		}
+	}
+	return allWarnings, nil
+}
+
+// calculateCanaryStep splits the total instances so that the canary runs the
+// given percentage of them, rounded up. Both applications keep at least one
+// instance until the canary is promoted or the release is aborted.
+func calculateCanaryStep(totalInstances int, percentage int) CanaryStep {
+	canaryInstances := (totalInstances*percentage + 99) / 100
+	if canaryInstances < 1 {
+		canaryInstances = 1
+	}
+
+	currentInstances := totalInstances - canaryInstances
+	if currentInstances < 1 {
+		currentInstances = 1
+	}
+
+	return CanaryStep{
+		Percentage:       percentage,
+		CurrentInstances: currentInstances,
+		CanaryInstances:  canaryInstances,
+	}
+}
+
+// ScaleCanary scales the canary up and then the current application down so
+// that the canary runs the given percentage of the total instances.
+func (actor Actor) ScaleCanary(config CanaryConfig, percentage int) (CanaryStep, Warnings, error) {
+	step := calculateCanaryStep(config.TotalInstances, percentage)
+	log.Debugf("scaling canary: %#v", step)
+
+	allWarnings, err := actor.scaleApplication(config.Canary.DesiredApplication.GUID, step.CanaryInstances)
+	if err != nil {
+		return CanaryStep{}, allWarnings, err
+	}
+
+	warnings, err := actor.scaleApplication(config.Current.DesiredApplication.GUID, step.CurrentInstances)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return CanaryStep{}, allWarnings, err
+	}
+
+	return step, allWarnings, nil
+}
+
+// GetCanaryHealth counts the canary's running, starting and crashed
+// instances. Flapping instances count as crashed.
+func (actor Actor) GetCanaryHealth(config CanaryConfig) (CanaryHealth, Warnings, error) {
+	instances, warnings, err := actor.V2Actor.GetApplicationInstancesWithStatsByApplication(config.Canary.DesiredApplication.GUID)
+	if _, ok := err.(v2action.ApplicationInstancesNotFoundError); ok {
+		return CanaryHealth{}, Warnings(warnings), nil
+	} else if err != nil {
+		return CanaryHealth{}, Warnings(warnings), err
+	}
+
+	var health CanaryHealth
+	for _, instance := range instances {
+		switch ccv2.ApplicationInstanceState(instance.State) {
+		case ccv2.ApplicationInstanceRunning:
+			health.Running++
+		case ccv2.ApplicationInstanceCrashed, ccv2.ApplicationInstanceFlapping:
+			health.Crashed++
+		default:
+			health.Starting++
+		}
+	}
+	return health, Warnings(warnings), nil
+}
+
+// PromoteCanary scales the canary to the total instances, unbinds the routes
+// from the current application and stops it.
+func (actor Actor) PromoteCanary(config CanaryConfig) (Warnings, error) {
+	allWarnings, err := actor.scaleApplication(config.Canary.DesiredApplication.GUID, config.TotalInstances)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err := actor.unbindRoutes(config.Current.CurrentRoutes, nil, config.Current.DesiredApplication.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.stopApplication(config.Current.DesiredApplication.GUID)
+	return append(allWarnings, warnings...), err
+}
+
+// AbortCanary scales the current application back to its original instances,
+// binds its routes again in case they were unbound while promoting, unbinds
+// the routes that were mapped to the canary for the release and stops the
+// canary.
+func (actor Actor) AbortCanary(config CanaryConfig) (Warnings, error) {
+	allWarnings, err := actor.scaleApplication(config.Current.DesiredApplication.GUID, config.OriginalInstances)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	for _, route := range config.Current.CurrentRoutes {
+		log.Debugf("binding route: %#v", route)
+		warnings, err := actor.bindRouteToApp(route, config.Current.DesiredApplication.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("binding route:", err)
+			return allWarnings, err
+		}
+	}
+
+	warnings, err := actor.unbindRoutes(config.Canary.DesiredRoutes, config.Canary.CurrentRoutes, config.Canary.DesiredApplication.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.stopApplication(config.Canary.DesiredApplication.GUID)
+	return append(allWarnings, warnings...), err
+}
+
+func (actor Actor) scaleApplication(appGUID string, instances int) (Warnings, error) {
+	_, warnings, err := actor.V2Actor.UpdateApplication(v2action.Application{
+		GUID:      appGUID,
+		Instances: instances,
+		State:     ccv2.ApplicationStarted,
+	})
+	if err != nil {
+		log.Errorln("scaling application:", err)
+	}
+	return Warnings(warnings), err
+}
+
+func (actor Actor) stopApplication(appGUID string) (Warnings, error) {
+	_, warnings, err := actor.V2Actor.UpdateApplication(v2action.Application{
+		GUID:  appGUID,
+		State: ccv2.ApplicationStopped,
+	})
+	if err != nil {
+		log.Errorln("stopping application:", err)
+	}
+	return Warnings(warnings), err
+}
+
+// unbindRoutes unbinds the routes, except the ones in keep, from the
+// application.
+func (actor Actor) unbindRoutes(routes []v2action.Route, keep []v2action.Route, appGUID string) (Warnings, error) {
+	var allWarnings Warnings
+	for _, route := range routes {
+		if actor.routeInList(route, keep) {
+			continue
+		}
+
+		log.Debugf("unbinding route: %#v", route)
+		warnings, err := actor.V2Actor.UnbindRouteFromApplication(route.GUID, appGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("unbinding route:", err)
+			return allWarnings, err
+		}
+	}
+	return allWarnings, nil
+}
@@ -0,0 +1,326 @@
+package pushaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Canary", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+
+		currentApp   v2action.Application
+		canaryApp    v2action.Application
+		sharedRoute  v2action.Route
+		currentRoute v2action.Route
+		canaryRoute  v2action.Route
+		config       CanaryConfig
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		actor = NewActor(fakeV2Actor)
+
+		currentApp = v2action.Application{Name: "current-app", GUID: "current-app-guid", Instances: 4}
+		canaryApp = v2action.Application{Name: "canary-app", GUID: "canary-app-guid", Instances: 1}
+		sharedRoute = v2action.Route{GUID: "shared-route-guid", Host: "shared"}
+		currentRoute = v2action.Route{GUID: "current-route-guid", Host: "current"}
+		canaryRoute = v2action.Route{GUID: "canary-route-guid", Host: "canary"}
+
+		config = CanaryConfig{
+			Current: ApplicationConfig{
+				CurrentApplication: currentApp,
+				DesiredApplication: currentApp,
+				CurrentRoutes:      []v2action.Route{sharedRoute, currentRoute},
+				DesiredRoutes:      []v2action.Route{sharedRoute, currentRoute},
+			},
+			Canary: ApplicationConfig{
+				CurrentApplication: canaryApp,
+				DesiredApplication: canaryApp,
+				CurrentRoutes:      []v2action.Route{canaryRoute, sharedRoute},
+				DesiredRoutes:      []v2action.Route{canaryRoute, sharedRoute, currentRoute},
+			},
+			TotalInstances:    4,
+			OriginalInstances: 4,
+		}
+	})
+
+	Describe("ConvertToCanaryConfig", func() {
+		var (
+			totalInstances int
+			canaryConfig   CanaryConfig
+			warnings       Warnings
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			totalInstances = 0
+		})
+
+		JustBeforeEach(func() {
+			canaryConfig, warnings, executeErr = actor.ConvertToCanaryConfig("current-app", "canary-app", "some-space-guid", totalInstances)
+		})
+
+		Context("when both applications exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationByNameAndSpaceStub = func(name string, _ string) (v2action.Application, v2action.Warnings, error) {
+					if name == "current-app" {
+						return currentApp, v2action.Warnings{"current-app-warning"}, nil
+					}
+					return canaryApp, v2action.Warnings{"canary-app-warning"}, nil
+				}
+				fakeV2Actor.GetApplicationRoutesStub = func(appGUID string) ([]v2action.Route, v2action.Warnings, error) {
+					if appGUID == "current-app-guid" {
+						return []v2action.Route{sharedRoute, currentRoute}, v2action.Warnings{"current-routes-warning"}, nil
+					}
+					return []v2action.Route{canaryRoute, sharedRoute}, v2action.Warnings{"canary-routes-warning"}, nil
+				}
+			})
+
+			It("adds the current application's routes to the canary's desired routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("current-app-warning", "current-routes-warning", "canary-app-warning", "canary-routes-warning"))
+
+				Expect(canaryConfig.Current.CurrentApplication).To(Equal(currentApp))
+				Expect(canaryConfig.Current.CurrentRoutes).To(Equal([]v2action.Route{sharedRoute, currentRoute}))
+				Expect(canaryConfig.Canary.CurrentApplication).To(Equal(canaryApp))
+				Expect(canaryConfig.Canary.CurrentRoutes).To(Equal([]v2action.Route{canaryRoute, sharedRoute}))
+				Expect(canaryConfig.Canary.DesiredRoutes).To(Equal([]v2action.Route{canaryRoute, sharedRoute, currentRoute}))
+			})
+
+			It("defaults the total instances to the current application's instances", func() {
+				Expect(canaryConfig.TotalInstances).To(Equal(4))
+			})
+
+			Context("when the total instances are provided", func() {
+				BeforeEach(func() {
+					totalInstances = 10
+				})
+
+				It("uses them and keeps the current application's instances to restore on abort", func() {
+					Expect(canaryConfig.TotalInstances).To(Equal(10))
+					Expect(canaryConfig.OriginalInstances).To(Equal(4))
+				})
+			})
+		})
+
+		Context("when the canary application does not exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationByNameAndSpaceStub = func(name string, _ string) (v2action.Application, v2action.Warnings, error) {
+					if name == "current-app" {
+						return currentApp, v2action.Warnings{"current-app-warning"}, nil
+					}
+					return v2action.Application{}, v2action.Warnings{"canary-app-warning"}, v2action.ApplicationNotFoundError{Name: name}
+				}
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(v2action.ApplicationNotFoundError{Name: "canary-app"}))
+				Expect(warnings).To(ConsistOf("current-app-warning", "canary-app-warning"))
+			})
+		})
+	})
+
+	Describe("MapCanaryRoutes", func() {
+		It("binds the routes the canary does not have yet", func() {
+			fakeV2Actor.BindRouteToApplicationReturns(v2action.Warnings{"bind-warning"}, nil)
+
+			warnings, err := actor.MapCanaryRoutes(config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("bind-warning"))
+
+			Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(1))
+			routeGUID, appGUID := fakeV2Actor.BindRouteToApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("current-route-guid"))
+			Expect(appGUID).To(Equal("canary-app-guid"))
+		})
+
+		It("returns binding errors", func() {
+			expectedErr := errors.New("bind failed")
+			fakeV2Actor.BindRouteToApplicationReturns(v2action.Warnings{"bind-warning"}, expectedErr)
+
+			warnings, err := actor.MapCanaryRoutes(config)
+			Expect(err).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("bind-warning"))
+		})
+	})
+
+	Describe("ScaleCanary", func() {
+		BeforeEach(func() {
+			fakeV2Actor.UpdateApplicationReturns(v2action.Application{}, v2action.Warnings{"update-warning"}, nil)
+		})
+
+		It("scales the canary up before scaling the current application down", func() {
+			step, warnings, err := actor.ScaleCanary(config, 25)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("update-warning", "update-warning"))
+			Expect(step).To(Equal(CanaryStep{Percentage: 25, CurrentInstances: 3, CanaryInstances: 1}))
+
+			Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(2))
+			Expect(fakeV2Actor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{
+				GUID:      "canary-app-guid",
+				Instances: 1,
+				State:     ccv2.ApplicationStarted,
+			}))
+			Expect(fakeV2Actor.UpdateApplicationArgsForCall(1)).To(Equal(v2action.Application{
+				GUID:      "current-app-guid",
+				Instances: 3,
+				State:     ccv2.ApplicationStarted,
+			}))
+		})
+
+		It("rounds the canary instances up", func() {
+			step, _, err := actor.ScaleCanary(config, 30)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(step).To(Equal(CanaryStep{Percentage: 30, CurrentInstances: 2, CanaryInstances: 2}))
+		})
+
+		It("keeps at least one instance of each application", func() {
+			config.TotalInstances = 2
+
+			step, _, err := actor.ScaleCanary(config, 90)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(step).To(Equal(CanaryStep{Percentage: 90, CurrentInstances: 1, CanaryInstances: 2}))
+		})
+
+		It("does not scale the current application down when scaling the canary fails", func() {
+			expectedErr := errors.New("scale failed")
+			fakeV2Actor.UpdateApplicationReturns(v2action.Application{}, v2action.Warnings{"update-warning"}, expectedErr)
+
+			_, warnings, err := actor.ScaleCanary(config, 25)
+			Expect(err).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("update-warning"))
+			Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(1))
+		})
+	})
+
+	Describe("GetCanaryHealth", func() {
+		It("counts the canary's instances by state", func() {
+			fakeV2Actor.GetApplicationInstancesWithStatsByApplicationReturns(
+				[]v2action.ApplicationInstanceWithStats{
+					{State: v2action.ApplicationInstanceState(ccv2.ApplicationInstanceRunning)},
+					{State: v2action.ApplicationInstanceState(ccv2.ApplicationInstanceRunning)},
+					{State: v2action.ApplicationInstanceState(ccv2.ApplicationInstanceStarting)},
+					{State: v2action.ApplicationInstanceState(ccv2.ApplicationInstanceFlapping)},
+				},
+				v2action.Warnings{"instances-warning"},
+				nil)
+
+			health, warnings, err := actor.GetCanaryHealth(config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("instances-warning"))
+			Expect(health).To(Equal(CanaryHealth{Running: 2, Starting: 1, Crashed: 1}))
+			Expect(health.Failed()).To(BeTrue())
+
+			Expect(fakeV2Actor.GetApplicationInstancesWithStatsByApplicationArgsForCall(0)).To(Equal("canary-app-guid"))
+		})
+
+		It("returns no instances when the canary has none yet", func() {
+			fakeV2Actor.GetApplicationInstancesWithStatsByApplicationReturns(nil, nil, v2action.ApplicationInstancesNotFoundError{})
+
+			health, _, err := actor.GetCanaryHealth(config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(health).To(Equal(CanaryHealth{}))
+			Expect(health.Failed()).To(BeFalse())
+		})
+	})
+
+	Describe("PromoteCanary", func() {
+		BeforeEach(func() {
+			fakeV2Actor.UpdateApplicationReturns(v2action.Application{}, v2action.Warnings{"update-warning"}, nil)
+			fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-warning"}, nil)
+		})
+
+		It("scales the canary, unbinds the current application's routes and stops it", func() {
+			warnings, err := actor.PromoteCanary(config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("update-warning", "unbind-warning", "unbind-warning", "update-warning"))
+
+			Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(2))
+			Expect(fakeV2Actor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{
+				GUID:      "canary-app-guid",
+				Instances: 4,
+				State:     ccv2.ApplicationStarted,
+			}))
+			Expect(fakeV2Actor.UpdateApplicationArgsForCall(1)).To(Equal(v2action.Application{
+				GUID:  "current-app-guid",
+				State: ccv2.ApplicationStopped,
+			}))
+
+			Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(2))
+			routeGUID, appGUID := fakeV2Actor.UnbindRouteFromApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("shared-route-guid"))
+			Expect(appGUID).To(Equal("current-app-guid"))
+			routeGUID, appGUID = fakeV2Actor.UnbindRouteFromApplicationArgsForCall(1)
+			Expect(routeGUID).To(Equal("current-route-guid"))
+			Expect(appGUID).To(Equal("current-app-guid"))
+		})
+	})
+
+	Describe("AbortCanary", func() {
+		BeforeEach(func() {
+			fakeV2Actor.UpdateApplicationReturns(v2action.Application{}, v2action.Warnings{"update-warning"}, nil)
+			fakeV2Actor.BindRouteToApplicationReturns(v2action.Warnings{"bind-warning"}, nil)
+			fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-warning"}, nil)
+			config.TotalInstances = 10
+		})
+
+		It("restores the current application, unbinds the routes added to the canary and stops it", func() {
+			warnings, err := actor.AbortCanary(config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("update-warning", "bind-warning", "bind-warning", "unbind-warning", "update-warning"))
+
+			Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(2))
+			Expect(fakeV2Actor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{
+				GUID:      "current-app-guid",
+				Instances: 4,
+				State:     ccv2.ApplicationStarted,
+			}))
+			Expect(fakeV2Actor.UpdateApplicationArgsForCall(1)).To(Equal(v2action.Application{
+				GUID:  "canary-app-guid",
+				State: ccv2.ApplicationStopped,
+			}))
+
+			Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(2))
+			routeGUID, appGUID := fakeV2Actor.BindRouteToApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("shared-route-guid"))
+			Expect(appGUID).To(Equal("current-app-guid"))
+			routeGUID, appGUID = fakeV2Actor.BindRouteToApplicationArgsForCall(1)
+			Expect(routeGUID).To(Equal("current-route-guid"))
+			Expect(appGUID).To(Equal("current-app-guid"))
+
+			Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(1))
+			routeGUID, appGUID = fakeV2Actor.UnbindRouteFromApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("current-route-guid"))
+			Expect(appGUID).To(Equal("canary-app-guid"))
+		})
+
+		It("returns binding errors", func() {
+			expectedErr := errors.New("bind failed")
+			fakeV2Actor.BindRouteToApplicationReturns(v2action.Warnings{"bind-warning"}, expectedErr)
+
+			warnings, err := actor.AbortCanary(config)
+			Expect(err).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("update-warning", "bind-warning"))
+			Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(0))
+		})
+
+		It("returns unbinding errors", func() {
+			expectedErr := errors.New("unbind failed")
+			fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-warning"}, expectedErr)
+
+			warnings, err := actor.AbortCanary(config)
+			Expect(err).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("update-warning", "bind-warning", "bind-warning", "unbind-warning"))
+			Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(1))
+		})
+	})
+})
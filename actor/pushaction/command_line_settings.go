@@ -3,4 +3,12 @@ package pushaction
 type CommandLineSettings struct {
 	Name string
 	Path string
+
+	Domain      string
+	Hostname    string
+	NoHostname  bool
+	NoRoute     bool
+	RandomRoute bool
+	RoutePath   string
+	RoutePort   int
 }
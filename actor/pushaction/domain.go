@@ -18,7 +18,7 @@ func (e NoDomainsFoundError) Error() string {
 }
 
 // DefaultDomain looks up the private and then shared domains and returns back
-// the first one in the list as the default.
+// the first HTTP one in the list as the default.
 func (actor Actor) DefaultDomain(orgGUID string) (v2action.Domain, Warnings, error) {
 	log.Infoln("getting org domains for org GUID:", orgGUID)
 	domains, warnings, err := actor.V2Actor.GetOrganizationDomains(orgGUID)
@@ -28,11 +28,34 @@ func (actor Actor) DefaultDomain(orgGUID string) (v2action.Domain, Warnings, err
 		return v2action.Domain{}, Warnings(warnings), err
 	}
 
-	if len(domains) == 0 {
-		log.Error("no domains found")
-		return v2action.Domain{}, Warnings(warnings), NoDomainsFoundError{OrganizationGUID: orgGUID}
+	for _, domain := range domains {
+		if !domain.IsTCP() {
+			log.Debugf("selecting first HTTP domain as default domain: %#v", domain)
+			return domain, Warnings(warnings), nil
+		}
 	}
 
-	log.Debugf("selecting first domain as default domain: %#v", domains)
-	return domains[0], Warnings(warnings), nil
+	log.Error("no domains found")
+	return v2action.Domain{}, Warnings(warnings), NoDomainsFoundError{OrganizationGUID: orgGUID}
+}
+
+// FindDomain returns the private or shared domain accessible to the
+// organization with the provided name.
+func (actor Actor) FindDomain(domainName string, orgGUID string) (v2action.Domain, Warnings, error) {
+	log.Infoln("getting org domains for org GUID:", orgGUID)
+	domains, warnings, err := actor.V2Actor.GetOrganizationDomains(orgGUID)
+	if err != nil {
+		log.Errorln("searching for domains in org:", err)
+		return v2action.Domain{}, Warnings(warnings), err
+	}
+
+	for _, domain := range domains {
+		if domain.Name == domainName {
+			log.Debugf("found domain: %#v", domain)
+			return domain, Warnings(warnings), nil
+		}
+	}
+
+	log.Errorf("domain %s not found", domainName)
+	return v2action.Domain{}, Warnings(warnings), v2action.DomainNotFoundError{Name: domainName}
 }
@@ -6,6 +6,7 @@ import (
 	. "code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -72,6 +73,27 @@ var _ = Describe("Domains", func() {
 			})
 		})
 
+		Context("when the first domain is a TCP domain", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{
+					{
+						Name:            "tcp-domain.com",
+						GUID:            "some-tcp-domain-guid",
+						RouterGroupType: ccv2.TCPRouterGroup,
+					},
+					{
+						Name: "shared-domain.com",
+						GUID: "some-shared-domain-guid",
+					},
+				}, nil, nil)
+			})
+
+			It("returns the first HTTP domain", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(defaultDomain.Name).To(Equal("shared-domain.com"))
+			})
+		})
+
 		Context("no domains exist", func() {
 			BeforeEach(func() {
 				fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{}, v2action.Warnings{"private-domain-warnings", "shared-domain-warnings"}, nil)
@@ -97,4 +119,43 @@ var _ = Describe("Domains", func() {
 			})
 		})
 	})
+
+	Describe("FindDomain", func() {
+		var (
+			domain     v2action.Domain
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{
+				{Name: "private-domain.com", GUID: "some-private-domain-guid"},
+				{Name: "shared-domain.com", GUID: "some-shared-domain-guid"},
+			}, v2action.Warnings{"domain-warnings"}, nil)
+		})
+
+		Context("when the domain exists", func() {
+			JustBeforeEach(func() {
+				domain, warnings, executeErr = actor.FindDomain("shared-domain.com", "some-org-guid")
+			})
+
+			It("returns the domain and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("domain-warnings"))
+				Expect(domain).To(Equal(v2action.Domain{Name: "shared-domain.com", GUID: "some-shared-domain-guid"}))
+				Expect(fakeV2Actor.GetOrganizationDomainsArgsForCall(0)).To(Equal("some-org-guid"))
+			})
+		})
+
+		Context("when the domain does not exist", func() {
+			JustBeforeEach(func() {
+				domain, warnings, executeErr = actor.FindDomain("unknown.com", "some-org-guid")
+			})
+
+			It("returns a DomainNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(v2action.DomainNotFoundError{Name: "unknown.com"}))
+				Expect(warnings).To(ConsistOf("domain-warnings"))
+			})
+		})
+	})
 })
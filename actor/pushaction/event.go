@@ -7,6 +7,7 @@ const (
 	ApplicationUpdated   Event = "application updated"
 	RouteCreated         Event = "route created"
 	RouteBound           Event = "route bound"
+	RoutesUnbound        Event = "routes unbound"
 	UploadingApplication Event = "uploading application"
 	UploadComplete       Event = "upload complete"
 	Complete             Event = "complete"
@@ -7,4 +7,12 @@ type Manifest struct {
 type Application struct {
 	Name string
 	Path string
+
+	Domain      string
+	Hostname    string
+	NoHostname  bool
+	NoRoute     bool
+	RandomRoute bool
+	RoutePath   string
+	RoutePort   int
 }
@@ -2,22 +2,102 @@ package pushaction
 
 import (
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	log "github.com/Sirupsen/logrus"
 )
 
+// PropertyCombinationError is returned when an application is given route
+// settings that cannot be used together.
+type PropertyCombinationError struct {
+	AppName    string
+	Properties []string
+}
+
+func (e PropertyCombinationError) Error() string {
+	return fmt.Sprintf("Application %s cannot use the combination of properties: %s", e.AppName, strings.Join(e.Properties, ", "))
+}
+
 func (actor Actor) MergeAndValidateSettingsAndManifests(cmdConfig CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error) {
 	if len(apps) != 0 {
 		return nil, errors.New("functionality still pending")
 	}
 	manifests := []manifest.Application{{
-		Name: cmdConfig.Name,
-		Path: cmdConfig.Path,
+		Name:        cmdConfig.Name,
+		Path:        cmdConfig.Path,
+		Domain:      cmdConfig.Domain,
+		Hostname:    cmdConfig.Hostname,
+		NoHostname:  cmdConfig.NoHostname,
+		NoRoute:     cmdConfig.NoRoute,
+		RandomRoute: cmdConfig.RandomRoute,
+		RoutePath:   cmdConfig.RoutePath,
+		RoutePort:   cmdConfig.RoutePort,
 	}}
 
-	//TODO Add validations
+	for _, app := range manifests {
+		err := validateRouteSettings(app)
+		if err != nil {
+			log.Errorln("validating route settings:", err)
+			return nil, err
+		}
+	}
 
 	log.Debugf("merged and validated manifests: %#v", manifests)
 	return manifests, nil
 }
+
+// routePropertyConflicts are the pairs of route settings that cannot be used
+// together.
+var routePropertyConflicts = [][2]string{
+	{"no-route", "domain"},
+	{"no-route", "hostname"},
+	{"no-route", "no-hostname"},
+	{"no-route", "random-route"},
+	{"no-route", "route-path"},
+	{"no-route", "route-port"},
+	{"hostname", "no-hostname"},
+	{"hostname", "random-route"},
+	{"no-hostname", "random-route"},
+	{"random-route", "route-port"},
+}
+
+// validateRouteSettings returns a PropertyCombinationError when the
+// application's route settings conflict with each other. Settings that depend
+// on the type of the domain are validated when the route is looked up.
+func validateRouteSettings(app manifest.Application) error {
+	isSet := map[string]bool{
+		"domain":       app.Domain != "",
+		"hostname":     app.Hostname != "",
+		"no-hostname":  app.NoHostname,
+		"no-route":     app.NoRoute,
+		"random-route": app.RandomRoute,
+		"route-path":   app.RoutePath != "",
+		"route-port":   app.RoutePort != 0,
+	}
+
+	conflicting := map[string]bool{}
+	for _, conflict := range routePropertyConflicts {
+		if isSet[conflict[0]] && isSet[conflict[1]] {
+			conflicting[conflict[0]] = true
+			conflicting[conflict[1]] = true
+		}
+	}
+
+	if len(conflicting) == 0 {
+		return nil
+	}
+
+	var properties []string
+	for property := range conflicting {
+		properties = append(properties, property)
+	}
+	sort.Strings(properties)
+
+	return PropertyCombinationError{
+		AppName:    app.Name,
+		Properties: properties,
+	}
+}
@@ -44,6 +44,95 @@ var _ = Describe("MergeAndValidateSettingsAndManifest", func() {
 		})
 	})
 
+	Context("when passed route settings", func() {
+		var cmdSettings CommandLineSettings
+
+		BeforeEach(func() {
+			cmdSettings = CommandLineSettings{
+				Name:        "some-app",
+				Domain:      "some-domain.com",
+				Hostname:    "some-host",
+				RandomRoute: false,
+				RoutePath:   "/some-path",
+			}
+		})
+
+		It("includes them in the manifest", func() {
+			manifests, err := actor.MergeAndValidateSettingsAndManifests(cmdSettings, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manifests).To(Equal([]manifest.Application{{
+				Name:      "some-app",
+				Domain:    "some-domain.com",
+				Hostname:  "some-host",
+				RoutePath: "/some-path",
+			}}))
+		})
+
+		Context("when no-route is combined with other route settings", func() {
+			BeforeEach(func() {
+				cmdSettings.NoRoute = true
+			})
+
+			It("returns a PropertyCombinationError", func() {
+				_, err := actor.MergeAndValidateSettingsAndManifests(cmdSettings, nil)
+				Expect(err).To(MatchError(PropertyCombinationError{
+					AppName:    "some-app",
+					Properties: []string{"domain", "hostname", "no-route", "route-path"},
+				}))
+			})
+		})
+
+		Context("when hostname is combined with random-route", func() {
+			BeforeEach(func() {
+				cmdSettings.RandomRoute = true
+			})
+
+			It("returns a PropertyCombinationError", func() {
+				_, err := actor.MergeAndValidateSettingsAndManifests(cmdSettings, nil)
+				Expect(err).To(MatchError(PropertyCombinationError{
+					AppName:    "some-app",
+					Properties: []string{"hostname", "random-route"},
+				}))
+			})
+		})
+	})
+
+	Context("when passed a route port", func() {
+		var cmdSettings CommandLineSettings
+
+		BeforeEach(func() {
+			cmdSettings = CommandLineSettings{
+				Name:      "some-app",
+				Domain:    "tcp.domain.com",
+				RoutePort: 1234,
+			}
+		})
+
+		It("includes it in the manifest", func() {
+			manifests, err := actor.MergeAndValidateSettingsAndManifests(cmdSettings, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manifests).To(Equal([]manifest.Application{{
+				Name:      "some-app",
+				Domain:    "tcp.domain.com",
+				RoutePort: 1234,
+			}}))
+		})
+
+		Context("when route-port is combined with random-route", func() {
+			BeforeEach(func() {
+				cmdSettings.RandomRoute = true
+			})
+
+			It("returns a PropertyCombinationError", func() {
+				_, err := actor.MergeAndValidateSettingsAndManifests(cmdSettings, nil)
+				Expect(err).To(MatchError(PropertyCombinationError{
+					AppName:    "some-app",
+					Properties: []string{"random-route", "route-port"},
+				}))
+			})
+		})
+	})
+
 	Context("when passed command line settings and manifests", func() {
 		// fill in here
 	})
@@ -85,6 +85,21 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetApplicationInstancesWithStatsByApplicationStub        func(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error)
+	getApplicationInstancesWithStatsByApplicationMutex       sync.RWMutex
+	getApplicationInstancesWithStatsByApplicationArgsForCall []struct {
+		guid string
+	}
+	getApplicationInstancesWithStatsByApplicationReturns struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationInstancesWithStatsByApplicationReturnsOnCall map[int]struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetApplicationRoutesStub        func(applicationGUID string) ([]v2action.Route, v2action.Warnings, error)
 	getApplicationRoutesMutex       sync.RWMutex
 	getApplicationRoutesArgsForCall []struct {
@@ -115,22 +130,50 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
-	GetRouteByHostAndDomainStub        func(host string, domainGUID string) (v2action.Route, v2action.Warnings, error)
-	getRouteByHostAndDomainMutex       sync.RWMutex
-	getRouteByHostAndDomainArgsForCall []struct {
-		host       string
-		domainGUID string
+	GetRouterGroupStub        func(guid string) (v2action.RouterGroup, v2action.Warnings, error)
+	getRouterGroupMutex       sync.RWMutex
+	getRouterGroupArgsForCall []struct {
+		guid string
+	}
+	getRouterGroupReturns struct {
+		result1 v2action.RouterGroup
+		result2 v2action.Warnings
+		result3 error
+	}
+	getRouterGroupReturnsOnCall map[int]struct {
+		result1 v2action.RouterGroup
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetRouteByComponentsStub        func(route v2action.Route) (v2action.Route, v2action.Warnings, error)
+	getRouteByComponentsMutex       sync.RWMutex
+	getRouteByComponentsArgsForCall []struct {
+		route v2action.Route
 	}
-	getRouteByHostAndDomainReturns struct {
+	getRouteByComponentsReturns struct {
 		result1 v2action.Route
 		result2 v2action.Warnings
 		result3 error
 	}
-	getRouteByHostAndDomainReturnsOnCall map[int]struct {
+	getRouteByComponentsReturnsOnCall map[int]struct {
 		result1 v2action.Route
 		result2 v2action.Warnings
 		result3 error
 	}
+	UnbindRouteFromApplicationStub        func(routeGUID string, appGUID string) (v2action.Warnings, error)
+	unbindRouteFromApplicationMutex       sync.RWMutex
+	unbindRouteFromApplicationArgsForCall []struct {
+		routeGUID string
+		appGUID   string
+	}
+	unbindRouteFromApplicationReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	unbindRouteFromApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	UpdateApplicationStub        func(application v2action.Application) (v2action.Application, v2action.Warnings, error)
 	updateApplicationMutex       sync.RWMutex
 	updateApplicationArgsForCall []struct {
@@ -420,6 +463,60 @@ func (fake *FakeV2Actor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) GetApplicationInstancesWithStatsByApplication(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error) {
+	fake.getApplicationInstancesWithStatsByApplicationMutex.Lock()
+	ret, specificReturn := fake.getApplicationInstancesWithStatsByApplicationReturnsOnCall[len(fake.getApplicationInstancesWithStatsByApplicationArgsForCall)]
+	fake.getApplicationInstancesWithStatsByApplicationArgsForCall = append(fake.getApplicationInstancesWithStatsByApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplicationInstancesWithStatsByApplication", []interface{}{guid})
+	fake.getApplicationInstancesWithStatsByApplicationMutex.Unlock()
+	if fake.GetApplicationInstancesWithStatsByApplicationStub != nil {
+		return fake.GetApplicationInstancesWithStatsByApplicationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationInstancesWithStatsByApplicationReturns.result1, fake.getApplicationInstancesWithStatsByApplicationReturns.result2, fake.getApplicationInstancesWithStatsByApplicationReturns.result3
+}
+
+func (fake *FakeV2Actor) GetApplicationInstancesWithStatsByApplicationCallCount() int {
+	fake.getApplicationInstancesWithStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesWithStatsByApplicationMutex.RUnlock()
+	return len(fake.getApplicationInstancesWithStatsByApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetApplicationInstancesWithStatsByApplicationArgsForCall(i int) string {
+	fake.getApplicationInstancesWithStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesWithStatsByApplicationMutex.RUnlock()
+	return fake.getApplicationInstancesWithStatsByApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeV2Actor) GetApplicationInstancesWithStatsByApplicationReturns(result1 []v2action.ApplicationInstanceWithStats, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationInstancesWithStatsByApplicationStub = nil
+	fake.getApplicationInstancesWithStatsByApplicationReturns = struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationInstancesWithStatsByApplicationReturnsOnCall(i int, result1 []v2action.ApplicationInstanceWithStats, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationInstancesWithStatsByApplicationStub = nil
+	if fake.getApplicationInstancesWithStatsByApplicationReturnsOnCall == nil {
+		fake.getApplicationInstancesWithStatsByApplicationReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ApplicationInstanceWithStats
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationInstancesWithStatsByApplicationReturnsOnCall[i] = struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) GetApplicationRoutes(applicationGUID string) ([]v2action.Route, v2action.Warnings, error) {
 	fake.getApplicationRoutesMutex.Lock()
 	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
@@ -528,61 +625,166 @@ func (fake *FakeV2Actor) GetOrganizationDomainsReturnsOnCall(i int, result1 []v2
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV2Actor) GetRouteByHostAndDomain(host string, domainGUID string) (v2action.Route, v2action.Warnings, error) {
-	fake.getRouteByHostAndDomainMutex.Lock()
-	ret, specificReturn := fake.getRouteByHostAndDomainReturnsOnCall[len(fake.getRouteByHostAndDomainArgsForCall)]
-	fake.getRouteByHostAndDomainArgsForCall = append(fake.getRouteByHostAndDomainArgsForCall, struct {
-		host       string
-		domainGUID string
-	}{host, domainGUID})
-	fake.recordInvocation("GetRouteByHostAndDomain", []interface{}{host, domainGUID})
-	fake.getRouteByHostAndDomainMutex.Unlock()
-	if fake.GetRouteByHostAndDomainStub != nil {
-		return fake.GetRouteByHostAndDomainStub(host, domainGUID)
+func (fake *FakeV2Actor) GetRouterGroup(guid string) (v2action.RouterGroup, v2action.Warnings, error) {
+	fake.getRouterGroupMutex.Lock()
+	ret, specificReturn := fake.getRouterGroupReturnsOnCall[len(fake.getRouterGroupArgsForCall)]
+	fake.getRouterGroupArgsForCall = append(fake.getRouterGroupArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetRouterGroup", []interface{}{guid})
+	fake.getRouterGroupMutex.Unlock()
+	if fake.GetRouterGroupStub != nil {
+		return fake.GetRouterGroupStub(guid)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getRouteByHostAndDomainReturns.result1, fake.getRouteByHostAndDomainReturns.result2, fake.getRouteByHostAndDomainReturns.result3
+	return fake.getRouterGroupReturns.result1, fake.getRouterGroupReturns.result2, fake.getRouterGroupReturns.result3
 }
 
-func (fake *FakeV2Actor) GetRouteByHostAndDomainCallCount() int {
-	fake.getRouteByHostAndDomainMutex.RLock()
-	defer fake.getRouteByHostAndDomainMutex.RUnlock()
-	return len(fake.getRouteByHostAndDomainArgsForCall)
+func (fake *FakeV2Actor) GetRouterGroupCallCount() int {
+	fake.getRouterGroupMutex.RLock()
+	defer fake.getRouterGroupMutex.RUnlock()
+	return len(fake.getRouterGroupArgsForCall)
 }
 
-func (fake *FakeV2Actor) GetRouteByHostAndDomainArgsForCall(i int) (string, string) {
-	fake.getRouteByHostAndDomainMutex.RLock()
-	defer fake.getRouteByHostAndDomainMutex.RUnlock()
-	return fake.getRouteByHostAndDomainArgsForCall[i].host, fake.getRouteByHostAndDomainArgsForCall[i].domainGUID
+func (fake *FakeV2Actor) GetRouterGroupArgsForCall(i int) string {
+	fake.getRouterGroupMutex.RLock()
+	defer fake.getRouterGroupMutex.RUnlock()
+	return fake.getRouterGroupArgsForCall[i].guid
 }
 
-func (fake *FakeV2Actor) GetRouteByHostAndDomainReturns(result1 v2action.Route, result2 v2action.Warnings, result3 error) {
-	fake.GetRouteByHostAndDomainStub = nil
-	fake.getRouteByHostAndDomainReturns = struct {
+func (fake *FakeV2Actor) GetRouterGroupReturns(result1 v2action.RouterGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetRouterGroupStub = nil
+	fake.getRouterGroupReturns = struct {
+		result1 v2action.RouterGroup
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetRouterGroupReturnsOnCall(i int, result1 v2action.RouterGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetRouterGroupStub = nil
+	if fake.getRouterGroupReturnsOnCall == nil {
+		fake.getRouterGroupReturnsOnCall = make(map[int]struct {
+			result1 v2action.RouterGroup
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouterGroupReturnsOnCall[i] = struct {
+		result1 v2action.RouterGroup
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetRouteByComponents(route v2action.Route) (v2action.Route, v2action.Warnings, error) {
+	fake.getRouteByComponentsMutex.Lock()
+	ret, specificReturn := fake.getRouteByComponentsReturnsOnCall[len(fake.getRouteByComponentsArgsForCall)]
+	fake.getRouteByComponentsArgsForCall = append(fake.getRouteByComponentsArgsForCall, struct {
+		route v2action.Route
+	}{route})
+	fake.recordInvocation("GetRouteByComponents", []interface{}{route})
+	fake.getRouteByComponentsMutex.Unlock()
+	if fake.GetRouteByComponentsStub != nil {
+		return fake.GetRouteByComponentsStub(route)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRouteByComponentsReturns.result1, fake.getRouteByComponentsReturns.result2, fake.getRouteByComponentsReturns.result3
+}
+
+func (fake *FakeV2Actor) GetRouteByComponentsCallCount() int {
+	fake.getRouteByComponentsMutex.RLock()
+	defer fake.getRouteByComponentsMutex.RUnlock()
+	return len(fake.getRouteByComponentsArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetRouteByComponentsArgsForCall(i int) v2action.Route {
+	fake.getRouteByComponentsMutex.RLock()
+	defer fake.getRouteByComponentsMutex.RUnlock()
+	return fake.getRouteByComponentsArgsForCall[i].route
+}
+
+func (fake *FakeV2Actor) GetRouteByComponentsReturns(result1 v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetRouteByComponentsStub = nil
+	fake.getRouteByComponentsReturns = struct {
 		result1 v2action.Route
 		result2 v2action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV2Actor) GetRouteByHostAndDomainReturnsOnCall(i int, result1 v2action.Route, result2 v2action.Warnings, result3 error) {
-	fake.GetRouteByHostAndDomainStub = nil
-	if fake.getRouteByHostAndDomainReturnsOnCall == nil {
-		fake.getRouteByHostAndDomainReturnsOnCall = make(map[int]struct {
+func (fake *FakeV2Actor) GetRouteByComponentsReturnsOnCall(i int, result1 v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetRouteByComponentsStub = nil
+	if fake.getRouteByComponentsReturnsOnCall == nil {
+		fake.getRouteByComponentsReturnsOnCall = make(map[int]struct {
 			result1 v2action.Route
 			result2 v2action.Warnings
 			result3 error
 		})
 	}
-	fake.getRouteByHostAndDomainReturnsOnCall[i] = struct {
+	fake.getRouteByComponentsReturnsOnCall[i] = struct {
 		result1 v2action.Route
 		result2 v2action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) UnbindRouteFromApplication(routeGUID string, appGUID string) (v2action.Warnings, error) {
+	fake.unbindRouteFromApplicationMutex.Lock()
+	ret, specificReturn := fake.unbindRouteFromApplicationReturnsOnCall[len(fake.unbindRouteFromApplicationArgsForCall)]
+	fake.unbindRouteFromApplicationArgsForCall = append(fake.unbindRouteFromApplicationArgsForCall, struct {
+		routeGUID string
+		appGUID   string
+	}{routeGUID, appGUID})
+	fake.recordInvocation("UnbindRouteFromApplication", []interface{}{routeGUID, appGUID})
+	fake.unbindRouteFromApplicationMutex.Unlock()
+	if fake.UnbindRouteFromApplicationStub != nil {
+		return fake.UnbindRouteFromApplicationStub(routeGUID, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.unbindRouteFromApplicationReturns.result1, fake.unbindRouteFromApplicationReturns.result2
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationCallCount() int {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return len(fake.unbindRouteFromApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationArgsForCall(i int) (string, string) {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return fake.unbindRouteFromApplicationArgsForCall[i].routeGUID, fake.unbindRouteFromApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationReturns(result1 v2action.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	fake.unbindRouteFromApplicationReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	if fake.unbindRouteFromApplicationReturnsOnCall == nil {
+		fake.unbindRouteFromApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.unbindRouteFromApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV2Actor) UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error) {
 	fake.updateApplicationMutex.Lock()
 	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
@@ -650,12 +852,18 @@ func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	defer fake.createRouteMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationInstancesWithStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesWithStatsByApplicationMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
 	defer fake.getApplicationRoutesMutex.RUnlock()
 	fake.getOrganizationDomainsMutex.RLock()
 	defer fake.getOrganizationDomainsMutex.RUnlock()
-	fake.getRouteByHostAndDomainMutex.RLock()
-	defer fake.getRouteByHostAndDomainMutex.RUnlock()
+	fake.getRouterGroupMutex.RLock()
+	defer fake.getRouterGroupMutex.RUnlock()
+	fake.getRouteByComponentsMutex.RLock()
+	defer fake.getRouteByComponentsMutex.RUnlock()
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
 	return fake.invocations
@@ -1,10 +1,52 @@
 package pushaction
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	log "github.com/Sirupsen/logrus"
 )
 
+// InvalidHTTPRouteSettings is returned when a port is provided for a route on
+// an HTTP domain.
+type InvalidHTTPRouteSettings struct {
+	Domain string
+}
+
+func (e InvalidHTTPRouteSettings) Error() string {
+	return fmt.Sprintf("Port not allowed in HTTP domain %s", e.Domain)
+}
+
+// InvalidTCPRouteSettings is returned when a hostname or path is provided for
+// a route on a TCP domain.
+type InvalidTCPRouteSettings struct {
+	Domain string
+}
+
+func (e InvalidTCPRouteSettings) Error() string {
+	return fmt.Sprintf("Host and path not allowed in route with TCP domain %s", e.Domain)
+}
+
+// InvalidTCPRoutePort is returned when the port provided for a route on a TCP
+// domain is not one of the domain's router group's reservable ports.
+type InvalidTCPRoutePort struct {
+	Domain          string
+	Port            int
+	ReservablePorts string
+}
+
+func (e InvalidTCPRoutePort) Error() string {
+	return fmt.Sprintf("Port %d is not available in TCP domain %s, reservable ports are %s", e.Port, e.Domain, e.ReservablePorts)
+}
+
+var (
+	forbiddenHostCharRegex = regexp.MustCompile("[^a-z0-9-]")
+	whitespaceRegex        = regexp.MustCompile(`[\s_]+`)
+)
+
 // FindOrReturnPartialRoute finds the route with the given host and domain. If
 // it is unable to find the route, it will return back the partial route. When
 // the route exists in another space, RouteInDifferentSpaceError is returned.
@@ -21,8 +63,7 @@ func (actor Actor) FindOrReturnPartialRoute(route v2action.Route) (v2action.Rout
 	if exists {
 		log.Debug("route exists")
 
-		// TODO: Use a more generic search mechanism to support path, port, and no host
-		existingRoute, routeWarnings, err := actor.V2Actor.GetRouteByHostAndDomain(route.Host, route.Domain.GUID)
+		existingRoute, routeWarnings, err := actor.V2Actor.GetRouteByComponents(route)
 		if _, ok := err.(v2action.RouteNotFoundError); ok {
 			log.Errorf("unable to find route %s in current space", route.String())
 			return v2action.Route{}, append(Warnings(warnings), routeWarnings...), v2action.RouteInDifferentSpaceError{Route: route.String()}
@@ -48,22 +89,95 @@ func (actor Actor) FindOrReturnPartialRoute(route v2action.Route) (v2action.Rout
 	return route, Warnings(warnings), nil
 }
 
-// GetRouteWithDefaultDomain returns a route with the host and the default org
-// domain. This may be a partial route (ie no GUID) if the route does not
-// exist.
-func (actor Actor) GetRouteWithDefaultDomain(host string, orgGUID string, spaceGUID string) (v2action.Route, Warnings, error) {
-	defaultDomain, warnings, err := actor.DefaultDomain(orgGUID)
+// GetRouteForApplication returns the route described by the application's
+// route settings. The domain defaults to the organization's default domain
+// and the hostname to the application name. Routes on TCP domains without a
+// port get a random port when they are created, unless the application
+// already has a route on that domain. A provided port must be reservable in
+// the domain's router group. This may be a partial route (ie no GUID) if the
+// route does not exist.
+func (actor Actor) GetRouteForApplication(app manifest.Application, orgGUID string, spaceGUID string, currentRoutes []v2action.Route) (v2action.Route, Warnings, error) {
+	var (
+		domain   v2action.Domain
+		warnings Warnings
+		err      error
+	)
+	if app.Domain != "" {
+		domain, warnings, err = actor.FindDomain(app.Domain, orgGUID)
+	} else {
+		domain, warnings, err = actor.DefaultDomain(orgGUID)
+	}
 	if err != nil {
-		log.Errorln("could not find default domains:", err.Error())
-		return v2action.Route{}, Warnings(warnings), err
+		log.Errorln("finding domain:", err)
+		return v2action.Route{}, warnings, err
 	}
 
-	route, routeWarnings, err := actor.FindOrReturnPartialRoute(v2action.Route{
-		Domain:    defaultDomain,
-		Host:      host,
+	route := v2action.Route{
+		Domain:    domain,
 		SpaceGUID: spaceGUID,
-	})
-	return route, append(Warnings(warnings), routeWarnings...), err
+	}
+
+	if domain.IsTCP() {
+		if app.Hostname != "" || app.RoutePath != "" {
+			log.Errorf("host or path provided for TCP domain %s", domain.Name)
+			return v2action.Route{}, warnings, InvalidTCPRouteSettings{Domain: domain.Name}
+		}
+
+		if app.RoutePort == 0 {
+			for _, currentRoute := range currentRoutes {
+				if currentRoute.Domain.GUID == domain.GUID {
+					log.Debugf("reusing route %s for random port", currentRoute)
+					return currentRoute, warnings, nil
+				}
+			}
+
+			log.Debug("returning partial route for random port")
+			return route, warnings, nil
+		}
+
+		routerGroup, routerGroupWarnings, err := actor.V2Actor.GetRouterGroup(domain.RouterGroupGUID)
+		warnings = append(warnings, routerGroupWarnings...)
+		if err != nil {
+			log.Errorln("getting router group:", err)
+			return v2action.Route{}, warnings, err
+		}
+
+		if !routerGroup.IsPortReservable(app.RoutePort) {
+			log.Errorf("port %d is not reservable in router group %s", app.RoutePort, routerGroup.Name)
+			return v2action.Route{}, warnings, InvalidTCPRoutePort{
+				Domain:          domain.Name,
+				Port:            app.RoutePort,
+				ReservablePorts: routerGroup.ReservablePorts,
+			}
+		}
+		route.Port = app.RoutePort
+	} else {
+		if app.RoutePort != 0 {
+			log.Errorf("port provided for HTTP domain %s", domain.Name)
+			return v2action.Route{}, warnings, InvalidHTTPRouteSettings{Domain: domain.Name}
+		}
+
+		switch {
+		case app.NoHostname:
+		case app.Hostname != "":
+			route.Host = app.Hostname
+		case app.RandomRoute:
+			route.Host = fmt.Sprintf("%s-%s", hostnameForAppName(app.Name), actor.WordGenerator.Babble())
+		default:
+			route.Host = hostnameForAppName(app.Name)
+		}
+		route.Path = app.RoutePath
+	}
+
+	foundRoute, routeWarnings, err := actor.FindOrReturnPartialRoute(route)
+	return foundRoute, append(warnings, routeWarnings...), err
+}
+
+// hostnameForAppName converts the application name into a valid hostname.
+func hostnameForAppName(appName string) string {
+	hostname := strings.ToLower(appName)
+	hostname = whitespaceRegex.ReplaceAllString(hostname, "-")
+	return forbiddenHostCharRegex.ReplaceAllString(hostname, "")
 }
 
 func (actor Actor) routeInList(route v2action.Route, routes []v2action.Route) bool {
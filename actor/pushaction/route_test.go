@@ -4,8 +4,11 @@ import (
 	"errors"
 
 	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/words/generator/generatorfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -58,7 +61,7 @@ var _ = Describe("Routes", func() {
 
 			Context("when the route exists in this space", func() {
 				BeforeEach(func() {
-					fakeV2Actor.GetRouteByHostAndDomainReturns(existingRoute, v2action.Warnings{"get-route-warnings"}, nil)
+					fakeV2Actor.GetRouteByComponentsReturns(existingRoute, v2action.Warnings{"get-route-warnings"}, nil)
 				})
 
 				It("returns the existing route", func() {
@@ -69,17 +72,15 @@ var _ = Describe("Routes", func() {
 					Expect(fakeV2Actor.CheckRouteCallCount()).To(Equal(1))
 					Expect(fakeV2Actor.CheckRouteArgsForCall(0)).To(Equal(route))
 
-					Expect(fakeV2Actor.GetRouteByHostAndDomainCallCount()).To(Equal(1))
-					host, domainGUID := fakeV2Actor.GetRouteByHostAndDomainArgsForCall(0)
-					Expect(host).To(Equal(route.Host))
-					Expect(domainGUID).To(Equal(route.Domain.GUID))
+					Expect(fakeV2Actor.GetRouteByComponentsCallCount()).To(Equal(1))
+					Expect(fakeV2Actor.GetRouteByComponentsArgsForCall(0)).To(Equal(route))
 				})
 			})
 
 			Context("when the route exists in a different space", func() {
 				Context("when the user has access to the space the route is in", func() {
 					BeforeEach(func() {
-						fakeV2Actor.GetRouteByHostAndDomainReturns(v2action.Route{SpaceGUID: "some-other-space-guid"}, v2action.Warnings{"get-route-warnings"}, nil)
+						fakeV2Actor.GetRouteByComponentsReturns(v2action.Route{SpaceGUID: "some-other-space-guid"}, v2action.Warnings{"get-route-warnings"}, nil)
 					})
 
 					It("returns a RouteInDifferentSpaceError and warnings", func() {
@@ -90,7 +91,7 @@ var _ = Describe("Routes", func() {
 
 				Context("when the user cannot see the space the route is in", func() {
 					BeforeEach(func() {
-						fakeV2Actor.GetRouteByHostAndDomainReturns(v2action.Route{}, v2action.Warnings{"get-route-warnings"}, v2action.RouteNotFoundError{})
+						fakeV2Actor.GetRouteByComponentsReturns(v2action.Route{}, v2action.Warnings{"get-route-warnings"}, v2action.RouteNotFoundError{})
 					})
 
 					It("returns a RouteInDifferentSpaceError and warnings", func() {
@@ -105,7 +106,7 @@ var _ = Describe("Routes", func() {
 
 				BeforeEach(func() {
 					expectedErr = errors.New("nooooo")
-					fakeV2Actor.GetRouteByHostAndDomainReturns(v2action.Route{}, v2action.Warnings{"get-route-warnings"}, expectedErr)
+					fakeV2Actor.GetRouteByComponentsReturns(v2action.Route{}, v2action.Warnings{"get-route-warnings"}, expectedErr)
 				})
 
 				It("the error and warnings", func() {
@@ -142,75 +143,269 @@ var _ = Describe("Routes", func() {
 		})
 	})
 
-	Describe("GetRouteWithDefaultDomain", func() {
+	Describe("GetRouteForApplication", func() {
 		var (
-			host      string
-			orgGUID   string
-			spaceGUID string
+			app           manifest.Application
+			orgGUID       string
+			spaceGUID     string
+			currentRoutes []v2action.Route
 
-			defaultRoute v2action.Route
-			warnings     Warnings
-			executeErr   error
+			returnedRoute v2action.Route
+			warnings      Warnings
+			executeErr    error
 
-			domain v2action.Domain
+			domain    v2action.Domain
+			tcpDomain v2action.Domain
 		)
 
 		BeforeEach(func() {
-			host = "some-app"
+			app = manifest.Application{Name: "Some App"}
 			orgGUID = "some-org-guid"
 			spaceGUID = "some-space-guid"
+			currentRoutes = nil
 
 			domain = v2action.Domain{
 				Name: "private-domain.com",
 				GUID: "some-private-domain-guid",
 			}
+			tcpDomain = v2action.Domain{
+				Name:            "tcp.domain.com",
+				GUID:            "some-tcp-domain-guid",
+				RouterGroupGUID: "some-router-group-guid",
+				RouterGroupType: ccv2.TCPRouterGroup,
+			}
 		})
 
 		JustBeforeEach(func() {
-			defaultRoute, warnings, executeErr = actor.GetRouteWithDefaultDomain(host, orgGUID, spaceGUID)
+			returnedRoute, warnings, executeErr = actor.GetRouteForApplication(app, orgGUID, spaceGUID, currentRoutes)
 		})
 
 		Context("when retrieving the domains is successful", func() {
 			BeforeEach(func() {
 				fakeV2Actor.GetOrganizationDomainsReturns(
-					[]v2action.Domain{domain},
+					[]v2action.Domain{tcpDomain, domain},
 					v2action.Warnings{"private-domain-warnings", "shared-domain-warnings"},
 					nil,
 				)
 			})
 
-			Context("when retrieving the routes is successful", func() {
+			Context("when no route settings are provided", func() {
+				Context("when retrieving the routes is successful", func() {
+					BeforeEach(func() {
+						// Assumes new route
+						fakeV2Actor.CheckRouteReturns(false, v2action.Warnings{"get-route-warnings"}, nil)
+					})
+
+					It("returns a route with the app name as the host on the first HTTP domain", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings", "get-route-warnings"))
+
+						expectedRoute := v2action.Route{Domain: domain, Host: "some-app", SpaceGUID: spaceGUID}
+						Expect(returnedRoute).To(Equal(expectedRoute))
+
+						Expect(fakeV2Actor.GetOrganizationDomainsCallCount()).To(Equal(1))
+						Expect(fakeV2Actor.GetOrganizationDomainsArgsForCall(0)).To(Equal(orgGUID))
+
+						Expect(fakeV2Actor.CheckRouteCallCount()).To(Equal(1))
+						Expect(fakeV2Actor.CheckRouteArgsForCall(0)).To(Equal(expectedRoute))
+					})
+				})
+
+				Context("when retrieving the routes errors", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("whoops")
+						fakeV2Actor.CheckRouteReturns(false, v2action.Warnings{"get-route-warnings"}, expectedErr)
+					})
+
+					It("returns errors and warnings", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings", "get-route-warnings"))
+					})
+				})
+			})
+
+			Context("when the hostname and path are provided", func() {
 				BeforeEach(func() {
-					// Assumes new route
-					fakeV2Actor.CheckRouteReturns(false, v2action.Warnings{"get-route-warnings"}, nil)
+					app.Hostname = "some-host"
+					app.RoutePath = "/some-path"
 				})
 
-				It("returns the route and warnings", func() {
+				It("returns a route with the hostname and path", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
-					Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings", "get-route-warnings"))
+					Expect(returnedRoute).To(Equal(v2action.Route{Domain: domain, Host: "some-host", Path: "/some-path", SpaceGUID: spaceGUID}))
+				})
+			})
 
-					Expect(defaultRoute).To(Equal(v2action.Route{Domain: domain, Host: host, SpaceGUID: spaceGUID}))
+			Context("when no-hostname is provided", func() {
+				BeforeEach(func() {
+					app.NoHostname = true
+				})
 
-					Expect(fakeV2Actor.GetOrganizationDomainsCallCount()).To(Equal(1))
-					Expect(fakeV2Actor.GetOrganizationDomainsArgsForCall(0)).To(Equal(orgGUID))
+				It("returns a route without a host", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(returnedRoute).To(Equal(v2action.Route{Domain: domain, SpaceGUID: spaceGUID}))
+				})
+			})
 
-					Expect(fakeV2Actor.CheckRouteCallCount()).To(Equal(1))
-					Expect(fakeV2Actor.CheckRouteArgsForCall(0)).To(Equal(v2action.Route{Domain: domain, Host: host, SpaceGUID: spaceGUID}))
+			Context("when random-route is provided", func() {
+				BeforeEach(func() {
+					app.RandomRoute = true
+					fakeWordGenerator := new(generatorfakes.FakeWordGenerator)
+					fakeWordGenerator.BabbleReturns("striped-llama")
+					actor.WordGenerator = fakeWordGenerator
+				})
+
+				It("returns a route with a random host", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(returnedRoute).To(Equal(v2action.Route{Domain: domain, Host: "some-app-striped-llama", SpaceGUID: spaceGUID}))
 				})
 			})
 
-			Context("when retrieving the routes errors", func() {
-				var expectedErr error
+			Context("when a port is provided for an HTTP domain", func() {
+				BeforeEach(func() {
+					app.RoutePort = 1234
+				})
+
+				It("returns an InvalidHTTPRouteSettings error", func() {
+					Expect(executeErr).To(MatchError(InvalidHTTPRouteSettings{Domain: "private-domain.com"}))
+					Expect(fakeV2Actor.CheckRouteCallCount()).To(Equal(0))
+				})
+			})
 
+			Context("when the domain is a TCP domain", func() {
 				BeforeEach(func() {
-					expectedErr = errors.New("whoops")
-					fakeV2Actor.CheckRouteReturns(false, v2action.Warnings{"get-route-warnings"}, expectedErr)
+					app.Domain = "tcp.domain.com"
 				})
 
-				It("returns errors and warnings", func() {
-					Expect(executeErr).To(MatchError(expectedErr))
-					Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings", "get-route-warnings"))
+				BeforeEach(func() {
+					fakeV2Actor.GetRouterGroupReturns(
+						v2action.RouterGroup{GUID: "some-router-group-guid", Name: "default-tcp", ReservablePorts: "1024-1233,1234"},
+						v2action.Warnings{"router-group-warnings"},
+						nil,
+					)
 				})
+
+				Context("when a port is provided", func() {
+					BeforeEach(func() {
+						app.RoutePort = 1234
+						fakeV2Actor.CheckRouteReturns(false, nil, nil)
+					})
+
+					It("returns a route with the port", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings", "router-group-warnings"))
+						Expect(returnedRoute).To(Equal(v2action.Route{Domain: tcpDomain, Port: 1234, SpaceGUID: spaceGUID}))
+						Expect(fakeV2Actor.CheckRouteArgsForCall(0)).To(Equal(returnedRoute))
+
+						Expect(fakeV2Actor.GetRouterGroupCallCount()).To(Equal(1))
+						Expect(fakeV2Actor.GetRouterGroupArgsForCall(0)).To(Equal("some-router-group-guid"))
+					})
+
+					Context("when the port is not reservable in the domain's router group", func() {
+						BeforeEach(func() {
+							app.RoutePort = 2000
+						})
+
+						It("returns an InvalidTCPRoutePort error without checking the route", func() {
+							Expect(executeErr).To(MatchError(InvalidTCPRoutePort{
+								Domain:          "tcp.domain.com",
+								Port:            2000,
+								ReservablePorts: "1024-1233,1234",
+							}))
+							Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings", "router-group-warnings"))
+							Expect(fakeV2Actor.CheckRouteCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when getting the router group fails", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("router group error")
+							fakeV2Actor.GetRouterGroupReturns(v2action.RouterGroup{}, v2action.Warnings{"router-group-warnings"}, expectedErr)
+						})
+
+						It("returns the error and warnings", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+							Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings", "router-group-warnings"))
+							Expect(fakeV2Actor.CheckRouteCallCount()).To(Equal(0))
+						})
+					})
+				})
+
+				Context("when the port is given as a command line setting", func() {
+					BeforeEach(func() {
+						manifests, err := actor.MergeAndValidateSettingsAndManifests(CommandLineSettings{
+							Name:      "Some App",
+							Domain:    "tcp.domain.com",
+							RoutePort: 1234,
+						}, nil)
+						Expect(err).ToNot(HaveOccurred())
+						app = manifests[0]
+						fakeV2Actor.CheckRouteReturns(false, nil, nil)
+					})
+
+					It("returns a route with the port", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(returnedRoute).To(Equal(v2action.Route{Domain: tcpDomain, Port: 1234, SpaceGUID: spaceGUID}))
+					})
+				})
+
+				Context("when no port is provided", func() {
+					It("returns a partial route without a port so one is generated", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeV2Actor.GetRouterGroupCallCount()).To(Equal(0))
+						Expect(returnedRoute).To(Equal(v2action.Route{Domain: tcpDomain, SpaceGUID: spaceGUID}))
+						Expect(fakeV2Actor.CheckRouteCallCount()).To(Equal(0))
+					})
+
+					Context("when the application already has a route on the domain", func() {
+						BeforeEach(func() {
+							currentRoutes = []v2action.Route{
+								{GUID: "http-route-guid", Domain: domain, Host: "some-app"},
+								{GUID: "tcp-route-guid", Domain: tcpDomain, Port: 1024},
+							}
+						})
+
+						It("returns the existing route", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(returnedRoute).To(Equal(currentRoutes[1]))
+						})
+					})
+				})
+
+				Context("when a hostname is provided", func() {
+					BeforeEach(func() {
+						app.Hostname = "some-host"
+					})
+
+					It("returns an InvalidTCPRouteSettings error", func() {
+						Expect(executeErr).To(MatchError(InvalidTCPRouteSettings{Domain: "tcp.domain.com"}))
+					})
+				})
+			})
+
+			Context("when the domain does not exist", func() {
+				BeforeEach(func() {
+					app.Domain = "unknown.com"
+				})
+
+				It("returns a DomainNotFoundError and warnings", func() {
+					Expect(executeErr).To(MatchError(v2action.DomainNotFoundError{Name: "unknown.com"}))
+					Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings"))
+				})
+			})
+		})
+
+		Context("when the organization only has TCP domains", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{tcpDomain}, nil, nil)
+			})
+
+			It("returns a NoDomainsFoundError", func() {
+				Expect(executeErr).To(MatchError(NoDomainsFoundError{OrganizationGUID: orgGUID}))
 			})
 		})
 
@@ -10,8 +10,11 @@ type V2Actor interface {
 	CreateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
 	CreateRoute(route v2action.Route, generatePort bool) (v2action.Route, v2action.Warnings, error)
 	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetApplicationInstancesWithStatsByApplication(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error)
 	GetApplicationRoutes(applicationGUID string) ([]v2action.Route, v2action.Warnings, error)
 	GetOrganizationDomains(orgGUID string) ([]v2action.Domain, v2action.Warnings, error)
-	GetRouteByHostAndDomain(host string, domainGUID string) (v2action.Route, v2action.Warnings, error)
+	GetRouterGroup(guid string) (v2action.RouterGroup, v2action.Warnings, error)
+	GetRouteByComponents(route v2action.Route) (v2action.Route, v2action.Warnings, error)
+	UnbindRouteFromApplication(routeGUID string, appGUID string) (v2action.Warnings, error)
 	UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
 }
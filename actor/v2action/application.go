@@ -181,6 +181,34 @@ func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warn
 	return apps, Warnings(warnings), nil
 }
 
+// GetStoppedApplicationsBySpace returns the applications in the space that
+// are stopped and have not been updated since the given time.
+func (actor Actor) GetStoppedApplicationsBySpace(spaceGUID string, notUpdatedSince time.Time) ([]Application, Warnings, error) {
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	var stoppedApps []Application
+	for _, app := range apps {
+		if app.State == ccv2.ApplicationStopped && app.UpdatedAt.Before(notUpdatedSince) {
+			stoppedApps = append(stoppedApps, app)
+		}
+	}
+
+	return stoppedApps, warnings, nil
+}
+
+// DeleteApplication deletes the application with the given GUID, along with
+// its service bindings and route mappings.
+func (actor Actor) DeleteApplication(guid string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteApplication(guid)
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return Warnings(warnings), ApplicationNotFoundError{GUID: guid}
+	}
+	return Warnings(warnings), err
+}
+
 // GetApplicationByNameAndSpace returns an application with matching name in
 // the space.
 func (actor Actor) GetApplicationByNameAndSpace(name string, spaceGUID string) (Application, Warnings, error) {
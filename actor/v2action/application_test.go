@@ -365,6 +365,59 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("GetStoppedApplicationsBySpace", func() {
+		var cutoff time.Time
+
+		BeforeEach(func() {
+			cutoff = time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{
+					{GUID: "old-stopped-guid", State: ccv2.ApplicationStopped, UpdatedAt: cutoff.Add(-time.Hour)},
+					{GUID: "new-stopped-guid", State: ccv2.ApplicationStopped, UpdatedAt: cutoff.Add(time.Hour)},
+					{GUID: "old-started-guid", State: ccv2.ApplicationStarted, UpdatedAt: cutoff.Add(-time.Hour)},
+				},
+				ccv2.Warnings{"apps-warning"},
+				nil,
+			)
+		})
+
+		It("returns the stopped applications not updated since the given time", func() {
+			apps, warnings, err := actor.GetStoppedApplicationsBySpace("some-space-guid", cutoff)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apps).To(Equal([]Application{
+				{GUID: "old-stopped-guid", State: ccv2.ApplicationStopped, UpdatedAt: cutoff.Add(-time.Hour)},
+			}))
+			Expect(warnings).To(ConsistOf("apps-warning"))
+		})
+	})
+
+	Describe("DeleteApplication", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the application", func() {
+				warnings, err := actor.DeleteApplication("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				Expect(fakeCloudControllerClient.DeleteApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"delete-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				warnings, err := actor.DeleteApplication("some-app-guid")
+				Expect(err).To(MatchError(ApplicationNotFoundError{GUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("delete-warning"))
+			})
+		})
+	})
+
 	Describe("GetApplicationByNameAndSpace", func() {
 		Context("when the application exists", func() {
 			BeforeEach(func() {
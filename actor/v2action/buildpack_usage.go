@@ -0,0 +1,236 @@
+package v2action
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// BuildpackUsage is a group of applications that use the same buildpack,
+// buildpack version and stack.
+type BuildpackUsage struct {
+	Buildpack    string
+	Version      string
+	Stack        string
+	Applications []BuildpackUsageApplication
+}
+
+// BuildpackUsageApplication is an application in a BuildpackUsage group,
+// along with the buildpack detected when it was last staged.
+type BuildpackUsageApplication struct {
+	Name              string
+	OrganizationName  string
+	SpaceName         string
+	DetectedBuildpack string
+	PackageState      ccv2.ApplicationPackageState
+}
+
+type buildpackUsageKey struct {
+	buildpack string
+	version   string
+	stack     string
+}
+
+// GetBuildpackUsage groups every application visible to the current user by
+// buildpack, buildpack version and stack. Applications staged with an admin
+// buildpack are grouped under its name, whether it was given explicitly or
+// detected; the version is taken from the detected buildpack. Applications
+// that have never been staged have an empty buildpack. Groups are sorted by
+// buildpack, version and stack, and the applications in each group by org,
+// space and name.
+func (actor Actor) GetBuildpackUsage() ([]BuildpackUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	ccv2Apps, warnings, err := actor.CloudControllerClient.GetApplications(nil)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	ccv2Spaces, warnings, err := actor.CloudControllerClient.GetSpaces(nil)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	ccv2Orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(nil)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	ccv2Buildpacks, warnings, err := actor.CloudControllerClient.GetBuildpacks(nil)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	adminBuildpackNames := map[string]string{}
+	for _, buildpack := range ccv2Buildpacks {
+		adminBuildpackNames[buildpack.GUID] = buildpack.Name
+	}
+
+	orgNames := map[string]string{}
+	for _, org := range ccv2Orgs {
+		orgNames[org.GUID] = org.Name
+	}
+
+	spaces := map[string]ccv2.Space{}
+	for _, space := range ccv2Spaces {
+		spaces[space.GUID] = space
+	}
+
+	stackNames := map[string]string{}
+	groups := map[buildpackUsageKey]*BuildpackUsage{}
+	for _, ccv2App := range ccv2Apps {
+		app := Application(ccv2App)
+
+		stackName, ok := stackNames[app.StackGUID]
+		if !ok {
+			stack, warnings, err := actor.GetStack(app.StackGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			stackName = stack.Name
+			stackNames[app.StackGUID] = stackName
+		}
+
+		buildpack := buildpackUsageName(app, adminBuildpackNames)
+		_, version := parseDetectedBuildpack(app.DetectedBuildpack)
+
+		key := buildpackUsageKey{buildpack: buildpack, version: version, stack: stackName}
+		group, ok := groups[key]
+		if !ok {
+			group = &BuildpackUsage{Buildpack: buildpack, Version: version, Stack: stackName}
+			groups[key] = group
+		}
+
+		space := spaces[app.SpaceGUID]
+		group.Applications = append(group.Applications, BuildpackUsageApplication{
+			Name:              app.Name,
+			OrganizationName:  orgNames[space.OrganizationGUID],
+			SpaceName:         space.Name,
+			DetectedBuildpack: app.DetectedBuildpack,
+			PackageState:      app.PackageState,
+		})
+	}
+
+	usages := make([]BuildpackUsage, 0, len(groups))
+	for _, group := range groups {
+		sort.Sort(sortableBuildpackUsageApplications(group.Applications))
+		usages = append(usages, *group)
+	}
+	sort.Sort(sortableBuildpackUsages(usages))
+
+	return usages, allWarnings, nil
+}
+
+// buildpackUsageName returns the name the application's buildpack is grouped
+// under: the admin buildpack it was staged with, else the buildpack set by the
+// user, else the name reported by the detected buildpack.
+func buildpackUsageName(app Application, adminBuildpackNames map[string]string) string {
+	if name, ok := adminBuildpackNames[app.DetectedBuildpackGUID]; ok {
+		return name
+	}
+
+	if app.Buildpack != "" {
+		return app.Buildpack
+	}
+
+	name, _ := parseDetectedBuildpack(app.DetectedBuildpack)
+	return name
+}
+
+// parseDetectedBuildpack splits a detected buildpack into a name and
+// version. Buildpacks report themselves either as "NAME VERSION ..." or as
+// "NAME=VERSION-DETAILS ..."; anything else is returned as the name with an
+// empty version.
+func parseDetectedBuildpack(detected string) (string, string) {
+	fields := strings.Fields(detected)
+	if len(fields) == 0 {
+		return "", ""
+	}
+
+	if parts := strings.SplitN(fields[0], "=", 2); len(parts) == 2 && isBuildpackVersion(parts[1]) {
+		return parts[0], strings.SplitN(parts[1], "-", 2)[0]
+	}
+
+	if len(fields) > 1 && isBuildpackVersion(fields[1]) {
+		return fields[0], fields[1]
+	}
+
+	return detected, ""
+}
+
+// isBuildpackVersion returns true for values such as 1.6.28 and v3.13.
+func isBuildpackVersion(value string) bool {
+	value = strings.TrimPrefix(value, "v")
+	return value != "" && unicode.IsDigit(rune(value[0]))
+}
+
+// buildpackVersionLess compares versions such as 1.6.28 and v3.13 part by
+// part, numerically where both parts are numbers, so that 1.10.0 sorts after
+// 1.9.0.
+func buildpackVersionLess(a string, b string) bool {
+	aParts := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	bParts := strings.Split(strings.TrimPrefix(b, "v"), ".")
+
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		if aParts[i] == bParts[i] {
+			continue
+		}
+
+		aNumber, aErr := strconv.Atoi(aParts[i])
+		bNumber, bErr := strconv.Atoi(bParts[i])
+		if aErr == nil && bErr == nil {
+			return aNumber < bNumber
+		}
+		return aParts[i] < bParts[i]
+	}
+
+	return len(aParts) < len(bParts)
+}
+
+type sortableBuildpackUsages []BuildpackUsage
+
+func (s sortableBuildpackUsages) Len() int {
+	return len(s)
+}
+
+func (s sortableBuildpackUsages) Swap(i int, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s sortableBuildpackUsages) Less(i int, j int) bool {
+	if s[i].Buildpack != s[j].Buildpack {
+		return s[i].Buildpack < s[j].Buildpack
+	}
+	if s[i].Version != s[j].Version {
+		return buildpackVersionLess(s[i].Version, s[j].Version)
+	}
+	return s[i].Stack < s[j].Stack
+}
+
+type sortableBuildpackUsageApplications []BuildpackUsageApplication
+
+func (s sortableBuildpackUsageApplications) Len() int {
+	return len(s)
+}
+
+func (s sortableBuildpackUsageApplications) Swap(i int, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s sortableBuildpackUsageApplications) Less(i int, j int) bool {
+	if s[i].OrganizationName != s[j].OrganizationName {
+		return s[i].OrganizationName < s[j].OrganizationName
+	}
+	if s[i].SpaceName != s[j].SpaceName {
+		return s[i].SpaceName < s[j].SpaceName
+	}
+	return s[i].Name < s[j].Name
+}
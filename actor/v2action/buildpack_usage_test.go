@@ -0,0 +1,221 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Buildpack Usage Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetBuildpackUsage", func() {
+		var (
+			usages   []BuildpackUsage
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			usages, warnings, err = actor.GetBuildpackUsage()
+		})
+
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{
+						{
+							Name:                  "ruby-app-2",
+							SpaceGUID:             "space-guid-2",
+							StackGUID:             "stack-guid-1",
+							DetectedBuildpack:     "ruby 1.6.28",
+							DetectedBuildpackGUID: "ruby-buildpack-guid",
+							PackageState:          ccv2.ApplicationPackageStaged,
+						},
+						{
+							Name:                  "ruby-app-1",
+							SpaceGUID:             "space-guid-1",
+							StackGUID:             "stack-guid-1",
+							Buildpack:             "ruby_buildpack",
+							DetectedBuildpack:     "ruby 1.6.28",
+							DetectedBuildpackGUID: "ruby-buildpack-guid",
+							PackageState:          ccv2.ApplicationPackageStaged,
+						},
+						{
+							Name:                  "old-ruby-app",
+							SpaceGUID:             "space-guid-1",
+							StackGUID:             "stack-guid-1",
+							DetectedBuildpack:     "ruby 1.6.9",
+							DetectedBuildpackGUID: "ruby-buildpack-guid",
+							PackageState:          ccv2.ApplicationPackageStaged,
+						},
+						{
+							Name:              "java-app",
+							SpaceGUID:         "space-guid-1",
+							StackGUID:         "stack-guid-2",
+							Buildpack:         "java_buildpack_offline",
+							DetectedBuildpack: "java-buildpack=v3.13-offline-https://github.com/cloudfoundry/java-buildpack.git#03b493f java-main open-jdk-like-jre=1.8.0_121",
+							PackageState:      ccv2.ApplicationPackageStaged,
+						},
+						{
+							Name:         "new-app",
+							SpaceGUID:    "space-guid-1",
+							StackGUID:    "stack-guid-1",
+							PackageState: ccv2.ApplicationPackagePending,
+						},
+					},
+					ccv2.Warnings{"apps-warning"},
+					nil)
+
+				fakeCloudControllerClient.GetBuildpacksReturns(
+					[]ccv2.Buildpack{
+						{GUID: "ruby-buildpack-guid", Name: "ruby_buildpack"},
+						{GUID: "java-buildpack-guid", Name: "java_buildpack"},
+					},
+					ccv2.Warnings{"buildpacks-warning"},
+					nil)
+
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{
+						{GUID: "space-guid-1", Name: "space-1", OrganizationGUID: "org-guid-1"},
+						{GUID: "space-guid-2", Name: "space-2", OrganizationGUID: "org-guid-2"},
+					},
+					ccv2.Warnings{"spaces-warning"},
+					nil)
+
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{
+						{GUID: "org-guid-1", Name: "org-1"},
+						{GUID: "org-guid-2", Name: "org-2"},
+					},
+					ccv2.Warnings{"orgs-warning"},
+					nil)
+
+				fakeCloudControllerClient.GetStackStub = func(guid string) (ccv2.Stack, ccv2.Warnings, error) {
+					return ccv2.Stack{GUID: guid, Name: "name-of-" + guid}, ccv2.Warnings{"stack-warning"}, nil
+				}
+			})
+
+			It("groups the applications by admin buildpack, version and stack", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("apps-warning", "spaces-warning", "orgs-warning", "buildpacks-warning", "stack-warning", "stack-warning"))
+
+				Expect(usages).To(Equal([]BuildpackUsage{
+					{
+						Buildpack: "",
+						Version:   "",
+						Stack:     "name-of-stack-guid-1",
+						Applications: []BuildpackUsageApplication{
+							{Name: "new-app", OrganizationName: "org-1", SpaceName: "space-1", PackageState: ccv2.ApplicationPackagePending},
+						},
+					},
+					{
+						Buildpack: "java_buildpack_offline",
+						Version:   "v3.13",
+						Stack:     "name-of-stack-guid-2",
+						Applications: []BuildpackUsageApplication{
+							{
+								Name:              "java-app",
+								OrganizationName:  "org-1",
+								SpaceName:         "space-1",
+								DetectedBuildpack: "java-buildpack=v3.13-offline-https://github.com/cloudfoundry/java-buildpack.git#03b493f java-main open-jdk-like-jre=1.8.0_121",
+								PackageState:      ccv2.ApplicationPackageStaged,
+							},
+						},
+					},
+					{
+						Buildpack: "ruby_buildpack",
+						Version:   "1.6.9",
+						Stack:     "name-of-stack-guid-1",
+						Applications: []BuildpackUsageApplication{
+							{Name: "old-ruby-app", OrganizationName: "org-1", SpaceName: "space-1", DetectedBuildpack: "ruby 1.6.9", PackageState: ccv2.ApplicationPackageStaged},
+						},
+					},
+					{
+						Buildpack: "ruby_buildpack",
+						Version:   "1.6.28",
+						Stack:     "name-of-stack-guid-1",
+						Applications: []BuildpackUsageApplication{
+							{Name: "ruby-app-1", OrganizationName: "org-1", SpaceName: "space-1", DetectedBuildpack: "ruby 1.6.28", PackageState: ccv2.ApplicationPackageStaged},
+							{Name: "ruby-app-2", OrganizationName: "org-2", SpaceName: "space-2", DetectedBuildpack: "ruby 1.6.28", PackageState: ccv2.ApplicationPackageStaged},
+						},
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetBuildpacksCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetStackCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the detected buildpack has no version", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{
+						{Name: "some-app", DetectedBuildpack: "staticfile buildpack", StackGUID: "stack-guid"},
+					}, nil, nil)
+				fakeCloudControllerClient.GetStackReturns(ccv2.Stack{Name: "some-stack"}, nil, nil)
+			})
+
+			It("uses the whole detected buildpack as the name", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(usages).To(HaveLen(1))
+				Expect(usages[0].Buildpack).To(Equal("staticfile buildpack"))
+				Expect(usages[0].Version).To(BeEmpty())
+			})
+		})
+
+		Context("when getting the applications fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("apps error")
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"apps-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("apps-warning"))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the buildpacks fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns(nil, ccv2.Warnings{"buildpacks-warning"}, errors.New("buildpacks error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("buildpacks error"))
+				Expect(warnings).To(ConsistOf("buildpacks-warning"))
+				Expect(fakeCloudControllerClient.GetStackCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting a stack fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{{Name: "some-app", StackGUID: "stack-guid"}}, nil, nil)
+				fakeCloudControllerClient.GetStackReturns(ccv2.Stack{}, ccv2.Warnings{"stack-warning"}, errors.New("stack error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("stack error"))
+				Expect(warnings).To(ConsistOf("stack-warning"))
+			})
+		})
+	})
+})
@@ -10,27 +10,43 @@ type CloudControllerClient interface {
 	BindRouteToApplication(routeGUID string, appGUID string) (ccv2.Route, ccv2.Warnings, error)
 	CheckRoute(route ccv2.Route) (bool, ccv2.Warnings, error)
 	CreateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
+	CreateOrganization(orgName string) (ccv2.Organization, ccv2.Warnings, error)
 	CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error)
+	CreateServiceBinding(appGUID string, serviceInstanceGUID string, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error)
+	CreateSpace(spaceName string, orgGUID string) (ccv2.Space, ccv2.Warnings, error)
 	CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
+	DeleteApplication(guid string) (ccv2.Warnings, error)
 	DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
+	DeleteOrganizationUserGUIDByRole(role ccv2.OrganizationRole, orgGUID string, userGUID string) (ccv2.Warnings, error)
 	DeleteRoute(routeGUID string) (ccv2.Warnings, error)
 	DeleteServiceBinding(serviceBindingGUID string) (ccv2.Warnings, error)
+	DeleteServiceInstance(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	DeleteServiceKey(serviceKeyGUID string) (ccv2.Warnings, error)
+	DeleteSpaceUserGUIDByRole(role ccv2.SpaceRole, spaceGUID string, userGUID string) (ccv2.Warnings, error)
 	GetApplication(guid string) (ccv2.Application, ccv2.Warnings, error)
 	GetApplicationInstancesByApplication(guid string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error)
 	GetApplicationInstanceStatusesByApplication(guid string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error)
 	GetApplicationRoutes(appGUID string, queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
 	GetApplications(queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error)
+	GetBuildpacks(queries []ccv2.Query) ([]ccv2.Buildpack, ccv2.Warnings, error)
 	GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	GetOrganization(guid string) (ccv2.Organization, ccv2.Warnings, error)
 	GetOrganizationPrivateDomains(orgGUID string, queries []ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error)
 	GetOrganizationQuota(guid string) (ccv2.OrganizationQuota, ccv2.Warnings, error)
+	GetOrganizationQuotas(queries []ccv2.Query) ([]ccv2.OrganizationQuota, ccv2.Warnings, error)
 	GetOrganizations(queries []ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error)
+	GetOrganizationUsersByRole(role ccv2.OrganizationRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
 	GetPrivateDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
+	GetRouterGroups() ([]ccv2.RouterGroup, ccv2.Warnings, error)
 	GetRouteApplications(routeGUID string, queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error)
 	GetRoutes(queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
+	GetRunningSecurityGroups() ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	GetSecurityGroups(queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
+	GetServiceBindingParameters(serviceBindingGUID string) (map[string]interface{}, ccv2.Warnings, error)
 	GetServiceBindings(queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
 	GetServiceInstances(queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
+	GetServiceKeys(queries []ccv2.Query) ([]ccv2.ServiceKey, ccv2.Warnings, error)
+	GetServicePlan(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error)
 	GetSharedDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	GetSharedDomains() ([]ccv2.Domain, ccv2.Warnings, error)
 	GetSpaceQuota(guid string) (ccv2.SpaceQuota, ccv2.Warnings, error)
@@ -39,11 +55,20 @@ type CloudControllerClient interface {
 	GetSpaces(queries []ccv2.Query) ([]ccv2.Space, ccv2.Warnings, error)
 	GetSpaceServiceInstances(spaceGUID string, includeUserProvidedServices bool, queries []ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
 	GetSpaceStagingSecurityGroupsBySpace(spaceGUID string) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
+	GetSpaceUsersByRole(role ccv2.SpaceRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
 	GetStack(guid string) (ccv2.Stack, ccv2.Warnings, error)
+	GetStacks(queries []ccv2.Query) ([]ccv2.Stack, ccv2.Warnings, error)
+	GetStagingSecurityGroups() ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	PollJob(job ccv2.Job) (ccv2.Warnings, error)
 	RemoveSpaceFromSecurityGroup(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error)
+	UnbindRouteFromApplication(routeGUID string, appGUID string) (ccv2.Warnings, error)
 	UpdateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
+	UpdateOrganizationQuota(orgGUID string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error)
+	UpdateOrganizationUserByRole(role ccv2.OrganizationRole, orgGUID string, username string) (ccv2.Warnings, error)
+	UpdateOrganizationUserGUIDByRole(role ccv2.OrganizationRole, orgGUID string, userGUID string) (ccv2.Warnings, error)
+	UpdateSpaceUserByRole(role ccv2.SpaceRole, spaceGUID string, username string) (ccv2.Warnings, error)
+	UpdateSpaceUserGUIDByRole(role ccv2.SpaceRole, spaceGUID string, userGUID string) (ccv2.Warnings, error)
 
 	API() string
 	APIVersion() string
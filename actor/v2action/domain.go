@@ -8,9 +8,17 @@ import (
 // Domain represents a CLI Domain.
 type Domain ccv2.Domain
 
+// IsTCP returns true when the domain's routes are served by a TCP router
+// group.
+func (domain Domain) IsTCP() bool {
+	return domain.RouterGroupType == ccv2.TCPRouterGroup
+}
+
 // DomainNotFoundError is an error wrapper that represents the case
 // when the domain is not found.
-type DomainNotFoundError struct{}
+type DomainNotFoundError struct {
+	Name string
+}
 
 // Error method to display the error message.
 func (e DomainNotFoundError) Error() string {
@@ -23,6 +23,13 @@ var _ = Describe("Domain Actions", func() {
 		actor = NewActor(fakeCloudControllerClient, nil)
 	})
 
+	Describe("IsTCP", func() {
+		It("returns true only for domains with a TCP router group", func() {
+			Expect(Domain{RouterGroupType: ccv2.TCPRouterGroup}.IsTCP()).To(BeTrue())
+			Expect(Domain{}.IsTCP()).To(BeFalse())
+		})
+	})
+
 	Describe("GetDomain", func() {
 		Context("when the domain exists and is a shared domain", func() {
 			var expectedDomain ccv2.Domain
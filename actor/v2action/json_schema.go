@@ -0,0 +1,138 @@
+package v2action
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// validateAgainstSchema checks value against the subset of JSON Schema that
+// service brokers use to describe their parameters: type, enum, required,
+// properties, additionalProperties and items. It returns one reason for
+// every violation found, each prefixed with the path of the offending value.
+func validateAgainstSchema(value interface{}, schema map[string]interface{}, path string) []string {
+	var reasons []string
+
+	if schemaType, ok := schema["type"]; ok && !matchesSchemaType(value, schemaType) {
+		return []string{fmt.Sprintf("%s must be of type %s", schemaPath(path), formatSchemaType(schemaType))}
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, allowed := range enum {
+			if reflect.DeepEqual(value, allowed) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			reasons = append(reasons, fmt.Sprintf("%s must be one of %v", schemaPath(path), enum))
+		}
+	}
+
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, name := range required {
+				if key, isString := name.(string); isString {
+					if _, present := typedValue[key]; !present {
+						reasons = append(reasons, fmt.Sprintf("%s is required", schemaPath(joinSchemaPath(path, key))))
+					}
+				}
+			}
+		}
+
+		properties, _ := schema["properties"].(map[string]interface{})
+		keys := make([]string, 0, len(typedValue))
+		for key := range typedValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if propertySchema, ok := properties[key].(map[string]interface{}); ok {
+				reasons = append(reasons, validateAgainstSchema(typedValue[key], propertySchema, joinSchemaPath(path, key))...)
+			} else if additional, ok := schema["additionalProperties"].(bool); ok && !additional {
+				reasons = append(reasons, fmt.Sprintf("%s is not allowed", schemaPath(joinSchemaPath(path, key))))
+			}
+		}
+	case []interface{}:
+		if itemSchema, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range typedValue {
+				reasons = append(reasons, validateAgainstSchema(item, itemSchema, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	}
+
+	return reasons
+}
+
+func matchesSchemaType(value interface{}, schemaType interface{}) bool {
+	switch typed := schemaType.(type) {
+	case string:
+		return matchesSingleSchemaType(value, typed)
+	case []interface{}:
+		for _, t := range typed {
+			if name, ok := t.(string); ok && matchesSingleSchemaType(value, name) {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+func matchesSingleSchemaType(value interface{}, schemaType string) bool {
+	switch schemaType {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+		return ok && number == math.Trunc(number)
+	case "null":
+		return value == nil
+	default:
+		return true
+	}
+}
+
+func formatSchemaType(schemaType interface{}) string {
+	if types, ok := schemaType.([]interface{}); ok {
+		names := make([]string, 0, len(types))
+		for _, t := range types {
+			names = append(names, fmt.Sprint(t))
+		}
+		return strings.Join(names, " or ")
+	}
+	return fmt.Sprint(schemaType)
+}
+
+func joinSchemaPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func schemaPath(path string) string {
+	if path == "" {
+		return "parameters"
+	}
+	return fmt.Sprintf("'%s'", path)
+}
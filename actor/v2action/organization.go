@@ -99,3 +99,16 @@ func (actor Actor) DeleteOrganization(orgName string) (Warnings, error) {
 
 	return allWarnings, err
 }
+
+// CreateOrganization creates an organization with the provided name.
+func (actor Actor) CreateOrganization(orgName string) (Organization, Warnings, error) {
+	org, warnings, err := actor.CloudControllerClient.CreateOrganization(orgName)
+	return Organization(org), Warnings(warnings), err
+}
+
+// UpdateOrganizationQuota sets the quota of the organization associated with
+// the provided GUID.
+func (actor Actor) UpdateOrganizationQuota(orgGUID string, quotaGUID string) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.UpdateOrganizationQuota(orgGUID, quotaGUID)
+	return Warnings(warnings), err
+}
@@ -11,9 +11,13 @@ type OrganizationQuota ccv2.OrganizationQuota
 
 type OrganizationQuotaNotFoundError struct {
 	GUID string
+	Name string
 }
 
 func (e OrganizationQuotaNotFoundError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("Organization quota '%s' not found.", e.Name)
+	}
 	return fmt.Sprintf("Organization quota with GUID '%s' not found.", e.GUID)
 }
 
@@ -26,3 +30,24 @@ func (actor Actor) GetOrganizationQuota(guid string) (OrganizationQuota, Warning
 
 	return OrganizationQuota(orgQuota), Warnings(warnings), err
 }
+
+// GetOrganizationQuotaByName returns the organization quota with the provided
+// name.
+func (actor Actor) GetOrganizationQuotaByName(name string) (OrganizationQuota, Warnings, error) {
+	quotas, warnings, err := actor.CloudControllerClient.GetOrganizationQuotas([]ccv2.Query{
+		{
+			Filter:   ccv2.NameFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    name,
+		},
+	})
+	if err != nil {
+		return OrganizationQuota{}, Warnings(warnings), err
+	}
+
+	if len(quotas) == 0 {
+		return OrganizationQuota{}, Warnings(warnings), OrganizationQuotaNotFoundError{Name: name}
+	}
+
+	return OrganizationQuota(quotas[0]), Warnings(warnings), nil
+}
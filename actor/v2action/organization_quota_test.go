@@ -75,4 +75,41 @@ var _ = Describe("OrganizationQuota Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationQuotaByName", func() {
+		Context("when the quota exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(
+					[]ccv2.OrganizationQuota{{GUID: "some-quota-guid", Name: "some-quota"}},
+					ccv2.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("returns the quota and all warnings", func() {
+				quota, warnings, err := actor.GetOrganizationQuotaByName("some-quota")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(quota).To(Equal(OrganizationQuota{GUID: "some-quota-guid", Name: "some-quota"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationQuotasArgsForCall(0)).To(Equal([]ccv2.Query{{
+					Filter:   ccv2.NameFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-quota",
+				}}))
+			})
+		})
+
+		Context("when the quota does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(nil, ccv2.Warnings{"warning-1"}, nil)
+			})
+
+			It("returns an OrganizationQuotaNotFoundError and all warnings", func() {
+				_, warnings, err := actor.GetOrganizationQuotaByName("some-quota")
+				Expect(err).To(MatchError(OrganizationQuotaNotFoundError{Name: "some-quota"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 })
@@ -300,4 +300,38 @@ var _ = Describe("Org Actions", func() {
 			})
 		})
 	})
+
+	Describe("CreateOrganization", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.CreateOrganizationReturns(
+				ccv2.Organization{GUID: "some-org-guid", Name: "some-org"},
+				ccv2.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("creates the organization and returns all warnings", func() {
+			org, warnings, err := actor.CreateOrganization("some-org")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(org).To(Equal(Organization{GUID: "some-org-guid", Name: "some-org"}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(fakeCloudControllerClient.CreateOrganizationArgsForCall(0)).To(Equal("some-org"))
+		})
+	})
+
+	Describe("UpdateOrganizationQuota", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateOrganizationQuotaReturns(ccv2.Organization{}, ccv2.Warnings{"warning-1"}, nil)
+		})
+
+		It("sets the quota and returns all warnings", func() {
+			warnings, err := actor.UpdateOrganizationQuota("some-org-guid", "some-quota-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			orgGUID, quotaGUID := fakeCloudControllerClient.UpdateOrganizationQuotaArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(quotaGUID).To(Equal("some-quota-guid"))
+		})
+	})
+
 })
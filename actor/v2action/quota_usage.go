@@ -0,0 +1,172 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// ResourceUsage is how much of a quota limited resource is in use. A Limit of
+// -1 means the quota does not limit the resource.
+type ResourceUsage struct {
+	Used  int
+	Limit int
+}
+
+// Unlimited returns true if the quota does not limit the resource.
+func (usage ResourceUsage) Unlimited() bool {
+	return usage.Limit < 0
+}
+
+// PercentUsed returns Used as a percentage of Limit. Unlimited resources are
+// always 0% used; a resource with a limit of 0 is 100% used as soon as
+// anything uses it.
+func (usage ResourceUsage) PercentUsed() int {
+	switch {
+	case usage.Unlimited():
+		return 0
+	case usage.Limit == 0:
+		if usage.Used > 0 {
+			return 100
+		}
+		return 0
+	default:
+		return usage.Used * 100 / usage.Limit
+	}
+}
+
+// QuotaUsage is the usage of an organization or space compared with the limits
+// of its quota. Memory is in megabytes and covers started application
+// instances. AppTasks only has its Limit set; running tasks are V3 resources.
+type QuotaUsage struct {
+	GUID      string
+	QuotaName string
+
+	Memory           ResourceUsage
+	Instances        ResourceUsage
+	Routes           ResourceUsage
+	ServiceInstances ResourceUsage
+	AppTasks         ResourceUsage
+}
+
+// GetOrganizationUsageByName returns the usage of the organization with the
+// provided name across all of its spaces.
+func (actor Actor) GetOrganizationUsageByName(orgName string) (QuotaUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return QuotaUsage{}, allWarnings, err
+	}
+
+	quota, warnings, err := actor.GetOrganizationQuota(org.QuotaDefinitionGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return QuotaUsage{}, allWarnings, err
+	}
+
+	usage := QuotaUsage{
+		GUID:             org.GUID,
+		QuotaName:        quota.Name,
+		Memory:           ResourceUsage{Limit: quota.MemoryLimit},
+		Instances:        ResourceUsage{Limit: quota.AppInstanceLimit},
+		Routes:           ResourceUsage{Limit: quota.TotalRoutes},
+		ServiceInstances: ResourceUsage{Limit: quota.TotalServices},
+		AppTasks:         ResourceUsage{Limit: quota.AppTaskLimit},
+	}
+
+	warnings, err = actor.addUsage(&usage, ccv2.OrganizationGUIDFilter, org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return QuotaUsage{}, allWarnings, err
+	}
+
+	return usage, allWarnings, nil
+}
+
+// GetSpaceUsageByOrganizationAndName returns the usage of the space with the
+// provided name in the provided organization. When the space has no space
+// quota every resource is unlimited, although the organization quota still
+// applies.
+func (actor Actor) GetSpaceUsageByOrganizationAndName(orgGUID string, spaceName string) (QuotaUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	space, warnings, err := actor.GetSpaceByOrganizationAndName(orgGUID, spaceName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return QuotaUsage{}, allWarnings, err
+	}
+
+	quota := SpaceQuota{
+		MemoryLimit:      -1,
+		AppInstanceLimit: -1,
+		AppTaskLimit:     -1,
+		TotalRoutes:      -1,
+		TotalServices:    -1,
+	}
+	if space.SpaceQuotaDefinitionGUID != "" {
+		quota, warnings, err = actor.GetSpaceQuota(space.SpaceQuotaDefinitionGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return QuotaUsage{}, allWarnings, err
+		}
+	}
+
+	usage := QuotaUsage{
+		GUID:             space.GUID,
+		QuotaName:        quota.Name,
+		Memory:           ResourceUsage{Limit: quota.MemoryLimit},
+		Instances:        ResourceUsage{Limit: quota.AppInstanceLimit},
+		Routes:           ResourceUsage{Limit: quota.TotalRoutes},
+		ServiceInstances: ResourceUsage{Limit: quota.TotalServices},
+		AppTasks:         ResourceUsage{Limit: quota.AppTaskLimit},
+	}
+
+	warnings, err = actor.addUsage(&usage, ccv2.SpaceGUIDFilter, space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return QuotaUsage{}, allWarnings, err
+	}
+
+	return usage, allWarnings, nil
+}
+
+// addUsage counts the started application instances, routes and service
+// instances matching the provided filter. User provided service instances do
+// not count against a quota and are not returned by the service instances
+// endpoint.
+func (actor Actor) addUsage(usage *QuotaUsage, filter ccv2.QueryFilter, guid string) (Warnings, error) {
+	var allWarnings Warnings
+
+	query := []ccv2.Query{{
+		Filter:   filter,
+		Operator: ccv2.EqualOperator,
+		Value:    guid,
+	}}
+
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(query)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+	for _, app := range apps {
+		if app.State != ccv2.ApplicationStarted {
+			continue
+		}
+		usage.Instances.Used += app.Instances
+		usage.Memory.Used += app.Instances * app.Memory
+	}
+
+	routes, warnings, err := actor.CloudControllerClient.GetRoutes(query)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+	usage.Routes.Used = len(routes)
+
+	serviceInstances, warnings, err := actor.CloudControllerClient.GetServiceInstances(query)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+	usage.ServiceInstances.Used = len(serviceInstances)
+
+	return allWarnings, nil
+}
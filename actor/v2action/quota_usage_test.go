@@ -0,0 +1,212 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Quota Usage Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("ResourceUsage", func() {
+		DescribeTable("PercentUsed",
+			func(usage ResourceUsage, expected int) {
+				Expect(usage.PercentUsed()).To(Equal(expected))
+			},
+			Entry("unlimited", ResourceUsage{Used: 10, Limit: -1}, 0),
+			Entry("zero limit and unused", ResourceUsage{Used: 0, Limit: 0}, 0),
+			Entry("zero limit and used", ResourceUsage{Used: 1, Limit: 0}, 100),
+			Entry("partially used", ResourceUsage{Used: 3, Limit: 4}, 75),
+			Entry("over the limit", ResourceUsage{Used: 6, Limit: 4}, 150),
+		)
+	})
+
+	Describe("GetOrganizationUsageByName", func() {
+		var (
+			usage    QuotaUsage
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "some-quota-guid"}},
+				ccv2.Warnings{"org-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationQuotaReturns(
+				ccv2.OrganizationQuota{
+					Name:             "some-quota",
+					MemoryLimit:      4096,
+					AppInstanceLimit: -1,
+					AppTaskLimit:     5,
+					TotalRoutes:      10,
+					TotalServices:    2,
+				},
+				ccv2.Warnings{"quota-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{
+					{Name: "app-1", State: ccv2.ApplicationStarted, Instances: 2, Memory: 512},
+					{Name: "app-2", State: ccv2.ApplicationStarted, Instances: 1, Memory: 1024},
+					{Name: "app-3", State: ccv2.ApplicationStopped, Instances: 4, Memory: 1024},
+				},
+				ccv2.Warnings{"apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRoutesReturns(
+				[]ccv2.Route{{GUID: "route-1"}, {GUID: "route-2"}},
+				ccv2.Warnings{"routes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "service-instance-1"}, {GUID: "service-instance-2"}},
+				ccv2.Warnings{"service-instances-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			usage, warnings, err = actor.GetOrganizationUsageByName("some-org")
+		})
+
+		It("aggregates usage across the org", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("org-warning", "quota-warning", "apps-warning", "routes-warning", "service-instances-warning"))
+			Expect(usage).To(Equal(QuotaUsage{
+				GUID:             "some-org-guid",
+				QuotaName:        "some-quota",
+				Memory:           ResourceUsage{Used: 2048, Limit: 4096},
+				Instances:        ResourceUsage{Used: 3, Limit: -1},
+				Routes:           ResourceUsage{Used: 2, Limit: 10},
+				ServiceInstances: ResourceUsage{Used: 2, Limit: 2},
+				AppTasks:         ResourceUsage{Limit: 5},
+			}))
+
+			Expect(fakeCloudControllerClient.GetOrganizationQuotaArgsForCall(0)).To(Equal("some-quota-guid"))
+			expectedQuery := []ccv2.Query{{
+				Filter:   ccv2.OrganizationGUIDFilter,
+				Operator: ccv2.EqualOperator,
+				Value:    "some-org-guid",
+			}}
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(expectedQuery))
+			Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal(expectedQuery))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(Equal(expectedQuery))
+		})
+
+		Context("when getting the routes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("routes error")
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv2.Warnings{"routes-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("org-warning", "quota-warning", "apps-warning", "routes-warning"))
+				Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetSpaceUsageByOrganizationAndName", func() {
+		var (
+			usage    QuotaUsage
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{{State: ccv2.ApplicationStarted, Instances: 2, Memory: 256}},
+				ccv2.Warnings{"apps-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			usage, warnings, err = actor.GetSpaceUsageByOrganizationAndName("some-org-guid", "some-space")
+		})
+
+		Context("when the space has a space quota", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{{GUID: "some-space-guid", Name: "some-space", SpaceQuotaDefinitionGUID: "some-space-quota-guid"}},
+					ccv2.Warnings{"space-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpaceQuotaReturns(
+					ccv2.SpaceQuota{Name: "some-space-quota", MemoryLimit: 1024, AppInstanceLimit: 4, AppTaskLimit: -1, TotalRoutes: 2, TotalServices: -1},
+					ccv2.Warnings{"space-quota-warning"},
+					nil,
+				)
+			})
+
+			It("compares the space usage with the space quota", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("space-warning", "space-quota-warning", "apps-warning"))
+				Expect(usage).To(Equal(QuotaUsage{
+					GUID:             "some-space-guid",
+					QuotaName:        "some-space-quota",
+					Memory:           ResourceUsage{Used: 512, Limit: 1024},
+					Instances:        ResourceUsage{Used: 2, Limit: 4},
+					Routes:           ResourceUsage{Limit: 2},
+					ServiceInstances: ResourceUsage{Limit: -1},
+					AppTasks:         ResourceUsage{Limit: -1},
+				}))
+
+				Expect(fakeCloudControllerClient.GetSpaceQuotaArgsForCall(0)).To(Equal("some-space-quota-guid"))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv2.Query{{
+					Filter:   ccv2.SpaceGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-space-guid",
+				}}))
+			})
+		})
+
+		Context("when the space does not have a space quota", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{{GUID: "some-space-guid", Name: "some-space"}},
+					ccv2.Warnings{"space-warning"},
+					nil,
+				)
+			})
+
+			It("treats every resource as unlimited", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(usage.QuotaName).To(BeEmpty())
+				Expect(usage.Memory).To(Equal(ResourceUsage{Used: 512, Limit: -1}))
+				Expect(usage.AppTasks.Unlimited()).To(BeTrue())
+				Expect(fakeCloudControllerClient.GetSpaceQuotaCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"space-warning"}, nil)
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(err).To(MatchError(SpaceNotFoundError{Name: "some-space"}))
+				Expect(warnings).To(ConsistOf("space-warning"))
+			})
+		})
+	})
+})
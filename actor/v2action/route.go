@@ -50,6 +50,8 @@ func (e OrphanedRoutesNotFoundError) Error() string {
 type RouteNotFoundError struct {
 	Host       string
 	DomainGUID string
+	Path       string
+	Port       int
 }
 
 func (e RouteNotFoundError) Error() string {
@@ -74,6 +76,12 @@ func (actor Actor) BindRouteToApplication(routeGUID string, appGUID string) (War
 	return Warnings(warnings), err
 }
 
+// UnbindRouteFromApplication unbinds the route from the application.
+func (actor Actor) UnbindRouteFromApplication(routeGUID string, appGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UnbindRouteFromApplication(routeGUID, appGUID)
+	return Warnings(warnings), err
+}
+
 func (actor Actor) CreateRoute(route Route, generatePort bool) (Route, Warnings, error) {
 	returnedRoute, warnings, err := actor.CloudControllerClient.CreateRoute(actorToCCRoute(route), generatePort)
 	return ccToActorRoute(returnedRoute, route.Domain), Warnings(warnings), err
@@ -171,6 +179,52 @@ func (actor Actor) GetRouteByHostAndDomain(host string, domainGUID string) (Rout
 	return routes[0], append(Warnings(warnings), domainWarnings...), err
 }
 
+// GetRouteByComponents returns the route with the matching host, domain, path
+// and port. TCP routes are matched by domain and port only. The returned route
+// uses the provided route's domain.
+func (actor Actor) GetRouteByComponents(route Route) (Route, Warnings, error) {
+	var queries []ccv2.Query
+	if route.Domain.IsTCP() {
+		queries = []ccv2.Query{
+			{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: route.Domain.GUID},
+			{Filter: ccv2.PortFilter, Operator: ccv2.EqualOperator, Value: fmt.Sprint(route.Port)},
+		}
+	} else {
+		queries = []ccv2.Query{
+			{Filter: ccv2.HostFilter, Operator: ccv2.EqualOperator, Value: route.Host},
+			{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: route.Domain.GUID},
+		}
+		if route.Path != "" {
+			queries = append(queries, ccv2.Query{Filter: ccv2.PathFilter, Operator: ccv2.EqualOperator, Value: route.Path})
+		}
+	}
+
+	ccv2Routes, warnings, err := actor.CloudControllerClient.GetRoutes(queries)
+	if err != nil {
+		return Route{}, Warnings(warnings), err
+	}
+
+	// The path filter is a prefix match and routes without a path are returned
+	// along with the ones that have one, so only exact matches are kept.
+	var matches []ccv2.Route
+	for _, ccv2Route := range ccv2Routes {
+		if ccv2Route.Path == route.Path && ccv2Route.Port == route.Port {
+			matches = append(matches, ccv2Route)
+		}
+	}
+
+	if len(matches) == 0 {
+		return Route{}, Warnings(warnings), RouteNotFoundError{
+			Host:       route.Host,
+			DomainGUID: route.Domain.GUID,
+			Path:       route.Path,
+			Port:       route.Port,
+		}
+	}
+
+	return ccToActorRoute(matches[0], route.Domain), Warnings(warnings), nil
+}
+
 func (actor Actor) CheckRoute(route Route) (bool, Warnings, error) {
 	exists, warnings, err := actor.CloudControllerClient.CheckRoute(actorToCCRoute(route))
 	return exists, Warnings(warnings), err
@@ -80,6 +80,44 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("UnbindRouteFromApplication", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UnbindRouteFromApplicationReturns(
+					ccv2.Warnings{"unbind warning"},
+					nil)
+			})
+
+			It("unbinds the route from the application and returns all warnings", func() {
+				warnings, err := actor.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("unbind warning"))
+
+				Expect(fakeCloudControllerClient.UnbindRouteFromApplicationCallCount()).To(Equal(1))
+				routeGUID, appGUID := fakeCloudControllerClient.UnbindRouteFromApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("unbind route failed")
+				fakeCloudControllerClient.UnbindRouteFromApplicationReturns(
+					ccv2.Warnings{"unbind warning"},
+					expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("unbind warning"))
+			})
+		})
+	})
+
 	Describe("CreateRoute", func() {
 		Context("when no errors are encountered", func() {
 			BeforeEach(func() {
@@ -719,6 +757,108 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("GetRouteByComponents", func() {
+		var (
+			route      Route
+			foundRoute Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			foundRoute, warnings, executeErr = actor.GetRouteByComponents(route)
+		})
+
+		Context("when the domain is an HTTP domain", func() {
+			BeforeEach(func() {
+				route = Route{
+					Domain:    Domain{GUID: "some-domain-guid", Name: "domain.com"},
+					Host:      "some-host",
+					Path:      "/some-path",
+					SpaceGUID: "some-space-guid",
+				}
+			})
+
+			Context("when a route with the exact path exists", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+						{GUID: "other-route-guid", Host: "some-host", Path: "/some-path/deeper", DomainGUID: "some-domain-guid"},
+						{GUID: "some-route-guid", Host: "some-host", Path: "/some-path", DomainGUID: "some-domain-guid", SpaceGUID: "some-space-guid"},
+					}, ccv2.Warnings{"get-routes-warning"}, nil)
+				})
+
+				It("returns the route with the provided domain and warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-routes-warning"))
+					Expect(foundRoute).To(Equal(Route{
+						Domain:    Domain{GUID: "some-domain-guid", Name: "domain.com"},
+						GUID:      "some-route-guid",
+						Host:      "some-host",
+						Path:      "/some-path",
+						SpaceGUID: "some-space-guid",
+					}))
+
+					Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal([]ccv2.Query{
+						{Filter: ccv2.HostFilter, Operator: ccv2.EqualOperator, Value: "some-host"},
+						{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-domain-guid"},
+						{Filter: ccv2.PathFilter, Operator: ccv2.EqualOperator, Value: "/some-path"},
+					}))
+				})
+			})
+
+			Context("when no route matches exactly", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+						{GUID: "other-route-guid", Host: "some-host", Path: "/some-path/deeper", DomainGUID: "some-domain-guid"},
+					}, ccv2.Warnings{"get-routes-warning"}, nil)
+				})
+
+				It("returns a RouteNotFoundError and warnings", func() {
+					Expect(executeErr).To(MatchError(RouteNotFoundError{
+						Host:       "some-host",
+						DomainGUID: "some-domain-guid",
+						Path:       "/some-path",
+					}))
+					Expect(warnings).To(ConsistOf("get-routes-warning"))
+				})
+			})
+		})
+
+		Context("when the domain is a TCP domain", func() {
+			BeforeEach(func() {
+				route = Route{
+					Domain: Domain{GUID: "tcp-domain-guid", Name: "tcp.domain.com", RouterGroupType: ccv2.TCPRouterGroup},
+					Port:   1234,
+				}
+				fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+					{GUID: "tcp-route-guid", Port: 1234, DomainGUID: "tcp-domain-guid"},
+				}, nil, nil)
+			})
+
+			It("searches by domain and port", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(foundRoute.GUID).To(Equal("tcp-route-guid"))
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal([]ccv2.Query{
+					{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: "tcp-domain-guid"},
+					{Filter: ccv2.PortFilter, Operator: ccv2.EqualOperator, Value: "1234"},
+				}))
+			})
+		})
+
+		Context("when getting the routes returns an error", func() {
+			BeforeEach(func() {
+				route = Route{Domain: Domain{GUID: "some-domain-guid"}, Host: "some-host"}
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, errors.New("get-routes-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-routes-error"))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+			})
+		})
+	})
+
 	Describe("CheckRoute", func() {
 		Context("when the API calls succeed", func() {
 			BeforeEach(func() {
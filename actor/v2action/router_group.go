@@ -0,0 +1,65 @@
+package v2action
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// RouterGroup represents a routing API router group.
+type RouterGroup ccv2.RouterGroup
+
+// RouterGroupNotFoundError is returned when a requested router group is not
+// found.
+type RouterGroupNotFoundError struct {
+	GUID string
+}
+
+func (e RouterGroupNotFoundError) Error() string {
+	return fmt.Sprintf("Router group with GUID '%s' not found.", e.GUID)
+}
+
+// IsPortReservable returns true if the port is in the router group's
+// reservable ports. Reservable ports are a comma separated list of ports and
+// port ranges, such as "1024-1033,2000".
+func (routerGroup RouterGroup) IsPortReservable(port int) bool {
+	for _, portRange := range strings.Split(routerGroup.ReservablePorts, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(portRange), "-", 2)
+
+		min, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			continue
+		}
+		max := min
+		if len(bounds) == 2 {
+			max, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err != nil {
+				continue
+			}
+		}
+
+		if min <= port && port <= max {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetRouterGroup returns the router group with the provided GUID.
+func (actor Actor) GetRouterGroup(guid string) (RouterGroup, Warnings, error) {
+	routerGroups, warnings, err := actor.CloudControllerClient.GetRouterGroups()
+	if err != nil {
+		return RouterGroup{}, Warnings(warnings), err
+	}
+
+	for _, routerGroup := range routerGroups {
+		if routerGroup.GUID == guid {
+			return RouterGroup(routerGroup), Warnings(warnings), nil
+		}
+	}
+
+	return RouterGroup{}, Warnings(warnings), RouterGroupNotFoundError{GUID: guid}
+}
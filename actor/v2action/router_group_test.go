@@ -0,0 +1,93 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Router Group Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	DescribeTable("IsPortReservable",
+		func(reservablePorts string, port int, expected bool) {
+			routerGroup := RouterGroup{ReservablePorts: reservablePorts}
+			Expect(routerGroup.IsPortReservable(port)).To(Equal(expected))
+		},
+
+		Entry("port in a range", "1024-1033", 1030, true),
+		Entry("port at the bounds of a range", "1024-1033", 1033, true),
+		Entry("port outside a range", "1024-1033", 1034, false),
+		Entry("single port", "1024-1033,2000", 2000, true),
+		Entry("port outside all ranges", "1024-1033, 2000", 1500, false),
+		Entry("no reservable ports", "", 1024, false),
+	)
+
+	Describe("GetRouterGroup", func() {
+		Context("when the router group exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouterGroupsReturns(
+					[]ccv2.RouterGroup{
+						{GUID: "some-other-guid", Name: "other-tcp"},
+						{GUID: "some-router-group-guid", Name: "default-tcp", Type: "tcp", ReservablePorts: "1024-1033"},
+					},
+					ccv2.Warnings{"router-groups-warning"},
+					nil,
+				)
+			})
+
+			It("returns the router group and all warnings", func() {
+				routerGroup, warnings, err := actor.GetRouterGroup("some-router-group-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routerGroup).To(Equal(RouterGroup{
+					GUID:            "some-router-group-guid",
+					Name:            "default-tcp",
+					Type:            "tcp",
+					ReservablePorts: "1024-1033",
+				}))
+				Expect(warnings).To(ConsistOf("router-groups-warning"))
+				Expect(fakeCloudControllerClient.GetRouterGroupsCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the router group does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouterGroupsReturns(nil, ccv2.Warnings{"router-groups-warning"}, nil)
+			})
+
+			It("returns a RouterGroupNotFoundError and all warnings", func() {
+				_, warnings, err := actor.GetRouterGroup("some-router-group-guid")
+				Expect(err).To(MatchError(RouterGroupNotFoundError{GUID: "some-router-group-guid"}))
+				Expect(warnings).To(ConsistOf("router-groups-warning"))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("router groups error")
+				fakeCloudControllerClient.GetRouterGroupsReturns(nil, ccv2.Warnings{"router-groups-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetRouterGroup("some-router-group-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("router-groups-warning"))
+			})
+		})
+	})
+})
@@ -2,9 +2,11 @@ package v2action
 
 import (
 	"fmt"
+	"net"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/securitygroup"
 )
 
 // SecurityGroup represents a CF SecurityGroup.
@@ -20,6 +22,22 @@ func (e SecurityGroupNotFoundError) Error() string {
 	return fmt.Sprintf("Security group '%s' not found.", e.Name)
 }
 
+// EgressRule is a security group rule that allows egress traffic, along with
+// whether its security group is bound to every space or only to the space
+// being explained.
+type EgressRule struct {
+	SecurityGroupRule
+	Global bool
+}
+
+// EgressExplanation lists the rules that allow traffic to a destination from
+// running and staging applications in a space. Traffic is denied for a
+// lifecycle when it has no rules.
+type EgressExplanation struct {
+	Running []EgressRule
+	Staging []EgressRule
+}
+
 func (actor Actor) BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.AssociateSpaceWithSecurityGroup(securityGroupGUID, spaceGUID)
 	return Warnings(warnings), err
@@ -61,6 +79,55 @@ func (actor Actor) GetSpaceStagingSecurityGroupsBySpace(spaceGUID string) ([]Sec
 	return processSecurityGroups(spaceGUID, ccv2SecurityGroups, Warnings(warnings), err)
 }
 
+// ExplainEgress evaluates the security groups bound globally and to the
+// provided space to find the rules that allow traffic over protocol to the
+// destination ip and port.
+func (actor Actor) ExplainEgress(spaceGUID string, protocol string, ip net.IP, port int) (EgressExplanation, Warnings, error) {
+	var (
+		allWarnings Warnings
+		explanation EgressExplanation
+	)
+
+	lifecycles := []struct {
+		name        string
+		getGlobal   func() ([]ccv2.SecurityGroup, ccv2.Warnings, error)
+		getForSpace func(string) ([]SecurityGroup, Warnings, error)
+		rules       *[]EgressRule
+	}{
+		{"running", actor.CloudControllerClient.GetRunningSecurityGroups, actor.GetSpaceRunningSecurityGroupsBySpace, &explanation.Running},
+		{"staging", actor.CloudControllerClient.GetStagingSecurityGroups, actor.GetSpaceStagingSecurityGroupsBySpace, &explanation.Staging},
+	}
+
+	for _, lifecycle := range lifecycles {
+		globalGroups, ccWarnings, err := lifecycle.getGlobal()
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return EgressExplanation{}, allWarnings, err
+		}
+
+		spaceGroups, warnings, err := lifecycle.getForSpace(spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return EgressExplanation{}, allWarnings, err
+		}
+
+		globalGUIDs := map[string]bool{}
+		for _, group := range globalGroups {
+			globalGUIDs[group.GUID] = true
+			*lifecycle.rules = append(*lifecycle.rules, matchingEgressRules(SecurityGroup(group), lifecycle.name, true, protocol, ip, port)...)
+		}
+
+		for _, group := range spaceGroups {
+			if globalGUIDs[group.GUID] {
+				continue
+			}
+			*lifecycle.rules = append(*lifecycle.rules, matchingEgressRules(group, lifecycle.name, false, protocol, ip, port)...)
+		}
+	}
+
+	return explanation, allWarnings, nil
+}
+
 func (actor Actor) UnbindSecurityGroupByNameAndSpace(securityGroupName string, spaceGUID string) (Warnings, error) {
 	var allWarnings Warnings
 
@@ -123,6 +190,24 @@ func extractSecurityGroupRules(securityGroup SecurityGroup, lifecycle string) []
 	return securityGroupRules
 }
 
+func matchingEgressRules(securityGroup SecurityGroup, lifecycle string, global bool, protocol string, ip net.IP, port int) []EgressRule {
+	var matches []EgressRule
+
+	for _, rule := range extractSecurityGroupRules(securityGroup, lifecycle) {
+		allowed := securitygroup.Rule{
+			Protocol:    rule.Protocol,
+			Destination: rule.Destination,
+			Ports:       rule.Ports,
+		}.Allows(protocol, ip, port)
+
+		if allowed {
+			matches = append(matches, EgressRule{SecurityGroupRule: rule, Global: global})
+		}
+	}
+
+	return matches
+}
+
 func processSecurityGroups(spaceGUID string, ccv2SecurityGroups []ccv2.SecurityGroup, warnings Warnings, err error) ([]SecurityGroup, Warnings, error) {
 	if err != nil {
 		switch err.(type) {
@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"net"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
@@ -138,6 +139,145 @@ var _ = Describe("Security Group Actions", func() {
 		})
 	})
 
+	Describe("ExplainEgress", func() {
+		var (
+			explanation EgressExplanation
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			explanation, warnings, executeErr = actor.ExplainEgress("space-guid", "tcp", net.ParseIP("10.0.0.5"), 5432)
+		})
+
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRunningSecurityGroupsReturns(
+					[]ccv2.SecurityGroup{
+						{
+							GUID: "public-networks-guid",
+							Name: "public_networks",
+							Rules: []ccv2.SecurityGroupRule{
+								{Protocol: "all", Destination: "0.0.0.0-9.255.255.255"},
+								{Protocol: "all", Destination: "11.0.0.0-169.253.255.255"},
+							},
+						},
+					},
+					ccv2.Warnings{"global-running-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceReturns(
+					[]ccv2.SecurityGroup{
+						{
+							GUID: "public-networks-guid",
+							Name: "public_networks",
+							Rules: []ccv2.SecurityGroupRule{
+								{Protocol: "all", Destination: "0.0.0.0-9.255.255.255"},
+							},
+						},
+						{
+							GUID: "database-guid",
+							Name: "database",
+							Rules: []ccv2.SecurityGroupRule{
+								{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "5432", Description: "postgres"},
+								{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "3306"},
+							},
+						},
+					},
+					ccv2.Warnings{"space-running-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetStagingSecurityGroupsReturns(
+					[]ccv2.SecurityGroup{
+						{
+							GUID: "dns-guid",
+							Name: "dns",
+							Rules: []ccv2.SecurityGroupRule{
+								{Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53"},
+							},
+						},
+					},
+					ccv2.Warnings{"global-staging-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpaceStagingSecurityGroupsBySpaceReturns(
+					nil,
+					ccv2.Warnings{"space-staging-warning"},
+					nil,
+				)
+			})
+
+			It("returns the rules that allow the traffic for each lifecycle", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("global-running-warning", "space-running-warning", "global-staging-warning", "space-staging-warning"))
+
+				Expect(explanation.Running).To(Equal([]EgressRule{
+					{
+						SecurityGroupRule: SecurityGroupRule{Name: "database", Description: "postgres", Destination: "10.0.0.0/24", Lifecycle: "running", Ports: "5432", Protocol: "tcp"},
+						Global:            false,
+					},
+				}))
+				Expect(explanation.Staging).To(BeEmpty())
+
+				Expect(fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceArgsForCall(0)).To(Equal("space-guid"))
+				Expect(fakeCloudControllerClient.GetSpaceStagingSecurityGroupsBySpaceArgsForCall(0)).To(Equal("space-guid"))
+			})
+
+			Context("when a globally bound group allows the traffic", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetStagingSecurityGroupsReturns(
+						[]ccv2.SecurityGroup{
+							{
+								GUID: "private-guid",
+								Name: "private",
+								Rules: []ccv2.SecurityGroupRule{
+									{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "1-65535"},
+								},
+							},
+						},
+						nil,
+						nil,
+					)
+				})
+
+				It("marks the rule as global", func() {
+					Expect(explanation.Staging).To(Equal([]EgressRule{
+						{
+							SecurityGroupRule: SecurityGroupRule{Name: "private", Destination: "10.0.0.0/8", Lifecycle: "staging", Ports: "1-65535", Protocol: "tcp"},
+							Global:            true,
+						},
+					}))
+				})
+			})
+		})
+
+		Context("when getting the global security groups fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("banana")
+				fakeCloudControllerClient.GetRunningSecurityGroupsReturns(nil, ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceRunningSecurityGroupsBySpaceReturns(nil, ccv2.Warnings{"warning-1"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(SpaceNotFoundError{GUID: "space-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("GetSpaceRunningSecurityGroupsBySpace", func() {
 		Context("when the space exists and there are no errors", func() {
 			BeforeEach(func() {
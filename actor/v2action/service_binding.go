@@ -2,7 +2,9 @@ package v2action
 
 import (
 	"fmt"
+	"strings"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 )
 
@@ -21,6 +23,117 @@ func (e ServiceBindingNotFoundError) Error() string {
 	return fmt.Sprintf("Service binding for application GUID '%s', and service instance GUID '%s' not found.", e.AppGUID, e.ServiceInstanceGUID)
 }
 
+// ServiceAlreadyBoundError is returned when the application is already bound
+// to the service instance.
+type ServiceAlreadyBoundError struct {
+	AppName             string
+	ServiceInstanceName string
+}
+
+func (e ServiceAlreadyBoundError) Error() string {
+	return fmt.Sprintf("App %s is already bound to %s.", e.AppName, e.ServiceInstanceName)
+}
+
+// ServiceBindingParametersInvalidError is returned when the binding
+// parameters do not satisfy the binding schema published by the service
+// broker.
+type ServiceBindingParametersInvalidError struct {
+	Reasons []string
+}
+
+func (e ServiceBindingParametersInvalidError) Error() string {
+	return fmt.Sprintf("The binding parameters do not match the schema of the service plan: %s", strings.Join(e.Reasons, "; "))
+}
+
+// ServiceBindingParametersNotRetrievableError is returned when the service
+// broker does not allow the parameters of a binding to be fetched.
+type ServiceBindingParametersNotRetrievableError struct {
+	Message string
+}
+
+func (e ServiceBindingParametersNotRetrievableError) Error() string {
+	return e.Message
+}
+
+// BindServiceBySpace binds the application to the service instance in the
+// given space, passing the parameters to the service broker. When the plan
+// of a managed service instance publishes a binding schema, the parameters
+// are validated against it before the binding is created.
+func (actor Actor) BindServiceBySpace(appName string, serviceInstanceName string, spaceGUID string, parameters map[string]interface{}) (Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	if ccv2.ServiceInstance(serviceInstance).Managed() && serviceInstance.ServicePlanGUID != "" {
+		servicePlan, ccWarnings, planErr := actor.CloudControllerClient.GetServicePlan(serviceInstance.ServicePlanGUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if planErr != nil {
+			return allWarnings, planErr
+		}
+
+		if len(servicePlan.BindingSchema) > 0 {
+			var value interface{} = map[string]interface{}{}
+			if parameters != nil {
+				value = parameters
+			}
+			if reasons := validateAgainstSchema(value, servicePlan.BindingSchema, ""); len(reasons) > 0 {
+				return allWarnings, ServiceBindingParametersInvalidError{Reasons: reasons}
+			}
+		}
+	}
+
+	_, ccWarnings, err := actor.CloudControllerClient.CreateServiceBinding(app.GUID, serviceInstance.GUID, parameters)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if _, ok := err.(ccerror.ServiceBindingTakenError); ok {
+		return allWarnings, ServiceAlreadyBoundError{AppName: appName, ServiceInstanceName: serviceInstanceName}
+	}
+
+	return allWarnings, err
+}
+
+// GetServiceBindingByApplicationAndServiceInstanceNames returns the service
+// binding between the named application and service instance in the given
+// space.
+func (actor Actor) GetServiceBindingByApplicationAndServiceInstanceNames(appName string, serviceInstanceName string, spaceGUID string) (ServiceBinding, Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceBinding{}, allWarnings, err
+	}
+
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceBinding{}, allWarnings, err
+	}
+
+	serviceBinding, warnings, err := actor.GetServiceBindingByApplicationAndServiceInstance(app.GUID, serviceInstance.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	return serviceBinding, allWarnings, err
+}
+
+// GetServiceBindingParameters returns the parameters the service binding was
+// created with.
+func (actor Actor) GetServiceBindingParameters(serviceBindingGUID string) (map[string]interface{}, Warnings, error) {
+	parameters, warnings, err := actor.CloudControllerClient.GetServiceBindingParameters(serviceBindingGUID)
+	if e, ok := err.(ccerror.BadRequestError); ok {
+		return nil, Warnings(warnings), ServiceBindingParametersNotRetrievableError{Message: e.Message}
+	}
+	return parameters, Warnings(warnings), err
+}
+
 // GetServiceBindingByApplicationAndServiceInstance returns a service binding
 // given an application GUID and and service instance GUID.
 func (actor Actor) GetServiceBindingByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (ServiceBinding, Warnings, error) {
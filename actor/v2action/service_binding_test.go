@@ -5,6 +5,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 
 	. "github.com/onsi/ginkgo"
@@ -89,6 +90,230 @@ var _ = Describe("Service Binding Actions", func() {
 		})
 	})
 
+	Describe("BindServiceBySpace", func() {
+		var (
+			parameters map[string]interface{}
+			warnings   Warnings
+			err        error
+		)
+
+		BeforeEach(func() {
+			parameters = map[string]interface{}{"permissions": "read-only"}
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app"}},
+				ccv2.Warnings{"foo-1"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{
+					GUID:            "some-service-instance-guid",
+					Name:            "some-service-instance",
+					Type:            ccv2.ManagedService,
+					ServicePlanGUID: "some-service-plan-guid",
+				}},
+				ccv2.Warnings{"foo-2"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServicePlanReturns(ccv2.ServicePlan{}, ccv2.Warnings{"foo-3"}, nil)
+			fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"foo-4"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.BindServiceBySpace("some-app", "some-service-instance", "some-space-guid", parameters)
+		})
+
+		Context("when the plan does not publish a binding schema", func() {
+			It("creates the service binding with the parameters", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("foo-1", "foo-2", "foo-3", "foo-4"))
+
+				Expect(fakeCloudControllerClient.GetServicePlanArgsForCall(0)).To(Equal("some-service-plan-guid"))
+				Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(1))
+				appGUID, serviceInstanceGUID, passedParameters := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+				Expect(passedParameters).To(Equal(parameters))
+			})
+		})
+
+		Context("when the plan publishes a binding schema", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlanReturns(ccv2.ServicePlan{
+					BindingSchema: map[string]interface{}{
+						"type":                 "object",
+						"required":             []interface{}{"permissions"},
+						"additionalProperties": false,
+						"properties": map[string]interface{}{
+							"permissions": map[string]interface{}{
+								"type": "string",
+								"enum": []interface{}{"read-only", "read-write"},
+							},
+							"ttl": map[string]interface{}{
+								"type": "integer",
+							},
+						},
+					},
+				}, ccv2.Warnings{"foo-3"}, nil)
+			})
+
+			Context("when the parameters are valid", func() {
+				It("creates the service binding", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the parameters are invalid", func() {
+				BeforeEach(func() {
+					parameters = map[string]interface{}{
+						"ttl":   1.5,
+						"extra": true,
+					}
+				})
+
+				It("returns every violation and does not create the binding", func() {
+					Expect(err).To(MatchError(ServiceBindingParametersInvalidError{
+						Reasons: []string{
+							"'permissions' is required",
+							"'extra' is not allowed",
+							"'ttl' must be of type integer",
+						},
+					}))
+					Expect(warnings).To(ConsistOf("foo-1", "foo-2", "foo-3"))
+					Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when a value is not in the enum", func() {
+				BeforeEach(func() {
+					parameters = map[string]interface{}{"permissions": "admin"}
+				})
+
+				It("returns a ServiceBindingParametersInvalidError", func() {
+					Expect(err).To(MatchError(ServiceBindingParametersInvalidError{
+						Reasons: []string{"'permissions' must be one of [read-only read-write]"},
+					}))
+				})
+			})
+
+			Context("when no parameters are provided", func() {
+				BeforeEach(func() {
+					parameters = nil
+				})
+
+				It("validates an empty object", func() {
+					Expect(err).To(MatchError(ServiceBindingParametersInvalidError{
+						Reasons: []string{"'permissions' is required"},
+					}))
+				})
+			})
+		})
+
+		Context("when the service instance is user provided", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", Type: ccv2.UserProvidedService}},
+					ccv2.Warnings{"foo-2"},
+					nil,
+				)
+			})
+
+			It("does not look up a service plan", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetServicePlanCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the app is already bound to the service instance", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"foo-4"}, ccerror.ServiceBindingTakenError{})
+			})
+
+			It("returns a ServiceAlreadyBoundError", func() {
+				Expect(err).To(MatchError(ServiceAlreadyBoundError{
+					AppName:             "some-app",
+					ServiceInstanceName: "some-service-instance",
+				}))
+				Expect(warnings).To(ConsistOf("foo-1", "foo-2", "foo-3", "foo-4"))
+			})
+		})
+
+		Context("when getting the service plan fails", func() {
+			var expectedError error
+
+			BeforeEach(func() {
+				expectedError = errors.New("plan error")
+				fakeCloudControllerClient.GetServicePlanReturns(ccv2.ServicePlan{}, ccv2.Warnings{"foo-3"}, expectedError)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError(expectedError))
+				Expect(warnings).To(ConsistOf("foo-1", "foo-2", "foo-3"))
+				Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetServiceBindingByApplicationAndServiceInstanceNames", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app"}},
+				ccv2.Warnings{"foo-1"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", Name: "some-service-instance"}},
+				ccv2.Warnings{"foo-2"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceBindingsReturns(
+				[]ccv2.ServiceBinding{{GUID: "some-service-binding-guid", Credentials: map[string]interface{}{"password": "secret"}}},
+				ccv2.Warnings{"foo-3"},
+				nil,
+			)
+		})
+
+		It("returns the service binding and all warnings", func() {
+			serviceBinding, warnings, err := actor.GetServiceBindingByApplicationAndServiceInstanceNames("some-app", "some-service-instance", "some-space-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceBinding).To(Equal(ServiceBinding{
+				GUID:        "some-service-binding-guid",
+				Credentials: map[string]interface{}{"password": "secret"},
+			}))
+			Expect(warnings).To(ConsistOf("foo-1", "foo-2", "foo-3"))
+		})
+	})
+
+	Describe("GetServiceBindingParameters", func() {
+		Context("when the parameters can be retrieved", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingParametersReturns(map[string]interface{}{"permissions": "read-only"}, ccv2.Warnings{"foo"}, nil)
+			})
+
+			It("returns the parameters and warnings", func() {
+				parameters, warnings, err := actor.GetServiceBindingParameters("some-service-binding-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parameters).To(Equal(map[string]interface{}{"permissions": "read-only"}))
+				Expect(warnings).To(ConsistOf("foo"))
+				Expect(fakeCloudControllerClient.GetServiceBindingParametersArgsForCall(0)).To(Equal("some-service-binding-guid"))
+			})
+		})
+
+		Context("when the broker does not support retrieving parameters", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingParametersReturns(nil, ccv2.Warnings{"foo"}, ccerror.BadRequestError{Message: "not supported"})
+			})
+
+			It("returns a ServiceBindingParametersNotRetrievableError", func() {
+				_, warnings, err := actor.GetServiceBindingParameters("some-service-binding-guid")
+				Expect(err).To(MatchError(ServiceBindingParametersNotRetrievableError{Message: "not supported"}))
+				Expect(warnings).To(ConsistOf("foo"))
+			})
+		})
+	})
+
 	Describe("UnbindServiceBySpace", func() {
 		Context("when the service binding exists", func() {
 			BeforeEach(func() {
@@ -9,6 +9,12 @@ import (
 // ServiceInstance represents an instance of a service.
 type ServiceInstance ccv2.ServiceInstance
 
+// OperationInProgress returns true if the service broker has not finished the
+// last operation on the service instance.
+func (instance ServiceInstance) OperationInProgress() bool {
+	return instance.LastOperation.State == ccv2.LastOperationInProgress
+}
+
 type ServiceInstanceNotFoundError struct {
 	Name string
 }
@@ -57,3 +63,42 @@ func (actor Actor) GetServiceInstancesBySpace(spaceGUID string) ([]ServiceInstan
 
 	return serviceInstances, Warnings(warnings), nil
 }
+
+// GetUnboundServiceInstancesBySpace returns the service instances in the
+// space that are not bound to any application.
+func (actor Actor) GetUnboundServiceInstancesBySpace(spaceGUID string) ([]ServiceInstance, Warnings, error) {
+	serviceInstances, allWarnings, err := actor.GetServiceInstancesBySpace(spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var unboundInstances []ServiceInstance
+	for _, serviceInstance := range serviceInstances {
+		bindings, warnings, err := actor.CloudControllerClient.GetServiceBindings([]ccv2.Query{
+			ccv2.Query{
+				Filter:   ccv2.ServiceInstanceGUIDFilter,
+				Operator: ccv2.EqualOperator,
+				Value:    serviceInstance.GUID,
+			},
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		if len(bindings) == 0 {
+			unboundInstances = append(unboundInstances, serviceInstance)
+		}
+	}
+
+	return unboundInstances, allWarnings, nil
+}
+
+// DeleteServiceInstance deletes the service instance with the given GUID.
+// The service instance must not have any bindings or service keys. If the
+// service broker deletes it asynchronously, the returned service instance has
+// an operation in progress.
+func (actor Actor) DeleteServiceInstance(serviceInstanceGUID string) (ServiceInstance, Warnings, error) {
+	serviceInstance, warnings, err := actor.CloudControllerClient.DeleteServiceInstance(serviceInstanceGUID)
+	return ServiceInstance(serviceInstance), Warnings(warnings), err
+}
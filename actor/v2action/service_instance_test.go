@@ -148,4 +148,103 @@ var _ = Describe("Service Instance Actions", func() {
 			})
 		})
 	})
+	Describe("GetUnboundServiceInstancesBySpace", func() {
+		Context("when some service instances have no bindings", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{
+						{GUID: "bound-guid", Name: "bound-instance"},
+						{GUID: "unbound-guid", Name: "unbound-instance"},
+					},
+					ccv2.Warnings{"instances-warning"},
+					nil)
+				fakeCloudControllerClient.GetServiceBindingsStub = func(queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
+					if queries[0].Value == "bound-guid" {
+						return []ccv2.ServiceBinding{{GUID: "some-binding-guid"}}, ccv2.Warnings{"bindings-warning"}, nil
+					}
+					return nil, ccv2.Warnings{"bindings-warning"}, nil
+				}
+			})
+
+			It("returns the unbound service instances and all warnings", func() {
+				serviceInstances, warnings, err := actor.GetUnboundServiceInstancesBySpace("some-space-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceInstances).To(Equal([]ServiceInstance{{GUID: "unbound-guid", Name: "unbound-instance"}}))
+				Expect(warnings).To(ConsistOf("instances-warning", "bindings-warning", "bindings-warning"))
+
+				spaceGUID, includeUserProvided, _ := fakeCloudControllerClient.GetSpaceServiceInstancesArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(includeUserProvided).To(BeTrue())
+
+				Expect(fakeCloudControllerClient.GetServiceBindingsCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetServiceBindingsArgsForCall(1)).To(Equal([]ccv2.Query{{
+					Filter:   ccv2.ServiceInstanceGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "unbound-guid",
+				}}))
+			})
+		})
+
+		Context("when getting the bindings fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("bindings error")
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns([]ccv2.ServiceInstance{{GUID: "some-guid"}}, nil, nil)
+				fakeCloudControllerClient.GetServiceBindingsReturns(nil, ccv2.Warnings{"bindings-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetUnboundServiceInstancesBySpace("some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("bindings-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteServiceInstance", func() {
+		Context("when the service instance is deleted synchronously", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceInstanceReturns(ccv2.ServiceInstance{}, ccv2.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the service instance", func() {
+				serviceInstance, warnings, err := actor.DeleteServiceInstance("some-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceInstance.OperationInProgress()).To(BeFalse())
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceArgsForCall(0)).To(Equal("some-guid"))
+			})
+		})
+
+		Context("when the service broker deletes the service instance asynchronously", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceInstanceReturns(
+					ccv2.ServiceInstance{
+						GUID:          "some-guid",
+						LastOperation: ccv2.LastOperation{Type: "delete", State: ccv2.LastOperationInProgress},
+					},
+					ccv2.Warnings{"delete-warning"}, nil)
+			})
+
+			It("returns the service instance with its operation in progress", func() {
+				serviceInstance, warnings, err := actor.DeleteServiceInstance("some-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceInstance.OperationInProgress()).To(BeTrue())
+				Expect(warnings).To(ConsistOf("delete-warning"))
+			})
+		})
+
+		Context("when the delete fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceInstanceReturns(ccv2.ServiceInstance{}, ccv2.Warnings{"delete-warning"}, errors.New("delete error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.DeleteServiceInstance("some-guid")
+				Expect(err).To(MatchError("delete error"))
+				Expect(warnings).To(ConsistOf("delete-warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,35 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// ServiceKey represents a set of credentials for a service instance that are
+// not bound to an application.
+type ServiceKey ccv2.ServiceKey
+
+// GetServiceKeysByServiceInstance returns the service keys of the service
+// instance with the given GUID.
+func (actor Actor) GetServiceKeysByServiceInstance(serviceInstanceGUID string) ([]ServiceKey, Warnings, error) {
+	ccv2ServiceKeys, warnings, err := actor.CloudControllerClient.GetServiceKeys([]ccv2.Query{
+		ccv2.Query{
+			Filter:   ccv2.ServiceInstanceGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    serviceInstanceGUID,
+		},
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	serviceKeys := make([]ServiceKey, len(ccv2ServiceKeys))
+	for i, ccv2ServiceKey := range ccv2ServiceKeys {
+		serviceKeys[i] = ServiceKey(ccv2ServiceKey)
+	}
+
+	return serviceKeys, Warnings(warnings), nil
+}
+
+// DeleteServiceKey deletes the service key with the given GUID.
+func (actor Actor) DeleteServiceKey(serviceKeyGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteServiceKey(serviceKeyGUID)
+	return Warnings(warnings), err
+}
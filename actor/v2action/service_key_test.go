@@ -0,0 +1,83 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Key Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetServiceKeysByServiceInstance", func() {
+		Context("when the service instance has service keys", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeysReturns(
+					[]ccv2.ServiceKey{
+						{GUID: "key-guid-1", Name: "key-1", ServiceInstanceGUID: "some-instance-guid"},
+						{GUID: "key-guid-2", Name: "key-2", ServiceInstanceGUID: "some-instance-guid"},
+					},
+					ccv2.Warnings{"keys-warning"},
+					nil,
+				)
+			})
+
+			It("returns the service keys and all warnings", func() {
+				serviceKeys, warnings, err := actor.GetServiceKeysByServiceInstance("some-instance-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(serviceKeys).To(Equal([]ServiceKey{
+					{GUID: "key-guid-1", Name: "key-1", ServiceInstanceGUID: "some-instance-guid"},
+					{GUID: "key-guid-2", Name: "key-2", ServiceInstanceGUID: "some-instance-guid"},
+				}))
+				Expect(warnings).To(ConsistOf("keys-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceKeysArgsForCall(0)).To(Equal([]ccv2.Query{{
+					Filter:   ccv2.ServiceInstanceGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-instance-guid",
+				}}))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("keys error")
+				fakeCloudControllerClient.GetServiceKeysReturns(nil, ccv2.Warnings{"keys-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetServiceKeysByServiceInstance("some-instance-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("keys-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteServiceKey", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteServiceKeyReturns(ccv2.Warnings{"delete-warning"}, nil)
+		})
+
+		It("deletes the service key", func() {
+			warnings, err := actor.DeleteServiceKey("key-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete-warning"))
+			Expect(fakeCloudControllerClient.DeleteServiceKeyArgsForCall(0)).To(Equal("key-guid"))
+		})
+	})
+})
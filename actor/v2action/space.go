@@ -90,3 +90,10 @@ func (actor Actor) GetSpaceByOrganizationAndName(orgGUID string, spaceName strin
 
 	return Space(ccv2Spaces[0]), Warnings(warnings), nil
 }
+
+// CreateSpace creates a space with the provided name in the organization
+// associated with the provided GUID.
+func (actor Actor) CreateSpace(spaceName string, orgGUID string) (Space, Warnings, error) {
+	space, warnings, err := actor.CloudControllerClient.CreateSpace(spaceName, orgGUID)
+	return Space(space), Warnings(warnings), err
+}
@@ -6,4 +6,5 @@ import "code.cloudfoundry.org/cli/api/uaa"
 
 type UAAClient interface {
 	CreateUser(username string, password string, origin string) (uaa.User, error)
+	GetUsers(username string, origin string) ([]uaa.User, error)
 }
@@ -16,3 +16,123 @@ func (actor Actor) CreateUser(username string, password string, origin string) (
 
 	return User(ccUser), Warnings(ccWarnings), err
 }
+
+// OrganizationRole is a role a user can have in an organization.
+type OrganizationRole ccv2.OrganizationRole
+
+const (
+	OrganizationUserRole           = OrganizationRole(ccv2.OrganizationUserRole)
+	OrganizationManagerRole        = OrganizationRole(ccv2.OrganizationManagerRole)
+	OrganizationBillingManagerRole = OrganizationRole(ccv2.OrganizationBillingManagerRole)
+	OrganizationAuditorRole        = OrganizationRole(ccv2.OrganizationAuditorRole)
+)
+
+// SpaceRole is a role a user can have in a space.
+type SpaceRole ccv2.SpaceRole
+
+const (
+	SpaceManagerRole   = SpaceRole(ccv2.SpaceManagerRole)
+	SpaceDeveloperRole = SpaceRole(ccv2.SpaceDeveloperRole)
+	SpaceAuditorRole   = SpaceRole(ccv2.SpaceAuditorRole)
+)
+
+// GetOrganizationUsersByRole returns the users with the provided role in the
+// organization.
+func (actor Actor) GetOrganizationUsersByRole(role OrganizationRole, orgGUID string) ([]User, Warnings, error) {
+	ccUsers, warnings, err := actor.CloudControllerClient.GetOrganizationUsersByRole(ccv2.OrganizationRole(role), orgGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var users []User
+	for _, ccUser := range ccUsers {
+		users = append(users, User(ccUser))
+	}
+	return users, Warnings(warnings), nil
+}
+
+// SetOrganizationRole gives the user the provided role in the organization,
+// adding them to the organization first if needed.
+func (actor Actor) SetOrganizationRole(role OrganizationRole, orgGUID string, username string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UpdateOrganizationUserByRole(ccv2.OrganizationUserRole, orgGUID, username)
+	allWarnings := Warnings(warnings)
+	if err != nil || role == OrganizationUserRole {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.UpdateOrganizationUserByRole(ccv2.OrganizationRole(role), orgGUID, username)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// SetOrganizationRoleByGUID gives the user with the provided GUID the
+// provided role in the organization, adding them to the organization first if
+// needed.
+func (actor Actor) SetOrganizationRoleByGUID(role OrganizationRole, orgGUID string, userGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UpdateOrganizationUserGUIDByRole(ccv2.OrganizationUserRole, orgGUID, userGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil || role == OrganizationUserRole {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.UpdateOrganizationUserGUIDByRole(ccv2.OrganizationRole(role), orgGUID, userGUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// UnsetOrganizationRoleByGUID removes the provided role from the user with
+// the provided GUID in the organization. The user remains a member of the
+// organization.
+func (actor Actor) UnsetOrganizationRoleByGUID(role OrganizationRole, orgGUID string, userGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteOrganizationUserGUIDByRole(ccv2.OrganizationRole(role), orgGUID, userGUID)
+	return Warnings(warnings), err
+}
+
+// GetSpaceUsersByRole returns the users with the provided role in the space.
+func (actor Actor) GetSpaceUsersByRole(role SpaceRole, spaceGUID string) ([]User, Warnings, error) {
+	ccUsers, warnings, err := actor.CloudControllerClient.GetSpaceUsersByRole(ccv2.SpaceRole(role), spaceGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var users []User
+	for _, ccUser := range ccUsers {
+		users = append(users, User(ccUser))
+	}
+	return users, Warnings(warnings), nil
+}
+
+// SetSpaceRole gives the user the provided role in the space, adding them to
+// the space's organization first if needed.
+func (actor Actor) SetSpaceRole(role SpaceRole, orgGUID string, spaceGUID string, username string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UpdateOrganizationUserByRole(ccv2.OrganizationUserRole, orgGUID, username)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.UpdateSpaceUserByRole(ccv2.SpaceRole(role), spaceGUID, username)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// SetSpaceRoleByGUID gives the user with the provided GUID the provided role
+// in the space, adding them to the space's organization first if needed.
+func (actor Actor) SetSpaceRoleByGUID(role SpaceRole, orgGUID string, spaceGUID string, userGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UpdateOrganizationUserGUIDByRole(ccv2.OrganizationUserRole, orgGUID, userGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.UpdateSpaceUserGUIDByRole(ccv2.SpaceRole(role), spaceGUID, userGUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// UnsetSpaceRoleByGUID removes the provided role from the user with the
+// provided GUID in the space.
+func (actor Actor) UnsetSpaceRoleByGUID(role SpaceRole, spaceGUID string, userGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteSpaceUserGUIDByRole(ccv2.SpaceRole(role), spaceGUID, userGUID)
+	return Warnings(warnings), err
+}
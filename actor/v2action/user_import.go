@@ -0,0 +1,439 @@
+package v2action
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// DefaultUserOrigin is the origin of users stored in UAA itself.
+const DefaultUserOrigin = "uaa"
+
+// InvalidUserImportFileError is returned when a user import file cannot be
+// used. Line is 0 when the problem is not specific to a line.
+type InvalidUserImportFileError struct {
+	Path    string
+	Line    int
+	Message string
+}
+
+func (e InvalidUserImportFileError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("Invalid user import file %s: %s", e.Path, e.Message)
+	}
+	return fmt.Sprintf("Invalid user import file %s, line %d: %s", e.Path, e.Line, e.Message)
+}
+
+// UserImportRow is a single role assignment from a user import file. Exactly
+// one of OrganizationRole and SpaceRole is set; Space is only set for space
+// roles.
+type UserImportRow struct {
+	Username         string
+	Password         string
+	Origin           string
+	Organization     string
+	Space            string
+	OrganizationRole OrganizationRole
+	SpaceRole        SpaceRole
+}
+
+// Role returns the name of the row's role.
+func (row UserImportRow) Role() string {
+	if row.SpaceRole != "" {
+		return string(row.SpaceRole)
+	}
+	return string(row.OrganizationRole)
+}
+
+// UserImportAction is the change importing a row made, or would make during a
+// dry run.
+type UserImportAction string
+
+const (
+	UserImportAssignRole UserImportAction = "assign"
+	UserImportRemoveRole UserImportAction = "remove"
+	UserImportNoChange   UserImportAction = "none"
+)
+
+// UserImportResult is the outcome of importing a row, or of removing a role
+// that is not listed in the file. CreatedUser is set when the user had to be
+// created in UAA first. When Err is set the Action was not completed.
+type UserImportResult struct {
+	UserImportRow
+	Action      UserImportAction
+	CreatedUser bool
+	Err         error
+}
+
+var userImportColumns = []string{"username", "password", "origin", "org", "space", "role"}
+
+var userImportOrganizationRoles = map[string]OrganizationRole{
+	"orgmanager":     OrganizationManagerRole,
+	"billingmanager": OrganizationBillingManagerRole,
+	"orgauditor":     OrganizationAuditorRole,
+}
+
+var userImportSpaceRoles = map[string]SpaceRole{
+	"spacemanager":   SpaceManagerRole,
+	"spacedeveloper": SpaceDeveloperRole,
+	"spaceauditor":   SpaceAuditorRole,
+}
+
+// ReadUserImportFile parses the CSV user import file at path. The first line
+// is a header naming the columns, in any order: username, org and role are
+// required; password, origin and space are optional. Roles use the names
+// accepted by set-org-role and set-space-role.
+func ReadUserImportFile(path string) ([]UserImportRow, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, InvalidUserImportFileError{Path: path, Message: "the file is empty"}
+	} else if err != nil {
+		return nil, InvalidUserImportFileError{Path: path, Message: err.Error()}
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"username", "org", "role"} {
+		if _, ok := columns[required]; !ok {
+			return nil, InvalidUserImportFileError{Path: path, Line: 1, Message: fmt.Sprintf("missing required column '%s' (columns are %s)", required, strings.Join(userImportColumns, ", "))}
+		}
+	}
+
+	var rows []UserImportRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, InvalidUserImportFileError{Path: path, Line: line, Message: err.Error()}
+		}
+
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		row := UserImportRow{
+			Username:     field("username"),
+			Password:     field("password"),
+			Origin:       field("origin"),
+			Organization: field("org"),
+			Space:        field("space"),
+		}
+		if row.Origin == "" {
+			row.Origin = DefaultUserOrigin
+		}
+
+		if row.Username == "" {
+			return nil, InvalidUserImportFileError{Path: path, Line: line, Message: "username is required"}
+		}
+		if row.Organization == "" {
+			return nil, InvalidUserImportFileError{Path: path, Line: line, Message: "org is required"}
+		}
+
+		role := strings.ToLower(field("role"))
+		if orgRole, ok := userImportOrganizationRoles[role]; ok {
+			if row.Space != "" {
+				return nil, InvalidUserImportFileError{Path: path, Line: line, Message: fmt.Sprintf("role '%s' is an org role and cannot have a space", field("role"))}
+			}
+			row.OrganizationRole = orgRole
+		} else if spaceRole, ok := userImportSpaceRoles[role]; ok {
+			if row.Space == "" {
+				return nil, InvalidUserImportFileError{Path: path, Line: line, Message: fmt.Sprintf("role '%s' is a space role and requires a space", field("role"))}
+			}
+			row.SpaceRole = spaceRole
+		} else {
+			return nil, InvalidUserImportFileError{Path: path, Line: line, Message: fmt.Sprintf("unknown role '%s'", field("role"))}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// ImportUsers creates any UAA users in rows that do not exist yet and gives
+// them the listed roles. When removeUnlisted is set, roles held by users who
+// are not listed with them are removed: org roles in every org with an org
+// role row, and space roles in every space with a space role row. When dryRun
+// is set nothing is changed, but the results describe what would be. Failures
+// are reported per result, so one bad row does not stop the rest of the
+// import.
+func (actor Actor) ImportUsers(rows []UserImportRow, removeUnlisted bool, dryRun bool) ([]UserImportResult, Warnings) {
+	importer := userImporter{
+		actor:       actor,
+		dryRun:      dryRun,
+		users:       map[string]userImportUser{},
+		orgs:        map[string]orgLookup{},
+		spaces:      map[string]spaceLookup{},
+		roleHolders: map[string]roleHoldersLookup{},
+	}
+
+	var results []UserImportResult
+	for _, row := range rows {
+		results = append(results, importer.importRow(row))
+	}
+
+	if removeUnlisted {
+		results = append(results, importer.removeUnlisted(rows)...)
+	}
+
+	return results, importer.warnings
+}
+
+// userImportUser is a user looked up in UAA. The GUID is empty when the user
+// could not be looked up, or would only be created by a dry run.
+type userImportUser struct {
+	guid    string
+	created bool
+	err     error
+}
+
+type orgLookup struct {
+	org Organization
+	err error
+}
+
+type spaceLookup struct {
+	space Space
+	err   error
+}
+
+type roleHoldersLookup struct {
+	users []User
+	err   error
+}
+
+// userImporter caches lookups so that each user, org, space and role is only
+// fetched once per import.
+type userImporter struct {
+	actor       Actor
+	dryRun      bool
+	warnings    Warnings
+	users       map[string]userImportUser
+	orgs        map[string]orgLookup
+	spaces      map[string]spaceLookup
+	roleHolders map[string]roleHoldersLookup
+}
+
+func (importer *userImporter) importRow(row UserImportRow) UserImportResult {
+	result := UserImportResult{UserImportRow: row, Action: UserImportAssignRole}
+
+	user, firstRow := importer.ensureUser(row)
+	result.CreatedUser = user.created && firstRow
+	if user.err != nil {
+		result.Err = user.err
+		return result
+	}
+
+	org, space, err := importer.target(row.Organization, row.Space)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	holders, err := importer.holders(row, org, space)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	if !user.created && containsUserGUID(holders, user.guid) {
+		result.Action = UserImportNoChange
+		return result
+	}
+
+	if !importer.dryRun {
+		var warnings Warnings
+		if row.SpaceRole != "" {
+			warnings, err = importer.actor.SetSpaceRoleByGUID(row.SpaceRole, org.GUID, space.GUID, user.guid)
+		} else {
+			warnings, err = importer.actor.SetOrganizationRoleByGUID(row.OrganizationRole, org.GUID, user.guid)
+		}
+		importer.warnings = append(importer.warnings, warnings...)
+		result.Err = err
+	}
+
+	return result
+}
+
+// removeUnlisted matches role holders to rows by user GUID, so that users
+// with the same username in different origins are told apart. A row whose
+// user could not be looked up is matched by username instead, so that a
+// failed lookup never removes the role the row lists.
+func (importer *userImporter) removeUnlisted(rows []UserImportRow) []UserImportResult {
+	listed := map[string]bool{}
+	var targets []UserImportRow
+	seenTargets := map[string]bool{}
+	for _, row := range rows {
+		if guid := importer.users[userImportKey(row)].guid; guid != "" {
+			listed[listedRoleKey(row, "guid:"+guid)] = true
+		} else {
+			listed[listedRoleKey(row, "username:"+row.Username)] = true
+		}
+
+		target := UserImportRow{Organization: row.Organization, Space: row.Space}
+		key := target.Organization + "/" + target.Space
+		if !seenTargets[key] {
+			seenTargets[key] = true
+			targets = append(targets, target)
+		}
+	}
+
+	var results []UserImportResult
+	for _, target := range targets {
+		org, space, err := importer.target(target.Organization, target.Space)
+		if err != nil {
+			// The failure has already been reported for the rows in this target.
+			continue
+		}
+
+		var roles []UserImportRow
+		if target.Space == "" {
+			for _, role := range []OrganizationRole{OrganizationManagerRole, OrganizationBillingManagerRole, OrganizationAuditorRole} {
+				roles = append(roles, UserImportRow{Organization: target.Organization, OrganizationRole: role})
+			}
+		} else {
+			for _, role := range []SpaceRole{SpaceManagerRole, SpaceDeveloperRole, SpaceAuditorRole} {
+				roles = append(roles, UserImportRow{Organization: target.Organization, Space: target.Space, SpaceRole: role})
+			}
+		}
+
+		for _, role := range roles {
+			holders, err := importer.holders(role, org, space)
+			if err != nil {
+				results = append(results, UserImportResult{UserImportRow: role, Action: UserImportRemoveRole, Err: err})
+				continue
+			}
+
+			for _, holder := range holders {
+				if listed[listedRoleKey(role, "guid:"+holder.GUID)] || listed[listedRoleKey(role, "username:"+holder.Username)] {
+					continue
+				}
+
+				result := UserImportResult{UserImportRow: role, Action: UserImportRemoveRole}
+				result.Username = holder.Username
+				if !importer.dryRun {
+					var warnings Warnings
+					if role.SpaceRole != "" {
+						warnings, err = importer.actor.UnsetSpaceRoleByGUID(role.SpaceRole, space.GUID, holder.GUID)
+					} else {
+						warnings, err = importer.actor.UnsetOrganizationRoleByGUID(role.OrganizationRole, org.GUID, holder.GUID)
+					}
+					importer.warnings = append(importer.warnings, warnings...)
+					result.Err = err
+				}
+				results = append(results, result)
+			}
+		}
+	}
+
+	return results
+}
+
+// ensureUser looks the user up in UAA and creates it if it is missing.
+// firstRow is true the first time a user is seen in the import.
+func (importer *userImporter) ensureUser(row UserImportRow) (user userImportUser, firstRow bool) {
+	key := userImportKey(row)
+	if user, ok := importer.users[key]; ok {
+		return user, false
+	}
+
+	uaaUsers, err := importer.actor.UAAClient.GetUsers(row.Username, row.Origin)
+	switch {
+	case err != nil:
+		user.err = err
+	case len(uaaUsers) > 0:
+		user.guid = uaaUsers[0].ID
+	case row.Origin == DefaultUserOrigin && row.Password == "":
+		user.err = fmt.Errorf("user %s does not exist and no password was provided", row.Username)
+	default:
+		user.created = true
+		if !importer.dryRun {
+			var (
+				ccUser   User
+				warnings Warnings
+			)
+			ccUser, warnings, user.err = importer.actor.CreateUser(row.Username, row.Password, row.Origin)
+			importer.warnings = append(importer.warnings, warnings...)
+			user.guid = ccUser.GUID
+		}
+	}
+
+	importer.users[key] = user
+	return user, true
+}
+
+// target returns the org, and the space when spaceName is set.
+func (importer *userImporter) target(orgName string, spaceName string) (Organization, Space, error) {
+	orgResult, ok := importer.orgs[orgName]
+	if !ok {
+		var warnings Warnings
+		orgResult.org, warnings, orgResult.err = importer.actor.GetOrganizationByName(orgName)
+		importer.warnings = append(importer.warnings, warnings...)
+		importer.orgs[orgName] = orgResult
+	}
+	if orgResult.err != nil || spaceName == "" {
+		return orgResult.org, Space{}, orgResult.err
+	}
+
+	key := orgResult.org.GUID + "/" + spaceName
+	spaceResult, ok := importer.spaces[key]
+	if !ok {
+		var warnings Warnings
+		spaceResult.space, warnings, spaceResult.err = importer.actor.GetSpaceByOrganizationAndName(orgResult.org.GUID, spaceName)
+		importer.warnings = append(importer.warnings, warnings...)
+		importer.spaces[key] = spaceResult
+	}
+	return orgResult.org, spaceResult.space, spaceResult.err
+}
+
+// holders returns the users that currently have the row's role.
+func (importer *userImporter) holders(row UserImportRow, org Organization, space Space) ([]User, error) {
+	key := org.GUID + "/" + space.GUID + "/" + row.Role()
+	result, ok := importer.roleHolders[key]
+	if !ok {
+		var warnings Warnings
+		if row.SpaceRole != "" {
+			result.users, warnings, result.err = importer.actor.GetSpaceUsersByRole(row.SpaceRole, space.GUID)
+		} else {
+			result.users, warnings, result.err = importer.actor.GetOrganizationUsersByRole(row.OrganizationRole, org.GUID)
+		}
+		importer.warnings = append(importer.warnings, warnings...)
+		importer.roleHolders[key] = result
+	}
+	return result.users, result.err
+}
+
+func userImportKey(row UserImportRow) string {
+	return strings.ToLower(row.Origin + "/" + row.Username)
+}
+
+// listedRoleKey identifies the row's role in its org or space, held by the
+// user identified by user.
+func listedRoleKey(row UserImportRow, user string) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s/%s/%s", row.Organization, row.Space, row.Role(), user))
+}
+
+func containsUserGUID(users []User, guid string) bool {
+	for _, user := range users {
+		if user.GUID == guid {
+			return true
+		}
+	}
+	return false
+}
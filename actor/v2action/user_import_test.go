@@ -0,0 +1,306 @@
+package v2action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("User Import Actions", func() {
+	Describe("ReadUserImportFile", func() {
+		var (
+			path string
+			rows []UserImportRow
+			err  error
+		)
+
+		writeFile := func(contents string) {
+			file, err := ioutil.TempFile("", "users")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = file.WriteString(contents)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+			path = file.Name()
+		}
+
+		AfterEach(func() {
+			Expect(os.Remove(path)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			rows, err = ReadUserImportFile(path)
+		})
+
+		Context("when the file is valid", func() {
+			BeforeEach(func() {
+				writeFile("role,username,org,space,origin,password\n" +
+					"OrgManager,alice,some-org,,,s3cret\n" +
+					"spacedeveloper, bob ,some-org,some-space,ldap,\n")
+			})
+
+			It("returns a row per role assignment", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rows).To(Equal([]UserImportRow{
+					{Username: "alice", Password: "s3cret", Origin: "uaa", Organization: "some-org", OrganizationRole: OrganizationManagerRole},
+					{Username: "bob", Origin: "ldap", Organization: "some-org", Space: "some-space", SpaceRole: SpaceDeveloperRole},
+				}))
+			})
+		})
+
+		Context("when a required column is missing", func() {
+			BeforeEach(func() {
+				writeFile("username,role\nalice,OrgManager\n")
+			})
+
+			It("returns an InvalidUserImportFileError", func() {
+				Expect(err).To(MatchError(InvalidUserImportFileError{
+					Path:    path,
+					Line:    1,
+					Message: "missing required column 'org' (columns are username, password, origin, org, space, role)",
+				}))
+			})
+		})
+
+		Context("when a space role has no space", func() {
+			BeforeEach(func() {
+				writeFile("username,org,space,role\nalice,some-org,some-space,OrgAuditor\nbob,some-org,,SpaceAuditor\n")
+			})
+
+			It("returns an InvalidUserImportFileError for the line", func() {
+				Expect(err).To(MatchError(InvalidUserImportFileError{
+					Path:    path,
+					Line:    2,
+					Message: "role 'OrgAuditor' is an org role and cannot have a space",
+				}))
+			})
+		})
+
+		Context("when a role is unknown", func() {
+			BeforeEach(func() {
+				writeFile("username,org,role\nalice,some-org,Admin\n")
+			})
+
+			It("returns an InvalidUserImportFileError for the line", func() {
+				Expect(err).To(MatchError(InvalidUserImportFileError{
+					Path:    path,
+					Line:    2,
+					Message: "unknown role 'Admin'",
+				}))
+			})
+		})
+	})
+
+	Describe("ImportUsers", func() {
+		var (
+			actor                     Actor
+			fakeUAAClient             *v2actionfakes.FakeUAAClient
+			fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+
+			rows           []UserImportRow
+			removeUnlisted bool
+			dryRun         bool
+			results        []UserImportResult
+			warnings       Warnings
+		)
+
+		BeforeEach(func() {
+			fakeUAAClient = new(v2actionfakes.FakeUAAClient)
+			fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, fakeUAAClient)
+
+			rows = []UserImportRow{
+				{Username: "alice", Origin: "uaa", Organization: "some-org", OrganizationRole: OrganizationManagerRole},
+				{Username: "bob", Password: "s3cret", Origin: "uaa", Organization: "some-org", Space: "some-space", SpaceRole: SpaceDeveloperRole},
+				{Username: "bob", Password: "s3cret", Origin: "uaa", Organization: "some-org", Space: "some-space", SpaceRole: SpaceManagerRole},
+			}
+			removeUnlisted = false
+			dryRun = false
+
+			fakeUAAClient.GetUsersStub = func(username string, origin string) ([]uaa.User, error) {
+				if username == "alice" {
+					return []uaa.User{{ID: "alice-id"}}, nil
+				}
+				return nil, nil
+			}
+			fakeUAAClient.CreateUserReturns(uaa.User{ID: "bob-id"}, nil)
+			fakeCloudControllerClient.CreateUserReturns(ccv2.User{GUID: "bob-id"}, ccv2.Warnings{"create-warning"}, nil)
+
+			fakeCloudControllerClient.GetOrganizationsReturns([]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org"}}, ccv2.Warnings{"org-warning"}, nil)
+			fakeCloudControllerClient.GetSpacesReturns([]ccv2.Space{{GUID: "some-space-guid", Name: "some-space"}}, ccv2.Warnings{"space-warning"}, nil)
+			fakeCloudControllerClient.GetOrganizationUsersByRoleStub = func(role ccv2.OrganizationRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+				if role == ccv2.OrganizationManagerRole {
+					return []ccv2.User{{GUID: "alice-id", Username: "Alice"}, {GUID: "mallory-id", Username: "mallory"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+			fakeCloudControllerClient.GetSpaceUsersByRoleStub = func(role ccv2.SpaceRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+				if role == ccv2.SpaceAuditorRole {
+					return []ccv2.User{{GUID: "eve-id", Username: "eve"}}, nil, nil
+				}
+				return nil, nil, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			results, warnings = actor.ImportUsers(rows, removeUnlisted, dryRun)
+		})
+
+		It("creates missing users and assigns missing roles", func() {
+			Expect(results).To(Equal([]UserImportResult{
+				{UserImportRow: rows[0], Action: UserImportNoChange},
+				{UserImportRow: rows[1], Action: UserImportAssignRole, CreatedUser: true},
+				{UserImportRow: rows[2], Action: UserImportAssignRole},
+			}))
+			Expect(warnings).To(ConsistOf("org-warning", "space-warning", "create-warning"))
+
+			Expect(fakeUAAClient.GetUsersCallCount()).To(Equal(2))
+			Expect(fakeUAAClient.CreateUserCallCount()).To(Equal(1))
+			username, password, origin := fakeUAAClient.CreateUserArgsForCall(0)
+			Expect(username).To(Equal("bob"))
+			Expect(password).To(Equal("s3cret"))
+			Expect(origin).To(Equal("uaa"))
+
+			Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateSpaceUserGUIDByRoleCallCount()).To(Equal(2))
+			role, spaceGUID, userGUID := fakeCloudControllerClient.UpdateSpaceUserGUIDByRoleArgsForCall(1)
+			Expect(role).To(Equal(ccv2.SpaceManagerRole))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(userGUID).To(Equal("bob-id"))
+		})
+
+		Context("when a user with the same username from another origin has the role", func() {
+			BeforeEach(func() {
+				rows[0].Origin = "ldap"
+				fakeUAAClient.GetUsersStub = func(username string, origin string) ([]uaa.User, error) {
+					if username == "alice" && origin == "ldap" {
+						return []uaa.User{{ID: "ldap-alice-id"}}, nil
+					}
+					return nil, nil
+				}
+				removeUnlisted = true
+			})
+
+			It("assigns the role to the listed user and removes it from the other one", func() {
+				Expect(results[0]).To(Equal(UserImportResult{UserImportRow: rows[0], Action: UserImportAssignRole}))
+
+				role, orgGUID, userGUID := fakeCloudControllerClient.UpdateOrganizationUserGUIDByRoleArgsForCall(1)
+				Expect(role).To(Equal(ccv2.OrganizationManagerRole))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(userGUID).To(Equal("ldap-alice-id"))
+
+				Expect(fakeCloudControllerClient.DeleteOrganizationUserGUIDByRoleCallCount()).To(Equal(2))
+				_, _, userGUID = fakeCloudControllerClient.DeleteOrganizationUserGUIDByRoleArgsForCall(0)
+				Expect(userGUID).To(Equal("alice-id"))
+			})
+		})
+
+		Context("when removing unlisted roles", func() {
+			BeforeEach(func() {
+				removeUnlisted = true
+			})
+
+			It("removes roles held by users not listed with them", func() {
+				Expect(results).To(HaveLen(5))
+				Expect(results[3]).To(Equal(UserImportResult{
+					UserImportRow: UserImportRow{Username: "mallory", Organization: "some-org", OrganizationRole: OrganizationManagerRole},
+					Action:        UserImportRemoveRole,
+				}))
+				Expect(results[4]).To(Equal(UserImportResult{
+					UserImportRow: UserImportRow{Username: "eve", Organization: "some-org", Space: "some-space", SpaceRole: SpaceAuditorRole},
+					Action:        UserImportRemoveRole,
+				}))
+
+				Expect(fakeCloudControllerClient.DeleteOrganizationUserGUIDByRoleCallCount()).To(Equal(1))
+				role, orgGUID, userGUID := fakeCloudControllerClient.DeleteOrganizationUserGUIDByRoleArgsForCall(0)
+				Expect(role).To(Equal(ccv2.OrganizationManagerRole))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(userGUID).To(Equal("mallory-id"))
+
+				Expect(fakeCloudControllerClient.DeleteSpaceUserGUIDByRoleCallCount()).To(Equal(1))
+				spaceRole, spaceGUID, userGUID := fakeCloudControllerClient.DeleteSpaceUserGUIDByRoleArgsForCall(0)
+				Expect(spaceRole).To(Equal(ccv2.SpaceAuditorRole))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(userGUID).To(Equal("eve-id"))
+			})
+
+			Context("when looking up a listed user fails", func() {
+				BeforeEach(func() {
+					fakeUAAClient.GetUsersStub = nil
+					fakeUAAClient.GetUsersReturns(nil, errors.New("uaa error"))
+				})
+
+				It("does not remove the roles listed for that user", func() {
+					Expect(results[0].Err).To(MatchError("uaa error"))
+					for _, result := range results[3:] {
+						Expect(result.Username).ToNot(Equal("Alice"))
+					}
+				})
+			})
+		})
+
+		Context("when doing a dry run", func() {
+			BeforeEach(func() {
+				dryRun = true
+				removeUnlisted = true
+			})
+
+			It("reports the changes without making them", func() {
+				Expect(results).To(HaveLen(5))
+				Expect(results[1]).To(Equal(UserImportResult{UserImportRow: rows[1], Action: UserImportAssignRole, CreatedUser: true}))
+				Expect(results[3].Action).To(Equal(UserImportRemoveRole))
+
+				Expect(fakeUAAClient.CreateUserCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserGUIDByRoleCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateSpaceUserGUIDByRoleCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.DeleteOrganizationUserGUIDByRoleCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.DeleteSpaceUserGUIDByRoleCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a missing UAA user has no password", func() {
+			BeforeEach(func() {
+				rows[1].Password = ""
+			})
+
+			It("fails the row without creating the user", func() {
+				Expect(results[1].Err).To(MatchError("user bob does not exist and no password was provided"))
+				Expect(fakeUAAClient.CreateUserCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"space-warning"}, nil)
+			})
+
+			It("fails the rows in that space and continues", func() {
+				Expect(results[0]).To(Equal(UserImportResult{UserImportRow: rows[0], Action: UserImportNoChange}))
+				Expect(results[1].Err).To(MatchError(SpaceNotFoundError{Name: "some-space"}))
+				Expect(results[2].Err).To(MatchError(SpaceNotFoundError{Name: "some-space"}))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when assigning a role fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("assign error")
+				fakeCloudControllerClient.UpdateSpaceUserGUIDByRoleReturns(ccv2.Warnings{"assign-warning"}, expectedErr)
+			})
+
+			It("reports the error on the row", func() {
+				Expect(results[1].Err).To(MatchError(expectedErr))
+				Expect(results[2].Err).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("assign-warning"))
+			})
+		})
+	})
+})
@@ -121,4 +121,192 @@ var _ = Describe("User Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationUsersByRole", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationUsersByRoleReturns(
+				[]ccv2.User{{GUID: "user-guid", Username: "some-user"}},
+				ccv2.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("returns the users with the role and all warnings", func() {
+			users, warnings, err := actor.GetOrganizationUsersByRole(OrganizationManagerRole, "some-org-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(users).To(ConsistOf(User{GUID: "user-guid", Username: "some-user"}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			role, orgGUID := fakeCloudControllerClient.GetOrganizationUsersByRoleArgsForCall(0)
+			Expect(role).To(Equal(ccv2.OrganizationManagerRole))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
+
+	Describe("SetOrganizationRole", func() {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			warnings, err = actor.SetOrganizationRole(OrganizationAuditorRole, "some-org-guid", "some-user")
+		})
+
+		Context("when adding the user to the organization succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateOrganizationUserByRoleReturnsOnCall(0, ccv2.Warnings{"warning-1"}, nil)
+				fakeCloudControllerClient.UpdateOrganizationUserByRoleReturnsOnCall(1, ccv2.Warnings{"warning-2"}, nil)
+			})
+
+			It("adds the user to the organization, gives them the role and returns all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserByRoleCallCount()).To(Equal(2))
+				role, orgGUID, username := fakeCloudControllerClient.UpdateOrganizationUserByRoleArgsForCall(0)
+				Expect(role).To(Equal(ccv2.OrganizationUserRole))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(username).To(Equal("some-user"))
+				role, _, _ = fakeCloudControllerClient.UpdateOrganizationUserByRoleArgsForCall(1)
+				Expect(role).To(Equal(ccv2.OrganizationAuditorRole))
+			})
+		})
+
+		Context("when adding the user to the organization fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("user not found")
+				fakeCloudControllerClient.UpdateOrganizationUserByRoleReturns(ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserByRoleCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("GetSpaceUsersByRole", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceUsersByRoleReturns(
+				[]ccv2.User{{GUID: "user-guid", Username: "some-user"}},
+				ccv2.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("returns the users with the role and all warnings", func() {
+			users, warnings, err := actor.GetSpaceUsersByRole(SpaceDeveloperRole, "some-space-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(users).To(ConsistOf(User{GUID: "user-guid", Username: "some-user"}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			role, spaceGUID := fakeCloudControllerClient.GetSpaceUsersByRoleArgsForCall(0)
+			Expect(role).To(Equal(ccv2.SpaceDeveloperRole))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	Describe("SetSpaceRole", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateOrganizationUserByRoleReturns(ccv2.Warnings{"warning-1"}, nil)
+			fakeCloudControllerClient.UpdateSpaceUserByRoleReturns(ccv2.Warnings{"warning-2"}, nil)
+		})
+
+		It("adds the user to the organization, gives them the space role and returns all warnings", func() {
+			warnings, err := actor.SetSpaceRole(SpaceManagerRole, "some-org-guid", "some-space-guid", "some-user")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+			orgRole, orgGUID, username := fakeCloudControllerClient.UpdateOrganizationUserByRoleArgsForCall(0)
+			Expect(orgRole).To(Equal(ccv2.OrganizationUserRole))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(username).To(Equal("some-user"))
+
+			spaceRole, spaceGUID, username := fakeCloudControllerClient.UpdateSpaceUserByRoleArgsForCall(0)
+			Expect(spaceRole).To(Equal(ccv2.SpaceManagerRole))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(username).To(Equal("some-user"))
+		})
+	})
+
+	Describe("SetOrganizationRoleByGUID", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateOrganizationUserGUIDByRoleReturnsOnCall(0, ccv2.Warnings{"warning-1"}, nil)
+			fakeCloudControllerClient.UpdateOrganizationUserGUIDByRoleReturnsOnCall(1, ccv2.Warnings{"warning-2"}, nil)
+		})
+
+		It("adds the user to the organization, gives them the role and returns all warnings", func() {
+			warnings, err := actor.SetOrganizationRoleByGUID(OrganizationAuditorRole, "some-org-guid", "some-user-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+			Expect(fakeCloudControllerClient.UpdateOrganizationUserGUIDByRoleCallCount()).To(Equal(2))
+			role, orgGUID, userGUID := fakeCloudControllerClient.UpdateOrganizationUserGUIDByRoleArgsForCall(0)
+			Expect(role).To(Equal(ccv2.OrganizationUserRole))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(userGUID).To(Equal("some-user-guid"))
+			role, _, _ = fakeCloudControllerClient.UpdateOrganizationUserGUIDByRoleArgsForCall(1)
+			Expect(role).To(Equal(ccv2.OrganizationAuditorRole))
+		})
+	})
+
+	Describe("SetSpaceRoleByGUID", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateOrganizationUserGUIDByRoleReturns(ccv2.Warnings{"warning-1"}, nil)
+			fakeCloudControllerClient.UpdateSpaceUserGUIDByRoleReturns(ccv2.Warnings{"warning-2"}, nil)
+		})
+
+		It("adds the user to the organization, gives them the space role and returns all warnings", func() {
+			warnings, err := actor.SetSpaceRoleByGUID(SpaceManagerRole, "some-org-guid", "some-space-guid", "some-user-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+			orgRole, orgGUID, userGUID := fakeCloudControllerClient.UpdateOrganizationUserGUIDByRoleArgsForCall(0)
+			Expect(orgRole).To(Equal(ccv2.OrganizationUserRole))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(userGUID).To(Equal("some-user-guid"))
+
+			spaceRole, spaceGUID, userGUID := fakeCloudControllerClient.UpdateSpaceUserGUIDByRoleArgsForCall(0)
+			Expect(spaceRole).To(Equal(ccv2.SpaceManagerRole))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(userGUID).To(Equal("some-user-guid"))
+		})
+	})
+
+	Describe("UnsetOrganizationRoleByGUID", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteOrganizationUserGUIDByRoleReturns(ccv2.Warnings{"delete-warning"}, nil)
+		})
+
+		It("removes the role from the user", func() {
+			warnings, err := actor.UnsetOrganizationRoleByGUID(OrganizationAuditorRole, "some-org-guid", "some-user-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete-warning"))
+
+			role, orgGUID, userGUID := fakeCloudControllerClient.DeleteOrganizationUserGUIDByRoleArgsForCall(0)
+			Expect(role).To(Equal(ccv2.OrganizationAuditorRole))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(userGUID).To(Equal("some-user-guid"))
+		})
+	})
+
+	Describe("UnsetSpaceRoleByGUID", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteSpaceUserGUIDByRoleReturns(ccv2.Warnings{"delete-warning"}, nil)
+		})
+
+		It("removes the role from the user", func() {
+			warnings, err := actor.UnsetSpaceRoleByGUID(SpaceManagerRole, "some-space-guid", "some-user-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete-warning"))
+
+			role, spaceGUID, userGUID := fakeCloudControllerClient.DeleteSpaceUserGUIDByRoleArgsForCall(0)
+			Expect(role).To(Equal(ccv2.SpaceManagerRole))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(userGUID).To(Equal("some-user-guid"))
+		})
+	})
+
 })
@@ -69,6 +69,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateOrganizationStub        func(orgName string) (ccv2.Organization, ccv2.Warnings, error)
+	createOrganizationMutex       sync.RWMutex
+	createOrganizationArgsForCall []struct {
+		orgName string
+	}
+	createOrganizationReturns struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createOrganizationReturnsOnCall map[int]struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateRouteStub        func(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error)
 	createRouteMutex       sync.RWMutex
 	createRouteArgsForCall []struct {
@@ -85,6 +100,39 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateServiceBindingStub        func(appGUID string, serviceInstanceGUID string, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error)
+	createServiceBindingMutex       sync.RWMutex
+	createServiceBindingArgsForCall []struct {
+		appGUID             string
+		serviceInstanceGUID string
+		parameters          map[string]interface{}
+	}
+	createServiceBindingReturns struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createServiceBindingReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateSpaceStub        func(spaceName string, orgGUID string) (ccv2.Space, ccv2.Warnings, error)
+	createSpaceMutex       sync.RWMutex
+	createSpaceArgsForCall []struct {
+		spaceName string
+		orgGUID   string
+	}
+	createSpaceReturns struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSpaceReturnsOnCall map[int]struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateUserStub        func(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -100,6 +148,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteApplicationStub        func(guid string) (ccv2.Warnings, error)
+	deleteApplicationMutex       sync.RWMutex
+	deleteApplicationArgsForCall []struct {
+		guid string
+	}
+	deleteApplicationReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteOrganizationStub        func(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteOrganizationMutex       sync.RWMutex
 	deleteOrganizationArgsForCall []struct {
@@ -115,6 +176,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteOrganizationUserGUIDByRoleStub        func(role ccv2.OrganizationRole, orgGUID string, userGUID string) (ccv2.Warnings, error)
+	deleteOrganizationUserGUIDByRoleMutex       sync.RWMutex
+	deleteOrganizationUserGUIDByRoleArgsForCall []struct {
+		role     ccv2.OrganizationRole
+		orgGUID  string
+		userGUID string
+	}
+	deleteOrganizationUserGUIDByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteOrganizationUserGUIDByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteRouteStub        func(routeGUID string) (ccv2.Warnings, error)
 	deleteRouteMutex       sync.RWMutex
 	deleteRouteArgsForCall []struct {
@@ -141,6 +217,49 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	DeleteServiceInstanceStub        func(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error)
+	deleteServiceInstanceMutex       sync.RWMutex
+	deleteServiceInstanceArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	deleteServiceInstanceReturns struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	deleteServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}
+	DeleteServiceKeyStub        func(serviceKeyGUID string) (ccv2.Warnings, error)
+	deleteServiceKeyMutex       sync.RWMutex
+	deleteServiceKeyArgsForCall []struct {
+		serviceKeyGUID string
+	}
+	deleteServiceKeyReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteServiceKeyReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteSpaceUserGUIDByRoleStub        func(role ccv2.SpaceRole, spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	deleteSpaceUserGUIDByRoleMutex       sync.RWMutex
+	deleteSpaceUserGUIDByRoleArgsForCall []struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+		userGUID  string
+	}
+	deleteSpaceUserGUIDByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteSpaceUserGUIDByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	GetApplicationStub        func(guid string) (ccv2.Application, ccv2.Warnings, error)
 	getApplicationMutex       sync.RWMutex
 	getApplicationArgsForCall []struct {
@@ -217,6 +336,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetBuildpacksStub        func(queries []ccv2.Query) ([]ccv2.Buildpack, ccv2.Warnings, error)
+	getBuildpacksMutex       sync.RWMutex
+	getBuildpacksArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getBuildpacksReturns struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getBuildpacksReturnsOnCall map[int]struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetJobStub        func(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	getJobMutex       sync.RWMutex
 	getJobArgsForCall []struct {
@@ -278,6 +412,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetOrganizationQuotasStub        func(queries []ccv2.Query) ([]ccv2.OrganizationQuota, ccv2.Warnings, error)
+	getOrganizationQuotasMutex       sync.RWMutex
+	getOrganizationQuotasArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getOrganizationQuotasReturns struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationQuotasReturnsOnCall map[int]struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetOrganizationsStub        func(queries []ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error)
 	getOrganizationsMutex       sync.RWMutex
 	getOrganizationsArgsForCall []struct {
@@ -293,6 +442,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetOrganizationUsersByRoleStub        func(role ccv2.OrganizationRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getOrganizationUsersByRoleMutex       sync.RWMutex
+	getOrganizationUsersByRoleArgsForCall []struct {
+		role    ccv2.OrganizationRole
+		orgGUID string
+	}
+	getOrganizationUsersByRoleReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationUsersByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetPrivateDomainStub        func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	getPrivateDomainMutex       sync.RWMutex
 	getPrivateDomainArgsForCall []struct {
@@ -308,6 +473,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetRouterGroupsStub        func() ([]ccv2.RouterGroup, ccv2.Warnings, error)
+	getRouterGroupsMutex       sync.RWMutex
+	getRouterGroupsArgsForCall []struct{}
+	getRouterGroupsReturns     struct {
+		result1 []ccv2.RouterGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getRouterGroupsReturnsOnCall map[int]struct {
+		result1 []ccv2.RouterGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetRouteApplicationsStub        func(routeGUID string, queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error)
 	getRouteApplicationsMutex       sync.RWMutex
 	getRouteApplicationsArgsForCall []struct {
@@ -339,6 +517,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetRunningSecurityGroupsStub        func() ([]ccv2.SecurityGroup, ccv2.Warnings, error)
+	getRunningSecurityGroupsMutex       sync.RWMutex
+	getRunningSecurityGroupsArgsForCall []struct{}
+	getRunningSecurityGroupsReturns     struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getRunningSecurityGroupsReturnsOnCall map[int]struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSecurityGroupsStub        func(queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	getSecurityGroupsMutex       sync.RWMutex
 	getSecurityGroupsArgsForCall []struct {
@@ -354,6 +545,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceBindingParametersStub        func(serviceBindingGUID string) (map[string]interface{}, ccv2.Warnings, error)
+	getServiceBindingParametersMutex       sync.RWMutex
+	getServiceBindingParametersArgsForCall []struct {
+		serviceBindingGUID string
+	}
+	getServiceBindingParametersReturns struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceBindingParametersReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServiceBindingsStub        func(queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
 	getServiceBindingsMutex       sync.RWMutex
 	getServiceBindingsArgsForCall []struct {
@@ -384,6 +590,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceKeysStub        func(queries []ccv2.Query) ([]ccv2.ServiceKey, ccv2.Warnings, error)
+	getServiceKeysMutex       sync.RWMutex
+	getServiceKeysArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getServiceKeysReturns struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceKeysReturnsOnCall map[int]struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServicePlanStub        func(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error)
+	getServicePlanMutex       sync.RWMutex
+	getServicePlanArgsForCall []struct {
+		servicePlanGUID string
+	}
+	getServicePlanReturns struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServicePlanReturnsOnCall map[int]struct {
+		result1 ccv2.ServicePlan
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSharedDomainStub        func(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	getSharedDomainMutex       sync.RWMutex
 	getSharedDomainArgsForCall []struct {
@@ -505,6 +741,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceUsersByRoleStub        func(role ccv2.SpaceRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getSpaceUsersByRoleMutex       sync.RWMutex
+	getSpaceUsersByRoleArgsForCall []struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+	}
+	getSpaceUsersByRoleReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceUsersByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetStackStub        func(guid string) (ccv2.Stack, ccv2.Warnings, error)
 	getStackMutex       sync.RWMutex
 	getStackArgsForCall []struct {
@@ -520,6 +772,34 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetStacksStub        func(queries []ccv2.Query) ([]ccv2.Stack, ccv2.Warnings, error)
+	getStacksMutex       sync.RWMutex
+	getStacksArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getStacksReturns struct {
+		result1 []ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getStacksReturnsOnCall map[int]struct {
+		result1 []ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetStagingSecurityGroupsStub        func() ([]ccv2.SecurityGroup, ccv2.Warnings, error)
+	getStagingSecurityGroupsMutex       sync.RWMutex
+	getStagingSecurityGroupsArgsForCall []struct{}
+	getStagingSecurityGroupsReturns     struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getStagingSecurityGroupsReturnsOnCall map[int]struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
 	PollJobStub        func(job ccv2.Job) (ccv2.Warnings, error)
 	pollJobMutex       sync.RWMutex
 	pollJobArgsForCall []struct {
@@ -560,6 +840,20 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	UnbindRouteFromApplicationStub        func(routeGUID string, appGUID string) (ccv2.Warnings, error)
+	unbindRouteFromApplicationMutex       sync.RWMutex
+	unbindRouteFromApplicationArgsForCall []struct {
+		routeGUID string
+		appGUID   string
+	}
+	unbindRouteFromApplicationReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	unbindRouteFromApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	UpdateApplicationStub        func(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
 	updateApplicationMutex       sync.RWMutex
 	updateApplicationArgsForCall []struct {
@@ -575,6 +869,82 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	UpdateOrganizationQuotaStub        func(orgGUID string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error)
+	updateOrganizationQuotaMutex       sync.RWMutex
+	updateOrganizationQuotaArgsForCall []struct {
+		orgGUID   string
+		quotaGUID string
+	}
+	updateOrganizationQuotaReturns struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateOrganizationUserByRoleStub        func(role ccv2.OrganizationRole, orgGUID string, username string) (ccv2.Warnings, error)
+	updateOrganizationUserByRoleMutex       sync.RWMutex
+	updateOrganizationUserByRoleArgsForCall []struct {
+		role     ccv2.OrganizationRole
+		orgGUID  string
+		username string
+	}
+	updateOrganizationUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateOrganizationUserGUIDByRoleStub        func(role ccv2.OrganizationRole, orgGUID string, userGUID string) (ccv2.Warnings, error)
+	updateOrganizationUserGUIDByRoleMutex       sync.RWMutex
+	updateOrganizationUserGUIDByRoleArgsForCall []struct {
+		role     ccv2.OrganizationRole
+		orgGUID  string
+		userGUID string
+	}
+	updateOrganizationUserGUIDByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationUserGUIDByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateSpaceUserByRoleStub        func(role ccv2.SpaceRole, spaceGUID string, username string) (ccv2.Warnings, error)
+	updateSpaceUserByRoleMutex       sync.RWMutex
+	updateSpaceUserByRoleArgsForCall []struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+		username  string
+	}
+	updateSpaceUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateSpaceUserGUIDByRoleStub        func(role ccv2.SpaceRole, spaceGUID string, userGUID string) (ccv2.Warnings, error)
+	updateSpaceUserGUIDByRoleMutex       sync.RWMutex
+	updateSpaceUserGUIDByRoleArgsForCall []struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+		userGUID  string
+	}
+	updateSpaceUserGUIDByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceUserGUIDByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	APIStub        func() string
 	aPIMutex       sync.RWMutex
 	aPIArgsForCall []struct{}
@@ -857,6 +1227,60 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateOrganization(orgName string) (ccv2.Organization, ccv2.Warnings, error) {
+	fake.createOrganizationMutex.Lock()
+	ret, specificReturn := fake.createOrganizationReturnsOnCall[len(fake.createOrganizationArgsForCall)]
+	fake.createOrganizationArgsForCall = append(fake.createOrganizationArgsForCall, struct {
+		orgName string
+	}{orgName})
+	fake.recordInvocation("CreateOrganization", []interface{}{orgName})
+	fake.createOrganizationMutex.Unlock()
+	if fake.CreateOrganizationStub != nil {
+		return fake.CreateOrganizationStub(orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createOrganizationReturns.result1, fake.createOrganizationReturns.result2, fake.createOrganizationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationCallCount() int {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return len(fake.createOrganizationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationArgsForCall(i int) string {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return fake.createOrganizationArgsForCall[i].orgName
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	fake.createOrganizationReturns = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	if fake.createOrganizationReturnsOnCall == nil {
+		fake.createOrganizationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Organization
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createOrganizationReturnsOnCall[i] = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error) {
 	fake.createRouteMutex.Lock()
 	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
@@ -912,66 +1336,228 @@ func (fake *FakeCloudControllerClient) CreateRouteReturnsOnCall(i int, result1 c
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error) {
-	fake.createUserMutex.Lock()
-	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
-	fake.createUserArgsForCall = append(fake.createUserArgsForCall, struct {
-		uaaUserID string
-	}{uaaUserID})
-	fake.recordInvocation("CreateUser", []interface{}{uaaUserID})
-	fake.createUserMutex.Unlock()
-	if fake.CreateUserStub != nil {
-		return fake.CreateUserStub(uaaUserID)
+func (fake *FakeCloudControllerClient) CreateServiceBinding(appGUID string, serviceInstanceGUID string, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error) {
+	fake.createServiceBindingMutex.Lock()
+	ret, specificReturn := fake.createServiceBindingReturnsOnCall[len(fake.createServiceBindingArgsForCall)]
+	fake.createServiceBindingArgsForCall = append(fake.createServiceBindingArgsForCall, struct {
+		appGUID             string
+		serviceInstanceGUID string
+		parameters          map[string]interface{}
+	}{appGUID, serviceInstanceGUID, parameters})
+	fake.recordInvocation("CreateServiceBinding", []interface{}{appGUID, serviceInstanceGUID, parameters})
+	fake.createServiceBindingMutex.Unlock()
+	if fake.CreateServiceBindingStub != nil {
+		return fake.CreateServiceBindingStub(appGUID, serviceInstanceGUID, parameters)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.createUserReturns.result1, fake.createUserReturns.result2, fake.createUserReturns.result3
+	return fake.createServiceBindingReturns.result1, fake.createServiceBindingReturns.result2, fake.createServiceBindingReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) CreateUserCallCount() int {
-	fake.createUserMutex.RLock()
-	defer fake.createUserMutex.RUnlock()
-	return len(fake.createUserArgsForCall)
+func (fake *FakeCloudControllerClient) CreateServiceBindingCallCount() int {
+	fake.createServiceBindingMutex.RLock()
+	defer fake.createServiceBindingMutex.RUnlock()
+	return len(fake.createServiceBindingArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) CreateUserArgsForCall(i int) string {
-	fake.createUserMutex.RLock()
-	defer fake.createUserMutex.RUnlock()
-	return fake.createUserArgsForCall[i].uaaUserID
+func (fake *FakeCloudControllerClient) CreateServiceBindingArgsForCall(i int) (string, string, map[string]interface{}) {
+	fake.createServiceBindingMutex.RLock()
+	defer fake.createServiceBindingMutex.RUnlock()
+	return fake.createServiceBindingArgsForCall[i].appGUID, fake.createServiceBindingArgsForCall[i].serviceInstanceGUID, fake.createServiceBindingArgsForCall[i].parameters
 }
 
-func (fake *FakeCloudControllerClient) CreateUserReturns(result1 ccv2.User, result2 ccv2.Warnings, result3 error) {
-	fake.CreateUserStub = nil
-	fake.createUserReturns = struct {
-		result1 ccv2.User
+func (fake *FakeCloudControllerClient) CreateServiceBindingReturns(result1 ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceBindingStub = nil
+	fake.createServiceBindingReturns = struct {
+		result1 ccv2.ServiceBinding
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreateUserReturnsOnCall(i int, result1 ccv2.User, result2 ccv2.Warnings, result3 error) {
-	fake.CreateUserStub = nil
-	if fake.createUserReturnsOnCall == nil {
-		fake.createUserReturnsOnCall = make(map[int]struct {
-			result1 ccv2.User
+func (fake *FakeCloudControllerClient) CreateServiceBindingReturnsOnCall(i int, result1 ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceBindingStub = nil
+	if fake.createServiceBindingReturnsOnCall == nil {
+		fake.createServiceBindingReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceBinding
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.createUserReturnsOnCall[i] = struct {
-		result1 ccv2.User
+	fake.createServiceBindingReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceBinding
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error) {
-	fake.deleteOrganizationMutex.Lock()
-	ret, specificReturn := fake.deleteOrganizationReturnsOnCall[len(fake.deleteOrganizationArgsForCall)]
-	fake.deleteOrganizationArgsForCall = append(fake.deleteOrganizationArgsForCall, struct {
-		orgGUID string
-	}{orgGUID})
+func (fake *FakeCloudControllerClient) CreateSpace(spaceName string, orgGUID string) (ccv2.Space, ccv2.Warnings, error) {
+	fake.createSpaceMutex.Lock()
+	ret, specificReturn := fake.createSpaceReturnsOnCall[len(fake.createSpaceArgsForCall)]
+	fake.createSpaceArgsForCall = append(fake.createSpaceArgsForCall, struct {
+		spaceName string
+		orgGUID   string
+	}{spaceName, orgGUID})
+	fake.recordInvocation("CreateSpace", []interface{}{spaceName, orgGUID})
+	fake.createSpaceMutex.Unlock()
+	if fake.CreateSpaceStub != nil {
+		return fake.CreateSpaceStub(spaceName, orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSpaceReturns.result1, fake.createSpaceReturns.result2, fake.createSpaceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceCallCount() int {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return len(fake.createSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceArgsForCall(i int) (string, string) {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return fake.createSpaceArgsForCall[i].spaceName, fake.createSpaceArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceReturns(result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	fake.createSpaceReturns = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceReturnsOnCall(i int, result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	if fake.createSpaceReturnsOnCall == nil {
+		fake.createSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createSpaceReturnsOnCall[i] = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error) {
+	fake.createUserMutex.Lock()
+	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
+	fake.createUserArgsForCall = append(fake.createUserArgsForCall, struct {
+		uaaUserID string
+	}{uaaUserID})
+	fake.recordInvocation("CreateUser", []interface{}{uaaUserID})
+	fake.createUserMutex.Unlock()
+	if fake.CreateUserStub != nil {
+		return fake.CreateUserStub(uaaUserID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createUserReturns.result1, fake.createUserReturns.result2, fake.createUserReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateUserCallCount() int {
+	fake.createUserMutex.RLock()
+	defer fake.createUserMutex.RUnlock()
+	return len(fake.createUserArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateUserArgsForCall(i int) string {
+	fake.createUserMutex.RLock()
+	defer fake.createUserMutex.RUnlock()
+	return fake.createUserArgsForCall[i].uaaUserID
+}
+
+func (fake *FakeCloudControllerClient) CreateUserReturns(result1 ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.CreateUserStub = nil
+	fake.createUserReturns = struct {
+		result1 ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateUserReturnsOnCall(i int, result1 ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.CreateUserStub = nil
+	if fake.createUserReturnsOnCall == nil {
+		fake.createUserReturnsOnCall = make(map[int]struct {
+			result1 ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createUserReturnsOnCall[i] = struct {
+		result1 ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplication(guid string) (ccv2.Warnings, error) {
+	fake.deleteApplicationMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationReturnsOnCall[len(fake.deleteApplicationArgsForCall)]
+	fake.deleteApplicationArgsForCall = append(fake.deleteApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("DeleteApplication", []interface{}{guid})
+	fake.deleteApplicationMutex.Unlock()
+	if fake.DeleteApplicationStub != nil {
+		return fake.DeleteApplicationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteApplicationReturns.result1, fake.deleteApplicationReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationCallCount() int {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return len(fake.deleteApplicationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationArgsForCall(i int) string {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return fake.deleteApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	fake.deleteApplicationReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	if fake.deleteApplicationReturnsOnCall == nil {
+		fake.deleteApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.deleteOrganizationMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationReturnsOnCall[len(fake.deleteOrganizationArgsForCall)]
+	fake.deleteOrganizationArgsForCall = append(fake.deleteOrganizationArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
 	fake.recordInvocation("DeleteOrganization", []interface{}{orgGUID})
 	fake.deleteOrganizationMutex.Unlock()
 	if fake.DeleteOrganizationStub != nil {
@@ -1020,6 +1606,59 @@ func (fake *FakeCloudControllerClient) DeleteOrganizationReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserGUIDByRole(role ccv2.OrganizationRole, orgGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.deleteOrganizationUserGUIDByRoleMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationUserGUIDByRoleReturnsOnCall[len(fake.deleteOrganizationUserGUIDByRoleArgsForCall)]
+	fake.deleteOrganizationUserGUIDByRoleArgsForCall = append(fake.deleteOrganizationUserGUIDByRoleArgsForCall, struct {
+		role     ccv2.OrganizationRole
+		orgGUID  string
+		userGUID string
+	}{role, orgGUID, userGUID})
+	fake.recordInvocation("DeleteOrganizationUserGUIDByRole", []interface{}{role, orgGUID, userGUID})
+	fake.deleteOrganizationUserGUIDByRoleMutex.Unlock()
+	if fake.DeleteOrganizationUserGUIDByRoleStub != nil {
+		return fake.DeleteOrganizationUserGUIDByRoleStub(role, orgGUID, userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteOrganizationUserGUIDByRoleReturns.result1, fake.deleteOrganizationUserGUIDByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserGUIDByRoleCallCount() int {
+	fake.deleteOrganizationUserGUIDByRoleMutex.RLock()
+	defer fake.deleteOrganizationUserGUIDByRoleMutex.RUnlock()
+	return len(fake.deleteOrganizationUserGUIDByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserGUIDByRoleArgsForCall(i int) (ccv2.OrganizationRole, string, string) {
+	fake.deleteOrganizationUserGUIDByRoleMutex.RLock()
+	defer fake.deleteOrganizationUserGUIDByRoleMutex.RUnlock()
+	return fake.deleteOrganizationUserGUIDByRoleArgsForCall[i].role, fake.deleteOrganizationUserGUIDByRoleArgsForCall[i].orgGUID, fake.deleteOrganizationUserGUIDByRoleArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserGUIDByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationUserGUIDByRoleStub = nil
+	fake.deleteOrganizationUserGUIDByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationUserGUIDByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteOrganizationUserGUIDByRoleStub = nil
+	if fake.deleteOrganizationUserGUIDByRoleReturnsOnCall == nil {
+		fake.deleteOrganizationUserGUIDByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteOrganizationUserGUIDByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteRoute(routeGUID string) (ccv2.Warnings, error) {
 	fake.deleteRouteMutex.Lock()
 	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
@@ -1122,6 +1761,164 @@ func (fake *FakeCloudControllerClient) DeleteServiceBindingReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteServiceInstance(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error) {
+	fake.deleteServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteServiceInstanceReturnsOnCall[len(fake.deleteServiceInstanceArgsForCall)]
+	fake.deleteServiceInstanceArgsForCall = append(fake.deleteServiceInstanceArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("DeleteServiceInstance", []interface{}{serviceInstanceGUID})
+	fake.deleteServiceInstanceMutex.Unlock()
+	if fake.DeleteServiceInstanceStub != nil {
+		return fake.DeleteServiceInstanceStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteServiceInstanceReturns.result1, fake.deleteServiceInstanceReturns.result2, fake.deleteServiceInstanceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceCallCount() int {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return len(fake.deleteServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceArgsForCall(i int) string {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return fake.deleteServiceInstanceArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturns(result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteServiceInstanceStub = nil
+	fake.deleteServiceInstanceReturns = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturnsOnCall(i int, result1 ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.DeleteServiceInstanceStub = nil
+	if fake.deleteServiceInstanceReturnsOnCall == nil {
+		fake.deleteServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.deleteServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKey(serviceKeyGUID string) (ccv2.Warnings, error) {
+	fake.deleteServiceKeyMutex.Lock()
+	ret, specificReturn := fake.deleteServiceKeyReturnsOnCall[len(fake.deleteServiceKeyArgsForCall)]
+	fake.deleteServiceKeyArgsForCall = append(fake.deleteServiceKeyArgsForCall, struct {
+		serviceKeyGUID string
+	}{serviceKeyGUID})
+	fake.recordInvocation("DeleteServiceKey", []interface{}{serviceKeyGUID})
+	fake.deleteServiceKeyMutex.Unlock()
+	if fake.DeleteServiceKeyStub != nil {
+		return fake.DeleteServiceKeyStub(serviceKeyGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteServiceKeyReturns.result1, fake.deleteServiceKeyReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyCallCount() int {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return len(fake.deleteServiceKeyArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyArgsForCall(i int) string {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return fake.deleteServiceKeyArgsForCall[i].serviceKeyGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceKeyStub = nil
+	fake.deleteServiceKeyReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceKeyStub = nil
+	if fake.deleteServiceKeyReturnsOnCall == nil {
+		fake.deleteServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceKeyReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserGUIDByRole(role ccv2.SpaceRole, spaceGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.deleteSpaceUserGUIDByRoleMutex.Lock()
+	ret, specificReturn := fake.deleteSpaceUserGUIDByRoleReturnsOnCall[len(fake.deleteSpaceUserGUIDByRoleArgsForCall)]
+	fake.deleteSpaceUserGUIDByRoleArgsForCall = append(fake.deleteSpaceUserGUIDByRoleArgsForCall, struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+		userGUID  string
+	}{role, spaceGUID, userGUID})
+	fake.recordInvocation("DeleteSpaceUserGUIDByRole", []interface{}{role, spaceGUID, userGUID})
+	fake.deleteSpaceUserGUIDByRoleMutex.Unlock()
+	if fake.DeleteSpaceUserGUIDByRoleStub != nil {
+		return fake.DeleteSpaceUserGUIDByRoleStub(role, spaceGUID, userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteSpaceUserGUIDByRoleReturns.result1, fake.deleteSpaceUserGUIDByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserGUIDByRoleCallCount() int {
+	fake.deleteSpaceUserGUIDByRoleMutex.RLock()
+	defer fake.deleteSpaceUserGUIDByRoleMutex.RUnlock()
+	return len(fake.deleteSpaceUserGUIDByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserGUIDByRoleArgsForCall(i int) (ccv2.SpaceRole, string, string) {
+	fake.deleteSpaceUserGUIDByRoleMutex.RLock()
+	defer fake.deleteSpaceUserGUIDByRoleMutex.RUnlock()
+	return fake.deleteSpaceUserGUIDByRoleArgsForCall[i].role, fake.deleteSpaceUserGUIDByRoleArgsForCall[i].spaceGUID, fake.deleteSpaceUserGUIDByRoleArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserGUIDByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSpaceUserGUIDByRoleStub = nil
+	fake.deleteSpaceUserGUIDByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceUserGUIDByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteSpaceUserGUIDByRoleStub = nil
+	if fake.deleteSpaceUserGUIDByRoleReturnsOnCall == nil {
+		fake.deleteSpaceUserGUIDByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteSpaceUserGUIDByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) GetApplication(guid string) (ccv2.Application, ccv2.Warnings, error) {
 	fake.getApplicationMutex.Lock()
 	ret, specificReturn := fake.getApplicationReturnsOnCall[len(fake.getApplicationArgsForCall)]
@@ -1403,6 +2200,65 @@ func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetBuildpacks(queries []ccv2.Query) ([]ccv2.Buildpack, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getBuildpacksMutex.Lock()
+	ret, specificReturn := fake.getBuildpacksReturnsOnCall[len(fake.getBuildpacksArgsForCall)]
+	fake.getBuildpacksArgsForCall = append(fake.getBuildpacksArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetBuildpacks", []interface{}{queriesCopy})
+	fake.getBuildpacksMutex.Unlock()
+	if fake.GetBuildpacksStub != nil {
+		return fake.GetBuildpacksStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getBuildpacksReturns.result1, fake.getBuildpacksReturns.result2, fake.getBuildpacksReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksCallCount() int {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return len(fake.getBuildpacksArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksArgsForCall(i int) []ccv2.Query {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return fake.getBuildpacksArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksReturns(result1 []ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	fake.getBuildpacksReturns = struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksReturnsOnCall(i int, result1 []ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	if fake.getBuildpacksReturnsOnCall == nil {
+		fake.getBuildpacksReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Buildpack
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getBuildpacksReturnsOnCall[i] = struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.getJobMutex.Lock()
 	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
@@ -1625,6 +2481,65 @@ func (fake *FakeCloudControllerClient) GetOrganizationQuotaReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetOrganizationQuotas(queries []ccv2.Query) ([]ccv2.OrganizationQuota, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getOrganizationQuotasMutex.Lock()
+	ret, specificReturn := fake.getOrganizationQuotasReturnsOnCall[len(fake.getOrganizationQuotasArgsForCall)]
+	fake.getOrganizationQuotasArgsForCall = append(fake.getOrganizationQuotasArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetOrganizationQuotas", []interface{}{queriesCopy})
+	fake.getOrganizationQuotasMutex.Unlock()
+	if fake.GetOrganizationQuotasStub != nil {
+		return fake.GetOrganizationQuotasStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationQuotasReturns.result1, fake.getOrganizationQuotasReturns.result2, fake.getOrganizationQuotasReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasCallCount() int {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return len(fake.getOrganizationQuotasArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasArgsForCall(i int) []ccv2.Query {
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
+	return fake.getOrganizationQuotasArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturns(result1 []ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotasStub = nil
+	fake.getOrganizationQuotasReturns = struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturnsOnCall(i int, result1 []ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationQuotasStub = nil
+	if fake.getOrganizationQuotasReturnsOnCall == nil {
+		fake.getOrganizationQuotasReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.OrganizationQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationQuotasReturnsOnCall[i] = struct {
+		result1 []ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetOrganizations(queries []ccv2.Query) ([]ccv2.Organization, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
@@ -1684,6 +2599,61 @@ func (fake *FakeCloudControllerClient) GetOrganizationsReturnsOnCall(i int, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRole(role ccv2.OrganizationRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getOrganizationUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getOrganizationUsersByRoleReturnsOnCall[len(fake.getOrganizationUsersByRoleArgsForCall)]
+	fake.getOrganizationUsersByRoleArgsForCall = append(fake.getOrganizationUsersByRoleArgsForCall, struct {
+		role    ccv2.OrganizationRole
+		orgGUID string
+	}{role, orgGUID})
+	fake.recordInvocation("GetOrganizationUsersByRole", []interface{}{role, orgGUID})
+	fake.getOrganizationUsersByRoleMutex.Unlock()
+	if fake.GetOrganizationUsersByRoleStub != nil {
+		return fake.GetOrganizationUsersByRoleStub(role, orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationUsersByRoleReturns.result1, fake.getOrganizationUsersByRoleReturns.result2, fake.getOrganizationUsersByRoleReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleCallCount() int {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return len(fake.getOrganizationUsersByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleArgsForCall(i int) (ccv2.OrganizationRole, string) {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return fake.getOrganizationUsersByRoleArgsForCall[i].role, fake.getOrganizationUsersByRoleArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	fake.getOrganizationUsersByRoleReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	if fake.getOrganizationUsersByRoleReturnsOnCall == nil {
+		fake.getOrganizationUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationUsersByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetPrivateDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error) {
 	fake.getPrivateDomainMutex.Lock()
 	ret, specificReturn := fake.getPrivateDomainReturnsOnCall[len(fake.getPrivateDomainArgsForCall)]
@@ -1738,6 +2708,52 @@ func (fake *FakeCloudControllerClient) GetPrivateDomainReturnsOnCall(i int, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetRouterGroups() ([]ccv2.RouterGroup, ccv2.Warnings, error) {
+	fake.getRouterGroupsMutex.Lock()
+	ret, specificReturn := fake.getRouterGroupsReturnsOnCall[len(fake.getRouterGroupsArgsForCall)]
+	fake.getRouterGroupsArgsForCall = append(fake.getRouterGroupsArgsForCall, struct{}{})
+	fake.recordInvocation("GetRouterGroups", []interface{}{})
+	fake.getRouterGroupsMutex.Unlock()
+	if fake.GetRouterGroupsStub != nil {
+		return fake.GetRouterGroupsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRouterGroupsReturns.result1, fake.getRouterGroupsReturns.result2, fake.getRouterGroupsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRouterGroupsCallCount() int {
+	fake.getRouterGroupsMutex.RLock()
+	defer fake.getRouterGroupsMutex.RUnlock()
+	return len(fake.getRouterGroupsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRouterGroupsReturns(result1 []ccv2.RouterGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouterGroupsStub = nil
+	fake.getRouterGroupsReturns = struct {
+		result1 []ccv2.RouterGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouterGroupsReturnsOnCall(i int, result1 []ccv2.RouterGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouterGroupsStub = nil
+	if fake.getRouterGroupsReturnsOnCall == nil {
+		fake.getRouterGroupsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.RouterGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRouterGroupsReturnsOnCall[i] = struct {
+		result1 []ccv2.RouterGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetRouteApplications(routeGUID string, queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
@@ -1857,6 +2873,52 @@ func (fake *FakeCloudControllerClient) GetRoutesReturnsOnCall(i int, result1 []c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetRunningSecurityGroups() ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
+	fake.getRunningSecurityGroupsMutex.Lock()
+	ret, specificReturn := fake.getRunningSecurityGroupsReturnsOnCall[len(fake.getRunningSecurityGroupsArgsForCall)]
+	fake.getRunningSecurityGroupsArgsForCall = append(fake.getRunningSecurityGroupsArgsForCall, struct{}{})
+	fake.recordInvocation("GetRunningSecurityGroups", []interface{}{})
+	fake.getRunningSecurityGroupsMutex.Unlock()
+	if fake.GetRunningSecurityGroupsStub != nil {
+		return fake.GetRunningSecurityGroupsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRunningSecurityGroupsReturns.result1, fake.getRunningSecurityGroupsReturns.result2, fake.getRunningSecurityGroupsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRunningSecurityGroupsCallCount() int {
+	fake.getRunningSecurityGroupsMutex.RLock()
+	defer fake.getRunningSecurityGroupsMutex.RUnlock()
+	return len(fake.getRunningSecurityGroupsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRunningSecurityGroupsReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetRunningSecurityGroupsStub = nil
+	fake.getRunningSecurityGroupsReturns = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRunningSecurityGroupsReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetRunningSecurityGroupsStub = nil
+	if fake.getRunningSecurityGroupsReturnsOnCall == nil {
+		fake.getRunningSecurityGroupsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRunningSecurityGroupsReturnsOnCall[i] = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSecurityGroups(queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
@@ -1916,6 +2978,60 @@ func (fake *FakeCloudControllerClient) GetSecurityGroupsReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceBindingParameters(serviceBindingGUID string) (map[string]interface{}, ccv2.Warnings, error) {
+	fake.getServiceBindingParametersMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingParametersReturnsOnCall[len(fake.getServiceBindingParametersArgsForCall)]
+	fake.getServiceBindingParametersArgsForCall = append(fake.getServiceBindingParametersArgsForCall, struct {
+		serviceBindingGUID string
+	}{serviceBindingGUID})
+	fake.recordInvocation("GetServiceBindingParameters", []interface{}{serviceBindingGUID})
+	fake.getServiceBindingParametersMutex.Unlock()
+	if fake.GetServiceBindingParametersStub != nil {
+		return fake.GetServiceBindingParametersStub(serviceBindingGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBindingParametersReturns.result1, fake.getServiceBindingParametersReturns.result2, fake.getServiceBindingParametersReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersCallCount() int {
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
+	return len(fake.getServiceBindingParametersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersArgsForCall(i int) string {
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
+	return fake.getServiceBindingParametersArgsForCall[i].serviceBindingGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersReturns(result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBindingParametersStub = nil
+	fake.getServiceBindingParametersReturns = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersReturnsOnCall(i int, result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceBindingParametersStub = nil
+	if fake.getServiceBindingParametersReturnsOnCall == nil {
+		fake.getServiceBindingParametersReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingParametersReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServiceBindings(queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
@@ -1994,41 +3110,154 @@ func (fake *FakeCloudControllerClient) GetServiceInstances(queries []ccv2.Query)
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getServiceInstancesReturns.result1, fake.getServiceInstancesReturns.result2, fake.getServiceInstancesReturns.result3
+	return fake.getServiceInstancesReturns.result1, fake.getServiceInstancesReturns.result2, fake.getServiceInstancesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesCallCount() int {
+	fake.getServiceInstancesMutex.RLock()
+	defer fake.getServiceInstancesMutex.RUnlock()
+	return len(fake.getServiceInstancesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesArgsForCall(i int) []ccv2.Query {
+	fake.getServiceInstancesMutex.RLock()
+	defer fake.getServiceInstancesMutex.RUnlock()
+	return fake.getServiceInstancesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesReturns(result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstancesStub = nil
+	fake.getServiceInstancesReturns = struct {
+		result1 []ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstancesReturnsOnCall(i int, result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstancesStub = nil
+	if fake.getServiceInstancesReturnsOnCall == nil {
+		fake.getServiceInstancesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceInstance
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstancesReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceInstance
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeys(queries []ccv2.Query) ([]ccv2.ServiceKey, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getServiceKeysMutex.Lock()
+	ret, specificReturn := fake.getServiceKeysReturnsOnCall[len(fake.getServiceKeysArgsForCall)]
+	fake.getServiceKeysArgsForCall = append(fake.getServiceKeysArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetServiceKeys", []interface{}{queriesCopy})
+	fake.getServiceKeysMutex.Unlock()
+	if fake.GetServiceKeysStub != nil {
+		return fake.GetServiceKeysStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceKeysReturns.result1, fake.getServiceKeysReturns.result2, fake.getServiceKeysReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysCallCount() int {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	return len(fake.getServiceKeysArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysArgsForCall(i int) []ccv2.Query {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	return fake.getServiceKeysArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysReturns(result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeysStub = nil
+	fake.getServiceKeysReturns = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysReturnsOnCall(i int, result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeysStub = nil
+	if fake.getServiceKeysReturnsOnCall == nil {
+		fake.getServiceKeysReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceKey
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeysReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServicePlan(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error) {
+	fake.getServicePlanMutex.Lock()
+	ret, specificReturn := fake.getServicePlanReturnsOnCall[len(fake.getServicePlanArgsForCall)]
+	fake.getServicePlanArgsForCall = append(fake.getServicePlanArgsForCall, struct {
+		servicePlanGUID string
+	}{servicePlanGUID})
+	fake.recordInvocation("GetServicePlan", []interface{}{servicePlanGUID})
+	fake.getServicePlanMutex.Unlock()
+	if fake.GetServicePlanStub != nil {
+		return fake.GetServicePlanStub(servicePlanGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicePlanReturns.result1, fake.getServicePlanReturns.result2, fake.getServicePlanReturns.result3
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesCallCount() int {
-	fake.getServiceInstancesMutex.RLock()
-	defer fake.getServiceInstancesMutex.RUnlock()
-	return len(fake.getServiceInstancesArgsForCall)
+func (fake *FakeCloudControllerClient) GetServicePlanCallCount() int {
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	return len(fake.getServicePlanArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesArgsForCall(i int) []ccv2.Query {
-	fake.getServiceInstancesMutex.RLock()
-	defer fake.getServiceInstancesMutex.RUnlock()
-	return fake.getServiceInstancesArgsForCall[i].queries
+func (fake *FakeCloudControllerClient) GetServicePlanArgsForCall(i int) string {
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
+	return fake.getServicePlanArgsForCall[i].servicePlanGUID
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesReturns(result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetServiceInstancesStub = nil
-	fake.getServiceInstancesReturns = struct {
-		result1 []ccv2.ServiceInstance
+func (fake *FakeCloudControllerClient) GetServicePlanReturns(result1 ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlanStub = nil
+	fake.getServicePlanReturns = struct {
+		result1 ccv2.ServicePlan
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetServiceInstancesReturnsOnCall(i int, result1 []ccv2.ServiceInstance, result2 ccv2.Warnings, result3 error) {
-	fake.GetServiceInstancesStub = nil
-	if fake.getServiceInstancesReturnsOnCall == nil {
-		fake.getServiceInstancesReturnsOnCall = make(map[int]struct {
-			result1 []ccv2.ServiceInstance
+func (fake *FakeCloudControllerClient) GetServicePlanReturnsOnCall(i int, result1 ccv2.ServicePlan, result2 ccv2.Warnings, result3 error) {
+	fake.GetServicePlanStub = nil
+	if fake.getServicePlanReturnsOnCall == nil {
+		fake.getServicePlanReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServicePlan
 			result2 ccv2.Warnings
 			result3 error
 		})
 	}
-	fake.getServiceInstancesReturnsOnCall[i] = struct {
-		result1 []ccv2.ServiceInstance
+	fake.getServicePlanReturnsOnCall[i] = struct {
+		result1 ccv2.ServicePlan
 		result2 ccv2.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -2476,6 +3705,61 @@ func (fake *FakeCloudControllerClient) GetSpaceStagingSecurityGroupsBySpaceRetur
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRole(role ccv2.SpaceRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getSpaceUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsersByRoleReturnsOnCall[len(fake.getSpaceUsersByRoleArgsForCall)]
+	fake.getSpaceUsersByRoleArgsForCall = append(fake.getSpaceUsersByRoleArgsForCall, struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+	}{role, spaceGUID})
+	fake.recordInvocation("GetSpaceUsersByRole", []interface{}{role, spaceGUID})
+	fake.getSpaceUsersByRoleMutex.Unlock()
+	if fake.GetSpaceUsersByRoleStub != nil {
+		return fake.GetSpaceUsersByRoleStub(role, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceUsersByRoleReturns.result1, fake.getSpaceUsersByRoleReturns.result2, fake.getSpaceUsersByRoleReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleCallCount() int {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return len(fake.getSpaceUsersByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleArgsForCall(i int) (ccv2.SpaceRole, string) {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return fake.getSpaceUsersByRoleArgsForCall[i].role, fake.getSpaceUsersByRoleArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	fake.getSpaceUsersByRoleReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	if fake.getSpaceUsersByRoleReturnsOnCall == nil {
+		fake.getSpaceUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceUsersByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetStack(guid string) (ccv2.Stack, ccv2.Warnings, error) {
 	fake.getStackMutex.Lock()
 	ret, specificReturn := fake.getStackReturnsOnCall[len(fake.getStackArgsForCall)]
@@ -2530,6 +3814,111 @@ func (fake *FakeCloudControllerClient) GetStackReturnsOnCall(i int, result1 ccv2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetStacks(queries []ccv2.Query) ([]ccv2.Stack, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getStacksMutex.Lock()
+	ret, specificReturn := fake.getStacksReturnsOnCall[len(fake.getStacksArgsForCall)]
+	fake.getStacksArgsForCall = append(fake.getStacksArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetStacks", []interface{}{queriesCopy})
+	fake.getStacksMutex.Unlock()
+	if fake.GetStacksStub != nil {
+		return fake.GetStacksStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStacksReturns.result1, fake.getStacksReturns.result2, fake.getStacksReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetStacksCallCount() int {
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	return len(fake.getStacksArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetStacksArgsForCall(i int) []ccv2.Query {
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	return fake.getStacksArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetStacksReturns(result1 []ccv2.Stack, result2 ccv2.Warnings, result3 error) {
+	fake.GetStacksStub = nil
+	fake.getStacksReturns = struct {
+		result1 []ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetStacksReturnsOnCall(i int, result1 []ccv2.Stack, result2 ccv2.Warnings, result3 error) {
+	fake.GetStacksStub = nil
+	if fake.getStacksReturnsOnCall == nil {
+		fake.getStacksReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Stack
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getStacksReturnsOnCall[i] = struct {
+		result1 []ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetStagingSecurityGroups() ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
+	fake.getStagingSecurityGroupsMutex.Lock()
+	ret, specificReturn := fake.getStagingSecurityGroupsReturnsOnCall[len(fake.getStagingSecurityGroupsArgsForCall)]
+	fake.getStagingSecurityGroupsArgsForCall = append(fake.getStagingSecurityGroupsArgsForCall, struct{}{})
+	fake.recordInvocation("GetStagingSecurityGroups", []interface{}{})
+	fake.getStagingSecurityGroupsMutex.Unlock()
+	if fake.GetStagingSecurityGroupsStub != nil {
+		return fake.GetStagingSecurityGroupsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStagingSecurityGroupsReturns.result1, fake.getStagingSecurityGroupsReturns.result2, fake.getStagingSecurityGroupsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetStagingSecurityGroupsCallCount() int {
+	fake.getStagingSecurityGroupsMutex.RLock()
+	defer fake.getStagingSecurityGroupsMutex.RUnlock()
+	return len(fake.getStagingSecurityGroupsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetStagingSecurityGroupsReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetStagingSecurityGroupsStub = nil
+	fake.getStagingSecurityGroupsReturns = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetStagingSecurityGroupsReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetStagingSecurityGroupsStub = nil
+	if fake.getStagingSecurityGroupsReturnsOnCall == nil {
+		fake.getStagingSecurityGroupsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getStagingSecurityGroupsReturnsOnCall[i] = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) PollJob(job ccv2.Job) (ccv2.Warnings, error) {
 	fake.pollJobMutex.Lock()
 	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
@@ -2684,6 +4073,58 @@ func (fake *FakeCloudControllerClient) TargetCFReturnsOnCall(i int, result1 ccv2
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) UnbindRouteFromApplication(routeGUID string, appGUID string) (ccv2.Warnings, error) {
+	fake.unbindRouteFromApplicationMutex.Lock()
+	ret, specificReturn := fake.unbindRouteFromApplicationReturnsOnCall[len(fake.unbindRouteFromApplicationArgsForCall)]
+	fake.unbindRouteFromApplicationArgsForCall = append(fake.unbindRouteFromApplicationArgsForCall, struct {
+		routeGUID string
+		appGUID   string
+	}{routeGUID, appGUID})
+	fake.recordInvocation("UnbindRouteFromApplication", []interface{}{routeGUID, appGUID})
+	fake.unbindRouteFromApplicationMutex.Unlock()
+	if fake.UnbindRouteFromApplicationStub != nil {
+		return fake.UnbindRouteFromApplicationStub(routeGUID, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.unbindRouteFromApplicationReturns.result1, fake.unbindRouteFromApplicationReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UnbindRouteFromApplicationCallCount() int {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return len(fake.unbindRouteFromApplicationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UnbindRouteFromApplicationArgsForCall(i int) (string, string) {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return fake.unbindRouteFromApplicationArgsForCall[i].routeGUID, fake.unbindRouteFromApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) UnbindRouteFromApplicationReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	fake.unbindRouteFromApplicationReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UnbindRouteFromApplicationReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	if fake.unbindRouteFromApplicationReturnsOnCall == nil {
+		fake.unbindRouteFromApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.unbindRouteFromApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UpdateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error) {
 	fake.updateApplicationMutex.Lock()
 	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
@@ -2738,6 +4179,273 @@ func (fake *FakeCloudControllerClient) UpdateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuota(orgGUID string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationQuotaReturnsOnCall[len(fake.updateOrganizationQuotaArgsForCall)]
+	fake.updateOrganizationQuotaArgsForCall = append(fake.updateOrganizationQuotaArgsForCall, struct {
+		orgGUID   string
+		quotaGUID string
+	}{orgGUID, quotaGUID})
+	fake.recordInvocation("UpdateOrganizationQuota", []interface{}{orgGUID, quotaGUID})
+	fake.updateOrganizationQuotaMutex.Unlock()
+	if fake.UpdateOrganizationQuotaStub != nil {
+		return fake.UpdateOrganizationQuotaStub(orgGUID, quotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateOrganizationQuotaReturns.result1, fake.updateOrganizationQuotaReturns.result2, fake.updateOrganizationQuotaReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaCallCount() int {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return len(fake.updateOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaArgsForCall(i int) (string, string) {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return fake.updateOrganizationQuotaArgsForCall[i].orgGUID, fake.updateOrganizationQuotaArgsForCall[i].quotaGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	fake.updateOrganizationQuotaReturns = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	if fake.updateOrganizationQuotaReturnsOnCall == nil {
+		fake.updateOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Organization
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRole(role ccv2.OrganizationRole, orgGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateOrganizationUserByRoleMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationUserByRoleReturnsOnCall[len(fake.updateOrganizationUserByRoleArgsForCall)]
+	fake.updateOrganizationUserByRoleArgsForCall = append(fake.updateOrganizationUserByRoleArgsForCall, struct {
+		role     ccv2.OrganizationRole
+		orgGUID  string
+		username string
+	}{role, orgGUID, username})
+	fake.recordInvocation("UpdateOrganizationUserByRole", []interface{}{role, orgGUID, username})
+	fake.updateOrganizationUserByRoleMutex.Unlock()
+	if fake.UpdateOrganizationUserByRoleStub != nil {
+		return fake.UpdateOrganizationUserByRoleStub(role, orgGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationUserByRoleReturns.result1, fake.updateOrganizationUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleCallCount() int {
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
+	return len(fake.updateOrganizationUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleArgsForCall(i int) (ccv2.OrganizationRole, string, string) {
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
+	return fake.updateOrganizationUserByRoleArgsForCall[i].role, fake.updateOrganizationUserByRoleArgsForCall[i].orgGUID, fake.updateOrganizationUserByRoleArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserByRoleStub = nil
+	fake.updateOrganizationUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserByRoleStub = nil
+	if fake.updateOrganizationUserByRoleReturnsOnCall == nil {
+		fake.updateOrganizationUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserGUIDByRole(role ccv2.OrganizationRole, orgGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.updateOrganizationUserGUIDByRoleMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationUserGUIDByRoleReturnsOnCall[len(fake.updateOrganizationUserGUIDByRoleArgsForCall)]
+	fake.updateOrganizationUserGUIDByRoleArgsForCall = append(fake.updateOrganizationUserGUIDByRoleArgsForCall, struct {
+		role     ccv2.OrganizationRole
+		orgGUID  string
+		userGUID string
+	}{role, orgGUID, userGUID})
+	fake.recordInvocation("UpdateOrganizationUserGUIDByRole", []interface{}{role, orgGUID, userGUID})
+	fake.updateOrganizationUserGUIDByRoleMutex.Unlock()
+	if fake.UpdateOrganizationUserGUIDByRoleStub != nil {
+		return fake.UpdateOrganizationUserGUIDByRoleStub(role, orgGUID, userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationUserGUIDByRoleReturns.result1, fake.updateOrganizationUserGUIDByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserGUIDByRoleCallCount() int {
+	fake.updateOrganizationUserGUIDByRoleMutex.RLock()
+	defer fake.updateOrganizationUserGUIDByRoleMutex.RUnlock()
+	return len(fake.updateOrganizationUserGUIDByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserGUIDByRoleArgsForCall(i int) (ccv2.OrganizationRole, string, string) {
+	fake.updateOrganizationUserGUIDByRoleMutex.RLock()
+	defer fake.updateOrganizationUserGUIDByRoleMutex.RUnlock()
+	return fake.updateOrganizationUserGUIDByRoleArgsForCall[i].role, fake.updateOrganizationUserGUIDByRoleArgsForCall[i].orgGUID, fake.updateOrganizationUserGUIDByRoleArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserGUIDByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserGUIDByRoleStub = nil
+	fake.updateOrganizationUserGUIDByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserGUIDByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserGUIDByRoleStub = nil
+	if fake.updateOrganizationUserGUIDByRoleReturnsOnCall == nil {
+		fake.updateOrganizationUserGUIDByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationUserGUIDByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRole(role ccv2.SpaceRole, spaceGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateSpaceUserByRoleMutex.Lock()
+	ret, specificReturn := fake.updateSpaceUserByRoleReturnsOnCall[len(fake.updateSpaceUserByRoleArgsForCall)]
+	fake.updateSpaceUserByRoleArgsForCall = append(fake.updateSpaceUserByRoleArgsForCall, struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+		username  string
+	}{role, spaceGUID, username})
+	fake.recordInvocation("UpdateSpaceUserByRole", []interface{}{role, spaceGUID, username})
+	fake.updateSpaceUserByRoleMutex.Unlock()
+	if fake.UpdateSpaceUserByRoleStub != nil {
+		return fake.UpdateSpaceUserByRoleStub(role, spaceGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateSpaceUserByRoleReturns.result1, fake.updateSpaceUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleCallCount() int {
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
+	return len(fake.updateSpaceUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleArgsForCall(i int) (ccv2.SpaceRole, string, string) {
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
+	return fake.updateSpaceUserByRoleArgsForCall[i].role, fake.updateSpaceUserByRoleArgsForCall[i].spaceGUID, fake.updateSpaceUserByRoleArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserByRoleStub = nil
+	fake.updateSpaceUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserByRoleStub = nil
+	if fake.updateSpaceUserByRoleReturnsOnCall == nil {
+		fake.updateSpaceUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserGUIDByRole(role ccv2.SpaceRole, spaceGUID string, userGUID string) (ccv2.Warnings, error) {
+	fake.updateSpaceUserGUIDByRoleMutex.Lock()
+	ret, specificReturn := fake.updateSpaceUserGUIDByRoleReturnsOnCall[len(fake.updateSpaceUserGUIDByRoleArgsForCall)]
+	fake.updateSpaceUserGUIDByRoleArgsForCall = append(fake.updateSpaceUserGUIDByRoleArgsForCall, struct {
+		role      ccv2.SpaceRole
+		spaceGUID string
+		userGUID  string
+	}{role, spaceGUID, userGUID})
+	fake.recordInvocation("UpdateSpaceUserGUIDByRole", []interface{}{role, spaceGUID, userGUID})
+	fake.updateSpaceUserGUIDByRoleMutex.Unlock()
+	if fake.UpdateSpaceUserGUIDByRoleStub != nil {
+		return fake.UpdateSpaceUserGUIDByRoleStub(role, spaceGUID, userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateSpaceUserGUIDByRoleReturns.result1, fake.updateSpaceUserGUIDByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserGUIDByRoleCallCount() int {
+	fake.updateSpaceUserGUIDByRoleMutex.RLock()
+	defer fake.updateSpaceUserGUIDByRoleMutex.RUnlock()
+	return len(fake.updateSpaceUserGUIDByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserGUIDByRoleArgsForCall(i int) (ccv2.SpaceRole, string, string) {
+	fake.updateSpaceUserGUIDByRoleMutex.RLock()
+	defer fake.updateSpaceUserGUIDByRoleMutex.RUnlock()
+	return fake.updateSpaceUserGUIDByRoleArgsForCall[i].role, fake.updateSpaceUserGUIDByRoleArgsForCall[i].spaceGUID, fake.updateSpaceUserGUIDByRoleArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserGUIDByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserGUIDByRoleStub = nil
+	fake.updateSpaceUserGUIDByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserGUIDByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserGUIDByRoleStub = nil
+	if fake.updateSpaceUserGUIDByRoleReturnsOnCall == nil {
+		fake.updateSpaceUserGUIDByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceUserGUIDByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) API() string {
 	fake.aPIMutex.Lock()
 	ret, specificReturn := fake.aPIReturnsOnCall[len(fake.aPIArgsForCall)]
@@ -3029,16 +4737,32 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.checkRouteMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
+	fake.createServiceBindingMutex.RLock()
+	defer fake.createServiceBindingMutex.RUnlock()
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
 	fake.deleteOrganizationMutex.RLock()
 	defer fake.deleteOrganizationMutex.RUnlock()
+	fake.deleteOrganizationUserGUIDByRoleMutex.RLock()
+	defer fake.deleteOrganizationUserGUIDByRoleMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
 	defer fake.deleteRouteMutex.RUnlock()
 	fake.deleteServiceBindingMutex.RLock()
 	defer fake.deleteServiceBindingMutex.RUnlock()
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	fake.deleteSpaceUserGUIDByRoleMutex.RLock()
+	defer fake.deleteSpaceUserGUIDByRoleMutex.RUnlock()
 	fake.getApplicationMutex.RLock()
 	defer fake.getApplicationMutex.RUnlock()
 	fake.getApplicationInstancesByApplicationMutex.RLock()
@@ -3049,6 +4773,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationRoutesMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
 	fake.getJobMutex.RLock()
 	defer fake.getJobMutex.RUnlock()
 	fake.getOrganizationMutex.RLock()
@@ -3057,20 +4783,34 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getOrganizationPrivateDomainsMutex.RUnlock()
 	fake.getOrganizationQuotaMutex.RLock()
 	defer fake.getOrganizationQuotaMutex.RUnlock()
+	fake.getOrganizationQuotasMutex.RLock()
+	defer fake.getOrganizationQuotasMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
 	fake.getPrivateDomainMutex.RLock()
 	defer fake.getPrivateDomainMutex.RUnlock()
+	fake.getRouterGroupsMutex.RLock()
+	defer fake.getRouterGroupsMutex.RUnlock()
 	fake.getRouteApplicationsMutex.RLock()
 	defer fake.getRouteApplicationsMutex.RUnlock()
 	fake.getRoutesMutex.RLock()
 	defer fake.getRoutesMutex.RUnlock()
+	fake.getRunningSecurityGroupsMutex.RLock()
+	defer fake.getRunningSecurityGroupsMutex.RUnlock()
 	fake.getSecurityGroupsMutex.RLock()
 	defer fake.getSecurityGroupsMutex.RUnlock()
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
 	fake.getServiceBindingsMutex.RLock()
 	defer fake.getServiceBindingsMutex.RUnlock()
 	fake.getServiceInstancesMutex.RLock()
 	defer fake.getServiceInstancesMutex.RUnlock()
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	fake.getServicePlanMutex.RLock()
+	defer fake.getServicePlanMutex.RUnlock()
 	fake.getSharedDomainMutex.RLock()
 	defer fake.getSharedDomainMutex.RUnlock()
 	fake.getSharedDomainsMutex.RLock()
@@ -3087,16 +4827,34 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSpaceServiceInstancesMutex.RUnlock()
 	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
 	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
 	fake.getStackMutex.RLock()
 	defer fake.getStackMutex.RUnlock()
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	fake.getStagingSecurityGroupsMutex.RLock()
+	defer fake.getStagingSecurityGroupsMutex.RUnlock()
 	fake.pollJobMutex.RLock()
 	defer fake.pollJobMutex.RUnlock()
 	fake.removeSpaceFromSecurityGroupMutex.RLock()
 	defer fake.removeSpaceFromSecurityGroupMutex.RUnlock()
 	fake.targetCFMutex.RLock()
 	defer fake.targetCFMutex.RUnlock()
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
+	fake.updateOrganizationUserGUIDByRoleMutex.RLock()
+	defer fake.updateOrganizationUserGUIDByRoleMutex.RUnlock()
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
+	fake.updateSpaceUserGUIDByRoleMutex.RLock()
+	defer fake.updateSpaceUserGUIDByRoleMutex.RUnlock()
 	fake.aPIMutex.RLock()
 	defer fake.aPIMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
@@ -24,6 +24,20 @@ type FakeUAAClient struct {
 		result1 uaa.User
 		result2 error
 	}
+	GetUsersStub        func(username string, origin string) ([]uaa.User, error)
+	getUsersMutex       sync.RWMutex
+	getUsersArgsForCall []struct {
+		username string
+		origin   string
+	}
+	getUsersReturns struct {
+		result1 []uaa.User
+		result2 error
+	}
+	getUsersReturnsOnCall map[int]struct {
+		result1 []uaa.User
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -81,11 +95,65 @@ func (fake *FakeUAAClient) CreateUserReturnsOnCall(i int, result1 uaa.User, resu
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) GetUsers(username string, origin string) ([]uaa.User, error) {
+	fake.getUsersMutex.Lock()
+	ret, specificReturn := fake.getUsersReturnsOnCall[len(fake.getUsersArgsForCall)]
+	fake.getUsersArgsForCall = append(fake.getUsersArgsForCall, struct {
+		username string
+		origin   string
+	}{username, origin})
+	fake.recordInvocation("GetUsers", []interface{}{username, origin})
+	fake.getUsersMutex.Unlock()
+	if fake.GetUsersStub != nil {
+		return fake.GetUsersStub(username, origin)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getUsersReturns.result1, fake.getUsersReturns.result2
+}
+
+func (fake *FakeUAAClient) GetUsersCallCount() int {
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
+	return len(fake.getUsersArgsForCall)
+}
+
+func (fake *FakeUAAClient) GetUsersArgsForCall(i int) (string, string) {
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
+	return fake.getUsersArgsForCall[i].username, fake.getUsersArgsForCall[i].origin
+}
+
+func (fake *FakeUAAClient) GetUsersReturns(result1 []uaa.User, result2 error) {
+	fake.GetUsersStub = nil
+	fake.getUsersReturns = struct {
+		result1 []uaa.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) GetUsersReturnsOnCall(i int, result1 []uaa.User, result2 error) {
+	fake.GetUsersStub = nil
+	if fake.getUsersReturnsOnCall == nil {
+		fake.getUsersReturnsOnCall = make(map[int]struct {
+			result1 []uaa.User
+			result2 error
+		})
+	}
+	fake.getUsersReturnsOnCall[i] = struct {
+		result1 []uaa.User
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
 	return fake.invocations
 }
 
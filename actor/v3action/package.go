@@ -2,8 +2,10 @@ package v3action
 
 import (
 	"archive/zip"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -84,6 +86,55 @@ func (actor Actor) CreateAndUploadPackageByApplicationNameAndSpace(appName strin
 	return Package(pkg), allWarnings, err
 }
 
+// ApplicationPackage is a package along with the name of the application it
+// belongs to.
+type ApplicationPackage struct {
+	Package
+	ApplicationName string
+}
+
+// GetFailedOrExpiredPackagesBySpace returns the packages of the applications
+// in the space that failed to process or have expired.
+func (actor Actor) GetFailedOrExpiredPackagesBySpace(spaceGUID string) ([]ApplicationPackage, Warnings, error) {
+	pkgs, warnings, err := actor.CloudControllerClient.GetPackages(url.Values{
+		ccv3.SpaceGUIDFilter: []string{spaceGUID},
+		ccv3.StatesFilter:    []string{fmt.Sprintf("%s,%s", ccv3.PackageStateFailed, ccv3.PackageStateExpired)},
+	})
+	allWarnings := Warnings(warnings)
+	if err != nil || len(pkgs) == 0 {
+		return nil, allWarnings, err
+	}
+
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(url.Values{
+		ccv3.SpaceGUIDFilter: []string{spaceGUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	appNames := map[string]string{}
+	for _, app := range apps {
+		appNames[app.GUID] = app.Name
+	}
+
+	appPackages := make([]ApplicationPackage, len(pkgs))
+	for i, pkg := range pkgs {
+		appPackages[i] = ApplicationPackage{
+			Package:         Package(pkg),
+			ApplicationName: appNames[pkg.Relationships.Application.GUID],
+		}
+	}
+
+	return appPackages, allWarnings, nil
+}
+
+// DeletePackage deletes the package with the given GUID.
+func (actor Actor) DeletePackage(guid string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeletePackage(guid)
+	return Warnings(warnings), err
+}
+
 func writeZipFile(dir string, targetFile *os.File) error {
 	isEmpty, err := fileutils.IsDirEmpty(dir)
 	if err != nil {
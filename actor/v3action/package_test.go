@@ -306,4 +306,96 @@ var _ = Describe("Package Actions", func() {
 			})
 		})
 	})
+	Describe("GetFailedOrExpiredPackagesBySpace", func() {
+		Context("when there are failed or expired packages", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(
+					[]ccv3.Package{
+						{
+							GUID:  "package-guid-1",
+							State: ccv3.PackageStateFailed,
+							Relationships: ccv3.PackageRelationships{
+								Application: ccv3.Relationship{GUID: "app-guid-1"},
+							},
+						},
+					},
+					ccv3.Warnings{"packages-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{GUID: "app-guid-1", Name: "app-1"}},
+					ccv3.Warnings{"apps-warning"},
+					nil,
+				)
+			})
+
+			It("returns the packages with their application names", func() {
+				pkgs, warnings, err := actor.GetFailedOrExpiredPackagesBySpace("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pkgs).To(Equal([]ApplicationPackage{
+					{
+						Package: Package{
+							GUID:  "package-guid-1",
+							State: ccv3.PackageStateFailed,
+							Relationships: ccv3.PackageRelationships{
+								Application: ccv3.Relationship{GUID: "app-guid-1"},
+							},
+						},
+						ApplicationName: "app-1",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("packages-warning", "apps-warning"))
+
+				Expect(fakeCloudControllerClient.GetPackagesArgsForCall(0)).To(Equal(url.Values{
+					ccv3.SpaceGUIDFilter: []string{"some-space-guid"},
+					ccv3.StatesFilter:    []string{"FAILED,EXPIRED"},
+				}))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{
+					ccv3.SpaceGUIDFilter: []string{"some-space-guid"},
+				}))
+			})
+		})
+
+		Context("when there are no failed or expired packages", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"packages-warning"}, nil)
+			})
+
+			It("does not look up the applications", func() {
+				pkgs, warnings, err := actor.GetFailedOrExpiredPackagesBySpace("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pkgs).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("packages-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the packages fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("packages error")
+				fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"packages-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetFailedOrExpiredPackagesBySpace("some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("packages-warning"))
+			})
+		})
+	})
+
+	Describe("DeletePackage", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeletePackageReturns(ccv3.Warnings{"delete-warning"}, nil)
+		})
+
+		It("deletes the package", func() {
+			warnings, err := actor.DeletePackage("package-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete-warning"))
+			Expect(fakeCloudControllerClient.DeletePackageArgsForCall(0)).To(Equal("package-guid"))
+		})
+	})
 })
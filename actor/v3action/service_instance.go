@@ -0,0 +1,56 @@
+package v3action
+
+import "fmt"
+
+// ServiceInstanceNotSharedError is returned when unsharing a service instance
+// from a space it is not shared with.
+type ServiceInstanceNotSharedError struct {
+	ServiceInstanceGUID string
+	SpaceGUID           string
+}
+
+func (e ServiceInstanceNotSharedError) Error() string {
+	return fmt.Sprintf("Service instance %s is not shared with space %s.", e.ServiceInstanceGUID, e.SpaceGUID)
+}
+
+// ShareServiceInstanceToSpace shares the service instance with the space, so
+// that apps in the space can bind to it.
+func (actor Actor) ShareServiceInstanceToSpace(serviceInstanceGUID string, spaceGUID string) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.ShareServiceInstanceToSpaces(serviceInstanceGUID, []string{spaceGUID})
+	return Warnings(warnings), err
+}
+
+// UnshareServiceInstanceFromSpace stops sharing the service instance with the
+// space. It returns a ServiceInstanceNotSharedError if the service instance
+// is not shared with the space.
+func (actor Actor) UnshareServiceInstanceFromSpace(serviceInstanceGUID string, spaceGUID string) (Warnings, error) {
+	sharedSpaceGUIDs, allWarnings, err := actor.GetServiceInstanceSharedSpaceGUIDs(serviceInstanceGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	var shared bool
+	for _, guid := range sharedSpaceGUIDs {
+		if guid == spaceGUID {
+			shared = true
+			break
+		}
+	}
+	if !shared {
+		return allWarnings, ServiceInstanceNotSharedError{
+			ServiceInstanceGUID: serviceInstanceGUID,
+			SpaceGUID:           spaceGUID,
+		}
+	}
+
+	warnings, err := actor.CloudControllerClient.UnshareServiceInstanceFromSpace(serviceInstanceGUID, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// GetServiceInstanceSharedSpaceGUIDs returns the GUIDs of the spaces the
+// service instance is shared with.
+func (actor Actor) GetServiceInstanceSharedSpaceGUIDs(serviceInstanceGUID string) ([]string, Warnings, error) {
+	relationships, warnings, err := actor.CloudControllerClient.GetServiceInstanceSharedSpaces(serviceInstanceGUID)
+	return relationships.GUIDs, Warnings(warnings), err
+}
@@ -0,0 +1,143 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("ShareServiceInstanceToSpace", func() {
+		Context("when the share succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.ShareServiceInstanceToSpacesReturns(
+					ccv3.RelationshipList{GUIDs: []string{"some-space-guid"}},
+					ccv3.Warnings{"share-warning"},
+					nil)
+			})
+
+			It("shares the service instance and returns the warnings", func() {
+				warnings, err := actor.ShareServiceInstanceToSpace("some-instance-guid", "some-space-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("share-warning"))
+
+				Expect(fakeCloudControllerClient.ShareServiceInstanceToSpacesCallCount()).To(Equal(1))
+				instanceGUID, spaceGUIDs := fakeCloudControllerClient.ShareServiceInstanceToSpacesArgsForCall(0)
+				Expect(instanceGUID).To(Equal("some-instance-guid"))
+				Expect(spaceGUIDs).To(ConsistOf("some-space-guid"))
+			})
+		})
+
+		Context("when the share fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("share failed")
+				fakeCloudControllerClient.ShareServiceInstanceToSpacesReturns(
+					ccv3.RelationshipList{},
+					ccv3.Warnings{"share-warning"},
+					expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := actor.ShareServiceInstanceToSpace("some-instance-guid", "some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("share-warning"))
+			})
+		})
+	})
+
+	Describe("UnshareServiceInstanceFromSpace", func() {
+		Context("when the service instance is shared with the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceSharedSpacesReturns(
+					ccv3.RelationshipList{GUIDs: []string{"other-space-guid", "some-space-guid"}},
+					ccv3.Warnings{"get-warning"},
+					nil)
+				fakeCloudControllerClient.UnshareServiceInstanceFromSpaceReturns(
+					ccv3.Warnings{"unshare-warning"},
+					nil)
+			})
+
+			It("unshares the service instance and returns all warnings", func() {
+				warnings, err := actor.UnshareServiceInstanceFromSpace("some-instance-guid", "some-space-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-warning", "unshare-warning"))
+
+				Expect(fakeCloudControllerClient.GetServiceInstanceSharedSpacesArgsForCall(0)).To(Equal("some-instance-guid"))
+				Expect(fakeCloudControllerClient.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(1))
+				instanceGUID, spaceGUID := fakeCloudControllerClient.UnshareServiceInstanceFromSpaceArgsForCall(0)
+				Expect(instanceGUID).To(Equal("some-instance-guid"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when the service instance is not shared with the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceSharedSpacesReturns(
+					ccv3.RelationshipList{GUIDs: []string{"other-space-guid"}},
+					ccv3.Warnings{"get-warning"},
+					nil)
+			})
+
+			It("returns a ServiceInstanceNotSharedError", func() {
+				warnings, err := actor.UnshareServiceInstanceFromSpace("some-instance-guid", "some-space-guid")
+				Expect(err).To(MatchError(ServiceInstanceNotSharedError{
+					ServiceInstanceGUID: "some-instance-guid",
+					SpaceGUID:           "some-space-guid",
+				}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+
+				Expect(fakeCloudControllerClient.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the shared spaces fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get failed")
+				fakeCloudControllerClient.GetServiceInstanceSharedSpacesReturns(
+					ccv3.RelationshipList{},
+					ccv3.Warnings{"get-warning"},
+					expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := actor.UnshareServiceInstanceFromSpace("some-instance-guid", "some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceInstanceSharedSpaceGUIDs", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstanceSharedSpacesReturns(
+				ccv3.RelationshipList{GUIDs: []string{"space-guid-1", "space-guid-2"}},
+				ccv3.Warnings{"get-warning"},
+				nil)
+		})
+
+		It("returns the shared space GUIDs and warnings", func() {
+			spaceGUIDs, warnings, err := actor.GetServiceInstanceSharedSpaceGUIDs("some-instance-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-warning"))
+			Expect(spaceGUIDs).To(Equal([]string{"space-guid-1", "space-guid-2"}))
+		})
+	})
+})
@@ -88,6 +88,38 @@ func (actor Actor) GetTaskBySequenceIDAndApplication(sequenceID int, appGUID str
 	return Task(tasks[0]), Warnings(warnings), nil
 }
 
+// GetRunningTasksByOrganization returns the tasks currently running in any
+// space of the provided organization.
+func (actor Actor) GetRunningTasksByOrganization(orgGUID string) ([]Task, Warnings, error) {
+	return actor.getRunningTasks(url.Values{
+		ccv3.OrganizationGUIDFilter: []string{orgGUID},
+	})
+}
+
+// GetRunningTasksBySpace returns the tasks currently running in the provided
+// space.
+func (actor Actor) GetRunningTasksBySpace(spaceGUID string) ([]Task, Warnings, error) {
+	return actor.getRunningTasks(url.Values{
+		ccv3.SpaceGUIDFilter: []string{spaceGUID},
+	})
+}
+
+func (actor Actor) getRunningTasks(query url.Values) ([]Task, Warnings, error) {
+	query.Set(ccv3.StatesFilter, "RUNNING")
+
+	tasks, warnings, err := actor.CloudControllerClient.GetTasks(query)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var runningTasks []Task
+	for _, task := range tasks {
+		runningTasks = append(runningTasks, Task(task))
+	}
+
+	return runningTasks, Warnings(warnings), nil
+}
+
 func (actor Actor) TerminateTask(taskGUID string) (Task, Warnings, error) {
 	task, warnings, err := actor.CloudControllerClient.UpdateTask(taskGUID)
 	return Task(task), Warnings(warnings), err
@@ -305,4 +305,74 @@ var _ = Describe("Task Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetRunningTasksByOrganization", func() {
+		Context("when the cloud controller request succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTasksReturns(
+					[]ccv3.Task{
+						{GUID: "task-1-guid", MemoryInMB: 256},
+						{GUID: "task-2-guid", MemoryInMB: 512},
+					},
+					ccv3.Warnings{"get-tasks-warning"},
+					nil)
+			})
+
+			It("returns the running tasks in the org and warnings", func() {
+				tasks, warnings, err := actor.GetRunningTasksByOrganization("some-org-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-tasks-warning"))
+				Expect(tasks).To(Equal([]Task{
+					{GUID: "task-1-guid", MemoryInMB: 256},
+					{GUID: "task-2-guid", MemoryInMB: 512},
+				}))
+
+				Expect(fakeCloudControllerClient.GetTasksCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetTasksArgsForCall(0)).To(Equal(url.Values{
+					"organization_guids": []string{"some-org-guid"},
+					"states":             []string{"RUNNING"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("cc-error")
+				fakeCloudControllerClient.GetTasksReturns(
+					nil,
+					ccv3.Warnings{"get-tasks-warning"},
+					expectedErr)
+			})
+
+			It("returns the same error and warnings", func() {
+				_, warnings, err := actor.GetRunningTasksByOrganization("some-org-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-tasks-warning"))
+			})
+		})
+	})
+
+	Describe("GetRunningTasksBySpace", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetTasksReturns(
+				[]ccv3.Task{{GUID: "task-1-guid"}},
+				ccv3.Warnings{"get-tasks-warning"},
+				nil)
+		})
+
+		It("returns the running tasks in the space and warnings", func() {
+			tasks, warnings, err := actor.GetRunningTasksBySpace("some-space-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-tasks-warning"))
+			Expect(tasks).To(Equal([]Task{{GUID: "task-1-guid"}}))
+
+			Expect(fakeCloudControllerClient.GetTasksArgsForCall(0)).To(Equal(url.Values{
+				"space_guids": []string{"some-space-guid"},
+				"states":      []string{"RUNNING"},
+			}))
+		})
+	})
+
 })
@@ -109,6 +109,19 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeletePackageStub        func(guid string) (ccv3.Warnings, error)
+	deletePackageMutex       sync.RWMutex
+	deletePackageArgsForCall []struct {
+		guid string
+	}
+	deletePackageReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	deletePackageReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	EntitleIsolationSegmentToOrganizationsStub        func(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
 	entitleIsolationSegmentToOrganizationsMutex       sync.RWMutex
 	entitleIsolationSegmentToOrganizationsArgsForCall []struct {
@@ -246,6 +259,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetPackagesStub        func(query url.Values) ([]ccv3.Package, ccv3.Warnings, error)
+	getPackagesMutex       sync.RWMutex
+	getPackagesArgsForCall []struct {
+		query url.Values
+	}
+	getPackagesReturns struct {
+		result1 []ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getPackagesReturnsOnCall map[int]struct {
+		result1 []ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetServiceInstanceSharedSpacesStub        func(serviceInstanceGUID string) (ccv3.RelationshipList, ccv3.Warnings, error)
+	getServiceInstanceSharedSpacesMutex       sync.RWMutex
+	getServiceInstanceSharedSpacesArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	getServiceInstanceSharedSpacesReturns struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServiceInstanceSharedSpacesReturnsOnCall map[int]struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetSpaceIsolationSegmentStub        func(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	getSpaceIsolationSegmentMutex       sync.RWMutex
 	getSpaceIsolationSegmentArgsForCall []struct {
@@ -261,6 +304,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetTasksStub        func(query url.Values) ([]ccv3.Task, ccv3.Warnings, error)
+	getTasksMutex       sync.RWMutex
+	getTasksArgsForCall []struct {
+		query url.Values
+	}
+	getTasksReturns struct {
+		result1 []ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getTasksReturnsOnCall map[int]struct {
+		result1 []ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}
 	RevokeIsolationSegmentFromOrganizationStub        func(isolationSegmentGUID string, organizationGUID string) (ccv3.Warnings, error)
 	revokeIsolationSegmentFromOrganizationMutex       sync.RWMutex
 	revokeIsolationSegmentFromOrganizationArgsForCall []struct {
@@ -275,6 +333,36 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	ShareServiceInstanceToSpacesStub        func(serviceInstanceGUID string, spaceGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
+	shareServiceInstanceToSpacesMutex       sync.RWMutex
+	shareServiceInstanceToSpacesArgsForCall []struct {
+		serviceInstanceGUID string
+		spaceGUIDs          []string
+	}
+	shareServiceInstanceToSpacesReturns struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
+	shareServiceInstanceToSpacesReturnsOnCall map[int]struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UnshareServiceInstanceFromSpaceStub        func(serviceInstanceGUID string, spaceGUID string) (ccv3.Warnings, error)
+	unshareServiceInstanceFromSpaceMutex       sync.RWMutex
+	unshareServiceInstanceFromSpaceArgsForCall []struct {
+		serviceInstanceGUID string
+		spaceGUID           string
+	}
+	unshareServiceInstanceFromSpaceReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	unshareServiceInstanceFromSpaceReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	UpdateTaskStub        func(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
 	updateTaskMutex       sync.RWMutex
 	updateTaskArgsForCall []struct {
@@ -673,6 +761,57 @@ func (fake *FakeCloudControllerClient) DeleteIsolationSegmentReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeletePackage(guid string) (ccv3.Warnings, error) {
+	fake.deletePackageMutex.Lock()
+	ret, specificReturn := fake.deletePackageReturnsOnCall[len(fake.deletePackageArgsForCall)]
+	fake.deletePackageArgsForCall = append(fake.deletePackageArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("DeletePackage", []interface{}{guid})
+	fake.deletePackageMutex.Unlock()
+	if fake.DeletePackageStub != nil {
+		return fake.DeletePackageStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deletePackageReturns.result1, fake.deletePackageReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageCallCount() int {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return len(fake.deletePackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageArgsForCall(i int) string {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return fake.deletePackageArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturns(result1 ccv3.Warnings, result2 error) {
+	fake.DeletePackageStub = nil
+	fake.deletePackageReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.DeletePackageStub = nil
+	if fake.deletePackageReturnsOnCall == nil {
+		fake.deletePackageReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.deletePackageReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) EntitleIsolationSegmentToOrganizations(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error) {
 	var orgGUIDsCopy []string
 	if orgGUIDs != nil {
@@ -1166,6 +1305,114 @@ func (fake *FakeCloudControllerClient) GetPackageReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetPackages(query url.Values) ([]ccv3.Package, ccv3.Warnings, error) {
+	fake.getPackagesMutex.Lock()
+	ret, specificReturn := fake.getPackagesReturnsOnCall[len(fake.getPackagesArgsForCall)]
+	fake.getPackagesArgsForCall = append(fake.getPackagesArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetPackages", []interface{}{query})
+	fake.getPackagesMutex.Unlock()
+	if fake.GetPackagesStub != nil {
+		return fake.GetPackagesStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getPackagesReturns.result1, fake.getPackagesReturns.result2, fake.getPackagesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetPackagesCallCount() int {
+	fake.getPackagesMutex.RLock()
+	defer fake.getPackagesMutex.RUnlock()
+	return len(fake.getPackagesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetPackagesArgsForCall(i int) url.Values {
+	fake.getPackagesMutex.RLock()
+	defer fake.getPackagesMutex.RUnlock()
+	return fake.getPackagesArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetPackagesReturns(result1 []ccv3.Package, result2 ccv3.Warnings, result3 error) {
+	fake.GetPackagesStub = nil
+	fake.getPackagesReturns = struct {
+		result1 []ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetPackagesReturnsOnCall(i int, result1 []ccv3.Package, result2 ccv3.Warnings, result3 error) {
+	fake.GetPackagesStub = nil
+	if fake.getPackagesReturnsOnCall == nil {
+		fake.getPackagesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Package
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getPackagesReturnsOnCall[i] = struct {
+		result1 []ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedSpaces(serviceInstanceGUID string) (ccv3.RelationshipList, ccv3.Warnings, error) {
+	fake.getServiceInstanceSharedSpacesMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceSharedSpacesReturnsOnCall[len(fake.getServiceInstanceSharedSpacesArgsForCall)]
+	fake.getServiceInstanceSharedSpacesArgsForCall = append(fake.getServiceInstanceSharedSpacesArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("GetServiceInstanceSharedSpaces", []interface{}{serviceInstanceGUID})
+	fake.getServiceInstanceSharedSpacesMutex.Unlock()
+	if fake.GetServiceInstanceSharedSpacesStub != nil {
+		return fake.GetServiceInstanceSharedSpacesStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceSharedSpacesReturns.result1, fake.getServiceInstanceSharedSpacesReturns.result2, fake.getServiceInstanceSharedSpacesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedSpacesCallCount() int {
+	fake.getServiceInstanceSharedSpacesMutex.RLock()
+	defer fake.getServiceInstanceSharedSpacesMutex.RUnlock()
+	return len(fake.getServiceInstanceSharedSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedSpacesArgsForCall(i int) string {
+	fake.getServiceInstanceSharedSpacesMutex.RLock()
+	defer fake.getServiceInstanceSharedSpacesMutex.RUnlock()
+	return fake.getServiceInstanceSharedSpacesArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedSpacesReturns(result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.GetServiceInstanceSharedSpacesStub = nil
+	fake.getServiceInstanceSharedSpacesReturns = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedSpacesReturnsOnCall(i int, result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.GetServiceInstanceSharedSpacesStub = nil
+	if fake.getServiceInstanceSharedSpacesReturnsOnCall == nil {
+		fake.getServiceInstanceSharedSpacesReturnsOnCall = make(map[int]struct {
+			result1 ccv3.RelationshipList
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceSharedSpacesReturnsOnCall[i] = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaceIsolationSegment(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
 	fake.getSpaceIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.getSpaceIsolationSegmentReturnsOnCall[len(fake.getSpaceIsolationSegmentArgsForCall)]
@@ -1220,6 +1467,60 @@ func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetTasks(query url.Values) ([]ccv3.Task, ccv3.Warnings, error) {
+	fake.getTasksMutex.Lock()
+	ret, specificReturn := fake.getTasksReturnsOnCall[len(fake.getTasksArgsForCall)]
+	fake.getTasksArgsForCall = append(fake.getTasksArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetTasks", []interface{}{query})
+	fake.getTasksMutex.Unlock()
+	if fake.GetTasksStub != nil {
+		return fake.GetTasksStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getTasksReturns.result1, fake.getTasksReturns.result2, fake.getTasksReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetTasksCallCount() int {
+	fake.getTasksMutex.RLock()
+	defer fake.getTasksMutex.RUnlock()
+	return len(fake.getTasksArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetTasksArgsForCall(i int) url.Values {
+	fake.getTasksMutex.RLock()
+	defer fake.getTasksMutex.RUnlock()
+	return fake.getTasksArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetTasksReturns(result1 []ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.GetTasksStub = nil
+	fake.getTasksReturns = struct {
+		result1 []ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetTasksReturnsOnCall(i int, result1 []ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.GetTasksStub = nil
+	if fake.getTasksReturnsOnCall == nil {
+		fake.getTasksReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Task
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getTasksReturnsOnCall[i] = struct {
+		result1 []ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) RevokeIsolationSegmentFromOrganization(isolationSegmentGUID string, organizationGUID string) (ccv3.Warnings, error) {
 	fake.revokeIsolationSegmentFromOrganizationMutex.Lock()
 	ret, specificReturn := fake.revokeIsolationSegmentFromOrganizationReturnsOnCall[len(fake.revokeIsolationSegmentFromOrganizationArgsForCall)]
@@ -1272,6 +1573,118 @@ func (fake *FakeCloudControllerClient) RevokeIsolationSegmentFromOrganizationRet
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpaces(serviceInstanceGUID string, spaceGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error) {
+	var spaceGUIDsCopy []string
+	if spaceGUIDs != nil {
+		spaceGUIDsCopy = make([]string, len(spaceGUIDs))
+		copy(spaceGUIDsCopy, spaceGUIDs)
+	}
+	fake.shareServiceInstanceToSpacesMutex.Lock()
+	ret, specificReturn := fake.shareServiceInstanceToSpacesReturnsOnCall[len(fake.shareServiceInstanceToSpacesArgsForCall)]
+	fake.shareServiceInstanceToSpacesArgsForCall = append(fake.shareServiceInstanceToSpacesArgsForCall, struct {
+		serviceInstanceGUID string
+		spaceGUIDs          []string
+	}{serviceInstanceGUID, spaceGUIDsCopy})
+	fake.recordInvocation("ShareServiceInstanceToSpaces", []interface{}{serviceInstanceGUID, spaceGUIDsCopy})
+	fake.shareServiceInstanceToSpacesMutex.Unlock()
+	if fake.ShareServiceInstanceToSpacesStub != nil {
+		return fake.ShareServiceInstanceToSpacesStub(serviceInstanceGUID, spaceGUIDs)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.shareServiceInstanceToSpacesReturns.result1, fake.shareServiceInstanceToSpacesReturns.result2, fake.shareServiceInstanceToSpacesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesCallCount() int {
+	fake.shareServiceInstanceToSpacesMutex.RLock()
+	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
+	return len(fake.shareServiceInstanceToSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesArgsForCall(i int) (string, []string) {
+	fake.shareServiceInstanceToSpacesMutex.RLock()
+	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
+	return fake.shareServiceInstanceToSpacesArgsForCall[i].serviceInstanceGUID, fake.shareServiceInstanceToSpacesArgsForCall[i].spaceGUIDs
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesReturns(result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.ShareServiceInstanceToSpacesStub = nil
+	fake.shareServiceInstanceToSpacesReturns = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesReturnsOnCall(i int, result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.ShareServiceInstanceToSpacesStub = nil
+	if fake.shareServiceInstanceToSpacesReturnsOnCall == nil {
+		fake.shareServiceInstanceToSpacesReturnsOnCall = make(map[int]struct {
+			result1 ccv3.RelationshipList
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.shareServiceInstanceToSpacesReturnsOnCall[i] = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UnshareServiceInstanceFromSpace(serviceInstanceGUID string, spaceGUID string) (ccv3.Warnings, error) {
+	fake.unshareServiceInstanceFromSpaceMutex.Lock()
+	ret, specificReturn := fake.unshareServiceInstanceFromSpaceReturnsOnCall[len(fake.unshareServiceInstanceFromSpaceArgsForCall)]
+	fake.unshareServiceInstanceFromSpaceArgsForCall = append(fake.unshareServiceInstanceFromSpaceArgsForCall, struct {
+		serviceInstanceGUID string
+		spaceGUID           string
+	}{serviceInstanceGUID, spaceGUID})
+	fake.recordInvocation("UnshareServiceInstanceFromSpace", []interface{}{serviceInstanceGUID, spaceGUID})
+	fake.unshareServiceInstanceFromSpaceMutex.Unlock()
+	if fake.UnshareServiceInstanceFromSpaceStub != nil {
+		return fake.UnshareServiceInstanceFromSpaceStub(serviceInstanceGUID, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.unshareServiceInstanceFromSpaceReturns.result1, fake.unshareServiceInstanceFromSpaceReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UnshareServiceInstanceFromSpaceCallCount() int {
+	fake.unshareServiceInstanceFromSpaceMutex.RLock()
+	defer fake.unshareServiceInstanceFromSpaceMutex.RUnlock()
+	return len(fake.unshareServiceInstanceFromSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UnshareServiceInstanceFromSpaceArgsForCall(i int) (string, string) {
+	fake.unshareServiceInstanceFromSpaceMutex.RLock()
+	defer fake.unshareServiceInstanceFromSpaceMutex.RUnlock()
+	return fake.unshareServiceInstanceFromSpaceArgsForCall[i].serviceInstanceGUID, fake.unshareServiceInstanceFromSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) UnshareServiceInstanceFromSpaceReturns(result1 ccv3.Warnings, result2 error) {
+	fake.UnshareServiceInstanceFromSpaceStub = nil
+	fake.unshareServiceInstanceFromSpaceReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UnshareServiceInstanceFromSpaceReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.UnshareServiceInstanceFromSpaceStub = nil
+	if fake.unshareServiceInstanceFromSpaceReturnsOnCall == nil {
+		fake.unshareServiceInstanceFromSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.unshareServiceInstanceFromSpaceReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error) {
 	fake.updateTaskMutex.Lock()
 	ret, specificReturn := fake.updateTaskReturnsOnCall[len(fake.updateTaskArgsForCall)]
@@ -1398,6 +1811,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.createPackageMutex.RUnlock()
 	fake.deleteIsolationSegmentMutex.RLock()
 	defer fake.deleteIsolationSegmentMutex.RUnlock()
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationsMutex.RLock()
 	defer fake.entitleIsolationSegmentToOrganizationsMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
@@ -1416,10 +1831,20 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getPackageMutex.RLock()
 	defer fake.getPackageMutex.RUnlock()
+	fake.getPackagesMutex.RLock()
+	defer fake.getPackagesMutex.RUnlock()
+	fake.getServiceInstanceSharedSpacesMutex.RLock()
+	defer fake.getServiceInstanceSharedSpacesMutex.RUnlock()
 	fake.getSpaceIsolationSegmentMutex.RLock()
 	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
+	fake.getTasksMutex.RLock()
+	defer fake.getTasksMutex.RUnlock()
 	fake.revokeIsolationSegmentFromOrganizationMutex.RLock()
 	defer fake.revokeIsolationSegmentFromOrganizationMutex.RUnlock()
+	fake.shareServiceInstanceToSpacesMutex.RLock()
+	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
+	fake.unshareServiceInstanceFromSpaceMutex.RLock()
+	defer fake.unshareServiceInstanceFromSpaceMutex.RUnlock()
 	fake.updateTaskMutex.RLock()
 	defer fake.updateTaskMutex.RUnlock()
 	fake.uploadPackageMutex.RLock()
@@ -0,0 +1,11 @@
+package ccerror
+
+// ServiceBindingTakenError is returned when an application is already bound
+// to the service instance.
+type ServiceBindingTakenError struct {
+	Message string
+}
+
+func (e ServiceBindingTakenError) Error() string {
+	return e.Message
+}
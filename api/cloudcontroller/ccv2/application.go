@@ -3,6 +3,7 @@ package ccv2
 import (
 	"bytes"
 	"encoding/json"
+	"net/url"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -36,6 +37,10 @@ type Application struct {
 	// DetectedBuildpack is the buildpack automatically detected.
 	DetectedBuildpack string `json:"-"`
 
+	// DetectedBuildpackGUID is the GUID of the admin buildpack the application
+	// was staged with.
+	DetectedBuildpackGUID string `json:"-"`
+
 	// DetectedStartCommand is the command used to start the application.
 	DetectedStartCommand string `json:"-"`
 
@@ -51,8 +56,9 @@ type Application struct {
 	// HealthCheckHTTPEndpoint is the url of the http health check endpoint.
 	HealthCheckHTTPEndpoint string `json:"health_check_http_endpoint,omitempty"`
 
-	// Instances is the total number of app instances.
-	Instances int `json:"-"`
+	// Instances is the total number of app instances. It is not sent when 0,
+	// so an application cannot be scaled to zero instances.
+	Instances int `json:"instances,omitempty"`
 
 	// Memory is the memory given to each instance, in megabytes.
 	Memory int `json:"-"`
@@ -70,7 +76,7 @@ type Application struct {
 	SpaceGUID string `json:"space_guid,omitempty"`
 
 	// StackGUID is the GUID for the Stack the application is running on.
-	StackGUID string `json:"-"`
+	StackGUID string `json:"stack_guid,omitempty"`
 
 	// StagingFailedDescription is the verbose description of why the package
 	// failed to stage.
@@ -81,6 +87,10 @@ type Application struct {
 
 	// State is the desired state of the application.
 	State ApplicationState `json:"state,omitempty"`
+
+	// UpdatedAt is the last time the application was updated. When the
+	// application has never been updated, it is the time it was created.
+	UpdatedAt time.Time `json:"-"`
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Application response.
@@ -90,6 +100,7 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 		Entity   struct {
 			Buildpack                string     `json:"buildpack"`
 			DetectedBuildpack        string     `json:"detected_buildpack"`
+			DetectedBuildpackGUID    string     `json:"detected_buildpack_guid"`
 			DetectedStartCommand     string     `json:"detected_start_command"`
 			DiskQuota                int        `json:"disk_quota"`
 			HealthCheckType          string     `json:"health_check_type"`
@@ -112,6 +123,7 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	application.GUID = ccApp.Metadata.GUID
 	application.Buildpack = ccApp.Entity.Buildpack
 	application.DetectedBuildpack = ccApp.Entity.DetectedBuildpack
+	application.DetectedBuildpackGUID = ccApp.Entity.DetectedBuildpackGUID
 	application.DetectedStartCommand = ccApp.Entity.DetectedStartCommand
 	application.DiskQuota = ccApp.Entity.DiskQuota
 	application.HealthCheckType = ccApp.Entity.HealthCheckType
@@ -128,6 +140,11 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	if ccApp.Entity.PackageUpdatedAt != nil {
 		application.PackageUpdatedAt = *ccApp.Entity.PackageUpdatedAt
 	}
+
+	application.UpdatedAt = ccApp.Metadata.CreatedAt
+	if ccApp.Metadata.UpdatedAt != nil {
+		application.UpdatedAt = *ccApp.Metadata.UpdatedAt
+	}
 	return nil
 }
 
@@ -156,6 +173,23 @@ func (client *Client) CreateApplication(app Application) (Application, Warnings,
 	return updatedApp, response.Warnings, err
 }
 
+// DeleteApplication deletes the application with the given GUID, along with
+// its service bindings and route mappings.
+func (client *Client) DeleteApplication(guid string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteAppRequest,
+		URIParams:   Params{"app_guid": guid},
+		Query:       url.Values{"recursive": {"true"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // GetApplication returns back an Application.
 func (client *Client) GetApplication(guid string) (Application, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -149,6 +149,83 @@ var _ = Describe("Application", func() {
 			})
 		})
 	})
+	Describe("Application UpdatedAt", func() {
+		var app Application
+
+		Context("when the app has been updated", func() {
+			BeforeEach(func() {
+				err := app.UnmarshalJSON([]byte(`{
+					"metadata": {
+						"guid": "app-guid-1",
+						"created_at": "2016-06-08T16:41:22Z",
+						"updated_at": "2017-01-02T03:04:05Z"
+					}
+				}`))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("uses the updated_at time", func() {
+				Expect(app.UpdatedAt).To(Equal(time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC)))
+			})
+		})
+
+		Context("when the app has never been updated", func() {
+			BeforeEach(func() {
+				err := app.UnmarshalJSON([]byte(`{
+					"metadata": {
+						"guid": "app-guid-1",
+						"created_at": "2016-06-08T16:41:22Z",
+						"updated_at": null
+					}
+				}`))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("uses the created_at time", func() {
+				Expect(app.UpdatedAt).To(Equal(time.Date(2016, 6, 8, 16, 41, 22, 0, time.UTC)))
+			})
+		})
+	})
+
+	Describe("DeleteApplication", func() {
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/app-guid-1", "recursive=true"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the app recursively and returns all warnings", func() {
+				warnings, err := client.DeleteApplication("app-guid-1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100004,
+					"description": "The app could not be found: app-guid-1",
+					"error_code": "CF-AppNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/app-guid-1", "recursive=true"),
+						RespondWith(http.StatusNotFound, response),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError", func() {
+				_, err := client.DeleteApplication("app-guid-1")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The app could not be found: app-guid-1"}))
+			})
+		})
+	})
 
 	Describe("GetApplications", func() {
 		BeforeEach(func() {
@@ -185,6 +262,7 @@ var _ = Describe("Application", func() {
 						"entity": {
 							"name": "app-name-2",
 							"detected_buildpack": "ruby 1.6.29",
+							"detected_buildpack_guid": "ruby-buildpack-guid",
 							"package_updated_at": null
 						}
 					}
@@ -257,7 +335,7 @@ var _ = Describe("Application", func() {
 						StagingFailedReason:     "some-reason",
 						State:                   ApplicationStopped,
 					},
-					{Name: "app-name-2", GUID: "app-guid-2", DetectedBuildpack: "ruby 1.6.29"},
+					{Name: "app-name-2", GUID: "app-guid-2", DetectedBuildpack: "ruby 1.6.29", DetectedBuildpackGUID: "ruby-buildpack-guid"},
 					{Name: "app-name-3", GUID: "app-guid-3"},
 					{Name: "app-name-4", GUID: "app-guid-4"},
 				}))
@@ -293,6 +371,7 @@ var _ = Describe("Application", func() {
 					expectedBody := map[string]string{
 						"health_check_http_endpoint": "/anything",
 						"health_check_type":          "some-health-check-type",
+						"stack_guid":                 "some-stack-guid",
 						"state":                      "STARTED",
 					}
 
@@ -310,6 +389,7 @@ var _ = Describe("Application", func() {
 						GUID:                    "some-app-guid",
 						HealthCheckType:         "some-health-check-type",
 						HealthCheckHTTPEndpoint: "/anything",
+						StackGUID:               "some-stack-guid",
 						State: ApplicationStarted,
 					})
 					Expect(err).NotTo(HaveOccurred())
@@ -395,6 +475,28 @@ var _ = Describe("Application", func() {
 					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 				})
 			})
+
+			Context("when scaling the application", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+							VerifyBody([]byte(`{"instances":3}`)),
+							RespondWith(http.StatusCreated, `{"metadata": {"guid": "some-app-guid"}, "entity": {"instances": 3}}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("sends the number of instances", func() {
+					app, warnings, err := client.UpdateApplication(Application{
+						GUID:      "some-app-guid",
+						Instances: 3,
+					})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(app.Instances).To(Equal(3))
+					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				})
+			})
 		})
 
 		Context("when the update returns an error", func() {
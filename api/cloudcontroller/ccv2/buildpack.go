@@ -0,0 +1,58 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Buildpack represents a Cloud Controller admin buildpack.
+type Buildpack struct {
+	GUID string
+	Name string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Buildpack response.
+func (buildpack *Buildpack) UnmarshalJSON(data []byte) error {
+	var ccBuildpack struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Name string `json:"name"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccBuildpack); err != nil {
+		return err
+	}
+
+	buildpack.GUID = ccBuildpack.Metadata.GUID
+	buildpack.Name = ccBuildpack.Entity.Name
+	return nil
+}
+
+// GetBuildpacks returns a list of admin Buildpacks based off of the provided
+// queries.
+func (client *Client) GetBuildpacks(queries []Query) ([]Buildpack, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetBuildpacksRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullBuildpacksList []Buildpack
+	warnings, err := client.paginate(request, Buildpack{}, func(item interface{}) error {
+		if buildpack, ok := item.(Buildpack); ok {
+			fullBuildpacksList = append(fullBuildpacksList, buildpack)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Buildpack{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullBuildpacksList, warnings, err
+}
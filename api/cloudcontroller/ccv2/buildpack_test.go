@@ -0,0 +1,101 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Buildpack", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetBuildpacks", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/buildpacks?page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "buildpack-guid-1"
+							},
+							"entity": {
+								"name": "ruby_buildpack"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "buildpack-guid-2"
+							},
+							"entity": {
+								"name": "java_buildpack"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns paginated results and all warnings", func() {
+				buildpacks, warnings, err := client.GetBuildpacks(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(buildpacks).To(Equal([]Buildpack{
+					{GUID: "buildpack-guid-1", Name: "ruby_buildpack"},
+					{GUID: "buildpack-guid-2", Name: "java_buildpack"},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"warning-1", "warning-2"}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 1,
+					"description": "some error description",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetBuildpacks(nil)
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        1,
+						Description: "some error description",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
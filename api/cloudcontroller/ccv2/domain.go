@@ -8,10 +8,21 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
+// RouterGroupType is the type of router group a domain's routes are served
+// by.
+type RouterGroupType string
+
+const (
+	// TCPRouterGroup is the router group type of TCP domains.
+	TCPRouterGroup RouterGroupType = "tcp"
+)
+
 // Domain represents a Cloud Controller Domain.
 type Domain struct {
-	GUID string
-	Name string
+	GUID            string
+	Name            string
+	RouterGroupGUID string
+	RouterGroupType RouterGroupType
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Domain response.
@@ -19,7 +30,9 @@ func (domain *Domain) UnmarshalJSON(data []byte) error {
 	var ccDomain struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name            string `json:"name"`
+			RouterGroupGUID string `json:"router_group_guid"`
+			RouterGroupType string `json:"router_group_type"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccDomain); err != nil {
@@ -28,6 +41,8 @@ func (domain *Domain) UnmarshalJSON(data []byte) error {
 
 	domain.GUID = ccDomain.Metadata.GUID
 	domain.Name = ccDomain.Entity.Name
+	domain.RouterGroupGUID = ccDomain.Entity.RouterGroupGUID
+	domain.RouterGroupType = RouterGroupType(ccDomain.Entity.RouterGroupType)
 	return nil
 }
 
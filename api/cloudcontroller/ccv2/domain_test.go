@@ -142,7 +142,9 @@ var _ = Describe("Domain", func() {
 							"guid": "domain-guid-2"
 						},
 						"entity": {
-							"name": "domain-name-2"
+							"name": "domain-name-2",
+							"router_group_guid": "some-router-group-guid",
+							"router_group_type": "tcp"
 						}
 					}
 				]
@@ -191,8 +193,10 @@ var _ = Describe("Domain", func() {
 						Name: "domain-name-1",
 					},
 					{
-						GUID: "domain-guid-2",
-						Name: "domain-name-2",
+						GUID:            "domain-guid-2",
+						Name:            "domain-name-2",
+						RouterGroupGUID: "some-router-group-guid",
+						RouterGroupType: TCPRouterGroup,
 					},
 					{
 						GUID: "domain-guid-3",
@@ -78,6 +78,8 @@ func handleBadRequest(errorResponse ccerror.V2ErrorResponse) error {
 		return ccerror.InvalidRelationError{Message: errorResponse.Description}
 	case "CF-NotStaged":
 		return ccerror.NotStagedError{Message: errorResponse.Description}
+	case "CF-ServiceBindingAppServiceTaken":
+		return ccerror.ServiceBindingTakenError{Message: errorResponse.Description}
 	default:
 		return ccerror.BadRequestError{Message: errorResponse.Description}
 	}
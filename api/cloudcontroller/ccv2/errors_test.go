@@ -135,6 +135,23 @@ var _ = Describe("Error Wrapper", func() {
 					})
 				})
 
+				Context("when the app is already bound to the service instance", func() {
+					BeforeEach(func() {
+						response = `{
+							"code": 90003,
+							"description": "The app is already bound to the service.",
+							"error_code": "CF-ServiceBindingAppServiceTaken"
+						}`
+					})
+
+					It("returns a ServiceBindingTakenError", func() {
+						_, _, err := client.GetApplications(nil)
+						Expect(err).To(MatchError(ccerror.ServiceBindingTakenError{
+							Message: "The app is already bound to the service.",
+						}))
+					})
+				})
+
 				Context("getting stats for a stopped app", func() {
 					BeforeEach(func() {
 						response = `{
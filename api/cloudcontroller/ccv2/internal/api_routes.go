@@ -15,44 +15,87 @@ import (
 //
 // The const name should always be the const value + Request.
 const (
-	DeleteSecurityGroupSpaceRequest       = "DeleteSecurityGroupSpace"
-	DeleteOrganizationRequest             = "DeleteOrganization"
-	DeleteRouteRequest                    = "DeleteRoute"
-	DeleteServiceBindingRequest           = "DeleteServiceBinding"
-	GetAppInstancesRequest                = "GetAppInstances"
-	GetAppRequest                         = "GetApp"
-	GetAppRoutesRequest                   = "GetAppRoutes"
-	GetAppsRequest                        = "GetApps"
-	GetAppStatsRequest                    = "GetAppStats"
-	GetInfoRequest                        = "GetInfo"
-	GetJobRequest                         = "GetJob"
-	GetOrganizationPrivateDomainsRequest  = "GetOrganizationPrivateDomains"
-	GetOrganizationQuotaDefinitionRequest = "GetOrganizationQuotaDefinition"
-	GetOrganizationRequest                = "GetOrganization"
-	GetOrganizationsRequest               = "GetOrganizations"
-	GetPrivateDomainRequest               = "GetPrivateDomain"
-	GetRouteAppsRequest                   = "GetRouteApps"
-	GetRouteReservedRequest               = "GetRouteReserved"
-	GetRouteRouteMappingsRequest          = "GetRouteRouteMappings"
-	GetRoutesRequest                      = "GetRoutes"
-	GetSecurityGroupsRequest              = "GetSecurityGroups"
-	GetServiceBindingsRequest             = "GetServiceBindings"
-	GetServiceInstancesRequest            = "GetServiceInstances"
-	GetSharedDomainRequest                = "GetSharedDomain"
-	GetSharedDomainsRequest               = "GetSharedDomains"
-	GetSpaceQuotaDefinitionRequest        = "GetSpaceQuotaDefinition"
-	GetSpaceRoutesRequest                 = "GetSpaceRoutes"
-	GetSpaceRunningSecurityGroupsRequest  = "GetSpaceRunningSecurityGroups"
-	GetSpaceServiceInstancesRequest       = "GetSpaceServiceInstances"
-	GetSpacesRequest                      = "GetSpaces"
-	GetSpaceStagingSecurityGroupsRequest  = "GetSpaceStagingSecurityGroups"
-	GetStackRequest                       = "GetStack"
-	GetUsersRequest                       = "GetUsers"
-	PostAppRequest                        = "PostApp"
-	PostRouteRequest                      = "PostRoute"
-	PutAppRequest                         = "PutApp"
-	PutBindRouteAppRequest                = "PutBindRouteApp"
-	PutSecurityGroupSpaceRequest          = "PutSecurityGroupSpace"
+	DeleteSecurityGroupSpaceRequest         = "DeleteSecurityGroupSpace"
+	DeleteAppRequest                        = "DeleteApp"
+	DeleteOrganizationRequest               = "DeleteOrganization"
+	DeleteOrganizationAuditorRequest        = "DeleteOrganizationAuditor"
+	DeleteOrganizationBillingManagerRequest = "DeleteOrganizationBillingManager"
+	DeleteOrganizationManagerRequest        = "DeleteOrganizationManager"
+	DeleteRouteAppRequest                   = "DeleteRouteApp"
+	DeleteRouteRequest                      = "DeleteRoute"
+	DeleteServiceBindingRequest             = "DeleteServiceBinding"
+	DeleteServiceInstanceRequest            = "DeleteServiceInstance"
+	DeleteServiceKeyRequest                 = "DeleteServiceKey"
+	DeleteSpaceAuditorRequest               = "DeleteSpaceAuditor"
+	DeleteSpaceDeveloperRequest             = "DeleteSpaceDeveloper"
+	DeleteSpaceManagerRequest               = "DeleteSpaceManager"
+	GetAppInstancesRequest                  = "GetAppInstances"
+	GetAppRequest                           = "GetApp"
+	GetAppRoutesRequest                     = "GetAppRoutes"
+	GetAppsRequest                          = "GetApps"
+	GetAppStatsRequest                      = "GetAppStats"
+	GetBuildpacksRequest                    = "GetBuildpacks"
+	GetConfigRunningSecurityGroupsRequest   = "GetConfigRunningSecurityGroups"
+	GetConfigStagingSecurityGroupsRequest   = "GetConfigStagingSecurityGroups"
+	GetInfoRequest                          = "GetInfo"
+	GetJobRequest                           = "GetJob"
+	GetOrganizationAuditorsRequest          = "GetOrganizationAuditors"
+	GetOrganizationBillingManagersRequest   = "GetOrganizationBillingManagers"
+	GetOrganizationManagersRequest          = "GetOrganizationManagers"
+	GetOrganizationPrivateDomainsRequest    = "GetOrganizationPrivateDomains"
+	GetOrganizationQuotaDefinitionRequest   = "GetOrganizationQuotaDefinition"
+	GetOrganizationQuotaDefinitionsRequest  = "GetOrganizationQuotaDefinitions"
+	GetOrganizationRequest                  = "GetOrganization"
+	GetOrganizationsRequest                 = "GetOrganizations"
+	GetOrganizationUsersRequest             = "GetOrganizationUsers"
+	GetPrivateDomainRequest                 = "GetPrivateDomain"
+	GetRouteAppsRequest                     = "GetRouteApps"
+	GetRouteReservedRequest                 = "GetRouteReserved"
+	GetRouteRouteMappingsRequest            = "GetRouteRouteMappings"
+	GetRoutesRequest                        = "GetRoutes"
+	GetSecurityGroupsRequest                = "GetSecurityGroups"
+	GetServiceBindingParametersRequest      = "GetServiceBindingParameters"
+	GetServiceBindingsRequest               = "GetServiceBindings"
+	GetServiceInstancesRequest              = "GetServiceInstances"
+	GetServiceKeysRequest                   = "GetServiceKeys"
+	GetServicePlanRequest                   = "GetServicePlan"
+	GetSharedDomainRequest                  = "GetSharedDomain"
+	GetSharedDomainsRequest                 = "GetSharedDomains"
+	GetSpaceAuditorsRequest                 = "GetSpaceAuditors"
+	GetSpaceDevelopersRequest               = "GetSpaceDevelopers"
+	GetSpaceManagersRequest                 = "GetSpaceManagers"
+	GetSpaceQuotaDefinitionRequest          = "GetSpaceQuotaDefinition"
+	GetSpaceRoutesRequest                   = "GetSpaceRoutes"
+	GetSpaceRunningSecurityGroupsRequest    = "GetSpaceRunningSecurityGroups"
+	GetSpaceServiceInstancesRequest         = "GetSpaceServiceInstances"
+	GetSpacesRequest                        = "GetSpaces"
+	GetSpaceStagingSecurityGroupsRequest    = "GetSpaceStagingSecurityGroups"
+	GetStackRequest                         = "GetStack"
+	GetStacksRequest                        = "GetStacks"
+	GetUsersRequest                         = "GetUsers"
+	PostAppRequest                          = "PostApp"
+	PostOrganizationRequest                 = "PostOrganization"
+	PostRouteRequest                        = "PostRoute"
+	PostServiceBindingRequest               = "PostServiceBinding"
+	PostSpaceRequest                        = "PostSpace"
+	PutAppRequest                           = "PutApp"
+	PutBindRouteAppRequest                  = "PutBindRouteApp"
+	PutOrganizationAuditorRequest           = "PutOrganizationAuditor"
+	PutOrganizationAuditorsRequest          = "PutOrganizationAuditors"
+	PutOrganizationBillingManagerRequest    = "PutOrganizationBillingManager"
+	PutOrganizationBillingManagersRequest   = "PutOrganizationBillingManagers"
+	PutOrganizationManagerRequest           = "PutOrganizationManager"
+	PutOrganizationManagersRequest          = "PutOrganizationManagers"
+	PutOrganizationRequest                  = "PutOrganization"
+	PutOrganizationUserRequest              = "PutOrganizationUser"
+	PutOrganizationUsersRequest             = "PutOrganizationUsers"
+	PutSecurityGroupSpaceRequest            = "PutSecurityGroupSpace"
+	PutSpaceAuditorRequest                  = "PutSpaceAuditor"
+	PutSpaceAuditorsRequest                 = "PutSpaceAuditors"
+	PutSpaceDeveloperRequest                = "PutSpaceDeveloper"
+	PutSpaceDevelopersRequest               = "PutSpaceDevelopers"
+	PutSpaceManagerRequest                  = "PutSpaceManager"
+	PutSpaceManagersRequest                 = "PutSpaceManagers"
 )
 
 // APIRoutes is a list of routes used by the rata library to construct request
@@ -60,40 +103,83 @@ const (
 var APIRoutes = rata.Routes{
 	{Path: "/v2/apps", Method: http.MethodGet, Name: GetAppsRequest},
 	{Path: "/v2/apps", Method: http.MethodPost, Name: PostAppRequest},
+	{Path: "/v2/apps/:app_guid", Method: http.MethodDelete, Name: DeleteAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodGet, Name: GetAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodPut, Name: PutAppRequest},
 	{Path: "/v2/apps/:app_guid/instances", Method: http.MethodGet, Name: GetAppInstancesRequest},
 	{Path: "/v2/apps/:app_guid/routes", Method: http.MethodGet, Name: GetAppRoutesRequest},
 	{Path: "/v2/apps/:app_guid/stats", Method: http.MethodGet, Name: GetAppStatsRequest},
+	{Path: "/v2/buildpacks", Method: http.MethodGet, Name: GetBuildpacksRequest},
+	{Path: "/v2/config/running_security_groups", Method: http.MethodGet, Name: GetConfigRunningSecurityGroupsRequest},
+	{Path: "/v2/config/staging_security_groups", Method: http.MethodGet, Name: GetConfigStagingSecurityGroupsRequest},
 	{Path: "/v2/info", Method: http.MethodGet, Name: GetInfoRequest},
 	{Path: "/v2/jobs/:job_guid", Method: http.MethodGet, Name: GetJobRequest},
 	{Path: "/v2/organizations", Method: http.MethodGet, Name: GetOrganizationsRequest},
+	{Path: "/v2/organizations", Method: http.MethodPost, Name: PostOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodGet, Name: GetOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid", Method: http.MethodPut, Name: PutOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid/auditors", Method: http.MethodGet, Name: GetOrganizationAuditorsRequest},
+	{Path: "/v2/organizations/:organization_guid/auditors", Method: http.MethodPut, Name: PutOrganizationAuditorsRequest},
+	{Path: "/v2/organizations/:organization_guid/auditors/:user_guid", Method: http.MethodDelete, Name: DeleteOrganizationAuditorRequest},
+	{Path: "/v2/organizations/:organization_guid/auditors/:user_guid", Method: http.MethodPut, Name: PutOrganizationAuditorRequest},
+	{Path: "/v2/organizations/:organization_guid/billing_managers", Method: http.MethodGet, Name: GetOrganizationBillingManagersRequest},
+	{Path: "/v2/organizations/:organization_guid/billing_managers", Method: http.MethodPut, Name: PutOrganizationBillingManagersRequest},
+	{Path: "/v2/organizations/:organization_guid/billing_managers/:user_guid", Method: http.MethodDelete, Name: DeleteOrganizationBillingManagerRequest},
+	{Path: "/v2/organizations/:organization_guid/billing_managers/:user_guid", Method: http.MethodPut, Name: PutOrganizationBillingManagerRequest},
+	{Path: "/v2/organizations/:organization_guid/managers", Method: http.MethodGet, Name: GetOrganizationManagersRequest},
+	{Path: "/v2/organizations/:organization_guid/managers", Method: http.MethodPut, Name: PutOrganizationManagersRequest},
+	{Path: "/v2/organizations/:organization_guid/managers/:user_guid", Method: http.MethodDelete, Name: DeleteOrganizationManagerRequest},
+	{Path: "/v2/organizations/:organization_guid/managers/:user_guid", Method: http.MethodPut, Name: PutOrganizationManagerRequest},
 	{Path: "/v2/organizations/:organization_guid/private_domains", Method: http.MethodGet, Name: GetOrganizationPrivateDomainsRequest},
+	{Path: "/v2/organizations/:organization_guid/users", Method: http.MethodGet, Name: GetOrganizationUsersRequest},
+	{Path: "/v2/organizations/:organization_guid/users", Method: http.MethodPut, Name: PutOrganizationUsersRequest},
+	{Path: "/v2/organizations/:organization_guid/users/:user_guid", Method: http.MethodPut, Name: PutOrganizationUserRequest},
 	{Path: "/v2/private_domains/:private_domain_guid", Method: http.MethodGet, Name: GetPrivateDomainRequest},
+	{Path: "/v2/quota_definitions", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionsRequest},
 	{Path: "/v2/quota_definitions/:organization_quota_guid", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionRequest},
 	{Path: "/v2/routes", Method: http.MethodGet, Name: GetRoutesRequest},
 	{Path: "/v2/routes", Method: http.MethodPost, Name: PostRouteRequest},
 	{Path: "/v2/routes/:route_guid", Method: http.MethodDelete, Name: DeleteRouteRequest},
 	{Path: "/v2/routes/:route_guid/apps", Method: http.MethodGet, Name: GetRouteAppsRequest},
 	{Path: "/v2/routes/:route_guid/apps/:app_guid", Method: http.MethodPut, Name: PutBindRouteAppRequest},
+	{Path: "/v2/routes/:route_guid/apps/:app_guid", Method: http.MethodDelete, Name: DeleteRouteAppRequest},
 	{Path: "/v2/routes/:route_guid/route_mappings", Method: http.MethodGet, Name: GetRouteRouteMappingsRequest},
 	{Path: "/v2/routes/reserved/domain/:domain_guid", Method: http.MethodGet, Name: GetRouteReservedRequest},
 	{Path: "/v2/security_groups", Method: http.MethodGet, Name: GetSecurityGroupsRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces/:space_guid", Method: http.MethodPut, Name: PutSecurityGroupSpaceRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteSecurityGroupSpaceRequest},
 	{Path: "/v2/service_bindings", Method: http.MethodGet, Name: GetServiceBindingsRequest},
+	{Path: "/v2/service_bindings", Method: http.MethodPost, Name: PostServiceBindingRequest},
 	{Path: "/v2/service_bindings/:service_binding_guid", Method: http.MethodDelete, Name: DeleteServiceBindingRequest},
+	{Path: "/v2/service_bindings/:service_binding_guid/parameters", Method: http.MethodGet, Name: GetServiceBindingParametersRequest},
 	{Path: "/v2/service_instances", Method: http.MethodGet, Name: GetServiceInstancesRequest},
+	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodDelete, Name: DeleteServiceInstanceRequest},
+	{Path: "/v2/service_keys", Method: http.MethodGet, Name: GetServiceKeysRequest},
+	{Path: "/v2/service_keys/:service_key_guid", Method: http.MethodDelete, Name: DeleteServiceKeyRequest},
+	{Path: "/v2/service_plans/:service_plan_guid", Method: http.MethodGet, Name: GetServicePlanRequest},
 	{Path: "/v2/shared_domains", Method: http.MethodGet, Name: GetSharedDomainsRequest},
 	{Path: "/v2/shared_domains/:shared_domain_guid", Method: http.MethodGet, Name: GetSharedDomainRequest},
 	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodGet, Name: GetSpaceQuotaDefinitionRequest},
 	{Path: "/v2/spaces", Method: http.MethodGet, Name: GetSpacesRequest},
+	{Path: "/v2/spaces", Method: http.MethodPost, Name: PostSpaceRequest},
 	{Path: "/v2/spaces/:guid/service_instances", Method: http.MethodGet, Name: GetSpaceServiceInstancesRequest},
+	{Path: "/v2/spaces/:space_guid/auditors", Method: http.MethodGet, Name: GetSpaceAuditorsRequest},
+	{Path: "/v2/spaces/:space_guid/auditors", Method: http.MethodPut, Name: PutSpaceAuditorsRequest},
+	{Path: "/v2/spaces/:space_guid/auditors/:user_guid", Method: http.MethodDelete, Name: DeleteSpaceAuditorRequest},
+	{Path: "/v2/spaces/:space_guid/auditors/:user_guid", Method: http.MethodPut, Name: PutSpaceAuditorRequest},
+	{Path: "/v2/spaces/:space_guid/developers", Method: http.MethodGet, Name: GetSpaceDevelopersRequest},
+	{Path: "/v2/spaces/:space_guid/developers", Method: http.MethodPut, Name: PutSpaceDevelopersRequest},
+	{Path: "/v2/spaces/:space_guid/developers/:user_guid", Method: http.MethodDelete, Name: DeleteSpaceDeveloperRequest},
+	{Path: "/v2/spaces/:space_guid/developers/:user_guid", Method: http.MethodPut, Name: PutSpaceDeveloperRequest},
+	{Path: "/v2/spaces/:space_guid/managers", Method: http.MethodGet, Name: GetSpaceManagersRequest},
+	{Path: "/v2/spaces/:space_guid/managers", Method: http.MethodPut, Name: PutSpaceManagersRequest},
+	{Path: "/v2/spaces/:space_guid/managers/:user_guid", Method: http.MethodDelete, Name: DeleteSpaceManagerRequest},
+	{Path: "/v2/spaces/:space_guid/managers/:user_guid", Method: http.MethodPut, Name: PutSpaceManagerRequest},
 	{Path: "/v2/spaces/:space_guid/routes", Method: http.MethodGet, Name: GetSpaceRoutesRequest},
 	{Path: "/v2/spaces/:space_guid/security_groups", Method: http.MethodGet, Name: GetSpaceRunningSecurityGroupsRequest},
 	{Path: "/v2/spaces/:space_guid/staging_security_groups", Method: http.MethodGet, Name: GetSpaceStagingSecurityGroupsRequest},
+	{Path: "/v2/stacks", Method: http.MethodGet, Name: GetStacksRequest},
 	{Path: "/v2/stacks/:stack_guid", Method: http.MethodGet, Name: GetStackRequest},
 	{Path: "/v2/users", Method: http.MethodPost, Name: GetUsersRequest},
 }
@@ -1,6 +1,7 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -83,3 +84,61 @@ func (client *Client) GetOrganizations(queries []Query) ([]Organization, Warning
 
 	return fullOrgsList, warnings, err
 }
+
+// CreateOrganization creates an Organization with the provided name.
+func (client *Client) CreateOrganization(orgName string) (Organization, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name string `json:"name"`
+	}{
+		Name: orgName,
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostOrganizationRequest,
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var org Organization
+	response := cloudcontroller.Response{
+		Result: &org,
+	}
+
+	err = client.connection.Make(request, &response)
+	return org, response.Warnings, err
+}
+
+// UpdateOrganizationQuota sets the quota definition of the Organization
+// associated with the provided GUID.
+func (client *Client) UpdateOrganizationQuota(orgGUID string, quotaGUID string) (Organization, Warnings, error) {
+	body, err := json.Marshal(struct {
+		QuotaDefinitionGUID string `json:"quota_definition_guid"`
+	}{
+		QuotaDefinitionGUID: quotaGUID,
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutOrganizationRequest,
+		URIParams:   Params{"organization_guid": orgGUID},
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var org Organization
+	response := cloudcontroller.Response{
+		Result: &org,
+	}
+
+	err = client.connection.Make(request, &response)
+	return org, response.Warnings, err
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
@@ -11,6 +12,26 @@ import (
 type OrganizationQuota struct {
 	GUID string
 	Name string
+
+	// MemoryLimit is the total memory, in megabytes, available to running
+	// application instances and tasks.
+	MemoryLimit int
+
+	// AppInstanceLimit is the total number of application instances allowed.
+	// -1 represents an unlimited amount.
+	AppInstanceLimit int
+
+	// AppTaskLimit is the number of tasks that may run at the same time. -1
+	// represents an unlimited amount.
+	AppTaskLimit int
+
+	// TotalRoutes is the total number of routes allowed. -1 represents an
+	// unlimited amount.
+	TotalRoutes int
+
+	// TotalServices is the total number of service instances allowed. -1
+	// represents an unlimited amount.
+	TotalServices int
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller organization quota response.
@@ -18,7 +39,12 @@ func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
 	var ccOrgQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name             string `json:"name"`
+			MemoryLimit      int    `json:"memory_limit"`
+			AppInstanceLimit int    `json:"app_instance_limit"`
+			AppTaskLimit     int    `json:"app_task_limit"`
+			TotalRoutes      int    `json:"total_routes"`
+			TotalServices    int    `json:"total_services"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccOrgQuota); err != nil {
@@ -27,6 +53,11 @@ func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
 
 	application.GUID = ccOrgQuota.Metadata.GUID
 	application.Name = ccOrgQuota.Entity.Name
+	application.MemoryLimit = ccOrgQuota.Entity.MemoryLimit
+	application.AppInstanceLimit = ccOrgQuota.Entity.AppInstanceLimit
+	application.AppTaskLimit = ccOrgQuota.Entity.AppTaskLimit
+	application.TotalRoutes = ccOrgQuota.Entity.TotalRoutes
+	application.TotalServices = ccOrgQuota.Entity.TotalServices
 
 	return nil
 }
@@ -49,3 +80,30 @@ func (client *Client) GetOrganizationQuota(guid string) (OrganizationQuota, Warn
 	err = client.connection.Make(request, &response)
 	return orgQuota, response.Warnings, err
 }
+
+// GetOrganizationQuotas returns a list of organization quotas (quota
+// definitions) based off of the provided queries.
+func (client *Client) GetOrganizationQuotas(queries []Query) ([]OrganizationQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationQuotaDefinitionsRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullQuotasList []OrganizationQuota
+	warnings, err := client.paginate(request, OrganizationQuota{}, func(item interface{}) error {
+		if quota, ok := item.(OrganizationQuota); ok {
+			fullQuotasList = append(fullQuotasList, quota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   OrganizationQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullQuotasList, warnings, err
+}
@@ -26,7 +26,12 @@ var _ = Describe("OrganizationQuota", func() {
 					"guid": "some-org-quota-guid"
 				},
 				"entity": {
-					"name": "some-org-quota"
+					"name": "some-org-quota",
+					"memory_limit": 10240,
+					"app_instance_limit": -1,
+					"app_task_limit": 5,
+					"total_routes": 1000,
+					"total_services": -1
 				}
 			}`
 				server.AppendHandlers(
@@ -42,8 +47,13 @@ var _ = Describe("OrganizationQuota", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(Equal(Warnings{"warning-1"}))
 				Expect(orgQuota).To(Equal(OrganizationQuota{
-					GUID: "some-org-quota-guid",
-					Name: "some-org-quota",
+					GUID:             "some-org-quota-guid",
+					Name:             "some-org-quota",
+					MemoryLimit:      10240,
+					AppInstanceLimit: -1,
+					AppTaskLimit:     5,
+					TotalRoutes:      1000,
+					TotalServices:    -1,
 				}))
 			})
 		})
@@ -73,4 +83,40 @@ var _ = Describe("OrganizationQuota", func() {
 		})
 
 	})
+
+	Describe("GetOrganizationQuotas", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-org-quota-guid"
+						},
+						"entity": {
+							"name": "some-org-quota"
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/quota_definitions", "q=name:some-org-quota"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("returns the organization quotas matching the queries and all warnings", func() {
+			quotas, warnings, err := client.GetOrganizationQuotas([]Query{{
+				Filter:   NameFilter,
+				Operator: EqualOperator,
+				Value:    "some-org-quota",
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quotas).To(ConsistOf(OrganizationQuota{GUID: "some-org-quota-guid", Name: "some-org-quota"}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
 })
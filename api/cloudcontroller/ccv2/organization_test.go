@@ -212,4 +212,89 @@ var _ = Describe("Organization", func() {
 			})
 		})
 	})
+
+	Describe("CreateOrganization", func() {
+		Context("when the organization is created", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-org-guid"
+					},
+					"entity": {
+						"name": "some-org",
+						"quota_definition_guid": "some-quota-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/organizations"),
+						VerifyJSON(`{"name":"some-org"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the organization and all warnings", func() {
+				org, warnings, err := client.CreateOrganization("some-org")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(org).To(Equal(Organization{
+					GUID:                "some-org-guid",
+					Name:                "some-org",
+					QuotaDefinitionGUID: "some-quota-guid",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 30002,
+					"description": "The organization name is taken: some-org",
+					"error_code": "CF-OrganizationNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/organizations"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.CreateOrganization("some-org")
+				Expect(err).To(MatchError(ccerror.BadRequestError{Message: "The organization name is taken: some-org"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UpdateOrganizationQuota", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {
+					"guid": "some-org-guid"
+				},
+				"entity": {
+					"name": "some-org",
+					"quota_definition_guid": "some-quota-guid"
+				}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid"),
+					VerifyJSON(`{"quota_definition_guid":"some-quota-guid"}`),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("sets the quota and returns the organization and all warnings", func() {
+			org, warnings, err := client.UpdateOrganizationQuota("some-org-guid", "some-quota-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(org.QuotaDefinitionGUID).To(Equal("some-quota-guid"))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
 })
@@ -29,6 +29,10 @@ const (
 	NameFilter QueryFilter = "name"
 	// HostFilter is the name of the 'host' filter.
 	HostFilter QueryFilter = "host"
+	// PathFilter is the name of the 'path' filter.
+	PathFilter QueryFilter = "path"
+	// PortFilter is the name of the 'port' filter.
+	PortFilter QueryFilter = "port"
 )
 
 const (
@@ -23,6 +23,9 @@ type requestOptions struct {
 
 	// URI is the URI of the request.
 	URI string
+	// URL is the full URL of the request. It is used for endpoints that are
+	// not served by the Cloud Controller.
+	URL string
 	// Method is the HTTP method of the request.
 	Method string
 
@@ -35,7 +38,13 @@ type requestOptions struct {
 func (client Client) newHTTPRequest(passedRequest requestOptions) (*http.Request, error) {
 	var request *http.Request
 	var err error
-	if passedRequest.URI != "" {
+	if passedRequest.URL != "" {
+		request, err = http.NewRequest(
+			passedRequest.Method,
+			passedRequest.URL,
+			passedRequest.Body,
+		)
+	} else if passedRequest.URI != "" {
 		request, err = http.NewRequest(
 			passedRequest.Method,
 			fmt.Sprintf("%s%s", client.API(), passedRequest.URI),
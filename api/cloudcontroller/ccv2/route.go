@@ -69,6 +69,25 @@ func (client *Client) BindRouteToApplication(routeGUID string, appGUID string) (
 	return route, response.Warnings, err
 }
 
+// UnbindRouteFromApplication unbinds the given route from the given
+// application.
+func (client *Client) UnbindRouteFromApplication(routeGUID string, appGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteRouteAppRequest,
+		URIParams: map[string]string{
+			"app_guid":   appGUID,
+			"route_guid": routeGUID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // CreateRoute creates the route with the given properties; SpaceGUID and
 // DomainGUID are required. Set generatePort true to generate a random port on
 // the cloud controller. generatePort takes precedence over manually specified
@@ -0,0 +1,36 @@
+package ccv2
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// RouterGroup represents a routing API router group. Router groups are not
+// Cloud Controller resources; they are requested from the routing endpoint
+// advertised by the Cloud Controller.
+type RouterGroup struct {
+	GUID            string `json:"guid"`
+	Name            string `json:"name"`
+	Type            string `json:"type"`
+	ReservablePorts string `json:"reservable_ports"`
+}
+
+// GetRouterGroups returns all the router groups from the routing API.
+func (client *Client) GetRouterGroups() ([]RouterGroup, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		Method: http.MethodGet,
+		URL:    client.routingEndpoint + "/v1/router_groups",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var routerGroups []RouterGroup
+	response := cloudcontroller.Response{
+		Result: &routerGroups,
+	}
+
+	err = client.connection.Make(request, &response)
+	return routerGroups, response.Warnings, err
+}
@@ -98,22 +98,34 @@ func (client *Client) GetSecurityGroups(queries []Query) ([]SecurityGroup, Warni
 	return securityGroupsList, warnings, err
 }
 
+// GetRunningSecurityGroups returns the Security Groups that are bound to all
+// running applications.
+func (client *Client) GetRunningSecurityGroups() ([]SecurityGroup, Warnings, error) {
+	return client.getSecurityGroupsByRequest(internal.GetConfigRunningSecurityGroupsRequest, nil)
+}
+
+// GetStagingSecurityGroups returns the Security Groups that are bound to all
+// staging applications.
+func (client *Client) GetStagingSecurityGroups() ([]SecurityGroup, Warnings, error) {
+	return client.getSecurityGroupsByRequest(internal.GetConfigStagingSecurityGroupsRequest, nil)
+}
+
 // GetSpaceRunningSecurityGroupsBySpace returns the running Security Groups
 // associated with the provided Space GUID.
 func (client *Client) GetSpaceRunningSecurityGroupsBySpace(spaceGUID string) ([]SecurityGroup, Warnings, error) {
-	return client.getSpaceSecurityGroupsBySpaceAndLifecycle(spaceGUID, internal.GetSpaceRunningSecurityGroupsRequest)
+	return client.getSecurityGroupsByRequest(internal.GetSpaceRunningSecurityGroupsRequest, map[string]string{"space_guid": spaceGUID})
 }
 
 // GetSpaceStagingSecurityGroupsBySpace returns the staging Security Groups
 // associated with the provided Space GUID.
 func (client *Client) GetSpaceStagingSecurityGroupsBySpace(spaceGUID string) ([]SecurityGroup, Warnings, error) {
-	return client.getSpaceSecurityGroupsBySpaceAndLifecycle(spaceGUID, internal.GetSpaceStagingSecurityGroupsRequest)
+	return client.getSecurityGroupsByRequest(internal.GetSpaceStagingSecurityGroupsRequest, map[string]string{"space_guid": spaceGUID})
 }
 
-func (client *Client) getSpaceSecurityGroupsBySpaceAndLifecycle(spaceGUID string, lifecycle string) ([]SecurityGroup, Warnings, error) {
+func (client *Client) getSecurityGroupsByRequest(requestName string, uriParams map[string]string) ([]SecurityGroup, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
-		RequestName: lifecycle,
-		URIParams:   map[string]string{"space_guid": spaceGUID},
+		RequestName: requestName,
+		URIParams:   uriParams,
 	})
 	if err != nil {
 		return nil, nil, err
@@ -66,6 +66,110 @@ var _ = Describe("Security Groups", func() {
 		})
 	})
 
+	Describe("GetRunningSecurityGroups", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/config/running_security_groups?page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "running-security-group-guid-1"
+							},
+							"entity": {
+								"name": "running-security-group-name-1",
+								"rules": [
+									{
+										"protocol": "all",
+										"destination": "0.0.0.0-9.255.255.255"
+									}
+								]
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "running-security-group-guid-2"
+							},
+							"entity": {
+								"name": "running-security-group-name-2",
+								"rules": [
+									{
+										"protocol": "tcp",
+										"ports": "53",
+										"destination": "0.0.0.0/0"
+									}
+								]
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/running_security_groups"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/running_security_groups", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the globally bound running security groups and all warnings", func() {
+				securityGroups, warnings, err := client.GetRunningSecurityGroups()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(securityGroups).To(Equal([]SecurityGroup{
+					{
+						GUID: "running-security-group-guid-1",
+						Name: "running-security-group-name-1",
+						Rules: []SecurityGroupRule{
+							{Protocol: "all", Destination: "0.0.0.0-9.255.255.255"},
+						},
+					},
+					{
+						GUID: "running-security-group-guid-2",
+						Name: "running-security-group-name-2",
+						Rules: []SecurityGroupRule{
+							{Protocol: "tcp", Ports: "53", Destination: "0.0.0.0/0"},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10003,
+					"description": "You are not authorized to perform the requested action",
+					"error_code": "CF-NotAuthorized"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/running_security_groups"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetRunningSecurityGroups()
+				Expect(err).To(MatchError(ccerror.ForbiddenError{
+					Message: "You are not authorized to perform the requested action",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("GetSecurityGroups", func() {
 		Context("when no errors are encountered", func() {
 			Context("when results are paginated", func() {
@@ -545,6 +649,110 @@ var _ = Describe("Security Groups", func() {
 		})
 	})
 
+	Describe("GetStagingSecurityGroups", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/config/staging_security_groups?page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "staging-security-group-guid-1"
+							},
+							"entity": {
+								"name": "staging-security-group-name-1",
+								"rules": [
+									{
+										"protocol": "all",
+										"destination": "0.0.0.0-9.255.255.255"
+									}
+								]
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "staging-security-group-guid-2"
+							},
+							"entity": {
+								"name": "staging-security-group-name-2",
+								"rules": [
+									{
+										"protocol": "tcp",
+										"ports": "53",
+										"destination": "0.0.0.0/0"
+									}
+								]
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/staging_security_groups"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/staging_security_groups", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the globally bound staging security groups and all warnings", func() {
+				securityGroups, warnings, err := client.GetStagingSecurityGroups()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(securityGroups).To(Equal([]SecurityGroup{
+					{
+						GUID: "staging-security-group-guid-1",
+						Name: "staging-security-group-name-1",
+						Rules: []SecurityGroupRule{
+							{Protocol: "all", Destination: "0.0.0.0-9.255.255.255"},
+						},
+					},
+					{
+						GUID: "staging-security-group-guid-2",
+						Name: "staging-security-group-name-2",
+						Rules: []SecurityGroupRule{
+							{Protocol: "tcp", Ports: "53", Destination: "0.0.0.0/0"},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10003,
+					"description": "You are not authorized to perform the requested action",
+					"error_code": "CF-NotAuthorized"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/staging_security_groups"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetStagingSecurityGroups()
+				Expect(err).To(MatchError(ccerror.ForbiddenError{
+					Message: "You are not authorized to perform the requested action",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("RemoveSpaceFromSecurityGroup", func() {
 		var (
 			warnings Warnings
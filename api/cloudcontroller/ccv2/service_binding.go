@@ -1,6 +1,7 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -10,13 +11,21 @@ import (
 
 // ServiceBinding represents a Cloud Controller Service Binding.
 type ServiceBinding struct {
-	GUID string
+	GUID                string
+	AppGUID             string
+	ServiceInstanceGUID string
+	Credentials         map[string]interface{}
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Binding response.
 func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	var ccServiceBinding struct {
 		Metadata internal.Metadata
+		Entity   struct {
+			AppGUID             string                 `json:"app_guid"`
+			ServiceInstanceGUID string                 `json:"service_instance_guid"`
+			Credentials         map[string]interface{} `json:"credentials"`
+		}
 	}
 	err := json.Unmarshal(data, &ccServiceBinding)
 	if err != nil {
@@ -24,9 +33,66 @@ func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	}
 
 	serviceBinding.GUID = ccServiceBinding.Metadata.GUID
+	serviceBinding.AppGUID = ccServiceBinding.Entity.AppGUID
+	serviceBinding.ServiceInstanceGUID = ccServiceBinding.Entity.ServiceInstanceGUID
+	serviceBinding.Credentials = ccServiceBinding.Entity.Credentials
 	return nil
 }
 
+// CreateServiceBinding binds the app to the service instance, passing the
+// provided parameters to the service broker.
+func (client *Client) CreateServiceBinding(appGUID string, serviceInstanceGUID string, parameters map[string]interface{}) (ServiceBinding, Warnings, error) {
+	requestBody := struct {
+		AppGUID             string                 `json:"app_guid"`
+		ServiceInstanceGUID string                 `json:"service_instance_guid"`
+		Parameters          map[string]interface{} `json:"parameters,omitempty"`
+	}{
+		AppGUID:             appGUID,
+		ServiceInstanceGUID: serviceInstanceGUID,
+		Parameters:          parameters,
+	}
+	body, err := json.Marshal(requestBody)
+	if err != nil {
+		return ServiceBinding{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostServiceBindingRequest,
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return ServiceBinding{}, nil, err
+	}
+
+	var serviceBinding ServiceBinding
+	response := cloudcontroller.Response{
+		Result: &serviceBinding,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceBinding, response.Warnings, err
+}
+
+// GetServiceBindingParameters returns the parameters the service binding was
+// created with, as reported by the service broker.
+func (client *Client) GetServiceBindingParameters(serviceBindingGUID string) (map[string]interface{}, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceBindingParametersRequest,
+		URIParams:   map[string]string{"service_binding_guid": serviceBindingGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var parameters map[string]interface{}
+	response := cloudcontroller.Response{
+		Result: &parameters,
+	}
+
+	err = client.connection.Make(request, &response)
+	return parameters, response.Warnings, err
+}
+
 // GetServiceBindings returns back a list of Service Bindings based off of the
 // provided queries.
 func (client *Client) GetServiceBindings(queries []Query) ([]ServiceBinding, Warnings, error) {
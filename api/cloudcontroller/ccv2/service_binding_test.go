@@ -82,6 +82,120 @@ var _ = Describe("Service Binding", func() {
 		})
 	})
 
+	Describe("CreateServiceBinding", func() {
+		Context("when the create is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-binding-guid"
+					},
+					"entity": {
+						"app_guid": "some-app-guid",
+						"service_instance_guid": "some-service-instance-guid",
+						"credentials": {
+							"password": "some-password"
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_bindings"),
+						VerifyJSON(`{
+							"app_guid": "some-app-guid",
+							"service_instance_guid": "some-service-instance-guid",
+							"parameters": {"permissions": "read-only"}
+						}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created service binding and warnings", func() {
+				serviceBinding, warnings, err := client.CreateServiceBinding("some-app-guid", "some-service-instance-guid", map[string]interface{}{"permissions": "read-only"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceBinding).To(Equal(ServiceBinding{
+					GUID:                "some-service-binding-guid",
+					AppGUID:             "some-app-guid",
+					ServiceInstanceGUID: "some-service-instance-guid",
+					Credentials:         map[string]interface{}{"password": "some-password"},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the app is already bound to the service instance", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 90003,
+					"description": "The app is already bound to the service.",
+					"error_code": "CF-ServiceBindingAppServiceTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_bindings"),
+						VerifyJSON(`{
+							"app_guid": "some-app-guid",
+							"service_instance_guid": "some-service-instance-guid"
+						}`),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ServiceBindingTakenError and warnings", func() {
+				_, warnings, err := client.CreateServiceBinding("some-app-guid", "some-service-instance-guid", nil)
+				Expect(err).To(MatchError(ccerror.ServiceBindingTakenError{
+					Message: "The app is already bound to the service.",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("GetServiceBindingParameters", func() {
+		Context("when the parameters can be retrieved", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_bindings/some-service-binding-guid/parameters"),
+						RespondWith(http.StatusOK, `{"permissions": "read-only"}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the parameters and warnings", func() {
+				parameters, warnings, err := client.GetServiceBindingParameters("some-service-binding-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parameters).To(Equal(map[string]interface{}{"permissions": "read-only"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the broker does not support retrieving parameters", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 90007,
+					"description": "This service does not support fetching service binding parameters.",
+					"error_code": "CF-ServiceFetchBindingParametersNotSupported"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_bindings/some-service-binding-guid/parameters"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a BadRequestError and warnings", func() {
+				_, warnings, err := client.GetServiceBindingParameters("some-service-binding-guid")
+				Expect(err).To(MatchError(ccerror.BadRequestError{
+					Message: "This service does not support fetching service binding parameters.",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("DeleteServiceBinding", func() {
 		Context("when the service binding exist", func() {
 			BeforeEach(func() {
@@ -2,7 +2,9 @@ package ccv2
 
 import (
 	"encoding/json"
+	"net/url"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
@@ -18,11 +20,24 @@ const (
 	ManagedService ServiceInstanceType = "managed_service_instance"
 )
 
+// LastOperationInProgress is the state of a service instance operation that
+// the service broker has not finished yet.
+const LastOperationInProgress = "in progress"
+
+// LastOperation is the last operation performed on a Service Instance.
+type LastOperation struct {
+	Type        string
+	State       string
+	Description string
+}
+
 // ServiceInstance represents a Cloud Controller Service Instance.
 type ServiceInstance struct {
-	GUID string
-	Name string
-	Type ServiceInstanceType
+	GUID            string
+	Name            string
+	Type            ServiceInstanceType
+	ServicePlanGUID string
+	LastOperation   LastOperation
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Instance response.
@@ -30,8 +45,14 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 	var ccServiceInstance struct {
 		Metadata internal.Metadata
 		Entity   struct {
-			Name string
-			Type string
+			Name            string
+			Type            string
+			ServicePlanGUID string `json:"service_plan_guid"`
+			LastOperation   struct {
+				Type        string `json:"type"`
+				State       string `json:"state"`
+				Description string `json:"description"`
+			} `json:"last_operation"`
 		}
 	}
 	err := json.Unmarshal(data, &ccServiceInstance)
@@ -42,6 +63,8 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 	serviceInstance.GUID = ccServiceInstance.Metadata.GUID
 	serviceInstance.Name = ccServiceInstance.Entity.Name
 	serviceInstance.Type = ServiceInstanceType(ccServiceInstance.Entity.Type)
+	serviceInstance.ServicePlanGUID = ccServiceInstance.Entity.ServicePlanGUID
+	serviceInstance.LastOperation = LastOperation(ccServiceInstance.Entity.LastOperation)
 	return nil
 }
 
@@ -117,3 +140,31 @@ func (client *Client) GetSpaceServiceInstances(spaceGUID string, includeUserProv
 
 	return fullInstancesList, warnings, err
 }
+
+// DeleteServiceInstance deletes the service instance with the given GUID.
+// The service instance must not have any bindings or service keys. When the
+// service broker deletes the instance asynchronously, the returned Service
+// Instance has a LastOperation that is still in progress.
+func (client *Client) DeleteServiceInstance(serviceInstanceGUID string) (ServiceInstance, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceInstanceRequest,
+		URIParams:   map[string]string{"service_instance_guid": serviceInstanceGUID},
+		Query:       url.Values{"accepts_incomplete": {"true"}},
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return ServiceInstance{}, response.Warnings, err
+	}
+
+	// A synchronous delete responds with No Content.
+	var serviceInstance ServiceInstance
+	if len(response.RawResponse) > 0 {
+		err = json.Unmarshal(response.RawResponse, &serviceInstance)
+	}
+	return serviceInstance, response.Warnings, err
+}
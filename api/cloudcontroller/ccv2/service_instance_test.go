@@ -61,7 +61,8 @@ var _ = Describe("Service Instance", func() {
 						},
 						"entity": {
 							"name": "some-service-name-1",
-							"type": "managed_service_instance"
+							"type": "managed_service_instance",
+							"service_plan_guid": "some-service-plan-guid"
 						}
 					},
 					{
@@ -125,7 +126,7 @@ var _ = Describe("Service Instance", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(serviceInstances).To(ConsistOf([]ServiceInstance{
-					{Name: "some-service-name-1", GUID: "some-service-guid-1", Type: ManagedService},
+					{Name: "some-service-name-1", GUID: "some-service-guid-1", Type: ManagedService, ServicePlanGUID: "some-service-plan-guid"},
 					{Name: "some-service-name-2", GUID: "some-service-guid-2", Type: ManagedService},
 					{Name: "some-service-name-3", GUID: "some-service-guid-3", Type: ManagedService},
 					{Name: "some-service-name-4", GUID: "some-service-guid-4", Type: ManagedService},
@@ -273,4 +274,64 @@ var _ = Describe("Service Instance", func() {
 			})
 		})
 	})
+	Describe("DeleteServiceInstance", func() {
+		Context("when the service broker deletes the instance synchronously", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "accepts_incomplete=true"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the service instance and returns all warnings", func() {
+				serviceInstance, warnings, err := client.DeleteServiceInstance("some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceInstance).To(Equal(ServiceInstance{}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the service broker deletes the instance asynchronously", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-instance-guid"
+					},
+					"entity": {
+						"name": "some-service-instance",
+						"type": "managed_service_instance",
+						"last_operation": {
+							"type": "delete",
+							"state": "in progress",
+							"description": "deleting"
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "accepts_incomplete=true"),
+						RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service instance with its last operation", func() {
+				serviceInstance, warnings, err := client.DeleteServiceInstance("some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceInstance).To(Equal(ServiceInstance{
+					GUID: "some-service-instance-guid",
+					Name: "some-service-instance",
+					Type: ManagedService,
+					LastOperation: LastOperation{
+						Type:        "delete",
+						State:       LastOperationInProgress,
+						Description: "deleting",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
 })
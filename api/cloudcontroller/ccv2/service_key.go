@@ -0,0 +1,78 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ServiceKey represents a Cloud Controller Service Key.
+type ServiceKey struct {
+	GUID                string
+	Name                string
+	ServiceInstanceGUID string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Key response.
+func (serviceKey *ServiceKey) UnmarshalJSON(data []byte) error {
+	var ccServiceKey struct {
+		Metadata internal.Metadata
+		Entity   struct {
+			Name                string `json:"name"`
+			ServiceInstanceGUID string `json:"service_instance_guid"`
+		}
+	}
+	err := json.Unmarshal(data, &ccServiceKey)
+	if err != nil {
+		return err
+	}
+
+	serviceKey.GUID = ccServiceKey.Metadata.GUID
+	serviceKey.Name = ccServiceKey.Entity.Name
+	serviceKey.ServiceInstanceGUID = ccServiceKey.Entity.ServiceInstanceGUID
+	return nil
+}
+
+// GetServiceKeys returns back a list of Service Keys based off of the
+// provided queries.
+func (client *Client) GetServiceKeys(queries []Query) ([]ServiceKey, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceKeysRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullKeysList []ServiceKey
+	warnings, err := client.paginate(request, ServiceKey{}, func(item interface{}) error {
+		if key, ok := item.(ServiceKey); ok {
+			fullKeysList = append(fullKeysList, key)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServiceKey{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullKeysList, warnings, err
+}
+
+// DeleteServiceKey deletes the Service Key with the given GUID.
+func (client *Client) DeleteServiceKey(serviceKeyGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceKeyRequest,
+		URIParams:   map[string]string{"service_key_guid": serviceKeyGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
@@ -0,0 +1,63 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ServicePlan represents a Cloud Controller Service Plan.
+type ServicePlan struct {
+	GUID string
+	Name string
+
+	// BindingSchema is the JSON schema the service broker publishes for the
+	// parameters accepted when binding to an instance of this plan. It is empty
+	// when the broker does not publish one.
+	BindingSchema map[string]interface{}
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Plan response.
+func (servicePlan *ServicePlan) UnmarshalJSON(data []byte) error {
+	var ccServicePlan struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Name    string `json:"name"`
+			Schemas struct {
+				ServiceBinding struct {
+					Create struct {
+						Parameters map[string]interface{} `json:"parameters"`
+					} `json:"create"`
+				} `json:"service_binding"`
+			} `json:"schemas"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccServicePlan); err != nil {
+		return err
+	}
+
+	servicePlan.GUID = ccServicePlan.Metadata.GUID
+	servicePlan.Name = ccServicePlan.Entity.Name
+	servicePlan.BindingSchema = ccServicePlan.Entity.Schemas.ServiceBinding.Create.Parameters
+	return nil
+}
+
+// GetServicePlan returns the service plan with the given GUID.
+func (client *Client) GetServicePlan(servicePlanGUID string) (ServicePlan, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicePlanRequest,
+		URIParams:   Params{"service_plan_guid": servicePlanGUID},
+	})
+	if err != nil {
+		return ServicePlan{}, nil, err
+	}
+
+	var servicePlan ServicePlan
+	response := cloudcontroller.Response{
+		Result: &servicePlan,
+	}
+
+	err = client.connection.Make(request, &response)
+	return servicePlan, response.Warnings, err
+}
@@ -0,0 +1,94 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Plan", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServicePlan", func() {
+		Context("when the service plan is found", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-plan-guid"
+					},
+					"entity": {
+						"name": "some-service-plan-name",
+						"schemas": {
+							"service_instance": {
+								"create": {
+									"parameters": {}
+								}
+							},
+							"service_binding": {
+								"create": {
+									"parameters": {
+										"type": "object",
+										"required": ["permissions"]
+									}
+								}
+							}
+						}
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_plans/some-service-plan-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the service plan, its binding schema and warnings", func() {
+				servicePlan, warnings, err := client.GetServicePlan("some-service-plan-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(servicePlan).To(Equal(ServicePlan{
+					GUID: "some-service-plan-guid",
+					Name: "some-service-plan-name",
+					BindingSchema: map[string]interface{}{
+						"type":     "object",
+						"required": []interface{}{"permissions"},
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 110003,
+					"description": "The service plan could not be found: some-service-plan-guid",
+					"error_code": "CF-ServicePlanNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_plans/some-service-plan-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetServicePlan("some-service-plan-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The service plan could not be found: some-service-plan-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
@@ -1,8 +1,10 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
@@ -12,6 +14,7 @@ type Space struct {
 	GUID                     string
 	Name                     string
 	AllowSSH                 bool
+	OrganizationGUID         string
 	SpaceQuotaDefinitionGUID string
 }
 
@@ -22,6 +25,7 @@ func (space *Space) UnmarshalJSON(data []byte) error {
 		Entity   struct {
 			Name                     string `json:"name"`
 			AllowSSH                 bool   `json:"allow_ssh"`
+			OrganizationGUID         string `json:"organization_guid"`
 			SpaceQuotaDefinitionGUID string `json:"space_quota_definition_guid"`
 		} `json:"entity"`
 	}
@@ -32,6 +36,7 @@ func (space *Space) UnmarshalJSON(data []byte) error {
 	space.GUID = ccSpace.Metadata.GUID
 	space.Name = ccSpace.Entity.Name
 	space.AllowSSH = ccSpace.Entity.AllowSSH
+	space.OrganizationGUID = ccSpace.Entity.OrganizationGUID
 	space.SpaceQuotaDefinitionGUID = ccSpace.Entity.SpaceQuotaDefinitionGUID
 	return nil
 }
@@ -61,3 +66,34 @@ func (client *Client) GetSpaces(queries []Query) ([]Space, Warnings, error) {
 
 	return fullSpacesList, warnings, err
 }
+
+// CreateSpace creates a Space with the provided name in the Organization
+// associated with the provided GUID.
+func (client *Client) CreateSpace(spaceName string, orgGUID string) (Space, Warnings, error) {
+	body, err := json.Marshal(struct {
+		Name             string `json:"name"`
+		OrganizationGUID string `json:"organization_guid"`
+	}{
+		Name:             spaceName,
+		OrganizationGUID: orgGUID,
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceRequest,
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return Space{}, nil, err
+	}
+
+	var space Space
+	response := cloudcontroller.Response{
+		Result: &space,
+	}
+
+	err = client.connection.Make(request, &response)
+	return space, response.Warnings, err
+}
@@ -10,6 +10,26 @@ import (
 type SpaceQuota struct {
 	GUID string
 	Name string
+
+	// MemoryLimit is the total memory, in megabytes, available to running
+	// application instances and tasks.
+	MemoryLimit int
+
+	// AppInstanceLimit is the total number of application instances allowed.
+	// -1 represents an unlimited amount.
+	AppInstanceLimit int
+
+	// AppTaskLimit is the number of tasks that may run at the same time. -1
+	// represents an unlimited amount.
+	AppTaskLimit int
+
+	// TotalRoutes is the total number of routes allowed. -1 represents an
+	// unlimited amount.
+	TotalRoutes int
+
+	// TotalServices is the total number of service instances allowed. -1
+	// represents an unlimited amount.
+	TotalServices int
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Space Quota response.
@@ -17,7 +37,12 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 	var ccSpaceQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name             string `json:"name"`
+			MemoryLimit      int    `json:"memory_limit"`
+			AppInstanceLimit int    `json:"app_instance_limit"`
+			AppTaskLimit     int    `json:"app_task_limit"`
+			TotalRoutes      int    `json:"total_routes"`
+			TotalServices    int    `json:"total_services"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccSpaceQuota); err != nil {
@@ -26,6 +51,11 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 
 	spaceQuota.GUID = ccSpaceQuota.Metadata.GUID
 	spaceQuota.Name = ccSpaceQuota.Entity.Name
+	spaceQuota.MemoryLimit = ccSpaceQuota.Entity.MemoryLimit
+	spaceQuota.AppInstanceLimit = ccSpaceQuota.Entity.AppInstanceLimit
+	spaceQuota.AppTaskLimit = ccSpaceQuota.Entity.AppTaskLimit
+	spaceQuota.TotalRoutes = ccSpaceQuota.Entity.TotalRoutes
+	spaceQuota.TotalServices = ccSpaceQuota.Entity.TotalServices
 	return nil
 }
 
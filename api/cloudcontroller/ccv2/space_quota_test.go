@@ -27,7 +27,12 @@ var _ = Describe("Space Quotas", func() {
 						"updated_at": null
 					},
 					"entity": {
-						"name": "space-quota"
+						"name": "space-quota",
+						"memory_limit": 2048,
+						"app_instance_limit": 10,
+						"app_task_limit": -1,
+						"total_routes": 20,
+						"total_services": 5
 					}
 				}`
 				server.AppendHandlers(
@@ -43,8 +48,13 @@ var _ = Describe("Space Quotas", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 				Expect(spaceQuota).To(Equal(SpaceQuota{
-					Name: "space-quota",
-					GUID: "space-quota-guid",
+					Name:             "space-quota",
+					GUID:             "space-quota-guid",
+					MemoryLimit:      2048,
+					AppInstanceLimit: 10,
+					AppTaskLimit:     -1,
+					TotalRoutes:      20,
+					TotalServices:    5,
 				}))
 			})
 		})
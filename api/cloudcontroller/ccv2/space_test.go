@@ -31,6 +31,7 @@ var _ = Describe("Space", func() {
 								"entity": {
 									"name": "space-1",
 									"allow_ssh": false,
+									"organization_guid": "some-org-guid",
 									"space_quota_definition_guid": "some-space-quota-guid-1"
 								}
 							},
@@ -41,6 +42,7 @@ var _ = Describe("Space", func() {
 								"entity": {
 									"name": "space-2",
 									"allow_ssh": true,
+									"organization_guid": "some-org-guid",
 									"space_quota_definition_guid": "some-space-quota-guid-2"
 								}
 							}
@@ -56,6 +58,7 @@ var _ = Describe("Space", func() {
 								"entity": {
 									"name": "space-3",
 									"allow_ssh": false,
+									"organization_guid": "some-org-guid",
 									"space_quota_definition_guid": "some-space-quota-guid-3"
 								}
 							},
@@ -66,6 +69,7 @@ var _ = Describe("Space", func() {
 								"entity": {
 									"name": "space-4",
 									"allow_ssh": true,
+									"organization_guid": "some-org-guid",
 									"space_quota_definition_guid": "some-space-quota-guid-4"
 								}
 							}
@@ -96,24 +100,28 @@ var _ = Describe("Space", func() {
 							GUID:                     "space-guid-1",
 							Name:                     "space-1",
 							AllowSSH:                 false,
+							OrganizationGUID:         "some-org-guid",
 							SpaceQuotaDefinitionGUID: "some-space-quota-guid-1",
 						},
 						{
 							GUID:                     "space-guid-2",
 							Name:                     "space-2",
 							AllowSSH:                 true,
+							OrganizationGUID:         "some-org-guid",
 							SpaceQuotaDefinitionGUID: "some-space-quota-guid-2",
 						},
 						{
 							GUID:                     "space-guid-3",
 							Name:                     "space-3",
 							AllowSSH:                 false,
+							OrganizationGUID:         "some-org-guid",
 							SpaceQuotaDefinitionGUID: "some-space-quota-guid-3",
 						},
 						{
 							GUID:                     "space-guid-4",
 							Name:                     "space-4",
 							AllowSSH:                 true,
+							OrganizationGUID:         "some-org-guid",
 							SpaceQuotaDefinitionGUID: "some-space-quota-guid-4",
 						},
 					}))
@@ -151,4 +159,56 @@ var _ = Describe("Space", func() {
 			})
 		})
 	})
+
+	Describe("CreateSpace", func() {
+		Context("when the space is created", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-space-guid"
+					},
+					"entity": {
+						"name": "some-space"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces"),
+						VerifyJSON(`{"name":"some-space","organization_guid":"some-org-guid"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the space and all warnings", func() {
+				space, warnings, err := client.CreateSpace("some-space", "some-org-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(space).To(Equal(Space{GUID: "some-space-guid", Name: "some-space"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 40002,
+					"description": "The app space name is taken: some-space",
+					"error_code": "CF-SpaceNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/spaces"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.CreateSpace("some-space", "some-org-guid")
+				Expect(err).To(MatchError(ccerror.BadRequestError{Message: "The app space name is taken: some-space"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 })
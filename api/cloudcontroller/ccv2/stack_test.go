@@ -74,4 +74,92 @@ var _ = Describe("Stack", func() {
 			})
 		})
 	})
+
+	Describe("GetStacks", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/stacks?q=name:some-stack-name&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "stack-guid-1"
+							},
+							"entity": {
+								"name": "some-stack-name",
+								"description": "some stack description"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "stack-guid-2"
+							},
+							"entity": {
+								"name": "some-stack-name",
+								"description": "some other stack description"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/stacks", "q=name:some-stack-name"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/stacks", "q=name:some-stack-name&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns paginated results and all warnings", func() {
+				stacks, warnings, err := client.GetStacks([]Query{{
+					Filter:   NameFilter,
+					Operator: EqualOperator,
+					Value:    "some-stack-name",
+				}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stacks).To(Equal([]Stack{
+					{GUID: "stack-guid-1", Name: "some-stack-name", Description: "some stack description"},
+					{GUID: "stack-guid-2", Name: "some-stack-name", Description: "some other stack description"},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"warning-1", "warning-2"}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 1,
+					"description": "some error description",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/stacks"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetStacks(nil)
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        1,
+						Description: "some error description",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
 })
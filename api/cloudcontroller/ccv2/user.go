@@ -3,14 +3,61 @@ package ccv2
 import (
 	"bytes"
 	"encoding/json"
+	"net/http"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
 // User represents a Cloud Controller User.
 type User struct {
-	GUID string
+	GUID     string
+	Username string
+}
+
+// OrganizationRole is a role a user can have in an organization.
+type OrganizationRole string
+
+const (
+	// OrganizationUserRole is membership of an organization.
+	OrganizationUserRole OrganizationRole = "OrgUser"
+	// OrganizationManagerRole is the Org Manager role.
+	OrganizationManagerRole OrganizationRole = "OrgManager"
+	// OrganizationBillingManagerRole is the Billing Manager role.
+	OrganizationBillingManagerRole OrganizationRole = "BillingManager"
+	// OrganizationAuditorRole is the Org Auditor role.
+	OrganizationAuditorRole OrganizationRole = "OrgAuditor"
+)
+
+// SpaceRole is a role a user can have in a space.
+type SpaceRole string
+
+const (
+	// SpaceManagerRole is the Space Manager role.
+	SpaceManagerRole SpaceRole = "SpaceManager"
+	// SpaceDeveloperRole is the Space Developer role.
+	SpaceDeveloperRole SpaceRole = "SpaceDeveloper"
+	// SpaceAuditorRole is the Space Auditor role.
+	SpaceAuditorRole SpaceRole = "SpaceAuditor"
+)
+
+// roleRequests are the requests for a role: get lists the users with the
+// role, put gives it to a user by username, and putGUID and deleteGUID give it
+// to and remove it from a user by GUID.
+type roleRequests struct{ get, put, putGUID, deleteGUID string }
+
+var organizationRoleRequests = map[OrganizationRole]roleRequests{
+	OrganizationUserRole:           {internal.GetOrganizationUsersRequest, internal.PutOrganizationUsersRequest, internal.PutOrganizationUserRequest, ""},
+	OrganizationManagerRole:        {internal.GetOrganizationManagersRequest, internal.PutOrganizationManagersRequest, internal.PutOrganizationManagerRequest, internal.DeleteOrganizationManagerRequest},
+	OrganizationBillingManagerRole: {internal.GetOrganizationBillingManagersRequest, internal.PutOrganizationBillingManagersRequest, internal.PutOrganizationBillingManagerRequest, internal.DeleteOrganizationBillingManagerRequest},
+	OrganizationAuditorRole:        {internal.GetOrganizationAuditorsRequest, internal.PutOrganizationAuditorsRequest, internal.PutOrganizationAuditorRequest, internal.DeleteOrganizationAuditorRequest},
+}
+
+var spaceRoleRequests = map[SpaceRole]roleRequests{
+	SpaceManagerRole:   {internal.GetSpaceManagersRequest, internal.PutSpaceManagersRequest, internal.PutSpaceManagerRequest, internal.DeleteSpaceManagerRequest},
+	SpaceDeveloperRole: {internal.GetSpaceDevelopersRequest, internal.PutSpaceDevelopersRequest, internal.PutSpaceDeveloperRequest, internal.DeleteSpaceDeveloperRequest},
+	SpaceAuditorRole:   {internal.GetSpaceAuditorsRequest, internal.PutSpaceAuditorsRequest, internal.PutSpaceAuditorRequest, internal.DeleteSpaceAuditorRequest},
 }
 
 // userRequestBody represents the body of the request.
@@ -22,12 +69,16 @@ type userRequestBody struct {
 func (user *User) UnmarshalJSON(data []byte) error {
 	var ccUser struct {
 		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Username string `json:"username"`
+		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccUser); err != nil {
 		return err
 	}
 
 	user.GUID = ccUser.Metadata.GUID
+	user.Username = ccUser.Entity.Username
 	return nil
 }
 
@@ -61,3 +112,126 @@ func (client *Client) CreateUser(uaaUserID string) (User, Warnings, error) {
 
 	return user, response.Warnings, nil
 }
+
+// GetOrganizationUsersByRole returns the Users that have the provided role in
+// the Organization associated with the provided GUID.
+func (client *Client) GetOrganizationUsersByRole(role OrganizationRole, orgGUID string) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: organizationRoleRequests[role].get,
+		URIParams:   Params{"organization_guid": orgGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateUsers(request)
+}
+
+// UpdateOrganizationUserByRole gives the user with the provided username the
+// provided role in the Organization associated with the provided GUID.
+func (client *Client) UpdateOrganizationUserByRole(role OrganizationRole, orgGUID string, username string) (Warnings, error) {
+	return client.sendUsername(organizationRoleRequests[role].put, Params{"organization_guid": orgGUID}, username)
+}
+
+// UpdateOrganizationUserGUIDByRole gives the user with the provided GUID the
+// provided role in the Organization associated with the provided GUID.
+func (client *Client) UpdateOrganizationUserGUIDByRole(role OrganizationRole, orgGUID string, userGUID string) (Warnings, error) {
+	return client.sendUserGUID(organizationRoleRequests[role].putGUID, Params{"organization_guid": orgGUID, "user_guid": userGUID})
+}
+
+// DeleteOrganizationUserGUIDByRole removes the provided role from the user
+// with the provided GUID in the Organization associated with the provided
+// GUID. Membership of the Organization itself (OrganizationUserRole) cannot be
+// removed this way.
+func (client *Client) DeleteOrganizationUserGUIDByRole(role OrganizationRole, orgGUID string, userGUID string) (Warnings, error) {
+	return client.sendUserGUID(organizationRoleRequests[role].deleteGUID, Params{"organization_guid": orgGUID, "user_guid": userGUID})
+}
+
+// GetSpaceUsersByRole returns the Users that have the provided role in the
+// Space associated with the provided GUID.
+func (client *Client) GetSpaceUsersByRole(role SpaceRole, spaceGUID string) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: spaceRoleRequests[role].get,
+		URIParams:   Params{"space_guid": spaceGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateUsers(request)
+}
+
+// UpdateSpaceUserByRole gives the user with the provided username the
+// provided role in the Space associated with the provided GUID. The user must
+// already be a member of the space's organization.
+func (client *Client) UpdateSpaceUserByRole(role SpaceRole, spaceGUID string, username string) (Warnings, error) {
+	return client.sendUsername(spaceRoleRequests[role].put, Params{"space_guid": spaceGUID}, username)
+}
+
+// UpdateSpaceUserGUIDByRole gives the user with the provided GUID the
+// provided role in the Space associated with the provided GUID. The user must
+// already be a member of the space's organization.
+func (client *Client) UpdateSpaceUserGUIDByRole(role SpaceRole, spaceGUID string, userGUID string) (Warnings, error) {
+	return client.sendUserGUID(spaceRoleRequests[role].putGUID, Params{"space_guid": spaceGUID, "user_guid": userGUID})
+}
+
+// DeleteSpaceUserGUIDByRole removes the provided role from the user with the
+// provided GUID in the Space associated with the provided GUID.
+func (client *Client) DeleteSpaceUserGUIDByRole(role SpaceRole, spaceGUID string, userGUID string) (Warnings, error) {
+	return client.sendUserGUID(spaceRoleRequests[role].deleteGUID, Params{"space_guid": spaceGUID, "user_guid": userGUID})
+}
+
+func (client *Client) paginateUsers(request *http.Request) ([]User, Warnings, error) {
+	var fullUsersList []User
+	warnings, err := client.paginate(request, User{}, func(item interface{}) error {
+		if user, ok := item.(User); ok {
+			fullUsersList = append(fullUsersList, user)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   User{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullUsersList, warnings, err
+}
+
+func (client *Client) sendUsername(requestName string, uriParams Params, username string) (Warnings, error) {
+	body, err := json.Marshal(struct {
+		Username string `json:"username"`
+	}{
+		Username: username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+func (client *Client) sendUserGUID(requestName string, uriParams Params) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
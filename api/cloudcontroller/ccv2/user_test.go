@@ -87,4 +87,186 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationUsersByRole", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/organizations/some-org-guid/managers?page=2",
+				"resources": [
+					{
+						"metadata": {"guid": "user-guid-1"},
+						"entity": {"username": "user-1"}
+					}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {"guid": "user-guid-2"},
+						"entity": {"username": "user-2"}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/managers"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/managers", "page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+				),
+			)
+		})
+
+		It("returns all the users with the role and all warnings", func() {
+			users, warnings, err := client.GetOrganizationUsersByRole(OrganizationManagerRole, "some-org-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(users).To(ConsistOf(
+				User{GUID: "user-guid-1", Username: "user-1"},
+				User{GUID: "user-guid-2", Username: "user-2"},
+			))
+			Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+		})
+	})
+
+	Describe("UpdateOrganizationUserByRole", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/billing_managers"),
+					VerifyJSON(`{"username":"some-user"}`),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("gives the user the role and returns all warnings", func() {
+			warnings, err := client.UpdateOrganizationUserByRole(OrganizationBillingManagerRole, "some-org-guid", "some-user")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("GetSpaceUsersByRole", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {"guid": "user-guid-1"},
+						"entity": {"username": "user-1"}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid/developers"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("returns the users with the role and all warnings", func() {
+			users, warnings, err := client.GetSpaceUsersByRole(SpaceDeveloperRole, "some-space-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(users).To(ConsistOf(User{GUID: "user-guid-1", Username: "user-1"}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("UpdateSpaceUserByRole", func() {
+		Context("when the user is not a member of the organization", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 1002,
+					"description": "Invalid relation: user is not a member of the org",
+					"error_code": "CF-InvalidRelation"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/spaces/some-space-guid/auditors"),
+						VerifyJSON(`{"username":"some-user"}`),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.UpdateSpaceUserByRole(SpaceAuditorRole, "some-space-guid", "some-user")
+				Expect(err).To(MatchError(ccerror.InvalidRelationError{Message: "Invalid relation: user is not a member of the org"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UpdateOrganizationUserGUIDByRole", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/managers/some-user-guid"),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("gives the user the role and returns all warnings", func() {
+			warnings, err := client.UpdateOrganizationUserGUIDByRole(OrganizationManagerRole, "some-org-guid", "some-user-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("UpdateSpaceUserGUIDByRole", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/spaces/some-space-guid/developers/some-user-guid"),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("gives the user the role and returns all warnings", func() {
+			warnings, err := client.UpdateSpaceUserGUIDByRole(SpaceDeveloperRole, "some-space-guid", "some-user-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("DeleteOrganizationUserGUIDByRole", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v2/organizations/some-org-guid/billing_managers/some-user-guid"),
+					RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("removes the role and returns all warnings", func() {
+			warnings, err := client.DeleteOrganizationUserGUIDByRole(OrganizationBillingManagerRole, "some-org-guid", "some-user-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("DeleteSpaceUserGUIDByRole", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v2/spaces/some-space-guid/developers/some-user-guid"),
+					RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("removes the role and returns all warnings", func() {
+			warnings, err := client.DeleteSpaceUserGUIDByRole(SpaceDeveloperRole, "some-space-guid", "some-user-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
 })
@@ -105,6 +105,9 @@ func SetupV3Response() {
 			},
 			"packages": {
 				"href": "SERVER_URL/v3/packages"
+			},
+			"service_instances": {
+				"href": "SERVER_URL/v3/service_instances"
 			}
 		}
 	}`, "SERVER_URL", serverURL, -1)
@@ -13,6 +13,8 @@ import "net/http"
 const (
 	DeleteIsolationSegmentRelationshipOrganizationRequest = "DeleteIsolationSegmentRelationshipOrganization"
 	DeleteIsolationSegmentRequest                         = "DeleteIsolationSegment"
+	DeletePackageRequest                                  = "DeletePackage"
+	DeleteServiceInstanceRelationshipSharedSpaceRequest   = "DeleteServiceInstanceRelationshipSharedSpace"
 	GetAppsRequest                                        = "GetApps"
 	GetAppTasksRequest                                    = "GetAppTasks"
 	GetIsolationSegmentOrganizationsRequest               = "GetIsolationSegmentRelationshipOrganizations"
@@ -21,13 +23,17 @@ const (
 	GetOrganizationDefaultIsolationSegmentRequest         = "GetOrganizationDefaultIsolationSegment"
 	GetOrgsRequest                                        = "GetOrgs"
 	GetPackageRequest                                     = "GetPackage"
+	GetPackagesRequest                                    = "GetPackages"
+	GetServiceInstanceRelationshipSharedSpacesRequest     = "GetServiceInstanceRelationshipSharedSpaces"
 	GetSpaceRelationshipIsolationSegmentRequest           = "GetSpaceRelationshipIsolationSegmentRequest"
+	GetTasksRequest                                       = "GetTasks"
 	PatchSpaceRelationshipIsolationSegmentRequest         = "PatchSpaceRelationshipIsolationSegmentRequest"
 	PostApplicationRequest                                = "PostApplicationRequest"
 	PostAppTasksRequest                                   = "PostAppTasks"
 	PostIsolationSegmentRelationshipOrganizationsRequest  = "PostIsolationSegmentRelationshipOrganizations"
 	PostIsolationSegmentsRequest                          = "PostIsolationSegments"
 	PostPackageRequest                                    = "PostPackageRequest"
+	PostServiceInstanceRelationshipSharedSpacesRequest    = "PostServiceInstanceRelationshipSharedSpaces"
 	PutTaskCancelRequest                                  = "PutTaskCancelRequest"
 )
 
@@ -36,6 +42,7 @@ const (
 	IsolationSegmentsResource = "isolation_segments"
 	OrgsResource              = "organizations"
 	PackagesResource          = "packages"
+	ServiceInstancesResource  = "service_instances"
 	SpaceResource             = "spaces"
 	TasksResource             = "tasks"
 )
@@ -45,10 +52,13 @@ var APIRoutes = []Route{
 	{Path: "/", Method: http.MethodGet, Name: GetAppsRequest, Resource: AppsResource},
 	{Path: "/", Method: http.MethodGet, Name: GetIsolationSegmentsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/", Method: http.MethodGet, Name: GetOrgsRequest, Resource: OrgsResource},
+	{Path: "/", Method: http.MethodGet, Name: GetPackagesRequest, Resource: PackagesResource},
+	{Path: "/", Method: http.MethodGet, Name: GetTasksRequest, Resource: TasksResource},
 	{Path: "/", Method: http.MethodPost, Name: PostApplicationRequest, Resource: AppsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostIsolationSegmentsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostPackageRequest, Resource: PackagesResource},
 	{Path: "/:guid", Method: http.MethodDelete, Name: DeleteIsolationSegmentRequest, Resource: IsolationSegmentsResource},
+	{Path: "/:guid", Method: http.MethodDelete, Name: DeletePackageRequest, Resource: PackagesResource},
 	{Path: "/:guid", Method: http.MethodGet, Name: GetIsolationSegmentRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:guid", Method: http.MethodGet, Name: GetPackageRequest, Resource: PackagesResource},
 	{Path: "/:guid/cancel", Method: http.MethodPut, Name: PutTaskCancelRequest, Resource: TasksResource},
@@ -58,6 +68,9 @@ var APIRoutes = []Route{
 	{Path: "/:guid/relationships/isolation_segment", Method: http.MethodPatch, Name: PatchSpaceRelationshipIsolationSegmentRequest, Resource: SpaceResource},
 	{Path: "/:guid/relationships/organizations", Method: http.MethodPost, Name: PostIsolationSegmentRelationshipOrganizationsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:guid/relationships/organizations/:org_guid", Method: http.MethodDelete, Name: DeleteIsolationSegmentRelationshipOrganizationRequest, Resource: IsolationSegmentsResource},
+	{Path: "/:guid/relationships/shared_spaces", Method: http.MethodGet, Name: GetServiceInstanceRelationshipSharedSpacesRequest, Resource: ServiceInstancesResource},
+	{Path: "/:guid/relationships/shared_spaces", Method: http.MethodPost, Name: PostServiceInstanceRelationshipSharedSpacesRequest, Resource: ServiceInstancesResource},
+	{Path: "/:guid/relationships/shared_spaces/:space_guid", Method: http.MethodDelete, Name: DeleteServiceInstanceRelationshipSharedSpaceRequest, Resource: ServiceInstancesResource},
 	{Path: "/:guid/tasks", Method: http.MethodGet, Name: GetAppTasksRequest, Resource: AppsResource},
 	{Path: "/:guid/tasks", Method: http.MethodPost, Name: PostAppTasksRequest, Resource: AppsResource},
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 
@@ -107,6 +108,77 @@ var _ = Describe("Package", func() {
 		})
 	})
 
+	Describe("GetPackages", func() {
+		BeforeEach(func() {
+			response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/packages?space_guids=some-space-guid&states=FAILED,EXPIRED&page=2"
+		}
+	},
+	"resources": [
+		{
+			"guid": "package-guid-1",
+			"state": "FAILED",
+			"created_at": "2017-01-02T03:04:05Z"
+		}
+	]
+}`, server.URL())
+			response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"guid": "package-guid-2",
+			"state": "EXPIRED"
+		}
+	]
+}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/packages", "space_guids=some-space-guid&states=FAILED,EXPIRED"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/packages", "space_guids=some-space-guid&states=FAILED,EXPIRED&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns the queried packages and all warnings", func() {
+			pkgs, warnings, err := client.GetPackages(url.Values{
+				SpaceGUIDFilter: []string{"some-space-guid"},
+				StatesFilter:    []string{"FAILED,EXPIRED"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pkgs).To(ConsistOf(
+				Package{GUID: "package-guid-1", State: PackageStateFailed, CreatedAt: "2017-01-02T03:04:05Z"},
+				Package{GUID: "package-guid-2", State: PackageStateExpired},
+			))
+			Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+		})
+	})
+
+	Describe("DeletePackage", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v3/packages/some-pkg-guid"),
+					RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("deletes the package and returns all warnings", func() {
+			warnings, err := client.DeletePackage("some-pkg-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
 	Describe("CreatePackage", func() {
 		Context("when the package successfully is created", func() {
 			BeforeEach(func() {
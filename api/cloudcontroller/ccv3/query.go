@@ -9,4 +9,6 @@ const (
 	OrganizationGUIDFilter = "organization_guids"
 	// SpaceGUIDFilter is a query paramater for listing objects by Space GUID.
 	SpaceGUIDFilter = "space_guids"
+	// StatesFilter is a query paramater for listing objects by state.
+	StatesFilter = "states"
 )
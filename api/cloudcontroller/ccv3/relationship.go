@@ -176,3 +176,66 @@ func (client *Client) GetOrganizationDefaultIsolationSegment(orgGUID string) (Re
 	err = client.connection.Make(request, &response)
 	return relationship, response.Warnings, err
 }
+
+// ShareServiceInstanceToSpaces shares the service instance with the provided
+// spaces, in addition to the spaces it is already shared with.
+func (client *Client) ShareServiceInstanceToSpaces(serviceInstanceGUID string, spaceGUIDs []string) (RelationshipList, Warnings, error) {
+	body, err := json.Marshal(RelationshipList{GUIDs: spaceGUIDs})
+	if err != nil {
+		return RelationshipList{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostServiceInstanceRelationshipSharedSpacesRequest,
+		URIParams:   internal.Params{"guid": serviceInstanceGUID},
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return RelationshipList{}, nil, err
+	}
+
+	var relationships RelationshipList
+	response := cloudcontroller.Response{
+		Result: &relationships,
+	}
+
+	err = client.connection.Make(request, &response)
+	return relationships, response.Warnings, err
+}
+
+// UnshareServiceInstanceFromSpace stops sharing the service instance with the
+// provided space.
+func (client *Client) UnshareServiceInstanceFromSpace(serviceInstanceGUID string, spaceGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceInstanceRelationshipSharedSpaceRequest,
+		URIParams:   internal.Params{"guid": serviceInstanceGUID, "space_guid": spaceGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
+
+// GetServiceInstanceSharedSpaces returns the spaces the service instance is
+// shared with.
+func (client *Client) GetServiceInstanceSharedSpaces(serviceInstanceGUID string) (RelationshipList, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceInstanceRelationshipSharedSpacesRequest,
+		URIParams:   internal.Params{"guid": serviceInstanceGUID},
+	})
+	if err != nil {
+		return RelationshipList{}, nil, err
+	}
+
+	var relationships RelationshipList
+	response := cloudcontroller.Response{
+		Result: &relationships,
+	}
+
+	err = client.connection.Make(request, &response)
+	return relationships, response.Warnings, err
+}
@@ -300,4 +300,120 @@ var _ = Describe("Relationship", func() {
 			})
 		})
 	})
+
+	Describe("ShareServiceInstanceToSpaces", func() {
+		Context("when the share is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"data": [
+						{
+							"guid": "some-space-guid"
+						},
+						{
+							"guid": "other-space-guid"
+						}
+					]
+				}`
+
+				requestBody := map[string][]map[string]string{
+					"data": {{"guid": "other-space-guid"}},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_instances/some-instance-guid/relationships/shared_spaces"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns all the shared spaces and warnings", func() {
+				relationships, warnings, err := client.ShareServiceInstanceToSpaces("some-instance-guid", []string{"other-space-guid"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(relationships).To(Equal(RelationshipList{
+					GUIDs: []string{"some-space-guid", "other-space-guid"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 390002,
+							"detail": "Service instances cannot be shared into the space where they were created.",
+							"title": "CF-InvalidServiceInstanceSharing"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_instances/some-instance-guid/relationships/shared_spaces"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.ShareServiceInstanceToSpaces("some-instance-guid", []string{"other-space-guid"})
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "Service instances cannot be shared into the space where they were created.",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UnshareServiceInstanceFromSpace", func() {
+		Context("when the unshare is successful", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/service_instances/some-instance-guid/relationships/shared_spaces/other-space-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns warnings", func() {
+				warnings, err := client.UnshareServiceInstanceFromSpace("some-instance-guid", "other-space-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(server.ReceivedRequests()).To(HaveLen(3))
+			})
+		})
+	})
+
+	Describe("GetServiceInstanceSharedSpaces", func() {
+		Context("when getting the shared spaces is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"data": [
+						{
+							"guid": "other-space-guid"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_instances/some-instance-guid/relationships/shared_spaces"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the shared spaces and warnings", func() {
+				relationships, warnings, err := client.GetServiceInstanceSharedSpaces("some-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(relationships).To(Equal(RelationshipList{
+					GUIDs: []string{"other-space-guid"},
+				}))
+			})
+		})
+	})
 })
@@ -80,6 +80,33 @@ func (client *Client) GetApplicationTasks(appGUID string, query url.Values) ([]T
 	return fullTasksList, warnings, err
 }
 
+// GetTasks returns a list of tasks across all applications visible to the
+// user. Results can be filtered by providing URL queries.
+func (client *Client) GetTasks(query url.Values) ([]Task, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetTasksRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullTasksList []Task
+	warnings, err := client.paginate(request, Task{}, func(item interface{}) error {
+		if task, ok := item.(Task); ok {
+			fullTasksList = append(fullTasksList, task)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Task{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullTasksList, warnings, err
+}
+
 // UpdateTask cancels a task.
 func (client *Client) UpdateTask(taskGUID string) (Task, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -331,6 +331,122 @@ var _ = Describe("Task", func() {
 		})
 	})
 
+	Describe("GetTasks", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+					"pagination": {
+						"next": {
+							"href": "%s/v3/tasks?space_guids=some-space-guid&states=RUNNING&page=2"
+						}
+					},
+					"resources": [
+						{
+							"guid": "task-1-guid",
+							"sequence_id": 1,
+							"name": "task-1",
+							"command": "some-command",
+							"state": "RUNNING",
+							"memory_in_mb": 256
+						}
+					]
+				}`, server.URL())
+				response2 := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "task-2-guid",
+							"sequence_id": 2,
+							"name": "task-2",
+							"command": "some-command",
+							"state": "RUNNING",
+							"memory_in_mb": 512
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/tasks", "space_guids=some-space-guid&states=RUNNING"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/tasks", "space_guids=some-space-guid&states=RUNNING&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns all the tasks and all warnings", func() {
+				tasks, warnings, err := client.GetTasks(url.Values{
+					SpaceGUIDFilter: []string{"some-space-guid"},
+					StatesFilter:    []string{"RUNNING"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(tasks).To(ConsistOf(
+					Task{
+						GUID:       "task-1-guid",
+						SequenceID: 1,
+						Name:       "task-1",
+						Command:    "some-command",
+						State:      "RUNNING",
+						MemoryInMB: 256,
+					},
+					Task{
+						GUID:       "task-2-guid",
+						SequenceID: 2,
+						Name:       "task-2",
+						Command:    "some-command",
+						State:      "RUNNING",
+						MemoryInMB: 512,
+					},
+				))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid: command presence",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/tasks"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the errors and all warnings", func() {
+				_, warnings, err := client.GetTasks(nil)
+				Expect(err).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						[]ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "The request is semantically invalid: command presence",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
 	Describe("UpdateTask", func() {
 		Context("when the request succeeds", func() {
 			BeforeEach(func() {
@@ -7,6 +7,7 @@ import (
 )
 
 const (
+	GetUsersRequest     = "GetUsers"
 	PostUserRequest     = "CreateUser"
 	RefreshTokenRequest = "RefreshToken"
 )
@@ -14,6 +15,7 @@ const (
 // Routes is a list of routes used by the rata library to construct request
 // URLs.
 var Routes = rata.Routes{
+	{Path: "/Users", Method: http.MethodGet, Name: GetUsersRequest},
 	{Path: "/Users", Method: http.MethodPost, Name: PostUserRequest},
 	{Path: "/oauth/token", Method: http.MethodPost, Name: RefreshTokenRequest},
 }
@@ -3,7 +3,9 @@ package uaa
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
 
 	"code.cloudfoundry.org/cli/api/uaa/internal"
 )
@@ -37,6 +39,13 @@ type newUserResponse struct {
 	ID string `json:"id"`
 }
 
+// usersResponse represents the HTTP JSON response of a user search.
+type usersResponse struct {
+	Resources []struct {
+		ID string `json:"id"`
+	} `json:"resources"`
+}
+
 // CreateUser creates a new UAA user account with the provided password.
 func (client *Client) CreateUser(user string, password string, origin string) (User, error) {
 	userRequest := newUserRequestBody{
@@ -83,3 +92,34 @@ func (client *Client) CreateUser(user string, password string, origin string) (U
 
 	return User{ID: userResponse.ID}, nil
 }
+
+// GetUsers returns the UAA user accounts with the provided username and
+// origin. There is at most one such account.
+func (client *Client) GetUsers(user string, origin string) ([]User, error) {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetUsersRequest,
+		Query: url.Values{
+			"filter":     {fmt.Sprintf(`userName eq %q and origin eq %q`, user, origin)},
+			"attributes": {"id"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var usersResponse usersResponse
+	response := Response{
+		Result: &usersResponse,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []User
+	for _, resource := range usersResponse.Resources {
+		users = append(users, User{ID: resource.ID})
+	}
+	return users, nil
+}
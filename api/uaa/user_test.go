@@ -92,4 +92,45 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("GetUsers", func() {
+		Context("when the user exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"resources": [
+						{ "id": "some-user-id" }
+					],
+					"totalResults": 1
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/Users", `filter=userName+eq+%22some-user%22+and+origin+eq+%22ldap%22&attributes=id`),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("returns the user", func() {
+				users, err := client.GetUsers("some-user", "ldap")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(users).To(Equal([]User{{ID: "some-user-id"}}))
+			})
+		})
+
+		Context("when the user does not exist", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/Users"),
+						RespondWith(http.StatusOK, `{"resources": [], "totalResults": 0}`),
+					))
+			})
+
+			It("returns no users", func() {
+				users, err := client.GetUsers("some-user", "uaa")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(users).To(BeEmpty())
+			})
+		})
+	})
+
 })
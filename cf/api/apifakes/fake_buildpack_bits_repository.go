@@ -10,7 +10,7 @@ import (
 )
 
 type FakeBuildpackBitsRepository struct {
-	UploadBuildpackStub        func(buildpack models.Buildpack, buildpackFile *os.File, zipFileName string) error
+	UploadBuildpackStub        func(buildpack models.Buildpack, buildpackFile *os.File, zipFileName string) (uploaded bool, err error)
 	uploadBuildpackMutex       sync.RWMutex
 	uploadBuildpackArgsForCall []struct {
 		buildpack     models.Buildpack
@@ -18,7 +18,8 @@ type FakeBuildpackBitsRepository struct {
 		zipFileName   string
 	}
 	uploadBuildpackReturns struct {
-		result1 error
+		result1 bool
+		result2 error
 	}
 	CreateBuildpackZipFileStub        func(buildpackPath string) (*os.File, string, error)
 	createBuildpackZipFileMutex       sync.RWMutex
@@ -34,7 +35,7 @@ type FakeBuildpackBitsRepository struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeBuildpackBitsRepository) UploadBuildpack(buildpack models.Buildpack, buildpackFile *os.File, zipFileName string) error {
+func (fake *FakeBuildpackBitsRepository) UploadBuildpack(buildpack models.Buildpack, buildpackFile *os.File, zipFileName string) (uploaded bool, err error) {
 	fake.uploadBuildpackMutex.Lock()
 	fake.uploadBuildpackArgsForCall = append(fake.uploadBuildpackArgsForCall, struct {
 		buildpack     models.Buildpack
@@ -46,7 +47,7 @@ func (fake *FakeBuildpackBitsRepository) UploadBuildpack(buildpack models.Buildp
 	if fake.UploadBuildpackStub != nil {
 		return fake.UploadBuildpackStub(buildpack, buildpackFile, zipFileName)
 	} else {
-		return fake.uploadBuildpackReturns.result1
+		return fake.uploadBuildpackReturns.result1, fake.uploadBuildpackReturns.result2
 	}
 }
 
@@ -62,11 +63,12 @@ func (fake *FakeBuildpackBitsRepository) UploadBuildpackArgsForCall(i int) (mode
 	return fake.uploadBuildpackArgsForCall[i].buildpack, fake.uploadBuildpackArgsForCall[i].buildpackFile, fake.uploadBuildpackArgsForCall[i].zipFileName
 }
 
-func (fake *FakeBuildpackBitsRepository) UploadBuildpackReturns(result1 error) {
+func (fake *FakeBuildpackBitsRepository) UploadBuildpackReturns(result1 bool, result2 error) {
 	fake.UploadBuildpackStub = nil
 	fake.uploadBuildpackReturns = struct {
-		result1 error
-	}{result1}
+		result1 bool
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeBuildpackBitsRepository) CreateBuildpackZipFile(buildpackPath string) (*os.File, string, error) {
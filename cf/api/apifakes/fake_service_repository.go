@@ -81,6 +81,15 @@ type FakeServiceRepository struct {
 		result1 models.ServiceInstance
 		result2 error
 	}
+	GetSharedSpaceGUIDsStub        func(instanceGUID string) (spaceGUIDs []string, apiErr error)
+	getSharedSpaceGUIDsMutex       sync.RWMutex
+	getSharedSpaceGUIDsArgsForCall []struct {
+		instanceGUID string
+	}
+	getSharedSpaceGUIDsReturns struct {
+		result1 []string
+		result2 error
+	}
 	PurgeServiceInstanceStub        func(instance models.ServiceInstance) error
 	purgeServiceInstanceMutex       sync.RWMutex
 	purgeServiceInstanceArgsForCall []struct {
@@ -443,6 +452,40 @@ func (fake *FakeServiceRepository) FindInstanceByNameReturns(result1 models.Serv
 	}{result1, result2}
 }
 
+func (fake *FakeServiceRepository) GetSharedSpaceGUIDs(instanceGUID string) (spaceGUIDs []string, apiErr error) {
+	fake.getSharedSpaceGUIDsMutex.Lock()
+	fake.getSharedSpaceGUIDsArgsForCall = append(fake.getSharedSpaceGUIDsArgsForCall, struct {
+		instanceGUID string
+	}{instanceGUID})
+	fake.recordInvocation("GetSharedSpaceGUIDs", []interface{}{instanceGUID})
+	fake.getSharedSpaceGUIDsMutex.Unlock()
+	if fake.GetSharedSpaceGUIDsStub != nil {
+		return fake.GetSharedSpaceGUIDsStub(instanceGUID)
+	} else {
+		return fake.getSharedSpaceGUIDsReturns.result1, fake.getSharedSpaceGUIDsReturns.result2
+	}
+}
+
+func (fake *FakeServiceRepository) GetSharedSpaceGUIDsCallCount() int {
+	fake.getSharedSpaceGUIDsMutex.RLock()
+	defer fake.getSharedSpaceGUIDsMutex.RUnlock()
+	return len(fake.getSharedSpaceGUIDsArgsForCall)
+}
+
+func (fake *FakeServiceRepository) GetSharedSpaceGUIDsArgsForCall(i int) string {
+	fake.getSharedSpaceGUIDsMutex.RLock()
+	defer fake.getSharedSpaceGUIDsMutex.RUnlock()
+	return fake.getSharedSpaceGUIDsArgsForCall[i].instanceGUID
+}
+
+func (fake *FakeServiceRepository) GetSharedSpaceGUIDsReturns(result1 []string, result2 error) {
+	fake.GetSharedSpaceGUIDsStub = nil
+	fake.getSharedSpaceGUIDsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeServiceRepository) PurgeServiceInstance(instance models.ServiceInstance) error {
 	fake.purgeServiceInstanceMutex.Lock()
 	fake.purgeServiceInstanceArgsForCall = append(fake.purgeServiceInstanceArgsForCall, struct {
@@ -820,6 +863,8 @@ func (fake *FakeServiceRepository) Invocations() map[string][][]interface{} {
 	defer fake.getServiceOfferingsForSpaceMutex.RUnlock()
 	fake.findInstanceByNameMutex.RLock()
 	defer fake.findInstanceByNameMutex.RUnlock()
+	fake.getSharedSpaceGUIDsMutex.RLock()
+	defer fake.getSharedSpaceGUIDsMutex.RUnlock()
 	fake.purgeServiceInstanceMutex.RLock()
 	defer fake.purgeServiceInstanceMutex.RUnlock()
 	fake.createServiceInstanceMutex.RLock()
@@ -13,6 +13,8 @@ type OldFakeBuildpackRepository struct {
 	FindByNameBuildpack   models.Buildpack
 	FindByNameAPIResponse error
 
+	FindByNameAndStackStack string
+
 	CreateBuildpackExists bool
 	CreateBuildpack       models.Buildpack
 	CreateAPIResponse     error
@@ -47,12 +49,17 @@ func (repo *OldFakeBuildpackRepository) FindByName(name string) (buildpack model
 	return
 }
 
-func (repo *OldFakeBuildpackRepository) Create(name string, position *int, enabled *bool, locked *bool) (createdBuildpack models.Buildpack, apiErr error) {
+func (repo *OldFakeBuildpackRepository) FindByNameAndStack(name, stack string) (buildpack models.Buildpack, apiErr error) {
+	repo.FindByNameAndStackStack = stack
+	return repo.FindByName(name)
+}
+
+func (repo *OldFakeBuildpackRepository) Create(name string, position *int, enabled *bool, locked *bool, stack string) (createdBuildpack models.Buildpack, apiErr error) {
 	if repo.CreateBuildpackExists {
 		return repo.CreateBuildpack, errors.NewHTTPError(400, errors.BuildpackNameTaken, "Buildpack already exists")
 	}
 
-	repo.CreateBuildpack = models.Buildpack{Name: name, Position: position, Enabled: enabled, Locked: locked}
+	repo.CreateBuildpack = models.Buildpack{Name: name, Position: position, Enabled: enabled, Locked: locked, Stack: stack}
 	return repo.CreateBuildpack, repo.CreateAPIResponse
 }
 
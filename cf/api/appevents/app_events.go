@@ -2,6 +2,9 @@ package appevents
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/api/resources"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -13,6 +16,19 @@ import (
 
 type Repository interface {
 	RecentEvents(appGUID string, limit int64) ([]models.EventFields, error)
+	ListEvents(filter EventFilter, cb func(models.EventFields) bool) error
+}
+
+// EventFilter narrows the events returned by ListEvents. Zero values do not
+// filter.
+type EventFilter struct {
+	Actee            string
+	Actor            string
+	OrganizationGUID string
+	SpaceGUID        string
+	Types            []string
+	Since            time.Time
+	Until            time.Time
 }
 
 type CloudControllerAppEventsRepository struct {
@@ -50,3 +66,48 @@ func (repo CloudControllerAppEventsRepository) listEvents(appGUID string, limit
 			return cb(resource.(resources.EventResource).ToFields())
 		})
 }
+
+// ListEvents calls cb for every event matching the filter, oldest first,
+// following the pagination of the events endpoint until cb returns false.
+func (repo CloudControllerAppEventsRepository) ListEvents(filter EventFilter, cb func(models.EventFields) bool) error {
+	queries := []string{}
+	if filter.Actee != "" {
+		queries = append(queries, "actee:"+filter.Actee)
+	}
+	if filter.Actor != "" {
+		queries = append(queries, "actor:"+filter.Actor)
+	}
+	if filter.OrganizationGUID != "" {
+		queries = append(queries, "organization_guid:"+filter.OrganizationGUID)
+	}
+	if filter.SpaceGUID != "" {
+		queries = append(queries, "space_guid:"+filter.SpaceGUID)
+	}
+	switch len(filter.Types) {
+	case 0:
+	case 1:
+		queries = append(queries, "type:"+filter.Types[0])
+	default:
+		queries = append(queries, "type IN "+strings.Join(filter.Types, ","))
+	}
+	if !filter.Since.IsZero() {
+		queries = append(queries, "timestamp>="+filter.Since.UTC().Format(time.RFC3339))
+	}
+	if !filter.Until.IsZero() {
+		queries = append(queries, "timestamp<="+filter.Until.UTC().Format(time.RFC3339))
+	}
+
+	path := "/v2/events?results-per-page=100&order-direction=asc"
+	for _, query := range queries {
+		path += "&q=" + url.QueryEscape(query)
+	}
+
+	return repo.gateway.ListPaginatedResources(
+		repo.config.APIEndpoint(),
+		path,
+		resources.EventResourceNewV2{},
+
+		func(resource interface{}) bool {
+			return cb(resource.(resources.EventResource).ToFields())
+		})
+}
@@ -14,6 +14,8 @@ import (
 	"code.cloudfoundry.org/cli/cf/trace/tracefakes"
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
 	testnet "code.cloudfoundry.org/cli/util/testhelpers/net"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -75,6 +77,73 @@ var _ = Describe("App Events Repo", func() {
 			}))
 		})
 	})
+
+	Describe("list events", func() {
+		It("follows every page of events matching the filter", func() {
+			setupTestServer(filteredEventsFirstPageRequest, filteredEventsSecondPageRequest)
+
+			since, err := time.Parse(eventTimestampFormat, "2014-01-01T00:00:00+00:00")
+			Expect(err).ToNot(HaveOccurred())
+
+			events := []models.EventFields{}
+			err = repo.ListEvents(EventFilter{
+				OrganizationGUID: "my-org-guid",
+				Types:            []string{"audit.app.update", "audit.service_instance.delete"},
+				Since:            since,
+			}, func(event models.EventFields) bool {
+				events = append(events, event)
+				return true
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handler).To(HaveAllRequestsCalled())
+
+			Expect(events).To(HaveLen(2))
+			Expect(events[0].GUID).To(Equal("event-1-guid"))
+			Expect(events[0].ActorType).To(Equal("user"))
+			Expect(events[0].ActeeType).To(Equal("app"))
+			Expect(events[0].ActeeName).To(Equal("dora"))
+			Expect(events[0].SpaceGUID).To(Equal("my-space-guid"))
+			Expect(events[0].OrganizationGUID).To(Equal("my-org-guid"))
+			Expect(events[1].GUID).To(Equal("event-2-guid"))
+			Expect(events[1].Name).To(Equal("audit.service_instance.delete"))
+		})
+
+		It("filters by actee", func() {
+			setupTestServer(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/events?q=actee%3Amy-app-guid&order-direction=asc&results-per-page=100",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{"resources": []}`},
+			})
+
+			err := repo.ListEvents(EventFilter{Actee: "my-app-guid"}, func(models.EventFields) bool { return true })
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handler).To(HaveAllRequestsCalled())
+		})
+
+		It("filters by actor", func() {
+			setupTestServer(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/events?q=actor%3Auser-guid&order-direction=asc&results-per-page=100",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{"resources": []}`},
+			})
+
+			err := repo.ListEvents(EventFilter{Actor: "user-guid"}, func(models.EventFields) bool { return true })
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handler).To(HaveAllRequestsCalled())
+		})
+
+		It("stops when the callback returns false", func() {
+			setupTestServer(filteredEventsFirstPageRequest)
+
+			count := 0
+			err := repo.ListEvents(EventFilter{OrganizationGUID: "my-org-guid"}, func(models.EventFields) bool {
+				count++
+				return false
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+	})
 })
 
 const eventTimestampFormat = "2006-01-02T15:04:05-07:00"
@@ -132,3 +201,64 @@ var eventsRequest = testnet.TestRequest{
 			}
 		  ]
 		}`}}
+
+var filteredEventsFirstPageRequest = testnet.TestRequest{
+	Method: "GET",
+	Path:   "/v2/events?q=organization_guid%3Amy-org-guid&order-direction=asc&results-per-page=100",
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `{
+		  "total_results": 2,
+		  "total_pages": 2,
+		  "next_url": "/v2/events?q=organization_guid%3Amy-org-guid&page=2",
+		  "resources": [
+			{
+			  "metadata": {
+				"guid": "event-1-guid"
+			  },
+			  "entity": {
+				"type": "audit.app.update",
+				"timestamp": "2014-01-21T00:20:11+00:00",
+				"actor": "user-guid",
+				"actor_type": "user",
+				"actor_name": "somebody@pivotallabs.com",
+				"actee": "app-guid",
+				"actee_type": "app",
+				"actee_name": "dora",
+				"space_guid": "my-space-guid",
+				"organization_guid": "my-org-guid",
+				"metadata": {}
+			  }
+			}
+		  ]
+		}`}}
+
+var filteredEventsSecondPageRequest = testnet.TestRequest{
+	Method: "GET",
+	Path:   "/v2/events?q=organization_guid%3Amy-org-guid&page=2",
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `{
+		  "total_results": 2,
+		  "total_pages": 2,
+		  "resources": [
+			{
+			  "metadata": {
+				"guid": "event-2-guid"
+			  },
+			  "entity": {
+				"type": "audit.service_instance.delete",
+				"timestamp": "2014-01-22T00:20:11+00:00",
+				"actor": "user-guid",
+				"actor_type": "user",
+				"actor_name": "somebody@pivotallabs.com",
+				"actee": "instance-guid",
+				"actee_type": "service_instance",
+				"actee_name": "my-db",
+				"space_guid": "my-space-guid",
+				"organization_guid": "my-org-guid",
+				"metadata": {}
+			  }
+			}
+		  ]
+		}`}}
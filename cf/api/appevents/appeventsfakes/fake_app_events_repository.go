@@ -19,6 +19,15 @@ type FakeAppEventsRepository struct {
 		result1 []models.EventFields
 		result2 error
 	}
+	ListEventsStub        func(filter appevents.EventFilter, cb func(models.EventFields) bool) error
+	listEventsMutex       sync.RWMutex
+	listEventsArgsForCall []struct {
+		filter appevents.EventFilter
+		cb     func(models.EventFields) bool
+	}
+	listEventsReturns struct {
+		result1 error
+	}
 }
 
 func (fake *FakeAppEventsRepository) RecentEvents(appGUID string, limit int64) ([]models.EventFields, error) {
@@ -55,4 +64,37 @@ func (fake *FakeAppEventsRepository) RecentEventsReturns(result1 []models.EventF
 	}{result1, result2}
 }
 
+func (fake *FakeAppEventsRepository) ListEvents(filter appevents.EventFilter, cb func(models.EventFields) bool) error {
+	fake.listEventsMutex.Lock()
+	fake.listEventsArgsForCall = append(fake.listEventsArgsForCall, struct {
+		filter appevents.EventFilter
+		cb     func(models.EventFields) bool
+	}{filter, cb})
+	fake.listEventsMutex.Unlock()
+	if fake.ListEventsStub != nil {
+		return fake.ListEventsStub(filter, cb)
+	} else {
+		return fake.listEventsReturns.result1
+	}
+}
+
+func (fake *FakeAppEventsRepository) ListEventsCallCount() int {
+	fake.listEventsMutex.RLock()
+	defer fake.listEventsMutex.RUnlock()
+	return len(fake.listEventsArgsForCall)
+}
+
+func (fake *FakeAppEventsRepository) ListEventsArgsForCall(i int) (appevents.EventFilter, func(models.EventFields) bool) {
+	fake.listEventsMutex.RLock()
+	defer fake.listEventsMutex.RUnlock()
+	return fake.listEventsArgsForCall[i].filter, fake.listEventsArgsForCall[i].cb
+}
+
+func (fake *FakeAppEventsRepository) ListEventsReturns(result1 error) {
+	fake.ListEventsStub = nil
+	fake.listEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
 var _ appevents.Repository = new(FakeAppEventsRepository)
@@ -19,12 +19,29 @@ type FakeRepository struct {
 		result1 []models.EventFields
 		result2 error
 	}
+	recentEventsReturnsOnCall map[int]struct {
+		result1 []models.EventFields
+		result2 error
+	}
+	ListEventsStub        func(filter appevents.EventFilter, cb func(models.EventFields) bool) error
+	listEventsMutex       sync.RWMutex
+	listEventsArgsForCall []struct {
+		filter appevents.EventFilter
+		cb     func(models.EventFields) bool
+	}
+	listEventsReturns struct {
+		result1 error
+	}
+	listEventsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
 func (fake *FakeRepository) RecentEvents(appGUID string, limit int64) ([]models.EventFields, error) {
 	fake.recentEventsMutex.Lock()
+	ret, specificReturn := fake.recentEventsReturnsOnCall[len(fake.recentEventsArgsForCall)]
 	fake.recentEventsArgsForCall = append(fake.recentEventsArgsForCall, struct {
 		appGUID string
 		limit   int64
@@ -33,9 +50,11 @@ func (fake *FakeRepository) RecentEvents(appGUID string, limit int64) ([]models.
 	fake.recentEventsMutex.Unlock()
 	if fake.RecentEventsStub != nil {
 		return fake.RecentEventsStub(appGUID, limit)
-	} else {
-		return fake.recentEventsReturns.result1, fake.recentEventsReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.recentEventsReturns.result1, fake.recentEventsReturns.result2
 }
 
 func (fake *FakeRepository) RecentEventsCallCount() int {
@@ -58,11 +77,76 @@ func (fake *FakeRepository) RecentEventsReturns(result1 []models.EventFields, re
 	}{result1, result2}
 }
 
+func (fake *FakeRepository) RecentEventsReturnsOnCall(i int, result1 []models.EventFields, result2 error) {
+	fake.RecentEventsStub = nil
+	if fake.recentEventsReturnsOnCall == nil {
+		fake.recentEventsReturnsOnCall = make(map[int]struct {
+			result1 []models.EventFields
+			result2 error
+		})
+	}
+	fake.recentEventsReturnsOnCall[i] = struct {
+		result1 []models.EventFields
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListEvents(filter appevents.EventFilter, cb func(models.EventFields) bool) error {
+	fake.listEventsMutex.Lock()
+	ret, specificReturn := fake.listEventsReturnsOnCall[len(fake.listEventsArgsForCall)]
+	fake.listEventsArgsForCall = append(fake.listEventsArgsForCall, struct {
+		filter appevents.EventFilter
+		cb     func(models.EventFields) bool
+	}{filter, cb})
+	fake.recordInvocation("ListEvents", []interface{}{filter, cb})
+	fake.listEventsMutex.Unlock()
+	if fake.ListEventsStub != nil {
+		return fake.ListEventsStub(filter, cb)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.listEventsReturns.result1
+}
+
+func (fake *FakeRepository) ListEventsCallCount() int {
+	fake.listEventsMutex.RLock()
+	defer fake.listEventsMutex.RUnlock()
+	return len(fake.listEventsArgsForCall)
+}
+
+func (fake *FakeRepository) ListEventsArgsForCall(i int) (appevents.EventFilter, func(models.EventFields) bool) {
+	fake.listEventsMutex.RLock()
+	defer fake.listEventsMutex.RUnlock()
+	return fake.listEventsArgsForCall[i].filter, fake.listEventsArgsForCall[i].cb
+}
+
+func (fake *FakeRepository) ListEventsReturns(result1 error) {
+	fake.ListEventsStub = nil
+	fake.listEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) ListEventsReturnsOnCall(i int, result1 error) {
+	fake.ListEventsStub = nil
+	if fake.listEventsReturnsOnCall == nil {
+		fake.listEventsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.listEventsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.recentEventsMutex.RLock()
 	defer fake.recentEventsMutex.RUnlock()
+	fake.listEventsMutex.RLock()
+	defer fake.listEventsMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -2,8 +2,11 @@ package api
 
 import (
 	"archive/zip"
+	"crypto/sha1"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -22,13 +25,14 @@ import (
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/util/downloader"
 	"code.cloudfoundry.org/gofileutils/fileutils"
 )
 
 //go:generate counterfeiter . BuildpackBitsRepository
 
 type BuildpackBitsRepository interface {
-	UploadBuildpack(buildpack models.Buildpack, buildpackFile *os.File, zipFileName string) error
+	UploadBuildpack(buildpack models.Buildpack, buildpackFile *os.File, zipFileName string) (uploaded bool, err error)
 	CreateBuildpackZipFile(buildpackPath string) (*os.File, string, error)
 }
 
@@ -200,62 +204,98 @@ func isWebURL(path string) bool {
 }
 
 func (repo CloudControllerBuildpackBitsRepository) downloadBuildpack(url string, cb func(*os.File, error)) {
-	fileutils.TempFile("buildpack-download", func(tempfile *os.File, err error) {
-		if err != nil {
-			cb(nil, err)
-			return
-		}
-
-		var certPool *x509.CertPool
-		if len(repo.TrustedCerts) > 0 {
-			certPool = x509.NewCertPool()
-			for _, tlsCert := range repo.TrustedCerts {
-				cert, _ := x509.ParseCertificate(tlsCert.Certificate[0])
-				certPool.AddCert(cert)
-			}
-		}
-
-		client := &http.Client{
-			Transport: &http.Transport{
-				Dial:            (&gonet.Dialer{Timeout: 5 * time.Second}).Dial,
-				TLSClientConfig: &tls.Config{RootCAs: certPool},
-				Proxy:           http.ProxyFromEnvironment,
-			},
+	downloadDir, err := ioutil.TempDir("", "buildpack-download")
+	if err != nil {
+		cb(nil, err)
+		return
+	}
+	defer os.RemoveAll(downloadDir)
+
+	var certPool *x509.CertPool
+	if len(repo.TrustedCerts) > 0 {
+		certPool = x509.NewCertPool()
+		for _, tlsCert := range repo.TrustedCerts {
+			cert, _ := x509.ParseCertificate(tlsCert.Certificate[0])
+			certPool.AddCert(cert)
 		}
+	}
 
-		response, err := client.Get(url)
-		if err != nil {
-			cb(nil, err)
-			return
-		}
-		defer response.Body.Close()
+	buildpackDownloader := downloader.NewDownloaderWithTransport(downloadDir, &http.Transport{
+		Dial:            (&gonet.Dialer{Timeout: 5 * time.Second}).Dial,
+		TLSClientConfig: &tls.Config{RootCAs: certPool},
+		Proxy:           http.ProxyFromEnvironment,
+	})
 
-		_, err = io.Copy(tempfile, response.Body)
-		if err != nil {
-			cb(nil, err)
-			return
-		}
+	_, filename, err := buildpackDownloader.DownloadFile(url)
+	if err != nil {
+		cb(nil, err)
+		return
+	}
 
-		_, err = tempfile.Seek(0, 0)
-		if err != nil {
-			cb(nil, err)
-			return
-		}
+	downloadFile, err := os.Open(filepath.Join(downloadDir, filename))
+	if err != nil {
+		cb(nil, err)
+		return
+	}
+	defer downloadFile.Close()
 
-		cb(tempfile, nil)
-	})
+	cb(downloadFile, nil)
 }
 
-func (repo CloudControllerBuildpackBitsRepository) UploadBuildpack(buildpack models.Buildpack, buildpackFile *os.File, buildpackName string) error {
+// UploadBuildpack uploads the zip file as the buildpack's bits and removes
+// it afterwards. The upload is skipped, and uploaded is false, when the
+// buildpack already has bits with the same checksum.
+func (repo CloudControllerBuildpackBitsRepository) UploadBuildpack(buildpack models.Buildpack, buildpackFile *os.File, buildpackName string) (bool, error) {
 	defer func() {
 		buildpackFile.Close()
 		os.Remove(buildpackFile.Name())
 	}()
-	return repo.performMultiPartUpload(
+
+	unchanged, err := buildpackBitsUnchanged(buildpack, buildpackFile)
+	if err != nil {
+		return false, err
+	}
+	if unchanged {
+		return false, nil
+	}
+
+	err = repo.performMultiPartUpload(
 		fmt.Sprintf("%s/v2/buildpacks/%s/bits", repo.config.APIEndpoint(), buildpack.GUID),
 		"buildpack",
 		buildpackName,
 		buildpackFile)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// buildpackBitsUnchanged reports whether the buildpack's stored bits match
+// the zip file. The Cloud Controller stores bits under a key of the form
+// GUID_CHECKSUM, where the checksum is a SHA256, or a SHA1 on older versions.
+func buildpackBitsUnchanged(buildpack models.Buildpack, buildpackFile *os.File) (bool, error) {
+	if buildpack.Key == "" {
+		return false, nil
+	}
+
+	sha1Hash := sha1.New()
+	sha256Hash := sha256.New()
+	_, err := io.Copy(io.MultiWriter(sha1Hash, sha256Hash), buildpackFile)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = buildpackFile.Seek(0, 0)
+	if err != nil {
+		return false, err
+	}
+
+	for _, checksum := range []string{hex.EncodeToString(sha256Hash.Sum(nil)), hex.EncodeToString(sha1Hash.Sum(nil))} {
+		if strings.HasSuffix(buildpack.Key, "_"+checksum) {
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
 func (repo CloudControllerBuildpackBitsRepository) performMultiPartUpload(url string, fieldName string, fileName string, body io.Reader) error {
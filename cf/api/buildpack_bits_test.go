@@ -3,6 +3,9 @@ package api_test
 import (
 	"archive/zip"
 	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -190,11 +193,69 @@ var _ = Describe("BuildpackBitsRepository", func() {
 			})
 			It("uploads the buildpack", func() {
 
-				apiErr := repo.UploadBuildpack(buildpack, zipFile, zipFileName)
+				uploaded, apiErr := repo.UploadBuildpack(buildpack, zipFile, zipFileName)
 
 				Expect(apiErr).NotTo(HaveOccurred())
+				Expect(uploaded).To(BeTrue())
 				Expect(testServerHandler).To(HaveAllRequestsCalled())
 			})
+
+			Context("when the buildpack's stored bits have a different checksum", func() {
+				BeforeEach(func() {
+					buildpack.Key = "my-cool-buildpack-guid_0123456789abcdef"
+				})
+
+				It("uploads the buildpack", func() {
+					uploaded, apiErr := repo.UploadBuildpack(buildpack, zipFile, zipFileName)
+
+					Expect(apiErr).NotTo(HaveOccurred())
+					Expect(uploaded).To(BeTrue())
+					Expect(testServerHandler).To(HaveAllRequestsCalled())
+				})
+			})
+
+			Context("when the buildpack's stored bits have the same SHA256 checksum", func() {
+				JustBeforeEach(func() {
+					hash := sha256.New()
+					_, err = io.Copy(hash, zipFile)
+					Expect(err).NotTo(HaveOccurred())
+					_, err = zipFile.Seek(0, 0)
+					Expect(err).NotTo(HaveOccurred())
+
+					buildpack.Key = "my-cool-buildpack-guid_" + hex.EncodeToString(hash.Sum(nil))
+				})
+
+				It("skips the upload and removes the zip file", func() {
+					uploaded, apiErr := repo.UploadBuildpack(buildpack, zipFile, zipFileName)
+
+					Expect(apiErr).NotTo(HaveOccurred())
+					Expect(uploaded).To(BeFalse())
+					Expect(testServerHandler).NotTo(HaveAllRequestsCalled())
+
+					_, err = os.Stat(zipFile.Name())
+					Expect(os.IsNotExist(err)).To(BeTrue())
+				})
+			})
+
+			Context("when the buildpack's stored bits have the same SHA1 checksum", func() {
+				JustBeforeEach(func() {
+					hash := sha1.New()
+					_, err = io.Copy(hash, zipFile)
+					Expect(err).NotTo(HaveOccurred())
+					_, err = zipFile.Seek(0, 0)
+					Expect(err).NotTo(HaveOccurred())
+
+					buildpack.Key = "my-cool-buildpack-guid_" + hex.EncodeToString(hash.Sum(nil))
+				})
+
+				It("skips the upload", func() {
+					uploaded, apiErr := repo.UploadBuildpack(buildpack, zipFile, zipFileName)
+
+					Expect(apiErr).NotTo(HaveOccurred())
+					Expect(uploaded).To(BeFalse())
+					Expect(testServerHandler).NotTo(HaveAllRequestsCalled())
+				})
+			})
 		})
 
 		Describe("when the buildpack is wrapped in an extra top-level directory", func() {
@@ -203,9 +264,10 @@ var _ = Describe("BuildpackBitsRepository", func() {
 			})
 			It("uploads a zip file containing only the actual buildpack", func() {
 
-				apiErr := repo.UploadBuildpack(buildpack, zipFile, zipFileName)
+				uploaded, apiErr := repo.UploadBuildpack(buildpack, zipFile, zipFileName)
 
 				Expect(apiErr).NotTo(HaveOccurred())
+				Expect(uploaded).To(BeTrue())
 				Expect(testServerHandler).To(HaveAllRequestsCalled())
 			})
 		})
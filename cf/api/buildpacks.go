@@ -18,8 +18,9 @@ import (
 
 type BuildpackRepository interface {
 	FindByName(name string) (buildpack models.Buildpack, apiErr error)
+	FindByNameAndStack(name, stack string) (buildpack models.Buildpack, apiErr error)
 	ListBuildpacks(func(models.Buildpack) bool) error
-	Create(name string, position *int, enabled *bool, locked *bool) (createdBuildpack models.Buildpack, apiErr error)
+	Create(name string, position *int, enabled *bool, locked *bool, stack string) (createdBuildpack models.Buildpack, apiErr error)
 	Delete(buildpackGUID string) (apiErr error)
 	Update(buildpack models.Buildpack) (updatedBuildpack models.Buildpack, apiErr error)
 }
@@ -46,10 +47,23 @@ func (repo CloudControllerBuildpackRepository) ListBuildpacks(cb func(models.Bui
 }
 
 func (repo CloudControllerBuildpackRepository) FindByName(name string) (buildpack models.Buildpack, apiErr error) {
+	return repo.findBy(fmt.Sprintf("%s?q=%s", buildpacksPath, url.QueryEscape("name:"+name)), name)
+}
+
+// FindByNameAndStack finds the buildpack with the given name that is
+// restricted to the given stack.
+func (repo CloudControllerBuildpackRepository) FindByNameAndStack(name, stack string) (buildpack models.Buildpack, apiErr error) {
+	return repo.findBy(
+		fmt.Sprintf("%s?q=%s&q=%s", buildpacksPath, url.QueryEscape("name:"+name), url.QueryEscape("stack:"+stack)),
+		fmt.Sprintf("%s (stack %s)", name, stack),
+	)
+}
+
+func (repo CloudControllerBuildpackRepository) findBy(path string, description string) (buildpack models.Buildpack, apiErr error) {
 	foundIt := false
 	apiErr = repo.gateway.ListPaginatedResources(
 		repo.config.APIEndpoint(),
-		fmt.Sprintf("%s?q=%s", buildpacksPath, url.QueryEscape("name:"+name)),
+		path,
 		resources.BuildpackResource{},
 		func(resource interface{}) bool {
 			buildpack = resource.(resources.BuildpackResource).ToFields()
@@ -58,13 +72,13 @@ func (repo CloudControllerBuildpackRepository) FindByName(name string) (buildpac
 		})
 
 	if !foundIt {
-		apiErr = errors.NewModelNotFoundError("Buildpack", name)
+		apiErr = errors.NewModelNotFoundError("Buildpack", description)
 	}
 	return
 }
 
-func (repo CloudControllerBuildpackRepository) Create(name string, position *int, enabled *bool, locked *bool) (createdBuildpack models.Buildpack, apiErr error) {
-	entity := resources.BuildpackEntity{Name: name, Position: position, Enabled: enabled, Locked: locked}
+func (repo CloudControllerBuildpackRepository) Create(name string, position *int, enabled *bool, locked *bool, stack string) (createdBuildpack models.Buildpack, apiErr error) {
+	entity := resources.BuildpackEntity{Name: name, Position: position, Enabled: enabled, Locked: locked, Stack: stack}
 	body, err := json.Marshal(entity)
 	if err != nil {
 		apiErr = fmt.Errorf("%s: %s", T("Could not serialize information"), err.Error())
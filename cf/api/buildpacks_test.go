@@ -156,6 +156,54 @@ var _ = Describe("Buildpacks repo", func() {
 		})
 	})
 
+	Describe("finding buildpacks by name and stack", func() {
+		It("returns the buildpack with that name and stack", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/buildpacks?q=name%3ABuildpack1&q=stack%3Acflinuxfs2",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body: `{"resources": [
+					  {
+						  "metadata": {
+							  "guid": "buildpack1-guid"
+						  },
+						  "entity": {
+							  "name": "Buildpack1",
+							  "stack": "cflinuxfs2",
+							  "position": 10
+						  }
+					  }
+					  ]
+				  }`}}))
+
+			buildpack, apiErr := repo.FindByNameAndStack("Buildpack1", "cflinuxfs2")
+
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr).NotTo(HaveOccurred())
+
+			Expect(buildpack.Name).To(Equal("Buildpack1"))
+			Expect(buildpack.GUID).To(Equal("buildpack1-guid"))
+			Expect(buildpack.Stack).To(Equal("cflinuxfs2"))
+		})
+
+		It("returns a ModelNotFoundError when the buildpack is not found", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/buildpacks?q=name%3ABuildpack1&q=stack%3Acflinuxfs2",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body:   `{"resources": []}`,
+				},
+			}))
+
+			_, apiErr := repo.FindByNameAndStack("Buildpack1", "cflinuxfs2")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr.(*errors.ModelNotFoundError)).NotTo(BeNil())
+			Expect(apiErr.Error()).To(ContainSubstring("Buildpack1 (stack cflinuxfs2)"))
+		})
+	})
+
 	Describe("creating buildpacks", func() {
 		It("returns an error when the buildpack has an invalid name", func() {
 			setupTestServer(testnet.TestRequest{
@@ -171,7 +219,7 @@ var _ = Describe("Buildpacks repo", func() {
 				}})
 
 			one := 1
-			createdBuildpack, apiErr := repo.Create("name with space", &one, nil, nil)
+			createdBuildpack, apiErr := repo.Create("name with space", &one, nil, nil, "")
 			Expect(apiErr).To(HaveOccurred())
 			Expect(createdBuildpack).To(Equal(models.Buildpack{}))
 			Expect(apiErr.(errors.HTTPError).ErrorCode()).To(Equal("290003"))
@@ -197,7 +245,7 @@ var _ = Describe("Buildpacks repo", func() {
 			}))
 
 			position := 999
-			created, apiErr := repo.Create("my-cool-buildpack", &position, nil, nil)
+			created, apiErr := repo.Create("my-cool-buildpack", &position, nil, nil, "")
 
 			Expect(handler).To(HaveAllRequestsCalled())
 			Expect(apiErr).NotTo(HaveOccurred())
@@ -228,7 +276,7 @@ var _ = Describe("Buildpacks repo", func() {
 
 			position := 999
 			enabled := true
-			created, apiErr := repo.Create("my-cool-buildpack", &position, &enabled, nil)
+			created, apiErr := repo.Create("my-cool-buildpack", &position, &enabled, nil, "")
 
 			Expect(handler).To(HaveAllRequestsCalled())
 			Expect(apiErr).NotTo(HaveOccurred())
@@ -237,6 +285,32 @@ var _ = Describe("Buildpacks repo", func() {
 			Expect(created.Name).To(Equal("my-cool-buildpack"))
 			Expect(999).To(Equal(*created.Position))
 		})
+		It("sets the stack when creating a buildpack", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:  "POST",
+				Path:    "/v2/buildpacks",
+				Matcher: testnet.RequestBodyMatcher(`{"name":"my-cool-buildpack","position":999,"stack":"cflinuxfs2"}`),
+				Response: testnet.TestResponse{
+					Status: http.StatusCreated,
+					Body: `{
+					"metadata": {
+						"guid": "my-cool-buildpack-guid"
+					},
+					"entity": {
+						"name": "my-cool-buildpack",
+						"position":999,
+						"stack":"cflinuxfs2"
+					}
+				}`},
+			}))
+
+			position := 999
+			created, apiErr := repo.Create("my-cool-buildpack", &position, nil, nil, "cflinuxfs2")
+
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(created.Stack).To(Equal("cflinuxfs2"))
+		})
 	})
 
 	It("deletes buildpacks", func() {
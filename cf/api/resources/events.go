@@ -18,11 +18,17 @@ type EventResource interface {
 type EventResourceNewV2 struct {
 	Resource
 	Entity struct {
-		Timestamp time.Time
-		Type      string
-		Actor     string `json:"actor"`
-		ActorName string `json:"actor_name"`
-		Metadata  map[string]interface{}
+		Timestamp        time.Time
+		Type             string
+		Actor            string `json:"actor"`
+		ActorType        string `json:"actor_type"`
+		ActorName        string `json:"actor_name"`
+		Actee            string `json:"actee"`
+		ActeeType        string `json:"actee_type"`
+		ActeeName        string `json:"actee_name"`
+		SpaceGUID        string `json:"space_guid"`
+		OrganizationGUID string `json:"organization_guid"`
+		Metadata         map[string]interface{}
 	}
 }
 
@@ -43,12 +49,18 @@ func (resource EventResourceNewV2) ToFields() models.EventFields {
 	}
 
 	return models.EventFields{
-		GUID:        resource.Metadata.GUID,
-		Name:        resource.Entity.Type,
-		Timestamp:   resource.Entity.Timestamp,
-		Description: formatDescription(metadata, knownMetadataKeys),
-		Actor:       resource.Entity.Actor,
-		ActorName:   resource.Entity.ActorName,
+		GUID:             resource.Metadata.GUID,
+		Name:             resource.Entity.Type,
+		Timestamp:        resource.Entity.Timestamp,
+		Description:      formatDescription(metadata, knownMetadataKeys),
+		Actor:            resource.Entity.Actor,
+		ActorType:        resource.Entity.ActorType,
+		ActorName:        resource.Entity.ActorName,
+		Actee:            resource.Entity.Actee,
+		ActeeType:        resource.Entity.ActeeType,
+		ActeeName:        resource.Entity.ActeeName,
+		SpaceGUID:        resource.Entity.SpaceGUID,
+		OrganizationGUID: resource.Entity.OrganizationGUID,
 	}
 }
 
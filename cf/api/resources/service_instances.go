@@ -22,6 +22,7 @@ type LastOperation struct {
 
 type ServiceInstanceEntity struct {
 	Name            string                   `json:"name"`
+	SpaceGUID       string                   `json:"space_guid"`
 	DashboardURL    string                   `json:"dashboard_url"`
 	Tags            []string                 `json:"tags"`
 	ServiceBindings []ServiceBindingResource `json:"service_bindings"`
@@ -34,6 +35,7 @@ func (resource ServiceInstanceResource) ToFields() models.ServiceInstanceFields
 	return models.ServiceInstanceFields{
 		GUID:         resource.Metadata.GUID,
 		Name:         resource.Entity.Name,
+		SpaceGUID:    resource.Entity.SpaceGUID,
 		Tags:         resource.Entity.Tags,
 		DashboardURL: resource.Entity.DashboardURL,
 		LastOperation: models.LastOperationFields{
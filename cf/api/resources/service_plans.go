@@ -1,7 +1,9 @@
 package resources
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"code.cloudfoundry.org/cli/cf/models"
 )
@@ -19,6 +21,33 @@ type ServicePlanEntity struct {
 	Description         string                  `json:"description"`
 	ServiceOfferingGUID string                  `json:"service_guid"`
 	ServiceOffering     ServiceOfferingResource `json:"service"`
+	Extra               ServicePlanExtra        `json:"extra"`
+	Schemas             ServicePlanSchemas      `json:"schemas"`
+}
+
+type ServicePlanExtra struct {
+	DisplayName string            `json:"displayName"`
+	Bullets     []string          `json:"bullets"`
+	Costs       []ServicePlanCost `json:"costs"`
+}
+
+type ServicePlanCost struct {
+	Amount map[string]float64 `json:"amount"`
+	Unit   string             `json:"unit"`
+}
+
+type ServicePlanSchemas struct {
+	ServiceInstance struct {
+		Create servicePlanSchemaParameters `json:"create"`
+		Update servicePlanSchemaParameters `json:"update"`
+	} `json:"service_instance"`
+	ServiceBinding struct {
+		Create servicePlanSchemaParameters `json:"create"`
+	} `json:"service_binding"`
+}
+
+type servicePlanSchemaParameters struct {
+	Parameters map[string]interface{} `json:"parameters"`
 }
 
 type ServicePlanDescription struct {
@@ -35,9 +64,38 @@ func (resource ServicePlanResource) ToFields() (fields models.ServicePlanFields)
 	fields.Public = resource.Entity.Public
 	fields.Active = resource.Entity.Active
 	fields.ServiceOfferingGUID = resource.Entity.ServiceOfferingGUID
+	fields.DisplayName = resource.Entity.Extra.DisplayName
+	fields.Bullets = resource.Entity.Extra.Bullets
+	for _, cost := range resource.Entity.Extra.Costs {
+		fields.Costs = append(fields.Costs, models.ServicePlanCost{Amount: cost.Amount, Unit: cost.Unit})
+	}
+	fields.Schemas = models.ServicePlanSchemas{
+		InstanceCreate: resource.Entity.Schemas.ServiceInstance.Create.Parameters,
+		InstanceUpdate: resource.Entity.Schemas.ServiceInstance.Update.Parameters,
+		BindingCreate:  resource.Entity.Schemas.ServiceBinding.Create.Parameters,
+	}
 	return
 }
 
+type servicePlanExtra ServicePlanExtra
+
+// UnmarshalJSON parses the extra field, which the cloud controller returns as
+// a JSON encoded string. Brokers are free to put anything in it, so an extra
+// that is not a string holding a JSON object is ignored.
+func (resource *ServicePlanExtra) UnmarshalJSON(rawData []byte) error {
+	unquoted, err := strconv.Unquote(string(rawData))
+	if err != nil {
+		return nil
+	}
+
+	extra := servicePlanExtra{}
+	if json.Unmarshal([]byte(unquoted), &extra) == nil {
+		*resource = ServicePlanExtra(extra)
+	}
+
+	return nil
+}
+
 func (planDesc ServicePlanDescription) String() string {
 	if planDesc.ServiceProvider == "" {
 		return fmt.Sprintf("%s %s", planDesc.ServiceLabel, planDesc.ServicePlanName) // v2 plan
@@ -0,0 +1,65 @@
+package resources_test
+
+import (
+	"encoding/json"
+
+	. "code.cloudfoundry.org/cli/cf/api/resources"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ServicePlanResource", func() {
+	Describe("ToFields", func() {
+		var resource ServicePlanResource
+
+		unmarshal := func(extra string) {
+			resource = ServicePlanResource{}
+			err := json.Unmarshal([]byte(`
+    {
+      "metadata": {
+        "guid": "fake-guid"
+      },
+      "entity": {
+        "name": "fake-plan",
+        "extra": `+extra+`
+      }
+    }`), &resource)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		It("parses the extra from its JSON encoded string", func() {
+			unmarshal(`"{\"displayName\":\"Big\",\"bullets\":[\"lots of space\"]}"`)
+
+			fields := resource.ToFields()
+			Expect(fields.Name).To(Equal("fake-plan"))
+			Expect(fields.DisplayName).To(Equal("Big"))
+			Expect(fields.Bullets).To(Equal([]string{"lots of space"}))
+		})
+
+		It("ignores an extra that is null", func() {
+			unmarshal(`null`)
+
+			fields := resource.ToFields()
+			Expect(fields.Name).To(Equal("fake-plan"))
+			Expect(fields.DisplayName).To(BeEmpty())
+		})
+
+		It("ignores an extra that is not a JSON encoded string", func() {
+			unmarshal(`{"displayName": "Big"}`)
+
+			fields := resource.ToFields()
+			Expect(fields.Name).To(Equal("fake-plan"))
+			Expect(fields.DisplayName).To(BeEmpty())
+			Expect(fields.Bullets).To(BeEmpty())
+		})
+
+		It("ignores an extra that does not hold a JSON object", func() {
+			unmarshal(`"not json"`)
+
+			fields := resource.ToFields()
+			Expect(fields.Name).To(Equal("fake-plan"))
+			Expect(fields.DisplayName).To(BeEmpty())
+		})
+	})
+})
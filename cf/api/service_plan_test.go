@@ -60,6 +60,13 @@ var _ = Describe("Service Plan Repository", func() {
 				Expect(servicePlansFields[0].Free).To(BeTrue())
 				Expect(servicePlansFields[0].Public).To(BeTrue())
 				Expect(servicePlansFields[0].Active).To(BeTrue())
+				Expect(servicePlansFields[0].DisplayName).To(Equal("Big"))
+				Expect(servicePlansFields[0].Bullets).To(Equal([]string{"lots of space"}))
+				Expect(servicePlansFields[0].Costs).To(Equal([]models.ServicePlanCost{{Amount: map[string]float64{"usd": 99}, Unit: "MONTHLY"}}))
+				Expect(servicePlansFields[0].Schemas).To(Equal(models.ServicePlanSchemas{
+					InstanceCreate: map[string]interface{}{"type": "object"},
+					BindingCreate:  map[string]interface{}{"required": []interface{}{"role"}},
+				}))
 				Expect(servicePlansFields[1].Name).To(Equal("The small second"))
 				Expect(servicePlansFields[1].GUID).To(Equal("the-small-second"))
 				Expect(servicePlansFields[1].Free).To(BeTrue())
@@ -158,7 +165,17 @@ var firstPlanRequest = apifakes.NewCloudControllerTestRequest(testnet.TestReques
         "name": "The big one",
         "free": true,
         "public": true,
-        "active": true
+        "active": true,
+        "extra": "{\"displayName\":\"Big\",\"bullets\":[\"lots of space\"],\"costs\":[{\"amount\":{\"usd\":99.0},\"unit\":\"MONTHLY\"}]}",
+        "schemas": {
+          "service_instance": {
+            "create": { "parameters": { "type": "object" } },
+            "update": {}
+          },
+          "service_binding": {
+            "create": { "parameters": { "required": ["role"] } }
+          }
+        }
       }
     }
   ]
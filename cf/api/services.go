@@ -27,6 +27,7 @@ type ServiceRepository interface {
 	GetAllServiceOfferings() (offerings models.ServiceOfferings, apiErr error)
 	GetServiceOfferingsForSpace(spaceGUID string) (offerings models.ServiceOfferings, apiErr error)
 	FindInstanceByName(name string) (instance models.ServiceInstance, apiErr error)
+	GetSharedSpaceGUIDs(instanceGUID string) (spaceGUIDs []string, apiErr error)
 	PurgeServiceInstance(instance models.ServiceInstance) error
 	CreateServiceInstance(name, planGUID string, params map[string]interface{}, tags []string) (apiErr error)
 	UpdateServiceInstance(instanceGUID, planGUID string, params map[string]interface{}, tags []string) (apiErr error)
@@ -138,6 +139,28 @@ func (repo CloudControllerServiceRepository) FindInstanceByName(name string) (in
 	return
 }
 
+// GetSharedSpaceGUIDs returns the GUIDs of the spaces the service instance is
+// shared with. Sharing is only available through the V3 API.
+func (repo CloudControllerServiceRepository) GetSharedSpaceGUIDs(instanceGUID string) ([]string, error) {
+	path := fmt.Sprintf("%s/v3/service_instances/%s/relationships/shared_spaces", repo.config.APIEndpoint(), instanceGUID)
+
+	var relationships struct {
+		Data []struct {
+			GUID string `json:"guid"`
+		} `json:"data"`
+	}
+	err := repo.gateway.GetResource(path, &relationships)
+	if err != nil {
+		return nil, err
+	}
+
+	spaceGUIDs := []string{}
+	for _, space := range relationships.Data {
+		spaceGUIDs = append(spaceGUIDs, space.GUID)
+	}
+	return spaceGUIDs, nil
+}
+
 func (repo CloudControllerServiceRepository) CreateServiceInstance(name, planGUID string, params map[string]interface{}, tags []string) (err error) {
 	path := "/v2/service_instances?accepts_incomplete=true"
 	request := models.ServiceInstanceCreateRequest{
@@ -498,6 +498,7 @@ var _ = Describe("Services Repo", func() {
 						  },
 						  "entity": {
 							"name": "my-service",
+							"space_guid": "my-space-guid",
 							"service_bindings": [
 							  {
 								"metadata": {
@@ -531,6 +532,7 @@ var _ = Describe("Services Repo", func() {
 
 			Expect(instance.Name).To(Equal("my-service"))
 			Expect(instance.GUID).To(Equal("my-service-instance-guid"))
+			Expect(instance.SpaceGUID).To(Equal("my-space-guid"))
 			Expect(instance.ServiceOffering.Label).To(Equal(""))
 			Expect(instance.ServicePlan.Name).To(Equal(""))
 			Expect(len(instance.ServiceBindings)).To(Equal(2))
@@ -564,6 +566,42 @@ var _ = Describe("Services Repo", func() {
 		})
 	})
 
+	Describe("GetSharedSpaceGUIDs", func() {
+		It("returns the GUIDs of the spaces the instance is shared with", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v3/service_instances/my-service-instance-guid/relationships/shared_spaces",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `
+				{
+					"data": [
+						{ "guid": "space-guid-1" },
+						{ "guid": "space-guid-2" }
+					]
+				}`},
+			}))
+
+			spaceGUIDs, err := repo.GetSharedSpaceGUIDs("my-service-instance-guid")
+
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spaceGUIDs).To(Equal([]string{"space-guid-1", "space-guid-2"}))
+		})
+
+		It("returns an empty list when the instance is not shared", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v3/service_instances/my-service-instance-guid/relationships/shared_spaces",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{ "data": [] }`},
+			}))
+
+			spaceGUIDs, err := repo.GetSharedSpaceGUIDs("my-service-instance-guid")
+
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spaceGUIDs).To(BeEmpty())
+		})
+	})
+
 	Describe("DeleteService", func() {
 		It("deletes the service when no apps and keys are bound", func() {
 			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
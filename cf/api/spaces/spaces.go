@@ -20,6 +20,7 @@ type SpaceRepository interface {
 	ListSpacesFromOrg(orgGUID string, spaceFunc func(models.Space) bool) error
 	FindByName(name string) (space models.Space, apiErr error)
 	FindByNameInOrg(name, orgGUID string) (space models.Space, apiErr error)
+	FindByGUID(guid string) (space models.Space, apiErr error)
 	Create(name string, orgGUID string, spaceQuotaGUID string) (space models.Space, apiErr error)
 	Rename(spaceGUID, newName string) (apiErr error)
 	SetAllowSSH(spaceGUID string, allow bool) (apiErr error)
@@ -80,6 +81,16 @@ func (repo CloudControllerSpaceRepository) FindByNameInOrg(name, orgGUID string)
 	return
 }
 
+func (repo CloudControllerSpaceRepository) FindByGUID(guid string) (models.Space, error) {
+	resource := new(resources.SpaceResource)
+	path := fmt.Sprintf("%s/v2/spaces/%s?inline-relations-depth=1", repo.config.APIEndpoint(), guid)
+	err := repo.gateway.GetResource(path, resource)
+	if err != nil {
+		return models.Space{}, err
+	}
+	return resource.ToModel(), nil
+}
+
 func (repo CloudControllerSpaceRepository) Create(name, orgGUID, spaceQuotaGUID string) (models.Space, error) {
 	var space models.Space
 	path := "/v2/spaces?inline-relations-depth=1"
@@ -246,6 +246,41 @@ var _ = Describe("Space Repository", func() {
 		})
 	})
 
+	It("finds spaces by guid", func() {
+		request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/spaces/space1-guid?inline-relations-depth=1",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: `
+			{
+				"metadata": {
+					"guid": "space1-guid"
+				},
+				"entity": {
+					"name": "Space1",
+					"organization_guid": "org1-guid",
+					"organization": {
+						"metadata": {
+							"guid": "org1-guid"
+						},
+						"entity": {
+							"name": "Org1"
+						}
+					}
+				}
+			}`},
+		})
+
+		ts, handler, repo := createSpacesRepo(request)
+		defer ts.Close()
+
+		space, apiErr := repo.FindByGUID("space1-guid")
+		Expect(handler).To(HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+		Expect(space.Name).To(Equal("Space1"))
+		Expect(space.GUID).To(Equal("space1-guid"))
+		Expect(space.Organization.Name).To(Equal("Org1"))
+	})
+
 	It("creates spaces without a space-quota", func() {
 		request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
 			Method:  "POST",
@@ -45,6 +45,15 @@ type FakeSpaceRepository struct {
 		result1 models.Space
 		result2 error
 	}
+	FindByGUIDStub        func(guid string) (space models.Space, apiErr error)
+	findByGUIDMutex       sync.RWMutex
+	findByGUIDArgsForCall []struct {
+		guid string
+	}
+	findByGUIDReturns struct {
+		result1 models.Space
+		result2 error
+	}
 	CreateStub        func(name string, orgGUID string, spaceQuotaGUID string) (space models.Space, apiErr error)
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
@@ -222,6 +231,40 @@ func (fake *FakeSpaceRepository) FindByNameInOrgReturns(result1 models.Space, re
 	}{result1, result2}
 }
 
+func (fake *FakeSpaceRepository) FindByGUID(guid string) (space models.Space, apiErr error) {
+	fake.findByGUIDMutex.Lock()
+	fake.findByGUIDArgsForCall = append(fake.findByGUIDArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("FindByGUID", []interface{}{guid})
+	fake.findByGUIDMutex.Unlock()
+	if fake.FindByGUIDStub != nil {
+		return fake.FindByGUIDStub(guid)
+	} else {
+		return fake.findByGUIDReturns.result1, fake.findByGUIDReturns.result2
+	}
+}
+
+func (fake *FakeSpaceRepository) FindByGUIDCallCount() int {
+	fake.findByGUIDMutex.RLock()
+	defer fake.findByGUIDMutex.RUnlock()
+	return len(fake.findByGUIDArgsForCall)
+}
+
+func (fake *FakeSpaceRepository) FindByGUIDArgsForCall(i int) string {
+	fake.findByGUIDMutex.RLock()
+	defer fake.findByGUIDMutex.RUnlock()
+	return fake.findByGUIDArgsForCall[i].guid
+}
+
+func (fake *FakeSpaceRepository) FindByGUIDReturns(result1 models.Space, result2 error) {
+	fake.FindByGUIDStub = nil
+	fake.findByGUIDReturns = struct {
+		result1 models.Space
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSpaceRepository) Create(name string, orgGUID string, spaceQuotaGUID string) (space models.Space, apiErr error) {
 	fake.createMutex.Lock()
 	fake.createArgsForCall = append(fake.createArgsForCall, struct {
@@ -370,6 +413,8 @@ func (fake *FakeSpaceRepository) Invocations() map[string][][]interface{} {
 	defer fake.findByNameMutex.RUnlock()
 	fake.findByNameInOrgMutex.RLock()
 	defer fake.findByNameInOrgMutex.RUnlock()
+	fake.findByGUIDMutex.RLock()
+	defer fake.findByGUIDMutex.RUnlock()
 	fake.createMutex.RLock()
 	defer fake.createMutex.RUnlock()
 	fake.renameMutex.RLock()
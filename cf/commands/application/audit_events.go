@@ -0,0 +1,364 @@
+package application
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/appevents"
+	"code.cloudfoundry.org/cli/cf/api/organizations"
+	"code.cloudfoundry.org/cli/cf/api/spaces"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+const auditEventDateFormat = "2006-01-02"
+
+var auditEventGUIDRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+type AuditEvents struct {
+	ui         terminal.UI
+	config     coreconfig.Reader
+	orgRepo    organizations.OrganizationRepository
+	spaceRepo  spaces.SpaceRepository
+	userRepo   api.UserRepository
+	eventsRepo appevents.Repository
+}
+
+type auditEvent struct {
+	GUID             string    `json:"guid"`
+	Type             string    `json:"type"`
+	Timestamp        time.Time `json:"timestamp"`
+	Actor            string    `json:"actor"`
+	ActorType        string    `json:"actor_type"`
+	ActorName        string    `json:"actor_name"`
+	Actee            string    `json:"actee"`
+	ActeeType        string    `json:"actee_type"`
+	ActeeName        string    `json:"actee_name"`
+	SpaceGUID        string    `json:"space_guid"`
+	OrganizationGUID string    `json:"organization_guid"`
+	Description      string    `json:"description"`
+}
+
+func init() {
+	commandregistry.Register(&AuditEvents{})
+}
+
+func (cmd *AuditEvents) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["org"] = &flags.StringFlag{Name: "org", Usage: T("Org to show events for, defaults to the targeted org")}
+	fs["space"] = &flags.StringFlag{Name: "space", Usage: T("Space in the org to show events for")}
+	fs["actor"] = &flags.StringFlag{Name: "actor", Usage: T("Only show events caused by this actor, given as a user name or GUID")}
+	fs["type"] = &flags.StringSliceFlag{Name: "type", Usage: T("Only show events of this type, flag can be specified multiple times")}
+	fs["from"] = &flags.StringFlag{Name: "from", Usage: T("Only show events at or after this time, given as YYYY-MM-DD or RFC3339")}
+	fs["to"] = &flags.StringFlag{Name: "to", Usage: T("Only show events at or before this time, given as YYYY-MM-DD or RFC3339")}
+	fs["output"] = &flags.StringFlag{Name: "output", Value: "table", Usage: T("Output format: table, json or csv")}
+	fs["file"] = &flags.StringFlag{Name: "file", Usage: T("Write the json or csv output to this file instead of the terminal")}
+	fs["follow"] = &flags.BoolFlag{Name: "follow", Usage: T("Keep polling for new events and show them as they arrive")}
+	fs["notify"] = &flags.StringFlag{Name: "notify", Usage: T("Local command to run for every new event while following; the event is passed in CF_EVENT_* environment variables")}
+	fs["notify-on"] = &flags.StringSliceFlag{Name: "notify-on", Usage: T("Only run the notify command for events of this type, wildcards such as audit.app.* are allowed, flag can be specified multiple times")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "audit-events",
+		Description: T("Show audit events of an org, space or actor"),
+		Usage: []string{
+			T("CF_NAME audit-events [--org ORG] [--space SPACE] [--actor ACTOR] [--type TYPE]... [--from TIME] [--to TIME] [--output table|json|csv] [--file PATH] [--follow [--notify COMMAND] [--notify-on EVENT_TYPE]...]"),
+		},
+		Examples: []string{
+			"CF_NAME audit-events --space development --type audit.app.update --type audit.app.delete-request",
+			"CF_NAME audit-events --org my-org --from 2017-01-01 --to 2017-03-31 --output csv --file q1-events.csv",
+			`CF_NAME audit-events --space production --follow --notify 'notify-send "$CF_EVENT_TYPE" "$CF_EVENT_ACTEE_NAME"' --notify-on app.crash`,
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *AuditEvents) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	if len(fc.Args()) != 0 {
+		cmd.ui.Failed(T("Incorrect Usage. No argument required\n\n") + commandregistry.Commands.CommandUsage("audit-events"))
+		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 0)
+	}
+
+	switch fc.String("output") {
+	case "table", "json", "csv":
+	default:
+		cmd.ui.Failed(T("Incorrect Usage. --output must be one of table, json or csv\n\n") + commandregistry.Commands.CommandUsage("audit-events"))
+		return nil, fmt.Errorf("Incorrect usage: invalid output format %s", fc.String("output"))
+	}
+
+	if fc.String("file") != "" && fc.String("output") == "table" {
+		cmd.ui.Failed(T("Incorrect Usage. --file requires --output json or --output csv\n\n") + commandregistry.Commands.CommandUsage("audit-events"))
+		return nil, fmt.Errorf("Incorrect usage: --file without an export format")
+	}
+
+	if fc.Bool("follow") && (fc.String("output") != "table" || fc.String("to") != "") {
+		cmd.ui.Failed(T("Incorrect Usage. --follow cannot be combined with --output json, --output csv or --to\n\n") + commandregistry.Commands.CommandUsage("audit-events"))
+		return nil, fmt.Errorf("Incorrect usage: --follow with an export format or end time")
+	}
+
+	if !fc.Bool("follow") && (fc.String("notify") != "" || len(fc.StringSlice("notify-on")) > 0) {
+		cmd.ui.Failed(T("Incorrect Usage. --notify and --notify-on require --follow\n\n") + commandregistry.Commands.CommandUsage("audit-events"))
+		return nil, fmt.Errorf("Incorrect usage: notification flags without --follow")
+	}
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+	}
+
+	if fc.String("org") == "" {
+		reqs = append(reqs, requirementsFactory.NewTargetedOrgRequirement())
+	}
+
+	return reqs, nil
+}
+
+func (cmd *AuditEvents) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.orgRepo = deps.RepoLocator.GetOrganizationRepository()
+	cmd.spaceRepo = deps.RepoLocator.GetSpaceRepository()
+	cmd.userRepo = deps.RepoLocator.GetUserRepository()
+	cmd.eventsRepo = deps.RepoLocator.GetAppEventsRepository()
+	return cmd
+}
+
+func (cmd *AuditEvents) Execute(c flags.FlagContext) error {
+	filter := appevents.EventFilter{
+		Types: c.StringSlice("type"),
+	}
+
+	var err error
+	if c.String("from") != "" {
+		filter.Since, err = parseAuditEventTime(c.String("from"), false)
+		if err != nil {
+			return err
+		}
+	}
+	if c.String("to") != "" {
+		filter.Until, err = parseAuditEventTime(c.String("to"), true)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the Cloud Controller only filters events by actor GUID, so user names
+	// are looked up first
+	if actor := c.String("actor"); actor != "" {
+		filter.Actor = actor
+		if !auditEventGUIDRegex.MatchString(actor) {
+			var user models.UserFields
+			user, err = cmd.userRepo.FindByUsername(actor)
+			if err != nil {
+				return err
+			}
+			filter.Actor = user.GUID
+		}
+	}
+
+	org := cmd.config.OrganizationFields()
+	if orgName := c.String("org"); orgName != "" {
+		var foundOrg models.Organization
+		foundOrg, err = cmd.orgRepo.FindByName(orgName)
+		if err != nil {
+			return err
+		}
+		org = foundOrg.OrganizationFields
+	}
+	filter.OrganizationGUID = org.GUID
+
+	scope := T("org {{.OrgName}}", map[string]interface{}{"OrgName": terminal.EntityNameColor(org.Name)})
+	if spaceName := c.String("space"); spaceName != "" {
+		var space models.Space
+		space, err = cmd.spaceRepo.FindByNameInOrg(spaceName, org.GUID)
+		if err != nil {
+			return err
+		}
+		filter.SpaceGUID = space.GUID
+		scope = T("org {{.OrgName}} / space {{.SpaceName}}",
+			map[string]interface{}{
+				"OrgName":   terminal.EntityNameColor(org.Name),
+				"SpaceName": terminal.EntityNameColor(space.Name),
+			})
+	}
+
+	output := c.String("output")
+	filePath := c.String("file")
+
+	if output == "table" || filePath != "" {
+		cmd.ui.Say(T("Getting audit events for {{.Scope}} as {{.Username}}...\n",
+			map[string]interface{}{
+				"Scope":    scope,
+				"Username": terminal.EntityNameColor(cmd.config.Username()),
+			}))
+	}
+
+	events := []models.EventFields{}
+	err = cmd.eventsRepo.ListEvents(filter, func(event models.EventFields) bool {
+		events = append(events, event)
+		return true
+	})
+	if err != nil {
+		return errors.New(T("Failed fetching events.\n{{.APIErr}}",
+			map[string]interface{}{"APIErr": err.Error()}))
+	}
+
+	var contents []byte
+	switch output {
+	case "json":
+		contents, err = formatAuditEventsJSON(events)
+	case "csv":
+		contents, err = formatAuditEventsCSV(events)
+	default:
+		err = cmd.printTable(events)
+		if err != nil || !c.Bool("follow") {
+			return err
+		}
+
+		follower := newEventFollower(cmd.ui, cmd.config, cmd.eventsRepo, filter)
+		follower.row = auditEventRow
+		follower.notifyCommand = c.String("notify")
+		follower.notifyTypes = c.StringSlice("notify-on")
+		follower.MarkSeen(events)
+		return follower.Follow()
+	}
+	if err != nil {
+		return err
+	}
+
+	if filePath == "" {
+		cmd.ui.Say(strings.TrimSuffix(string(contents), "\n"))
+		return nil
+	}
+
+	err = ioutil.WriteFile(filePath, contents, 0644)
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say(T("Exported {{.Count}} events to {{.Path}}",
+		map[string]interface{}{
+			"Count": len(events),
+			"Path":  terminal.EntityNameColor(filePath),
+		}))
+	return nil
+}
+
+func (cmd *AuditEvents) printTable(events []models.EventFields) error {
+	if len(events) == 0 {
+		cmd.ui.Say(T("No events found"))
+		return nil
+	}
+
+	table := cmd.ui.Table([]string{T("time"), T("event"), T("actor"), T("actee type"), T("actee"), T("description")})
+	for _, event := range events {
+		table.Add(auditEventRow(event)...)
+	}
+	return table.Print()
+}
+
+func auditEventRow(event models.EventFields) []string {
+	actee := event.ActeeName
+	if actee == "" {
+		actee = event.Actee
+	}
+
+	return []string{
+		event.Timestamp.Local().Format(eventTimestampFormat),
+		event.Name,
+		eventActor(event),
+		event.ActeeType,
+		actee,
+		event.Description,
+	}
+}
+
+func formatAuditEventsJSON(events []models.EventFields) ([]byte, error) {
+	exported := make([]auditEvent, 0, len(events))
+	for _, event := range events {
+		exported = append(exported, auditEvent{
+			GUID:             event.GUID,
+			Type:             event.Name,
+			Timestamp:        event.Timestamp,
+			Actor:            event.Actor,
+			ActorType:        event.ActorType,
+			ActorName:        event.ActorName,
+			Actee:            event.Actee,
+			ActeeType:        event.ActeeType,
+			ActeeName:        event.ActeeName,
+			SpaceGUID:        event.SpaceGUID,
+			OrganizationGUID: event.OrganizationGUID,
+			Description:      event.Description,
+		})
+	}
+
+	contents, err := json.MarshalIndent(exported, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(contents, '\n'), nil
+}
+
+func formatAuditEventsCSV(events []models.EventFields) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	writer := csv.NewWriter(buffer)
+
+	err := writer.Write([]string{"guid", "type", "timestamp", "actor", "actor_type", "actor_name", "actee", "actee_type", "actee_name", "space_guid", "organization_guid", "description"})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		err = writer.Write([]string{
+			event.GUID,
+			event.Name,
+			event.Timestamp.UTC().Format(time.RFC3339),
+			event.Actor,
+			event.ActorType,
+			event.ActorName,
+			event.Actee,
+			event.ActeeType,
+			event.ActeeName,
+			event.SpaceGUID,
+			event.OrganizationGUID,
+			event.Description,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	return buffer.Bytes(), writer.Error()
+}
+
+// parseAuditEventTime accepts RFC3339 timestamps and plain dates. A plain date
+// used as the end of a range includes the whole day.
+func parseAuditEventTime(value string, endOfDay bool) (time.Time, error) {
+	if timestamp, err := time.Parse(time.RFC3339, value); err == nil {
+		return timestamp, nil
+	}
+
+	date, err := time.Parse(auditEventDateFormat, value)
+	if err != nil {
+		return time.Time{}, errors.New(T("Invalid time {{.Time}}. Use YYYY-MM-DD or an RFC3339 timestamp such as 2017-01-02T15:04:05Z.",
+			map[string]interface{}{"Time": value}))
+	}
+
+	if endOfDay {
+		date = date.Add(24*time.Hour - time.Second)
+	}
+	return date, nil
+}
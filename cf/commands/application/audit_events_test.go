@@ -0,0 +1,239 @@
+package application_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/appevents"
+	"code.cloudfoundry.org/cli/cf/api/appevents/appeventsfakes"
+	"code.cloudfoundry.org/cli/cf/api/organizations/organizationsfakes"
+	"code.cloudfoundry.org/cli/cf/api/spaces/spacesfakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("audit-events command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		configRepo          coreconfig.Repository
+		requirementsFactory *requirementsfakes.FakeFactory
+		eventsRepo          *appeventsfakes.FakeAppEventsRepository
+		orgRepo             *organizationsfakes.FakeOrganizationRepository
+		spaceRepo           *spacesfakes.FakeSpaceRepository
+		userRepo            *apifakes.FakeUserRepository
+		deps                commandregistry.Dependency
+		timestamp           time.Time
+	)
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = configRepo
+		deps.RepoLocator = deps.RepoLocator.SetAppEventsRepository(eventsRepo)
+		deps.RepoLocator = deps.RepoLocator.SetOrganizationRepository(orgRepo)
+		deps.RepoLocator = deps.RepoLocator.SetSpaceRepository(spaceRepo)
+		deps.RepoLocator = deps.RepoLocator.SetUserRepository(userRepo)
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("audit-events").SetDependency(deps, pluginCall))
+	}
+
+	BeforeEach(func() {
+		ui = new(testterm.FakeUI)
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+		requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+		requirementsFactory.NewTargetedOrgRequirementReturns(new(requirementsfakes.FakeTargetedOrgRequirement))
+		eventsRepo = new(appeventsfakes.FakeAppEventsRepository)
+		orgRepo = new(organizationsfakes.FakeOrganizationRepository)
+		spaceRepo = new(spacesfakes.FakeSpaceRepository)
+		userRepo = new(apifakes.FakeUserRepository)
+
+		timestamp = time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC)
+		eventsRepo.ListEventsStub = func(filter appevents.EventFilter, cb func(models.EventFields) bool) error {
+			events := []models.EventFields{
+				{GUID: "event-1-guid", Name: "audit.app.update", Timestamp: timestamp, Actor: "user-1-guid", ActorType: "user", ActorName: "alice", Actee: "app-guid", ActeeType: "app", ActeeName: "my-app", Description: "instances: 2"},
+				{GUID: "event-2-guid", Name: "audit.service_instance.delete", Timestamp: timestamp, Actor: "user-2-guid", ActorType: "user", ActorName: "bob", Actee: "instance-guid", ActeeType: "service_instance", ActeeName: "my-db"},
+			}
+			for _, event := range events {
+				if !cb(event) {
+					break
+				}
+			}
+			return nil
+		}
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("audit-events", args, requirementsFactory, updateCommandDependency, false, ui)
+	}
+
+	Describe("requirements", func() {
+		It("fails when not logged in", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Failing{Message: "not logged in"})
+			Expect(runCommand()).To(BeFalse())
+		})
+
+		It("requires a targeted org unless --org is given", func() {
+			runCommand()
+			Expect(requirementsFactory.NewTargetedOrgRequirementCallCount()).To(Equal(1))
+
+			runCommand("--org", "other-org")
+			Expect(requirementsFactory.NewTargetedOrgRequirementCallCount()).To(Equal(1))
+		})
+
+		It("fails with usage when given an unknown output format", func() {
+			Expect(runCommand("--output", "xml")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"Incorrect Usage", "--output must be one of table, json or csv"}))
+		})
+
+		It("fails with usage when following an export", func() {
+			Expect(runCommand("--follow", "--output", "json")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"Incorrect Usage", "--follow cannot be combined with"}))
+		})
+
+		It("fails with usage when notifying without following", func() {
+			Expect(runCommand("--notify-on", "app.crash")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"Incorrect Usage", "--notify and --notify-on require --follow"}))
+		})
+
+		It("fails with usage when writing a table to a file", func() {
+			Expect(runCommand("--file", "events.txt")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"Incorrect Usage", "--file requires --output json or --output csv"}))
+		})
+	})
+
+	It("lists the events of the targeted org", func() {
+		Expect(runCommand()).To(BeTrue())
+
+		filter, _ := eventsRepo.ListEventsArgsForCall(0)
+		Expect(filter).To(Equal(appevents.EventFilter{OrganizationGUID: "my-org-guid", Types: []string{}}))
+
+		Expect(ui.Outputs()).To(ContainSubstrings(
+			[]string{"Getting audit events for org my-org as my-user"},
+			[]string{"time", "event", "actor", "actee type", "actee", "description"},
+			[]string{"audit.app.update", "alice", "app", "my-app", "instances: 2"},
+			[]string{"audit.service_instance.delete", "bob", "service_instance", "my-db"},
+		))
+	})
+
+	It("filters by org, space, type and time range", func() {
+		org := models.Organization{}
+		org.GUID = "other-org-guid"
+		org.Name = "other-org"
+		orgRepo.FindByNameReturns(org, nil)
+		space := models.Space{}
+		space.GUID = "dev-guid"
+		space.Name = "dev"
+		spaceRepo.FindByNameInOrgReturns(space, nil)
+
+		Expect(runCommand("--org", "other-org", "--space", "dev", "--type", "audit.app.update", "--type", "audit.app.delete-request", "--from", "2017-03-01", "--to", "2017-03-31")).To(BeTrue())
+
+		Expect(orgRepo.FindByNameArgsForCall(0)).To(Equal("other-org"))
+		spaceName, orgGUID := spaceRepo.FindByNameInOrgArgsForCall(0)
+		Expect(spaceName).To(Equal("dev"))
+		Expect(orgGUID).To(Equal("other-org-guid"))
+
+		filter, _ := eventsRepo.ListEventsArgsForCall(0)
+		Expect(filter.OrganizationGUID).To(Equal("other-org-guid"))
+		Expect(filter.SpaceGUID).To(Equal("dev-guid"))
+		Expect(filter.Types).To(Equal([]string{"audit.app.update", "audit.app.delete-request"}))
+		Expect(filter.Since).To(Equal(time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(filter.Until).To(Equal(time.Date(2017, 3, 31, 23, 59, 59, 0, time.UTC)))
+
+		Expect(ui.Outputs()).To(ContainSubstrings([]string{"Getting audit events for org other-org / space dev as my-user"}))
+	})
+
+	It("fails when given an invalid time", func() {
+		Expect(runCommand("--from", "last tuesday")).To(BeFalse())
+		Expect(eventsRepo.ListEventsCallCount()).To(Equal(0))
+		Expect(ui.Outputs()).To(ContainSubstrings([]string{"Invalid time last tuesday"}))
+	})
+
+	Context("when an actor is given", func() {
+		It("asks for the events of the actor GUID", func() {
+			Expect(runCommand("--actor", "0a6f2c4e-4b1d-4a5e-9f0e-1c2d3e4f5a6b")).To(BeTrue())
+
+			filter, _ := eventsRepo.ListEventsArgsForCall(0)
+			Expect(filter.Actor).To(Equal("0a6f2c4e-4b1d-4a5e-9f0e-1c2d3e4f5a6b"))
+			Expect(userRepo.FindByUsernameCallCount()).To(Equal(0))
+		})
+
+		It("looks up the GUID of a user name", func() {
+			userRepo.FindByUsernameReturns(models.UserFields{GUID: "user-2-guid", Username: "bob"}, nil)
+
+			Expect(runCommand("--actor", "bob")).To(BeTrue())
+
+			Expect(userRepo.FindByUsernameArgsForCall(0)).To(Equal("bob"))
+			filter, _ := eventsRepo.ListEventsArgsForCall(0)
+			Expect(filter.Actor).To(Equal("user-2-guid"))
+		})
+
+		It("fails when the user cannot be found", func() {
+			userRepo.FindByUsernameReturns(models.UserFields{}, errors.New("user bob not found"))
+
+			Expect(runCommand("--actor", "bob")).To(BeFalse())
+			Expect(eventsRepo.ListEventsCallCount()).To(Equal(0))
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"user bob not found"}))
+		})
+	})
+
+	It("says when there are no events", func() {
+		eventsRepo.ListEventsStub = nil
+		Expect(runCommand()).To(BeTrue())
+		Expect(ui.Outputs()).To(ContainSubstrings([]string{"No events found"}))
+	})
+
+	It("prints the events as JSON", func() {
+		Expect(runCommand("--output", "json")).To(BeTrue())
+		Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Getting audit events"}))
+
+		var exported []map[string]interface{}
+		Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &exported)).To(Succeed())
+		Expect(exported).To(HaveLen(2))
+		Expect(exported[0]).To(HaveKeyWithValue("type", "audit.app.update"))
+		Expect(exported[0]).To(HaveKeyWithValue("actor_name", "alice"))
+		Expect(exported[0]).To(HaveKeyWithValue("actee_type", "app"))
+		Expect(exported[0]).To(HaveKeyWithValue("timestamp", "2017-03-01T12:00:00Z"))
+	})
+
+	It("exports the events as CSV to a file", func() {
+		dir, err := ioutil.TempDir("", "audit-events")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "events.csv")
+
+		Expect(runCommand("--output", "csv", "--file", path)).To(BeTrue())
+		Expect(ui.Outputs()).To(ContainSubstrings(
+			[]string{"OK"},
+			[]string{"Exported 2 events to", path},
+		))
+
+		contents, err := ioutil.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(contents)).To(Equal(
+			"guid,type,timestamp,actor,actor_type,actor_name,actee,actee_type,actee_name,space_guid,organization_guid,description\n" +
+				"event-1-guid,audit.app.update,2017-03-01T12:00:00Z,user-1-guid,user,alice,app-guid,app,my-app,,,instances: 2\n" +
+				"event-2-guid,audit.service_instance.delete,2017-03-01T12:00:00Z,user-2-guid,user,bob,instance-guid,service_instance,my-db,,,\n",
+		))
+	})
+
+	It("returns an error when the events cannot be fetched", func() {
+		eventsRepo.ListEventsReturns(errors.New("welp"))
+		Expect(runCommand()).To(BeFalse())
+		Expect(ui.Outputs()).To(ContainSubstrings([]string{"Failed fetching events"}, []string{"welp"}))
+	})
+})
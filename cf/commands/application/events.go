@@ -1,14 +1,21 @@
 package application
 
 import (
-	"errors"
 	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"runtime"
+	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/api/appevents"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
@@ -25,13 +32,22 @@ func init() {
 }
 
 func (cmd *Events) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["follow"] = &flags.BoolFlag{Name: "follow", Usage: T("Keep polling for new events and show them as they arrive")}
+	fs["notify"] = &flags.StringFlag{Name: "notify", Usage: T("Local command to run for every new event while following; the event is passed in CF_EVENT_* environment variables")}
+	fs["notify-on"] = &flags.StringSliceFlag{Name: "notify-on", Usage: T("Only run the notify command for events of this type, wildcards such as audit.app.* are allowed, flag can be specified multiple times")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "events",
 		Description: T("Show recent app events"),
 		Usage: []string{
 			"CF_NAME events ",
-			T("APP_NAME"),
+			T("APP_NAME [--follow [--notify COMMAND] [--notify-on EVENT_TYPE]...]"),
+		},
+		Examples: []string{
+			`CF_NAME events my-app --follow --notify 'notify-send "$CF_EVENT_TYPE" "$CF_EVENT_DESCRIPTION"' --notify-on app.crash`,
 		},
+		Flags: fs,
 	}
 }
 
@@ -41,6 +57,11 @@ func (cmd *Events) Requirements(requirementsFactory requirements.Factory, c flag
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(c.Args()), 1)
 	}
 
+	if !c.Bool("follow") && (c.String("notify") != "" || len(c.StringSlice("notify-on")) > 0) {
+		cmd.ui.Failed(T("Incorrect Usage. --notify and --notify-on require --follow\n\n") + commandregistry.Commands.CommandUsage("events"))
+		return nil, fmt.Errorf("Incorrect usage: notification flags without --follow")
+	}
+
 	cmd.appReq = requirementsFactory.NewApplicationRequirement(c.Args()[0])
 
 	reqs := []requirements.Requirement{
@@ -78,17 +99,7 @@ func (cmd *Events) Execute(c flags.FlagContext) error {
 	}
 
 	for _, event := range events {
-		actor := event.ActorName
-		if actor == "" {
-			actor = event.Actor
-		}
-
-		table.Add(
-			event.Timestamp.Local().Format("2006-01-02T15:04:05.00-0700"),
-			event.Name,
-			actor,
-			event.Description,
-		)
+		table.Add(eventRow(event)...)
 	}
 
 	err = table.Print()
@@ -99,7 +110,175 @@ func (cmd *Events) Execute(c flags.FlagContext) error {
 	if len(events) == 0 {
 		cmd.ui.Say(T("No events for app {{.AppName}}",
 			map[string]interface{}{"AppName": terminal.EntityNameColor(app.Name)}))
+	}
+
+	if !c.Bool("follow") {
 		return nil
 	}
+
+	follower := newEventFollower(cmd.ui, cmd.config, cmd.eventsRepo, appevents.EventFilter{Actee: app.GUID})
+	follower.notifyCommand = c.String("notify")
+	follower.notifyTypes = c.StringSlice("notify-on")
+	follower.MarkSeen(events)
+	return follower.Follow()
+}
+
+const eventTimestampFormat = "2006-01-02T15:04:05.00-0700"
+
+// eventFollower polls the events endpoint for events newer than the ones it
+// has already seen, prints them as they arrive and runs a notification command
+// for the ones whose type matches.
+type eventFollower struct {
+	ui            terminal.UI
+	config        coreconfig.Reader
+	eventsRepo    appevents.Repository
+	filter        appevents.EventFilter
+	row           func(models.EventFields) []string
+	notifyCommand string
+	notifyTypes   []string
+
+	lastTimestamp time.Time
+	seen          map[string]time.Time
+}
+
+func newEventFollower(ui terminal.UI, config coreconfig.Reader, eventsRepo appevents.Repository, filter appevents.EventFilter) *eventFollower {
+	return &eventFollower{
+		ui:         ui,
+		config:     config,
+		eventsRepo: eventsRepo,
+		filter:     filter,
+		row:        eventRow,
+		seen:       map[string]time.Time{},
+	}
+}
+
+// MarkSeen records events that were already displayed so that following
+// starts after the newest of them instead of at the current time.
+func (f *eventFollower) MarkSeen(events []models.EventFields) {
+	for _, event := range events {
+		f.record(event)
+	}
+}
+
+// Follow polls for new events until fetching them fails.
+func (f *eventFollower) Follow() error {
+	if f.lastTimestamp.IsZero() {
+		f.lastTimestamp = time.Now()
+	}
+
+	f.ui.Say(T("Following events. Press Ctrl-C to stop."))
+
+	for {
+		time.Sleep(f.config.PollingInterval())
+
+		err := f.poll()
+		if err != nil {
+			return errors.New(T("Failed fetching events.\n{{.APIErr}}",
+				map[string]interface{}{"APIErr": err.Error()}))
+		}
+	}
+}
+
+func (f *eventFollower) poll() error {
+	filter := f.filter
+	filter.Since = f.lastTimestamp
+
+	events := []models.EventFields{}
+	err := f.eventsRepo.ListEvents(filter, func(event models.EventFields) bool {
+		if _, ok := f.seen[event.GUID]; !ok {
+			events = append(events, event)
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		f.record(event)
+		f.ui.Say(strings.Join(f.row(event), "  "))
+
+		if f.notifyCommand != "" && f.matchesNotifyTypes(event.Name) {
+			f.notify(event)
+		}
+	}
+
 	return nil
 }
+
+// record remembers the GUID of an event. Only GUIDs at the newest timestamp
+// are kept, since polling includes events at exactly that timestamp.
+func (f *eventFollower) record(event models.EventFields) {
+	if event.Timestamp.After(f.lastTimestamp) {
+		f.lastTimestamp = event.Timestamp
+		for guid, timestamp := range f.seen {
+			if timestamp.Before(f.lastTimestamp) {
+				delete(f.seen, guid)
+			}
+		}
+	}
+
+	if !event.Timestamp.Before(f.lastTimestamp) {
+		f.seen[event.GUID] = event.Timestamp
+	}
+}
+
+func (f *eventFollower) matchesNotifyTypes(eventType string) bool {
+	if len(f.notifyTypes) == 0 {
+		return true
+	}
+
+	for _, pattern := range f.notifyTypes {
+		if matched, _ := path.Match(pattern, eventType); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *eventFollower) notify(event models.EventFields) {
+	var notifier *exec.Cmd
+	if runtime.GOOS == "windows" {
+		notifier = exec.Command("cmd", "/C", f.notifyCommand)
+	} else {
+		notifier = exec.Command("sh", "-c", f.notifyCommand)
+	}
+
+	notifier.Env = append(os.Environ(),
+		"CF_EVENT_GUID="+event.GUID,
+		"CF_EVENT_TYPE="+event.Name,
+		"CF_EVENT_TIMESTAMP="+event.Timestamp.UTC().Format(time.RFC3339),
+		"CF_EVENT_ACTOR="+eventActor(event),
+		"CF_EVENT_ACTEE="+event.Actee,
+		"CF_EVENT_ACTEE_NAME="+event.ActeeName,
+		"CF_EVENT_DESCRIPTION="+event.Description,
+	)
+
+	output, err := notifier.CombinedOutput()
+	if len(output) > 0 {
+		f.ui.Say(strings.TrimSuffix(string(output), "\n"))
+	}
+	if err != nil {
+		f.ui.Warn(T("Notification command failed for event {{.EventGUID}}: {{.Error}}",
+			map[string]interface{}{
+				"EventGUID": event.GUID,
+				"Error":     err.Error(),
+			}))
+	}
+}
+
+func eventActor(event models.EventFields) string {
+	if event.ActorName != "" {
+		return event.ActorName
+	}
+	return event.Actor
+}
+
+func eventRow(event models.EventFields) []string {
+	return []string{
+		event.Timestamp.Local().Format(eventTimestampFormat),
+		event.Name,
+		eventActor(event),
+		event.Description,
+	}
+}
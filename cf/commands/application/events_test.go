@@ -2,9 +2,15 @@ package application_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/appevents"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands/application"
 	"code.cloudfoundry.org/cli/cf/flags"
@@ -85,6 +91,18 @@ var _ = Describe("events command", func() {
 			})
 		})
 
+		Context("when given notification flags without --follow", func() {
+			It("fails", func() {
+				err := flagContext.Parse("my-app", "--notify", "say crashed")
+				Expect(err).NotTo(HaveOccurred())
+				_, err = cmd.Requirements(reqFactory, flagContext)
+				Expect(err).To(HaveOccurred())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "--notify and --notify-on require --follow"},
+				))
+			})
+		})
+
 		Context("when provided exactly one arg", func() {
 			var actualRequirements []requirements.Requirement
 
@@ -218,5 +236,88 @@ var _ = Describe("events command", func() {
 				Expect(errStr).To(ContainSubstring("welp"))
 			})
 		})
+
+		Context("when following events", func() {
+			var (
+				seenTimestamp time.Time
+				newTimestamp  time.Time
+				notifyDir     string
+				notifyFile    string
+			)
+
+			BeforeEach(func() {
+				if runtime.GOOS == "windows" {
+					Skip("the notify command uses a POSIX shell")
+				}
+
+				var err error
+				notifyDir, err = ioutil.TempDir("", "events-notify")
+				Expect(err).NotTo(HaveOccurred())
+				notifyFile = filepath.Join(notifyDir, "notifications")
+
+				seenTimestamp = time.Date(2000, 1, 1, 0, 1, 11, 0, time.UTC)
+				newTimestamp = seenTimestamp.Add(time.Minute)
+				config.PollingIntervalReturns(time.Millisecond)
+
+				eventsRepo.RecentEventsReturns([]models.EventFields{
+					{GUID: "event-guid-1", Name: "audit.app.update", Timestamp: seenTimestamp, Actor: "george"},
+				}, nil)
+
+				calls := 0
+				eventsRepo.ListEventsStub = func(filter appevents.EventFilter, cb func(models.EventFields) bool) error {
+					calls++
+					if calls > 1 {
+						return errors.New("connection lost")
+					}
+
+					cb(models.EventFields{GUID: "event-guid-1", Name: "audit.app.update", Timestamp: seenTimestamp, Actor: "george"})
+					cb(models.EventFields{GUID: "event-guid-2", Name: "app.crash", Timestamp: newTimestamp, Description: "exit_status: 137", ActeeName: "my-app"})
+					cb(models.EventFields{GUID: "event-guid-3", Name: "audit.app.update", Timestamp: newTimestamp, Actor: "marcel", ActeeName: "my-app"})
+					return nil
+				}
+
+				flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+				err = flagContext.Parse("my-app", "--follow", "--notify", `echo "$CF_EVENT_TYPE $CF_EVENT_ACTEE_NAME" >> `+notifyFile, "--notify-on", "app.*")
+				Expect(err).NotTo(HaveOccurred())
+
+				cmd.SetDependency(deps, false)
+				cmd.Requirements(reqFactory, flagContext)
+			})
+
+			AfterEach(func() {
+				os.RemoveAll(notifyDir)
+			})
+
+			It("polls for events newer than the ones shown until polling fails", func() {
+				Expect(executeCmdErr).To(MatchError(ContainSubstring("connection lost")))
+
+				Expect(eventsRepo.ListEventsCallCount()).To(Equal(2))
+				filter, _ := eventsRepo.ListEventsArgsForCall(0)
+				Expect(filter.Actee).To(Equal("my-app-guid"))
+				Expect(filter.Since).To(Equal(seenTimestamp))
+				filter, _ = eventsRepo.ListEventsArgsForCall(1)
+				Expect(filter.Since).To(Equal(newTimestamp))
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"audit.app.update", "george"},
+					[]string{"Following events"},
+					[]string{"app.crash", "exit_status: 137"},
+					[]string{"audit.app.update", "marcel"},
+				))
+				georgeLines := 0
+				for _, line := range ui.Outputs() {
+					if strings.Contains(line, "george") {
+						georgeLines++
+					}
+				}
+				Expect(georgeLines).To(Equal(1))
+			})
+
+			It("runs the notify command for matching events", func() {
+				contents, err := ioutil.ReadFile(notifyFile)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("app.crash my-app\n"))
+			})
+		})
 	})
 })
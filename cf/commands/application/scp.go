@@ -0,0 +1,162 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	sshTerminal "code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+type SCP struct {
+	ui            terminal.UI
+	config        coreconfig.Reader
+	gateway       net.Gateway
+	appReq        requirements.ApplicationRequirement
+	sshCodeGetter commands.SSHCodeGetter
+	opts          *options.SCPOptions
+	secureShell   sshCmd.SecureShell
+}
+
+func init() {
+	commandregistry.Register(&SCP{})
+}
+
+func (cmd *SCP) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["app-instance-index"] = &flags.IntFlag{Name: "app-instance-index", ShortName: "i", Usage: T("Application instance index")}
+	fs["recursive"] = &flags.BoolFlag{Name: "recursive", ShortName: "r", Usage: T("Recursively copy entire directories")}
+	fs["skip-host-validation"] = &flags.BoolFlag{Name: "skip-host-validation", ShortName: "k", Usage: T("Skip host key validation")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "scp",
+		Description: T("Copy files to or from an application container instance"),
+		Usage: []string{
+			T("CF_NAME scp [APP_NAME:]SOURCE [APP_NAME:]DESTINATION [-i app-instance-index] [-r] [--skip-host-validation]"),
+		},
+		Examples: []string{
+			"CF_NAME scp my-app:/home/vcap/app/heap.hprof ./heap.hprof",
+			"CF_NAME scp -i 2 -r ./config my-app:/home/vcap/app",
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *SCP) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	if len(fc.Args()) != 2 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires SOURCE and DESTINATION as arguments") + "\n\n" + commandregistry.Commands.CommandUsage("scp"))
+		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 2)
+	}
+
+	if fc.IsSet("i") && fc.Int("i") < 0 {
+		cmd.ui.Failed(fmt.Sprintf(T("Incorrect Usage:")+" %s\n\n%s", T("Value for flag 'app-instance-index' cannot be negative"), commandregistry.Commands.CommandUsage("scp")))
+		return nil, fmt.Errorf("Incorrect usage: app-instance-index cannot be negative")
+	}
+
+	var err error
+	cmd.opts, err = options.NewSCPOptions(fc)
+
+	if err != nil {
+		cmd.ui.Failed(fmt.Sprintf(T("Incorrect Usage:")+" %s\n\n%s", err.Error(), commandregistry.Commands.CommandUsage("scp")))
+		return nil, err
+	}
+
+	cmd.appReq = requirementsFactory.NewApplicationRequirement(cmd.opts.AppName)
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+		cmd.appReq,
+	}
+
+	return reqs, nil
+}
+
+func (cmd *SCP) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.gateway = deps.Gateways["cloud-controller"]
+
+	if deps.WildcardDependency != nil {
+		cmd.secureShell = deps.WildcardDependency.(sshCmd.SecureShell)
+	}
+
+	//get ssh-code for dependency
+	sshCodeGetter := commandregistry.Commands.FindCommand("ssh-code")
+	sshCodeGetter = sshCodeGetter.SetDependency(deps, false)
+	cmd.sshCodeGetter = sshCodeGetter.(commands.SSHCodeGetter)
+
+	return cmd
+}
+
+func (cmd *SCP) Execute(fc flags.FlagContext) error {
+	app := cmd.appReq.GetApplication()
+	info, err := getSSHEndpointInfo(cmd.gateway, cmd.config)
+	if err != nil {
+		return errors.New(T("Error getting SSH info:") + err.Error())
+	}
+
+	sshAuthCode, err := cmd.sshCodeGetter.Get()
+	if err != nil {
+		return errors.New(T("Error getting one time auth code: ") + err.Error())
+	}
+
+	//init secureShell if it is not already set by SetDependency() with fakes
+	if cmd.secureShell == nil {
+		cmd.secureShell = sshCmd.NewSecureShell(
+			sshCmd.DefaultSecureDialer(),
+			sshTerminal.DefaultHelper(),
+			sshCmd.DefaultListenerFactory(),
+			30*time.Second,
+			app,
+			info.SSHEndpointFingerprint,
+			info.SSHEndpoint,
+			sshAuthCode,
+			newKnownHosts(cmd.config),
+			cmd.ui,
+		)
+	}
+
+	err = cmd.secureShell.Connect(&cmd.opts.SSHOptions)
+	if err != nil {
+		return errors.New(T("Error opening SSH connection: ") + err.Error())
+	}
+	defer cmd.secureShell.Close()
+
+	source, destination := fc.Args()[0], fc.Args()[1]
+	cmd.ui.Say(T("Copying {{.Source}} to {{.Destination}} on instance {{.Index}} of app {{.AppName}}...",
+		map[string]interface{}{
+			"Source":      terminal.EntityNameColor(source),
+			"Destination": terminal.EntityNameColor(destination),
+			"Index":       cmd.opts.Index,
+			"AppName":     terminal.EntityNameColor(app.Name),
+		}))
+
+	err = cmd.secureShell.Copy(cmd.opts, cmd.progressReader)
+	if err != nil {
+		return errors.New(T("Error copying files: ") + err.Error())
+	}
+
+	cmd.ui.Ok()
+	return nil
+}
+
+func (cmd *SCP) progressReader(source io.ReadSeeker, size int64) io.Reader {
+	progressReader := net.NewProgressReader(source, cmd.ui, 5*time.Second)
+	progressReader.SetTotalSize(size)
+	if cmd.opts.Direction == options.CopyFromContainer {
+		progressReader.SetDirection(net.ProgressDownload)
+	}
+	return progressReader
+}
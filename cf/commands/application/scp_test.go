@@ -0,0 +1,218 @@
+package application_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/commandsfakes"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/cf/trace/tracefakes"
+	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testnet "code.cloudfoundry.org/cli/util/testhelpers/net"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SCP command", func() {
+	var (
+		ui *testterm.FakeUI
+
+		sshCodeGetter         *commandsfakes.FakeSSHCodeGetter
+		originalSSHCodeGetter commandregistry.Command
+
+		requirementsFactory *requirementsfakes.FakeFactory
+		configRepo          coreconfig.Repository
+		deps                commandregistry.Dependency
+
+		fakeSecureShell *sshfakes.FakeSecureShell
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+		deps.Gateways = make(map[string]net.Gateway)
+
+		//save original command and restore later
+		originalSSHCodeGetter = commandregistry.Commands.FindCommand("ssh-code")
+
+		sshCodeGetter = new(commandsfakes.FakeSSHCodeGetter)
+
+		//setup fakes to correctly interact with commandregistry
+		sshCodeGetter.SetDependencyStub = func(_ commandregistry.Dependency, _ bool) commandregistry.Command {
+			return sshCodeGetter
+		}
+		sshCodeGetter.MetaDataReturns(commandregistry.CommandMetadata{Name: "ssh-code"})
+	})
+
+	AfterEach(func() {
+		//restore original command
+		commandregistry.Register(originalSSHCodeGetter)
+	})
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = configRepo
+
+		//inject fake 'sshCodeGetter' into registry
+		commandregistry.Register(sshCodeGetter)
+
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("scp").SetDependency(deps, pluginCall))
+	}
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("scp", args, requirementsFactory, updateCommandDependency, false, ui)
+	}
+
+	Describe("Requirements", func() {
+		BeforeEach(func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+		})
+
+		It("fails with usage when not provided exactly two args", func() {
+			runCommand("my-app:/tmp/file")
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Requires", "SOURCE and DESTINATION"},
+			))
+		})
+
+		It("fails with usage when the instance index is negative", func() {
+			Expect(runCommand("-i", "-1", "my-app:/tmp/file", "file")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "cannot be negative"},
+			))
+		})
+
+		It("fails with usage when neither path refers to an app", func() {
+			Expect(runCommand("./source", "./destination")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "APP_NAME:PATH"},
+				[]string{"USAGE:"},
+			))
+		})
+
+		It("fails requirements when a space is not targeted", func() {
+			requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Failing{Message: "not targeting space"})
+			Expect(runCommand("my-app:/tmp/file", "file")).To(BeFalse())
+		})
+
+		It("requires the app named in the remote path", func() {
+			requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
+			applicationReq := new(requirementsfakes.FakeApplicationRequirement)
+			applicationReq.ExecuteReturns(errors.New("no app"))
+			requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+
+			Expect(runCommand("file", "my-app:/tmp/file")).To(BeFalse())
+			Expect(requirementsFactory.NewApplicationRequirementArgsForCall(0)).To(Equal("my-app"))
+		})
+	})
+
+	Describe("copying files", func() {
+		var testServer *httptest.Server
+
+		BeforeEach(func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+			requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
+
+			currentApp := models.Application{}
+			currentApp.Name = "my-app"
+			currentApp.State = "started"
+			currentApp.GUID = "my-app-guid"
+			currentApp.EnableSSH = true
+			currentApp.Diego = true
+
+			applicationReq := new(requirementsfakes.FakeApplicationRequirement)
+			applicationReq.GetApplicationReturns(currentApp)
+			requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+
+			fakeSecureShell = new(sshfakes.FakeSecureShell)
+			deps.WildcardDependency = fakeSecureShell
+
+			getRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/info",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body:   getInfoResponseBody,
+				},
+			})
+
+			testServer, _ = testnet.NewServer([]testnet.TestRequest{getRequest})
+			configRepo.SetAPIEndpoint(testServer.URL)
+			deps.Gateways["cloud-controller"] = net.NewCloudControllerGateway(configRepo, time.Now, &testterm.FakeUI{}, new(tracefakes.FakePrinter), "")
+		})
+
+		AfterEach(func() {
+			testServer.Close()
+			deps.WildcardDependency = nil
+		})
+
+		It("connects to the requested instance and copies from the container", func() {
+			Expect(runCommand("-i", "2", "my-app:/home/vcap/heap.hprof", "heap.hprof")).To(BeTrue())
+
+			Expect(fakeSecureShell.ConnectCallCount()).To(Equal(1))
+			Expect(fakeSecureShell.ConnectArgsForCall(0).Index).To(Equal(uint(2)))
+
+			Expect(fakeSecureShell.CopyCallCount()).To(Equal(1))
+			copyOpts, progress := fakeSecureShell.CopyArgsForCall(0)
+			Expect(copyOpts.Direction).To(Equal(options.CopyFromContainer))
+			Expect(copyOpts.RemotePath).To(Equal("/home/vcap/heap.hprof"))
+			Expect(copyOpts.LocalPath).To(Equal("heap.hprof"))
+			Expect(progress).NotTo(BeNil())
+
+			Expect(fakeSecureShell.CloseCallCount()).To(Equal(1))
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Copying", "my-app:/home/vcap/heap.hprof", "heap.hprof", "instance 2"},
+				[]string{"OK"},
+			))
+		})
+
+		It("reports the copy from the container as a download", func() {
+			Expect(runCommand("my-app:/home/vcap/heap.hprof", "heap.hprof")).To(BeTrue())
+
+			_, progress := fakeSecureShell.CopyArgsForCall(0)
+			_, err := ioutil.ReadAll(progress(bytes.NewReader([]byte("heap")), 4))
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(ui.Outputs).Should(ContainSubstrings([]string{"Done downloading"}))
+		})
+
+		Context("when connecting fails", func() {
+			It("notifies users", func() {
+				fakeSecureShell.ConnectReturns(errors.New("dial error"))
+
+				Expect(runCommand("my-app:/tmp/file", "file")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Error opening SSH connection", "dial error"},
+				))
+				Expect(fakeSecureShell.CopyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when copying fails", func() {
+			It("notifies users", func() {
+				fakeSecureShell.CopyReturns(errors.New("permission denied"))
+
+				Expect(runCommand("file", "my-app:/tmp/file")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Error copying files", "permission denied"},
+				))
+			})
+		})
+	})
+})
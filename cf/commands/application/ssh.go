@@ -4,15 +4,19 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"golang.org/x/crypto/ssh"
 
+	"code.cloudfoundry.org/cli/cf/api/appinstances"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/net"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
@@ -22,13 +26,14 @@ import (
 )
 
 type SSH struct {
-	ui            terminal.UI
-	config        coreconfig.Reader
-	gateway       net.Gateway
-	appReq        requirements.ApplicationRequirement
-	sshCodeGetter commands.SSHCodeGetter
-	opts          *options.SSHOptions
-	secureShell   sshCmd.SecureShell
+	ui               terminal.UI
+	config           coreconfig.Reader
+	gateway          net.Gateway
+	appInstancesRepo appinstances.Repository
+	appReq           requirements.ApplicationRequirement
+	sshCodeGetter    commands.SSHCodeGetter
+	opts             *options.SSHOptions
+	secureShell      sshCmd.SecureShell
 }
 
 type sshInfo struct {
@@ -43,6 +48,8 @@ func init() {
 func (cmd *SSH) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["L"] = &flags.StringSliceFlag{ShortName: "L", Usage: T("Local port forward specification. This flag can be defined more than once.")}
+	fs["R"] = &flags.StringSliceFlag{ShortName: "R", Usage: T("Remote port forward specification. This flag can be defined more than once.")}
+	fs["D"] = &flags.StringSliceFlag{ShortName: "D", Usage: T("Dynamic SOCKS5 port forward specification. This flag can be defined more than once.")}
 	fs["command"] = &flags.StringSliceFlag{Name: "command", ShortName: "c", Usage: T("Command to run. This flag can be defined more than once.")}
 	fs["app-instance-index"] = &flags.IntFlag{Name: "app-instance-index", ShortName: "i", Usage: T("Application instance index")}
 	fs["skip-host-validation"] = &flags.BoolFlag{Name: "skip-host-validation", ShortName: "k", Usage: T("Skip host key validation")}
@@ -50,12 +57,18 @@ func (cmd *SSH) MetaData() commandregistry.CommandMetadata {
 	fs["request-pseudo-tty"] = &flags.BoolFlag{Name: "request-pseudo-tty", ShortName: "t", Usage: T("Request pseudo-tty allocation")}
 	fs["force-pseudo-tty"] = &flags.BoolFlag{Name: "force-pseudo-tty", ShortName: "tt", Usage: T("Force pseudo-tty allocation")}
 	fs["disable-pseudo-tty"] = &flags.BoolFlag{Name: "disable-pseudo-tty", ShortName: "T", Usage: T("Disable pseudo-tty allocation")}
+	fs["record"] = &flags.StringFlag{Name: "record", Usage: T("Record the session to an asciinema compatible file in DIR")}
+	fs["all-instances"] = &flags.BoolFlag{Name: "all-instances", Usage: T("Run the command on every running instance of the application")}
+	fs["concurrency"] = &flags.IntFlag{Name: "concurrency", Usage: T("Maximum number of instances to run the command on at once when using --all-instances (Default: {{.Concurrency}})", map[string]interface{}{"Concurrency": options.DefaultAllInstancesConcurrency})}
+	fs["timeout"] = &flags.IntFlag{Name: "timeout", Usage: T("Time in seconds each instance has to complete the command when using --all-instances (Default: {{.Timeout}})", map[string]interface{}{"Timeout": int(options.DefaultAllInstancesTimeout.Seconds())})}
 
 	return commandregistry.CommandMetadata{
 		Name:        "ssh",
 		Description: T("SSH to an application container instance"),
 		Usage: []string{
-			T("CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [--skip-host-validation] [--skip-remote-execution] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty]"),
+			T("CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [-R [bind_address:]port:host:hostport] [-D [bind_address:]port] [--skip-host-validation] [--skip-remote-execution] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty] [--record DIR]"),
+			"\n   ",
+			T("CF_NAME ssh APP_NAME --all-instances -c command [--concurrency max-instances] [--timeout seconds] [--skip-host-validation]"),
 		},
 		Flags: fs,
 	}
@@ -95,6 +108,7 @@ func (cmd *SSH) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.gateway = deps.Gateways["cloud-controller"]
+	cmd.appInstancesRepo = deps.RepoLocator.GetAppInstancesRepository()
 
 	if deps.WildcardDependency != nil {
 		cmd.secureShell = deps.WildcardDependency.(sshCmd.SecureShell)
@@ -110,11 +124,15 @@ func (cmd *SSH) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 
 func (cmd *SSH) Execute(fc flags.FlagContext) error {
 	app := cmd.appReq.GetApplication()
-	info, err := cmd.getSSHEndpointInfo()
+	info, err := getSSHEndpointInfo(cmd.gateway, cmd.config)
 	if err != nil {
 		return errors.New(T("Error getting SSH info:") + err.Error())
 	}
 
+	if cmd.opts.AllInstances {
+		return cmd.executeOnAllInstances(app, info)
+	}
+
 	sshAuthCode, err := cmd.sshCodeGetter.Get()
 	if err != nil {
 		return errors.New(T("Error getting one time auth code: ") + err.Error())
@@ -131,6 +149,8 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 			info.SSHEndpointFingerprint,
 			info.SSHEndpoint,
 			sshAuthCode,
+			newKnownHosts(cmd.config),
+			cmd.ui,
 		)
 	}
 
@@ -145,6 +165,16 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 		return errors.New(T("Error forwarding port: ") + err.Error())
 	}
 
+	err = cmd.secureShell.RemotePortForward()
+	if err != nil {
+		return errors.New(T("Error forwarding remote port: ") + err.Error())
+	}
+
+	err = cmd.secureShell.DynamicPortForward()
+	if err != nil {
+		return errors.New(T("Error starting SOCKS proxy: ") + err.Error())
+	}
+
 	if cmd.opts.SkipRemoteExecution {
 		err = cmd.secureShell.Wait()
 	} else {
@@ -168,8 +198,104 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 	return nil
 }
 
-func (cmd *SSH) getSSHEndpointInfo() (sshInfo, error) {
+func (cmd *SSH) executeOnAllInstances(app models.Application, info sshInfo) error {
+	instances, err := cmd.appInstancesRepo.GetInstances(app.GUID)
+	if err != nil {
+		return errors.New(T("Error getting application instances: ") + err.Error())
+	}
+
+	var indexes []uint
+	for index, instance := range instances {
+		if instance.State == models.InstanceRunning {
+			indexes = append(indexes, uint(index))
+		}
+	}
+
+	if len(indexes) == 0 {
+		return errors.New(T("No running instances of app {{.AppName}}", map[string]interface{}{"AppName": app.Name}))
+	}
+
+	cmd.ui.Say(T("Running {{.Command}} on {{.Count}} instances of app {{.AppName}}...",
+		map[string]interface{}{
+			"Command": terminal.EntityNameColor(strings.Join(cmd.opts.Command, " ")),
+			"Count":   len(indexes),
+			"AppName": terminal.EntityNameColor(app.Name),
+		}))
+	cmd.ui.Say("")
+
+	// host keys can only be trusted interactively by a single connection
+	knownHosts := newKnownHosts(cmd.config)
+	newShell := func() (sshCmd.SecureShell, error) {
+		//reuse secureShell if it was set by SetDependency() with fakes
+		if cmd.secureShell != nil {
+			return cmd.secureShell, nil
+		}
+
+		// each connection needs its own one time auth code
+		sshAuthCode, codeErr := cmd.sshCodeGetter.Get()
+		if codeErr != nil {
+			return nil, errors.New(T("Error getting one time auth code: ") + codeErr.Error())
+		}
+
+		return sshCmd.NewSecureShell(
+			sshCmd.DefaultSecureDialer(),
+			sshTerminal.DefaultHelper(),
+			sshCmd.DefaultListenerFactory(),
+			30*time.Second,
+			app,
+			info.SSHEndpointFingerprint,
+			info.SSHEndpoint,
+			sshAuthCode,
+			knownHosts,
+			nil,
+		), nil
+	}
+
+	results := sshCmd.RunOnInstances(newShell, cmd.opts, indexes, cmd.ui.Writer(), os.Stderr)
+
+	cmd.ui.Say("")
+	table := cmd.ui.Table([]string{T("instance"), T("exit code"), T("error")})
+
+	failed := 0
+	for _, result := range results {
+		exitCode := strconv.Itoa(result.ExitCode)
+		errMessage := ""
+		if result.Err != nil {
+			exitCode = ""
+			errMessage = result.Err.Error()
+		}
+		if result.ExitCode != 0 {
+			failed++
+		}
+
+		table.Add(fmt.Sprintf("#%d", result.Index), exitCode, errMessage)
+	}
+
+	err = table.Print()
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return errors.New(T("Command failed on {{.Failed}} of {{.Total}} instances", map[string]interface{}{
+			"Failed": failed,
+			"Total":  len(results),
+		}))
+	}
+
+	return nil
+}
+
+func newKnownHosts(config coreconfig.Reader) sshCmd.KnownHosts {
+	path, err := sshCmd.DefaultKnownHostsPath()
+	if err != nil {
+		return nil
+	}
+	return sshCmd.NewKnownHostsFile(path, config.APIEndpoint())
+}
+
+func getSSHEndpointInfo(gateway net.Gateway, config coreconfig.Reader) (sshInfo, error) {
 	info := sshInfo{}
-	err := cmd.gateway.GetResource(cmd.config.APIEndpoint()+"/v2/info", &info)
+	err := gateway.GetResource(config.APIEndpoint()+"/v2/info", &info)
 	return info, err
 }
@@ -0,0 +1,81 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+type SSHReplay struct {
+	ui terminal.UI
+}
+
+func init() {
+	commandregistry.Register(&SSHReplay{})
+}
+
+func (cmd *SSHReplay) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["speed"] = &flags.Float64Flag{Name: "speed", Usage: T("Playback speed multiplier (Default: 1)")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "ssh-replay",
+		Description: T("Replay a session recorded with 'CF_NAME ssh --record'"),
+		Usage: []string{
+			T("CF_NAME ssh-replay FILE [--speed SPEED]"),
+		},
+		Examples: []string{
+			"CF_NAME ssh-replay ./recordings/my-app-0-20170101T120000Z.cast",
+			"CF_NAME ssh-replay ./recordings/my-app-0-20170101T120000Z.cast --speed 2",
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *SSHReplay) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	if len(fc.Args()) != 1 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires FILE as argument") + "\n\n" + commandregistry.Commands.CommandUsage("ssh-replay"))
+		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
+	}
+
+	if fc.IsSet("speed") && fc.Float64("speed") <= 0 {
+		cmd.ui.Failed(fmt.Sprintf(T("Incorrect Usage:")+" %s\n\n%s", T("Value for flag 'speed' must be greater than zero"), commandregistry.Commands.CommandUsage("ssh-replay")))
+		return nil, fmt.Errorf("Incorrect usage: speed must be greater than zero")
+	}
+
+	reqs := []requirements.Requirement{}
+	return reqs, nil
+}
+
+func (cmd *SSHReplay) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	return cmd
+}
+
+func (cmd *SSHReplay) Execute(fc flags.FlagContext) error {
+	speed := 1.0
+	if fc.IsSet("speed") {
+		speed = fc.Float64("speed")
+	}
+
+	file, err := os.Open(fc.Args()[0])
+	if err != nil {
+		return errors.New(T("Error opening recording: ") + err.Error())
+	}
+	defer file.Close()
+
+	err = sshCmd.Replay(file, cmd.ui.Writer(), speed, time.Sleep)
+	if err != nil {
+		return errors.New(T("Error replaying recording: ") + err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package application_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	io_helpers "code.cloudfoundry.org/cli/util/testhelpers/io"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ssh-replay command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		requirementsFactory *requirementsfakes.FakeFactory
+		deps                commandregistry.Dependency
+		tempDir             string
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "ssh-replay")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("ssh-replay").SetDependency(deps, pluginCall))
+	}
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("ssh-replay", args, requirementsFactory, updateCommandDependency, false, ui)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not provided exactly one arg", func() {
+			Expect(runCommand()).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Requires FILE as argument"},
+			))
+		})
+
+		It("fails with usage when the speed is not positive", func() {
+			Expect(runCommand("session.cast", "--speed", "0")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Value for flag 'speed' must be greater than zero"},
+			))
+		})
+	})
+
+	Describe("Execute", func() {
+		It("writes the recorded output", func() {
+			path := filepath.Join(tempDir, "session.cast")
+			err := ioutil.WriteFile(path, []byte(`{"version":2,"width":80,"height":24}
+[0.001,"o","hello from "]
+[0.002,"r","100x30"]
+[0.003,"o","the recording\n"]
+`), 0600)
+			Expect(err).NotTo(HaveOccurred())
+
+			var passed bool
+			output := io_helpers.CaptureOutput(func() {
+				passed = runCommand(path, "--speed", "10")
+			})
+
+			Expect(passed).To(BeTrue())
+			Expect(output).To(ContainElement("hello from the recording"))
+		})
+
+		It("returns an error when the file does not exist", func() {
+			Expect(runCommand(filepath.Join(tempDir, "missing.cast"))).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Error opening recording"},
+			))
+		})
+
+		It("returns an error when the file is not a recording", func() {
+			path := filepath.Join(tempDir, "session.cast")
+			err := ioutil.WriteFile(path, []byte("not a recording\n"), 0600)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(runCommand(path)).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Error replaying recording", "Invalid recording header"},
+			))
+		})
+	})
+})
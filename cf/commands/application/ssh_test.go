@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/appinstances/appinstancesfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands/commandsfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -140,6 +141,16 @@ var _ = Describe("SSH command", func() {
 		})
 
 		Describe("SSHOptions", func() {
+			Context("when --all-instances is provided without a command", func() {
+				It("shows error and prints command usage", func() {
+					Expect(runCommand("app_name", "--all-instances")).To(BeFalse())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Incorrect Usage", "requires a command"},
+						[]string{"USAGE:"},
+					))
+				})
+			})
+
 			Context("when an error is returned during initialization", func() {
 				It("shows error and prints command usage", func() {
 					Expect(runCommand("app_name", "-L", "[9999:localhost...")).To(BeFalse())
@@ -296,6 +307,30 @@ var _ = Describe("SSH command", func() {
 				})
 			})
 
+			Context("Error port forwarding when -R is provided", func() {
+				It("notifies users", func() {
+					fakeSecureShell.RemotePortForwardReturns(errors.New("tcpip-forward request denied by peer"))
+
+					runCommand("my-app", "-R", "8000:localhost:8000")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Error forwarding remote port", "tcpip-forward request denied by peer"},
+					))
+				})
+			})
+
+			Context("Error starting the SOCKS proxy when -D is provided", func() {
+				It("notifies users", func() {
+					fakeSecureShell.DynamicPortForwardReturns(errors.New("listen error"))
+
+					runCommand("my-app", "-D", "1080")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Error starting SOCKS proxy", "listen error"},
+					))
+				})
+			})
+
 			Context("when -N is provided", func() {
 				It("calls secureShell.Wait()", func() {
 					fakeSecureShell.ConnectReturns(nil)
@@ -318,6 +353,62 @@ var _ = Describe("SSH command", func() {
 				})
 			})
 
+			Context("when --all-instances is provided", func() {
+				var appInstancesRepo *appinstancesfakes.FakeAppInstancesRepository
+
+				BeforeEach(func() {
+					appInstancesRepo = new(appinstancesfakes.FakeAppInstancesRepository)
+					appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+						{State: models.InstanceRunning},
+						{State: models.InstanceCrashed},
+						{State: models.InstanceRunning},
+					}, nil)
+					deps.RepoLocator = deps.RepoLocator.SetAppInstancesRepository(appInstancesRepo)
+				})
+
+				It("runs the command on every running instance", func() {
+					Expect(runCommand("my-app", "--all-instances", "-c", "uptime")).To(BeTrue())
+
+					Expect(appInstancesRepo.GetInstancesArgsForCall(0)).To(Equal("my-app-guid"))
+					Expect(fakeSecureShell.ConnectCallCount()).To(Equal(2))
+					var indexes []uint
+					for i := 0; i < fakeSecureShell.ConnectCallCount(); i++ {
+						indexes = append(indexes, fakeSecureShell.ConnectArgsForCall(i).Index)
+					}
+					Expect(indexes).To(ConsistOf(uint(0), uint(2)))
+					Expect(fakeSecureShell.ExecuteCommandCallCount()).To(Equal(2))
+					Expect(fakeSecureShell.InteractiveSessionCallCount()).To(Equal(0))
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Running", "uptime", "2 instances", "my-app"},
+						[]string{"instance", "exit code", "error"},
+						[]string{"#0", "0"},
+						[]string{"#2", "0"},
+					))
+				})
+
+				It("reports the instances that failed", func() {
+					fakeSecureShell.ExecuteCommandReturns(errors.New("session failed"))
+
+					Expect(runCommand("my-app", "--all-instances", "-c", "uptime")).To(BeFalse())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"#0", "session failed"},
+						[]string{"#2", "session failed"},
+						[]string{"Command failed on 2 of 2 instances"},
+					))
+				})
+
+				It("fails when no instances are running", func() {
+					appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{{State: models.InstanceDown}}, nil)
+
+					Expect(runCommand("my-app", "--all-instances", "-c", "uptime")).To(BeFalse())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"No running instances of app my-app"},
+					))
+					Expect(fakeSecureShell.ConnectCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when Wait() or InteractiveSession() returns error", func() {
 
 				It("notifities users", func() {
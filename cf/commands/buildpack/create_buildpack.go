@@ -30,12 +30,13 @@ func (cmd *CreateBuildpack) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["enable"] = &flags.BoolFlag{Name: "enable", Usage: T("Enable the buildpack to be used for staging")}
 	fs["disable"] = &flags.BoolFlag{Name: "disable", Usage: T("Disable the buildpack from being used for staging")}
+	fs["stack"] = &flags.StringFlag{Name: "stack", Usage: T("Stack the buildpack can be used with (Default: any stack)")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "create-buildpack",
 		Description: T("Create a buildpack"),
 		Usage: []string{
-			T("CF_NAME create-buildpack BUILDPACK PATH POSITION [--enable|--disable]"),
+			T("CF_NAME create-buildpack BUILDPACK PATH POSITION [--enable|--disable] [--stack STACK]"),
 			T("\n\nTIP:\n"),
 			T("   Path should be a zip file, a url to a zip file, or a local directory. Position is a positive integer, sets priority, and is sorted from lowest to highest."),
 		},
@@ -92,10 +93,13 @@ func (cmd *CreateBuildpack) Execute(c flags.FlagContext) error {
 
 	cmd.ui.Say(T("Uploading buildpack {{.BuildpackName}}...", map[string]interface{}{"BuildpackName": terminal.EntityNameColor(buildpackName)}))
 
-	err = cmd.buildpackBitsRepo.UploadBuildpack(buildpack, buildpackFile, buildpackFileName)
+	uploaded, err := cmd.buildpackBitsRepo.UploadBuildpack(buildpack, buildpackFile, buildpackFileName)
 	if err != nil {
 		return err
 	}
+	if !uploaded {
+		cmd.ui.Say(T("Buildpack bits are unchanged, skipping upload"))
+	}
 
 	cmd.ui.Ok()
 	return nil
@@ -127,7 +131,7 @@ func (cmd CreateBuildpack) createBuildpack(buildpackName string, c flags.FlagCon
 		enableOption = &disabled
 	}
 
-	buildpack, apiErr = cmd.buildpackRepo.Create(buildpackName, &position, enableOption, nil)
+	buildpack, apiErr = cmd.buildpackRepo.Create(buildpackName, &position, enableOption, nil, c.String("stack"))
 
 	return
 }
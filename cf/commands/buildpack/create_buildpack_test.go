@@ -37,6 +37,7 @@ var _ = Describe("create-buildpack command", func() {
 		requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
 		repo = new(apifakes.OldFakeBuildpackRepository)
 		bitsRepo = new(apifakes.FakeBuildpackBitsRepository)
+		bitsRepo.UploadBuildpackReturns(true, nil)
 		ui = &testterm.FakeUI{}
 	})
 
@@ -126,8 +127,26 @@ var _ = Describe("create-buildpack command", func() {
 		Expect(*repo.CreateBuildpack.Enabled).To(Equal(false))
 	})
 
+	It("creates the buildpack for the given stack when given the --stack flag", func() {
+		testcmd.RunCLICommand("create-buildpack", []string{"--stack", "my-stack", "my-buildpack", "my.war", "5"}, requirementsFactory, updateCommandDependency, false, ui)
+
+		Expect(repo.CreateBuildpack.Stack).To(Equal("my-stack"))
+	})
+
+	It("tells the user when the buildpack bits are unchanged", func() {
+		bitsRepo.UploadBuildpackReturns(false, nil)
+
+		testcmd.RunCLICommand("create-buildpack", []string{"my-buildpack", "my.war", "5"}, requirementsFactory, updateCommandDependency, false, ui)
+
+		Expect(ui.Outputs()).To(ContainSubstrings(
+			[]string{"Uploading buildpack", "my-buildpack"},
+			[]string{"Buildpack bits are unchanged, skipping upload"},
+			[]string{"OK"},
+		))
+	})
+
 	It("alerts the user when uploading the buildpack bits fails", func() {
-		bitsRepo.UploadBuildpackReturns(fmt.Errorf("upload error"))
+		bitsRepo.UploadBuildpackReturns(false, fmt.Errorf("upload error"))
 
 		testcmd.RunCLICommand("create-buildpack", []string{"my-buildpack", "bogus/path", "5"}, requirementsFactory, updateCommandDependency, false, ui)
 
@@ -32,12 +32,13 @@ func (cmd *UpdateBuildpack) MetaData() commandregistry.CommandMetadata {
 	fs["disable"] = &flags.BoolFlag{Name: "disable", Usage: T("Disable the buildpack from being used for staging")}
 	fs["lock"] = &flags.BoolFlag{Name: "lock", Usage: T("Lock the buildpack to prevent updates")}
 	fs["unlock"] = &flags.BoolFlag{Name: "unlock", Usage: T("Unlock the buildpack to enable updates")}
+	fs["stack"] = &flags.StringFlag{Name: "stack", Usage: T("Stack of the buildpack to update, when buildpacks with the same name exist for several stacks")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "update-buildpack",
 		Description: T("Update a buildpack"),
 		Usage: []string{
-			T("CF_NAME update-buildpack BUILDPACK [-p PATH] [-i POSITION] [--enable|--disable] [--lock|--unlock]"),
+			T("CF_NAME update-buildpack BUILDPACK [-p PATH] [-i POSITION] [--enable|--disable] [--lock|--unlock] [--stack STACK]"),
 			T("\n\nTIP:\n"),
 			T("   Path should be a zip file, a url to a zip file, or a local directory. Position is a positive integer, sets priority, and is sorted from lowest to highest."),
 		},
@@ -52,7 +53,7 @@ func (cmd *UpdateBuildpack) Requirements(requirementsFactory requirements.Factor
 	}
 
 	loginReq := requirementsFactory.NewLoginRequirement()
-	cmd.buildpackReq = requirementsFactory.NewBuildpackRequirement(fc.Args()[0])
+	cmd.buildpackReq = requirementsFactory.NewBuildpackRequirement(fc.Args()[0], fc.String("stack"))
 
 	reqs := []requirements.Requirement{
 		loginReq,
@@ -148,13 +149,16 @@ func (cmd *UpdateBuildpack) Execute(c flags.FlagContext) error {
 	}
 
 	if path != "" {
-		err := cmd.buildpackBitsRepo.UploadBuildpack(buildpack, buildpackFile, buildpackFileName)
+		uploaded, err := cmd.buildpackBitsRepo.UploadBuildpack(buildpack, buildpackFile, buildpackFileName)
 		if err != nil {
 			return errors.New(T("Error uploading buildpack {{.Name}}\n{{.Error}}", map[string]interface{}{
 				"Name":  terminal.EntityNameColor(buildpack.Name),
 				"Error": err.Error(),
 			}))
 		}
+		if !uploaded {
+			cmd.ui.Say(T("Buildpack bits are unchanged, skipping upload"))
+		}
 	}
 	cmd.ui.Ok()
 	return nil
@@ -60,6 +60,7 @@ var _ = Describe("Updating buildpack command", func() {
 		ui = new(testterm.FakeUI)
 		repo = new(apifakes.OldFakeBuildpackRepository)
 		bitsRepo = new(apifakes.FakeBuildpackBitsRepository)
+		bitsRepo.UploadBuildpackReturns(true, nil)
 	})
 
 	runCommand := func(args ...string) bool {
@@ -80,6 +81,17 @@ var _ = Describe("Updating buildpack command", func() {
 		})
 	})
 
+	Context("when a stack is provided", func() {
+		It("requires the buildpack with that name and stack", func() {
+			Expect(runCommand("--stack", "my-stack", buildpackName)).To(BeTrue())
+
+			Expect(requirementsFactory.NewBuildpackRequirementCallCount()).To(Equal(1))
+			name, stack := requirementsFactory.NewBuildpackRequirementArgsForCall(0)
+			Expect(name).To(Equal(buildpackName))
+			Expect(stack).To(Equal("my-stack"))
+		})
+	})
+
 	Context("when a file is provided", func() {
 		It("prints error and do not call create buildpack", func() {
 			bitsRepo.CreateBuildpackZipFileReturns(nil, "", fmt.Errorf("create buildpack error"))
@@ -162,8 +174,20 @@ var _ = Describe("Updating buildpack command", func() {
 				successfulUpdate(ui, buildpackName)
 			})
 
+			It("tells the user when the buildpack bits are unchanged", func() {
+				bitsRepo.UploadBuildpackReturns(false, nil)
+
+				runCommand("-p", "buildpack.zip", buildpackName)
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Updating buildpack", buildpackName},
+					[]string{"Buildpack bits are unchanged, skipping upload"},
+					[]string{"OK"},
+				))
+			})
+
 			It("errors when passed invalid path", func() {
-				bitsRepo.UploadBuildpackReturns(fmt.Errorf("upload error"))
+				bitsRepo.UploadBuildpackReturns(false, fmt.Errorf("upload error"))
 
 				runCommand("-p", "bogus/path", buildpackName)
 
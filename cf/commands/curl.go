@@ -6,13 +6,18 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/cf/flagcontext"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+	cfjson "code.cloudfoundry.org/cli/util/json"
 
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -24,7 +29,7 @@ import (
 
 type Curl struct {
 	ui         terminal.UI
-	config     coreconfig.Reader
+	config     coreconfig.ReadWriter
 	curlRepo   api.CurlRepository
 	pluginCall bool
 }
@@ -40,34 +45,69 @@ func (cmd *Curl) MetaData() commandregistry.CommandMetadata {
 	fs["H"] = &flags.StringSliceFlag{ShortName: "H", Usage: T("Custom headers to include in the request, flag can be specified multiple times")}
 	fs["d"] = &flags.StringFlag{ShortName: "d", Usage: T("HTTP data to include in the request body, or '@' followed by a file name to read the data from")}
 	fs["output"] = &flags.StringFlag{Name: "output", Usage: T("Write curl body to FILE instead of stdout")}
+	fs["all-pages"] = &flags.BoolFlag{Name: "all-pages", Usage: T("Follow the pagination links of a GET request and merge the resources of every page into a single response")}
+	fs["select"] = &flags.StringFlag{Name: "select", Usage: T("Print only the values matching a path expression, e.g. '.resources[].entity.name'")}
+	fs["template"] = &flags.StringFlag{Name: "template", Usage: T("Run the request saved under the given template name, any other flags are applied on top of it")}
+	fs["save-template"] = &flags.StringFlag{Name: "save-template", Usage: T("Save the request under the given template name instead of running it")}
+	fs["delete-template"] = &flags.StringFlag{Name: "delete-template", Usage: T("Delete the saved request template with the given name")}
+	fs["list-templates"] = &flags.BoolFlag{Name: "list-templates", Usage: T("List the saved request templates")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "curl",
 		Description: T("Executes a request to the targeted API endpoint"),
 		Usage: []string{
-			T(`CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE]
+			T(`CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE] [--all-pages] [--select EXPRESSION] [--save-template NAME]
+   CF_NAME curl --template NAME [PATH] [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE] [--all-pages] [--select EXPRESSION]
+   CF_NAME curl --list-templates
+   CF_NAME curl --delete-template NAME
 
    By default 'CF_NAME curl' will perform a GET to the specified PATH. If data
    is provided via -d, a POST will be performed instead, and the Content-Type
    will be set to application/json. You may override headers with -H and the
    request method with -X.
 
+   With --all-pages, the next_url (v2) or pagination.next (v3) links are
+   followed and the resources of every page are merged into one response.
+
+   Requests saved with --save-template are stored in the CLI config and can
+   be rerun with --template.
+
    For API documentation, please visit http://apidocs.cloudfoundry.org.`),
 		},
 		Examples: []string{
 			`CF_NAME curl "/v2/apps" -X GET -H "Content-Type: application/x-www-form-urlencoded" -d 'q=name:myapp'`,
 			`CF_NAME curl "/v2/apps" -d @/path/to/file`,
+			`CF_NAME curl "/v3/apps" --all-pages --select '.resources[].name'`,
+			`CF_NAME curl "/v2/apps" --all-pages --select '.resources[].entity.name' --save-template app-names`,
+			`CF_NAME curl --template app-names`,
 		},
 		Flags: fs,
 	}
 }
 
 func (cmd *Curl) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
-	if len(fc.Args()) != 1 {
+	if fc.Bool("list-templates") || fc.IsSet("delete-template") {
+		if len(fc.Args()) != 0 {
+			cmd.ui.Failed(T("Incorrect Usage. No argument required\n\n") + commandregistry.Commands.CommandUsage("curl"))
+			return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 0)
+		}
+		return []requirements.Requirement{}, nil
+	}
+
+	if fc.IsSet("template") {
+		if len(fc.Args()) > 1 {
+			cmd.ui.Failed(T("Incorrect Usage. Only the PATH argument may be given with --template\n\n") + commandregistry.Commands.CommandUsage("curl"))
+			return nil, fmt.Errorf("Incorrect usage: %d arguments of at most %d required", len(fc.Args()), 1)
+		}
+	} else if len(fc.Args()) != 1 {
 		cmd.ui.Failed(T("Incorrect Usage. An argument is missing or not correctly enclosed.\n\n") + commandregistry.Commands.CommandUsage("curl"))
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
 	}
 
+	if fc.IsSet("save-template") {
+		return []requirements.Requirement{}, nil
+	}
+
 	reqs := []requirements.Requirement{
 		requirementsFactory.NewAPIEndpointRequirement(),
 	}
@@ -84,29 +124,36 @@ func (cmd *Curl) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 }
 
 func (cmd *Curl) Execute(c flags.FlagContext) error {
-	path := c.Args()[0]
-	headers := c.StringSlice("H")
-
-	var method string
-	var body string
+	if c.Bool("list-templates") {
+		return cmd.listTemplates()
+	}
 
-	if c.IsSet("d") {
-		method = "POST"
+	if c.IsSet("delete-template") {
+		return cmd.deleteTemplate(c.String("delete-template"))
+	}
 
-		jsonBytes, err := flagcontext.GetContentsFromOptionalFlagValue(c.String("d"))
-		if err != nil {
-			return err
-		}
-		body = string(jsonBytes)
+	request, err := cmd.buildRequest(c)
+	if err != nil {
+		return err
 	}
 
-	if c.IsSet("X") {
-		method = c.String("X")
+	if c.IsSet("save-template") {
+		request.Name = c.String("save-template")
+		cmd.ui.Say(T("Saving curl template {{.Name}}...", map[string]interface{}{"Name": terminal.EntityNameColor(request.Name)}))
+		cmd.config.SetCurlTemplate(request)
+		cmd.ui.Ok()
+		return nil
 	}
 
-	reqHeader := strings.Join(headers, "\n")
+	reqHeader := strings.Join(request.Headers, "\n")
 
-	responseHeader, responseBody, apiErr := cmd.curlRepo.Request(method, path, reqHeader, body)
+	var responseHeader, responseBody string
+	var apiErr error
+	if request.AllPages {
+		responseHeader, responseBody, apiErr = cmd.requestAllPages(request.Path, reqHeader)
+	} else {
+		responseHeader, responseBody, apiErr = cmd.curlRepo.Request(request.Method, request.Path, reqHeader, request.Body)
+	}
 	if apiErr != nil {
 		return errors.New(T("Error creating request:\n{{.Err}}", map[string]interface{}{"Err": apiErr.Error()}))
 	}
@@ -119,13 +166,20 @@ func (cmd *Curl) Execute(c flags.FlagContext) error {
 		cmd.ui.Say(responseHeader)
 	}
 
+	if request.Select != "" {
+		responseBody, err = selectFromResponse(responseBody, request.Select)
+		if err != nil {
+			return err
+		}
+	}
+
 	if c.String("output") != "" {
 		err := cmd.writeToFile(responseBody, c.String("output"))
 		if err != nil {
 			return errors.New(T("Error creating request:\n{{.Err}}", map[string]interface{}{"Err": err}))
 		}
 	} else {
-		if strings.Contains(responseHeader, "application/json") {
+		if request.Select == "" && strings.Contains(responseHeader, "application/json") {
 			buffer := bytes.Buffer{}
 			err := json.Indent(&buffer, []byte(responseBody), "", "   ")
 			if err == nil {
@@ -138,6 +192,222 @@ func (cmd *Curl) Execute(c flags.FlagContext) error {
 	return nil
 }
 
+// buildRequest combines the saved template, if any, with the arguments and
+// flags given on the command line. Flags override the template.
+func (cmd *Curl) buildRequest(c flags.FlagContext) (models.CurlTemplate, error) {
+	var request models.CurlTemplate
+
+	if c.IsSet("template") {
+		template, found := cmd.findTemplate(c.String("template"))
+		if !found {
+			return models.CurlTemplate{}, errors.New(T("Curl template {{.Name}} not found",
+				map[string]interface{}{"Name": c.String("template")}))
+		}
+		request = template
+		request.Headers = append([]string{}, template.Headers...)
+	}
+
+	if len(c.Args()) > 0 {
+		request.Path = c.Args()[0]
+	}
+
+	request.Headers = append(request.Headers, c.StringSlice("H")...)
+
+	if c.IsSet("d") {
+		request.Method = "POST"
+
+		jsonBytes, err := flagcontext.GetContentsFromOptionalFlagValue(c.String("d"))
+		if err != nil {
+			return models.CurlTemplate{}, err
+		}
+		request.Body = string(jsonBytes)
+	}
+
+	if c.IsSet("X") {
+		request.Method = c.String("X")
+	}
+
+	if c.Bool("all-pages") {
+		request.AllPages = true
+	}
+
+	if c.IsSet("select") {
+		request.Select = c.String("select")
+	}
+
+	if request.AllPages && !strings.EqualFold(request.Method, "GET") && (request.Method != "" || request.Body != "") {
+		return models.CurlTemplate{}, errors.New(T("--all-pages can only be used with GET requests"))
+	}
+
+	return request, nil
+}
+
+// requestAllPages follows the next_url (v2) or pagination.next (v3) links of a
+// paginated response and returns the first page with the resources of every
+// page merged into it. Responses without resources are returned as they are.
+func (cmd *Curl) requestAllPages(path, reqHeader string) (string, string, error) {
+	var firstHeader string
+	var merged map[string]interface{}
+	resources := []interface{}{}
+
+	for path != "" {
+		responseHeader, responseBody, err := cmd.curlRepo.Request("GET", path, reqHeader, "")
+		if err != nil {
+			return "", "", err
+		}
+
+		page := map[string]interface{}{}
+		v2Page := ccv2.NewPaginatedResources(map[string]interface{}{})
+		v3Page := ccv3.NewPaginatedResources(map[string]interface{}{})
+		if json.Unmarshal([]byte(responseBody), &page) != nil ||
+			json.Unmarshal([]byte(responseBody), &v2Page) != nil ||
+			json.Unmarshal([]byte(responseBody), &v3Page) != nil ||
+			len(v2Page.ResourcesBytes) == 0 {
+			if merged == nil {
+				return responseHeader, responseBody, nil
+			}
+			return "", "", errors.New(T("Unexpected response fetching {{.Path}}:\n{{.Body}}",
+				map[string]interface{}{"Path": path, "Body": responseBody}))
+		}
+
+		pageResources, err := v2Page.Resources()
+		if err != nil {
+			return "", "", err
+		}
+		resources = append(resources, pageResources...)
+
+		if merged == nil {
+			firstHeader = responseHeader
+			merged = page
+		}
+
+		next := v2Page.NextURL
+		if next == "" {
+			next = v3Page.NextPage()
+		}
+		path = cmd.apiRelativePath(next)
+	}
+
+	merged["resources"] = resources
+	if _, ok := merged["next_url"]; ok {
+		merged["next_url"] = nil
+		merged["prev_url"] = nil
+		merged["total_pages"] = 1
+	}
+	if pagination, ok := merged["pagination"].(map[string]interface{}); ok {
+		pagination["next"] = nil
+		pagination["previous"] = nil
+		pagination["last"] = pagination["first"]
+		pagination["total_pages"] = 1
+	}
+
+	body, err := json.Marshal(merged)
+	if err != nil {
+		return "", "", err
+	}
+	return firstHeader, string(body), nil
+}
+
+// apiRelativePath turns the absolute links returned by the v3 API into paths
+// relative to the targeted API endpoint.
+func (cmd *Curl) apiRelativePath(link string) string {
+	if strings.HasPrefix(link, cmd.config.APIEndpoint()) {
+		return strings.TrimPrefix(link, cmd.config.APIEndpoint())
+	}
+
+	parsed, err := url.Parse(link)
+	if err == nil && parsed.IsAbs() {
+		return parsed.RequestURI()
+	}
+	return link
+}
+
+func selectFromResponse(responseBody, expression string) (string, error) {
+	var document interface{}
+	err := json.Unmarshal([]byte(responseBody), &document)
+	if err != nil {
+		return "", errors.New(T("--select can only be used with JSON responses:\n{{.Body}}",
+			map[string]interface{}{"Body": responseBody}))
+	}
+
+	results, err := cfjson.Select(document, expression)
+	if err != nil {
+		return "", err
+	}
+
+	lines := make([]string, 0, len(results))
+	for _, result := range results {
+		if str, ok := result.(string); ok {
+			lines = append(lines, str)
+			continue
+		}
+
+		resultBytes, err := json.Marshal(result)
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, string(resultBytes))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func (cmd *Curl) findTemplate(name string) (models.CurlTemplate, bool) {
+	for _, template := range cmd.config.CurlTemplates() {
+		if template.Name == name {
+			return template, true
+		}
+	}
+	return models.CurlTemplate{}, false
+}
+
+func (cmd *Curl) listTemplates() error {
+	cmd.ui.Say(T("Getting curl templates..."))
+
+	templates := cmd.config.CurlTemplates()
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if len(templates) == 0 {
+		cmd.ui.Say(T("No curl templates found"))
+		return nil
+	}
+
+	table := cmd.ui.Table([]string{T("name"), T("method"), T("path"), T("all pages"), T("select")})
+	for _, template := range templates {
+		method := template.Method
+		if method == "" {
+			method = "GET"
+			if template.Body != "" {
+				method = "POST"
+			}
+		}
+
+		allPages := ""
+		if template.AllPages {
+			allPages = T("yes")
+		}
+
+		table.Add(template.Name, method, template.Path, allPages, template.Select)
+	}
+
+	return table.Print()
+}
+
+func (cmd *Curl) deleteTemplate(name string) error {
+	cmd.ui.Say(T("Deleting curl template {{.Name}}...", map[string]interface{}{"Name": terminal.EntityNameColor(name)}))
+
+	if _, found := cmd.findTemplate(name); !found {
+		cmd.ui.Ok()
+		cmd.ui.Warn(T("Curl template {{.Name}} does not exist.", map[string]interface{}{"Name": name}))
+		return nil
+	}
+
+	cmd.config.UnSetCurlTemplate(name)
+	cmd.ui.Ok()
+	return nil
+}
+
 func (cmd Curl) writeToFile(responseBody, filePath string) (err error) {
 	if _, err = os.Stat(filePath); os.IsNotExist(err) {
 		err = os.MkdirAll(filepath.Dir(filePath), 0755)
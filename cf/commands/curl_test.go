@@ -5,9 +5,11 @@ import (
 	"os"
 	"path/filepath"
 
+	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
 	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
@@ -28,12 +30,13 @@ var _ = Describe("curl command", func() {
 		config              coreconfig.Repository
 		requirementsFactory *requirementsfakes.FakeFactory
 		curlRepo            *apifakes.OldFakeCurlRepository
+		curlRepository      api.CurlRepository
 		deps                commandregistry.Dependency
 	)
 
 	updateCommandDependency := func(pluginCall bool) {
 		deps.UI = ui
-		deps.RepoLocator = deps.RepoLocator.SetCurlRepository(curlRepo)
+		deps.RepoLocator = deps.RepoLocator.SetCurlRepository(curlRepository)
 		deps.Config = config
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("curl").SetDependency(deps, pluginCall))
 	}
@@ -44,6 +47,7 @@ var _ = Describe("curl command", func() {
 		requirementsFactory = new(requirementsfakes.FakeFactory)
 		requirementsFactory.NewAPIEndpointRequirementReturns(requirements.Passing{})
 		curlRepo = new(apifakes.OldFakeCurlRepository)
+		curlRepository = curlRepo
 
 		trace.LoggingToStdout = false
 	})
@@ -266,4 +270,207 @@ var _ = Describe("curl command", func() {
 			})
 		})
 	})
+
+	Context("when --all-pages is provided", func() {
+		var pagedCurlRepo *apifakes.FakeCurlRepository
+
+		BeforeEach(func() {
+			pagedCurlRepo = new(apifakes.FakeCurlRepository)
+			curlRepository = pagedCurlRepo
+		})
+
+		Context("when the API is v2", func() {
+			BeforeEach(func() {
+				pagedCurlRepo.RequestStub = func(method, path, header, body string) (string, string, error) {
+					switch path {
+					case "/v2/apps":
+						return "Content-Type: application/json", `{"total_results":3,"total_pages":2,"prev_url":null,"next_url":"/v2/apps?page=2","resources":[{"entity":{"name":"app-1"}},{"entity":{"name":"app-2"}}]}`, nil
+					case "/v2/apps?page=2":
+						return "Content-Type: application/json", `{"total_results":3,"total_pages":2,"prev_url":"/v2/apps?page=1","next_url":null,"resources":[{"entity":{"name":"app-3"}}]}`, nil
+					}
+					return "", "", errors.New("unexpected path " + path)
+				}
+			})
+
+			It("follows next_url and merges the resources of every page", func() {
+				runCurlWithInputs([]string{"--all-pages", "/v2/apps"})
+
+				Expect(pagedCurlRepo.RequestCallCount()).To(Equal(2))
+				method, path, _, _ := pagedCurlRepo.RequestArgsForCall(1)
+				Expect(method).To(Equal("GET"))
+				Expect(path).To(Equal("/v2/apps?page=2"))
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{`"next_url": null`},
+					[]string{`"name": "app-1"`},
+					[]string{`"name": "app-2"`},
+					[]string{`"name": "app-3"`},
+					[]string{`"total_pages": 1`},
+				))
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"FAILED"}))
+			})
+
+			It("applies --select to the merged response", func() {
+				runCurlWithInputs([]string{"--all-pages", "--select", ".resources[].entity.name", "/v2/apps"})
+
+				Expect(ui.Outputs()).To(Equal([]string{"app-1", "app-2", "app-3"}))
+			})
+		})
+
+		Context("when the API is v3", func() {
+			BeforeEach(func() {
+				config.SetAPIEndpoint("https://api.example.com")
+				pagedCurlRepo.RequestStub = func(method, path, header, body string) (string, string, error) {
+					switch path {
+					case "/v3/apps":
+						return "Content-Type: application/json", `{"pagination":{"total_results":2,"total_pages":2,"next":{"href":"https://api.example.com/v3/apps?page=2&per_page=1"}},"resources":[{"name":"app-1"}]}`, nil
+					case "/v3/apps?page=2&per_page=1":
+						return "Content-Type: application/json", `{"pagination":{"total_results":2,"total_pages":2,"next":null},"resources":[{"name":"app-2"}]}`, nil
+					}
+					return "", "", errors.New("unexpected path " + path)
+				}
+			})
+
+			It("follows pagination.next relative to the API endpoint", func() {
+				runCurlWithInputs([]string{"--all-pages", "--select", ".resources[].name", "/v3/apps"})
+
+				Expect(pagedCurlRepo.RequestCallCount()).To(Equal(2))
+				Expect(ui.Outputs()).To(Equal([]string{"app-1", "app-2"}))
+			})
+		})
+
+		It("returns responses without resources as they are", func() {
+			pagedCurlRepo.RequestReturns("Content-Type: application/json", `{"name":"my-app"}`, nil)
+
+			runCurlWithInputs([]string{"--all-pages", "/v2/apps/some-guid"})
+
+			Expect(pagedCurlRepo.RequestCallCount()).To(Equal(1))
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{`"name": "my-app"`}))
+		})
+
+		It("fails for requests that are not GET requests", func() {
+			runCurlWithInputs([]string{"--all-pages", "-X", "DELETE", "/v2/apps"})
+
+			Expect(pagedCurlRepo.RequestCallCount()).To(Equal(0))
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"--all-pages can only be used with GET requests"},
+			))
+		})
+	})
+
+	Context("when --select is provided", func() {
+		It("prints each selected value on its own line", func() {
+			curlRepo.ResponseHeader = "Content-Type: application/json"
+			curlRepo.ResponseBody = `{"entity":{"name":"my-app","instances":2,"ports":[8080]}}`
+
+			runCurlWithInputs([]string{"--select", ".entity.name", "/v2/apps/some-guid"})
+			Expect(ui.Outputs()).To(Equal([]string{"my-app"}))
+
+			ui = &testterm.FakeUI{}
+			runCurlWithInputs([]string{"--select", ".entity.ports", "/v2/apps/some-guid"})
+			Expect(ui.Outputs()).To(Equal([]string{"[8080]"}))
+		})
+
+		It("fails when the response is not JSON", func() {
+			curlRepo.ResponseBody = "not json"
+
+			runCurlWithInputs([]string{"--select", ".entity.name", "/foo"})
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"--select can only be used with JSON responses"},
+			))
+		})
+	})
+
+	Describe("templates", func() {
+		It("saves the request under the given name without running it", func() {
+			Expect(runCurlWithInputs([]string{"--save-template", "app-names", "--all-pages", "--select", ".resources[].entity.name", "-H", "Accept: application/json", "/v2/apps"})).To(BeTrue())
+
+			Expect(curlRepo.Path).To(BeEmpty())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Saving curl template", "app-names"},
+				[]string{"OK"},
+			))
+			Expect(config.CurlTemplates()).To(Equal([]models.CurlTemplate{{
+				Name:     "app-names",
+				Path:     "/v2/apps",
+				Headers:  []string{"Accept: application/json"},
+				AllPages: true,
+				Select:   ".resources[].entity.name",
+			}}))
+		})
+
+		Context("when a template has been saved", func() {
+			BeforeEach(func() {
+				config.SetCurlTemplate(models.CurlTemplate{
+					Name:    "create-thing",
+					Method:  "PUT",
+					Path:    "/v2/things",
+					Headers: []string{"Accept: application/json"},
+					Body:    `{"name":"thing"}`,
+				})
+			})
+
+			It("runs the saved request", func() {
+				runCurlWithInputs([]string{"--template", "create-thing"})
+
+				Expect(curlRepo.Method).To(Equal("PUT"))
+				Expect(curlRepo.Path).To(Equal("/v2/things"))
+				Expect(curlRepo.Header).To(Equal("Accept: application/json"))
+				Expect(curlRepo.Body).To(Equal(`{"name":"thing"}`))
+			})
+
+			It("applies the path and flags on top of the template", func() {
+				runCurlWithInputs([]string{"--template", "create-thing", "-X", "POST", "-H", "X-Foo: bar", "/v2/other-things"})
+
+				Expect(curlRepo.Method).To(Equal("POST"))
+				Expect(curlRepo.Path).To(Equal("/v2/other-things"))
+				Expect(curlRepo.Header).To(Equal("Accept: application/json\nX-Foo: bar"))
+				Expect(config.CurlTemplates()[0].Headers).To(Equal([]string{"Accept: application/json"}))
+			})
+
+			It("lists the saved templates", func() {
+				Expect(runCurlWithInputs([]string{"--list-templates"})).To(BeTrue())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Getting curl templates"},
+					[]string{"name", "method", "path", "all pages", "select"},
+					[]string{"create-thing", "PUT", "/v2/things"},
+				))
+			})
+
+			It("deletes a template", func() {
+				Expect(runCurlWithInputs([]string{"--delete-template", "create-thing"})).To(BeTrue())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Deleting curl template", "create-thing"},
+					[]string{"OK"},
+				))
+				Expect(config.CurlTemplates()).To(BeEmpty())
+			})
+		})
+
+		It("fails when the template does not exist", func() {
+			runCurlWithInputs([]string{"--template", "missing"})
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Curl template missing not found"},
+			))
+		})
+
+		It("warns when deleting a template that does not exist", func() {
+			runCurlWithInputs([]string{"--delete-template", "missing"})
+
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"OK"}))
+			Expect(ui.WarnOutputs).To(ContainSubstrings([]string{"Curl template missing does not exist."}))
+		})
+
+		It("fails with usage when --list-templates is given a path", func() {
+			runCurlWithInputs([]string{"--list-templates", "/v2/apps"})
+
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"Incorrect Usage", "No argument required"}))
+		})
+	})
 })
@@ -3,7 +3,10 @@ package route
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
@@ -13,14 +16,21 @@ import (
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/routecheck"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
+const (
+	defaultRouteCheckTimeout = 5
+	routeCheckConcurrency    = 10
+)
+
 type ListRoutes struct {
-	ui         terminal.UI
-	routeRepo  api.RouteRepository
-	domainRepo api.DomainRepository
-	config     coreconfig.Reader
+	ui           terminal.UI
+	routeRepo    api.RouteRepository
+	domainRepo   api.DomainRepository
+	config       coreconfig.Reader
+	routeChecker routecheck.Checker
 }
 
 func init() {
@@ -30,13 +40,20 @@ func init() {
 func (cmd *ListRoutes) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["orglevel"] = &flags.BoolFlag{Name: "orglevel", Usage: T("List all the routes for all spaces of current organization")}
+	fs["check"] = &flags.BoolFlag{Name: "check", Usage: T("Request every HTTP route and report its status code, latency, certificate expiry and the apps backing it")}
+	fs["check-path"] = &flags.StringFlag{Name: "check-path", Usage: T("Path to request on each route when checking (Default: /)")}
+	fs["timeout"] = &flags.IntFlag{Name: "timeout", Usage: T("Seconds to wait for each route to respond when checking (Default: 5)")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "routes",
 		ShortName:   "r",
 		Description: T("List all routes in the current space or the current organization"),
 		Usage: []string{
-			"CF_NAME routes [--orglevel]",
+			"CF_NAME routes [--orglevel] [--check [--check-path PATH] [--timeout SECONDS]]",
+		},
+		Examples: []string{
+			"CF_NAME routes --check",
+			"CF_NAME routes --orglevel --check --check-path /health --timeout 10",
 		},
 		Flags: fs,
 	}
@@ -50,6 +67,14 @@ func (cmd *ListRoutes) Requirements(requirementsFactory requirements.Factory, fc
 		},
 	)
 
+	if !fc.Bool("check") && (fc.IsSet("check-path") || fc.IsSet("timeout")) {
+		return nil, errors.New(T("--check-path and --timeout can only be used with --check"))
+	}
+
+	if fc.IsSet("timeout") && fc.Int("timeout") < 1 {
+		return nil, errors.New(T("--timeout must be a positive number of seconds"))
+	}
+
 	reqs := []requirements.Requirement{
 		usageReq,
 		requirementsFactory.NewLoginRequirement(),
@@ -64,6 +89,11 @@ func (cmd *ListRoutes) SetDependency(deps commandregistry.Dependency, pluginCall
 	cmd.config = deps.Config
 	cmd.routeRepo = deps.RepoLocator.GetRouteRepository()
 	cmd.domainRepo = deps.RepoLocator.GetDomainRepository()
+
+	cmd.routeChecker = nil
+	if deps.WildcardDependency != nil {
+		cmd.routeChecker = deps.WildcardDependency.(routecheck.Checker)
+	}
 	return cmd
 }
 
@@ -85,8 +115,6 @@ func (cmd *ListRoutes) Execute(c flags.FlagContext) error {
 			}))
 	}
 
-	table := cmd.ui.Table([]string{T("space"), T("host"), T("domain"), T("port"), T("path"), T("type"), T("apps"), T("service")})
-
 	d := make(map[string]models.DomainFields)
 	err := cmd.domainRepo.ListDomainsForOrg(cmd.config.OrganizationFields().GUID, func(domain models.DomainFields) bool {
 		d[domain.GUID] = domain
@@ -101,9 +129,27 @@ func (cmd *ListRoutes) Execute(c flags.FlagContext) error {
 		))
 	}
 
-	var routesFound bool
+	var routes []models.Route
 	cb := func(route models.Route) bool {
-		routesFound = true
+		routes = append(routes, route)
+		return true
+	}
+
+	if orglevel {
+		err = cmd.routeRepo.ListAllRoutes(cb)
+	} else {
+		err = cmd.routeRepo.ListRoutes(cb)
+	}
+	if err != nil {
+		return errors.New(T("Failed fetching routes.\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+	}
+
+	if c.Bool("check") {
+		return cmd.checkRoutes(routes, d, c)
+	}
+
+	table := cmd.ui.Table([]string{T("space"), T("host"), T("domain"), T("port"), T("path"), T("type"), T("apps"), T("service")})
+	for _, route := range routes {
 		appNames := []string{}
 		for _, app := range route.Apps {
 			appNames = append(appNames, app.Name)
@@ -126,25 +172,150 @@ func (cmd *ListRoutes) Execute(c flags.FlagContext) error {
 			strings.Join(appNames, ","),
 			route.ServiceInstance.Name,
 		)
-		return true
 	}
 
-	if orglevel {
-		err = cmd.routeRepo.ListAllRoutes(cb)
-	} else {
-		err = cmd.routeRepo.ListRoutes(cb)
-	}
+	err = table.Print()
 	if err != nil {
-		return errors.New(T("Failed fetching routes.\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+		return err
 	}
 
-	err = table.Print()
+	if len(routes) == 0 {
+		cmd.ui.Say(T("No routes found"))
+	}
+	return nil
+}
+
+// checkRoutes requests every HTTP route concurrently and prints a row per
+// route with the response it gave. TCP routes are listed but not requested.
+func (cmd *ListRoutes) checkRoutes(routes []models.Route, domains map[string]models.DomainFields, c flags.FlagContext) error {
+	if len(routes) == 0 {
+		cmd.ui.Say(T("No routes found"))
+		return nil
+	}
+
+	timeout := time.Duration(defaultRouteCheckTimeout) * time.Second
+	if c.IsSet("timeout") {
+		timeout = time.Duration(c.Int("timeout")) * time.Second
+	}
+
+	//init routeChecker if it is not already set by SetDependency() with fakes
+	if cmd.routeChecker == nil {
+		cmd.routeChecker = routecheck.NewChecker(cmd.config.IsSSLDisabled())
+	}
+
+	var urls []string
+	var checked []int
+	for i, route := range routes {
+		if isTCPRoute(route, domains) {
+			continue
+		}
+		urls = append(urls, routeCheckURL(route, c.String("check-path")))
+		checked = append(checked, i)
+	}
+
+	cmd.ui.Say(T("Checking {{.Count}} routes...\n", map[string]interface{}{
+		"Count": terminal.EntityNameColor(strconv.Itoa(len(urls))),
+	}))
+
+	results := make([]*routecheck.Result, len(routes))
+	for i, result := range routecheck.CheckAll(cmd.routeChecker, urls, timeout, routeCheckConcurrency) {
+		result := result
+		results[checked[i]] = &result
+	}
+
+	table := cmd.ui.Table([]string{T("space"), T("route"), T("apps"), T("status"), T("latency"), T("certificate expires")})
+	var unreachable int
+	for i, route := range routes {
+		result := results[i]
+		if result == nil {
+			table.Add(route.Space.Name, route.URL(), routeApps(route), T("not checked (tcp)"), "", "")
+			continue
+		}
+
+		if result.Err != nil {
+			unreachable++
+			table.Add(route.Space.Name, route.URL(), routeApps(route), terminal.FailureColor(routeCheckFailure(result.Err)), "", "")
+			continue
+		}
+
+		table.Add(
+			route.Space.Name,
+			result.URL,
+			routeApps(route),
+			routeCheckStatus(result.StatusCode),
+			fmt.Sprintf("%dms", result.Latency.Nanoseconds()/int64(time.Millisecond)),
+			certificateExpiry(result.CertificateExpiry),
+		)
+	}
+
+	err := table.Print()
 	if err != nil {
 		return err
 	}
 
-	if !routesFound {
-		cmd.ui.Say(T("No routes found"))
+	if unreachable > 0 {
+		return errors.New(T("{{.Count}} of {{.Total}} routes could not be reached", map[string]interface{}{
+			"Count": unreachable,
+			"Total": len(urls),
+		}))
 	}
 	return nil
 }
+
+func isTCPRoute(route models.Route, domains map[string]models.DomainFields) bool {
+	return route.Port != 0 || domains[route.Domain.GUID].RouterGroupType == "tcp"
+}
+
+func routeCheckURL(route models.Route, checkPath string) string {
+	if checkPath == "" {
+		return route.URL()
+	}
+	return strings.TrimSuffix(route.URL(), "/") + "/" + strings.TrimPrefix(checkPath, "/")
+}
+
+func routeApps(route models.Route) string {
+	apps := []string{}
+	for _, app := range route.Apps {
+		apps = append(apps, T("{{.AppName}} ({{.Instances}} instances)", map[string]interface{}{
+			"AppName":   app.Name,
+			"Instances": app.InstanceCount,
+		}))
+	}
+	return strings.Join(apps, ", ")
+}
+
+// routeCheckFailure describes why a route could not be reached. The request
+// method and URL that net/http prefixes to the error are left out, since the
+// row already shows the route.
+func routeCheckFailure(err error) string {
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+	return T("unreachable: {{.Reason}}", map[string]interface{}{"Reason": err.Error()})
+}
+
+func routeCheckStatus(statusCode int) string {
+	status := strconv.Itoa(statusCode)
+	if statusCode >= 500 {
+		return terminal.FailureColor(status)
+	}
+	if statusCode >= 400 {
+		return terminal.WarningColor(status)
+	}
+	return terminal.SuccessColor(status)
+}
+
+func certificateExpiry(expiry time.Time) string {
+	if expiry.IsZero() {
+		return ""
+	}
+
+	days := int(time.Until(expiry).Hours() / 24)
+	if days < 0 {
+		return terminal.FailureColor(T("{{.Date}} (expired)", map[string]interface{}{"Date": expiry.Format("2006-01-02")}))
+	}
+	return T("{{.Date}} ({{.Days}} days)", map[string]interface{}{
+		"Date": expiry.Format("2006-01-02"),
+		"Days": days,
+	})
+}
@@ -2,6 +2,8 @@ package route_test
 
 import (
 	"errors"
+	neturl "net/url"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -10,6 +12,8 @@ import (
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	"code.cloudfoundry.org/cli/cf/routecheck"
+	"code.cloudfoundry.org/cli/cf/routecheck/routecheckfakes"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
@@ -28,6 +32,7 @@ var _ = Describe("routes command", func() {
 		domainRepo          *apifakes.FakeDomainRepository
 		configRepo          coreconfig.Repository
 		requirementsFactory *requirementsfakes.FakeFactory
+		routeChecker        *routecheckfakes.FakeChecker
 		deps                commandregistry.Dependency
 	)
 
@@ -35,6 +40,7 @@ var _ = Describe("routes command", func() {
 		deps.UI = ui
 		deps.RepoLocator = deps.RepoLocator.SetRouteRepository(routeRepo).SetDomainRepository(domainRepo)
 		deps.Config = configRepo
+		deps.WildcardDependency = routeChecker
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("routes").SetDependency(deps, pluginCall))
 	}
 
@@ -46,6 +52,7 @@ var _ = Describe("routes command", func() {
 		requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
 		routeRepo = new(apifakes.FakeRouteRepository)
 		domainRepo = new(apifakes.FakeDomainRepository)
+		routeChecker = new(routecheckfakes.FakeChecker)
 	})
 
 	runCommand := func(args ...string) bool {
@@ -85,6 +92,20 @@ var _ = Describe("routes command", func() {
 				Expect(err.Error()).To(ContainSubstring("Incorrect Usage"))
 				Expect(err.Error()).To(ContainSubstring("No argument required"))
 			})
+
+			It("fails when --check-path or --timeout are given without --check", func() {
+				flagContext.Parse("--timeout", "10")
+
+				_, err := cmd.Requirements(requirementsFactory, flagContext)
+				Expect(err).To(MatchError("--check-path and --timeout can only be used with --check"))
+			})
+
+			It("fails when the timeout is not positive", func() {
+				flagContext.Parse("--check", "--timeout", "0")
+
+				_, err := cmd.Requirements(requirementsFactory, flagContext)
+				Expect(err).To(MatchError("--timeout must be a positive number of seconds"))
+			})
 		})
 	})
 
@@ -163,6 +184,79 @@ var _ = Describe("routes command", func() {
 		})
 	})
 
+	Context("when checking the routes", func() {
+		BeforeEach(func() {
+			domainRepo.ListDomainsForOrgStub = func(_ string, cb func(models.DomainFields) bool) error {
+				cb(models.DomainFields{GUID: "tcp-domain-guid", RouterGroupType: "tcp"})
+				return nil
+			}
+
+			routeRepo.ListRoutesStub = func(cb func(models.Route) bool) error {
+				cb(models.Route{
+					Space:  models.SpaceFields{Name: "my-space"},
+					Host:   "hostname-1",
+					Domain: models.DomainFields{Name: "example.com"},
+					Apps:   []models.ApplicationFields{{Name: "dora", InstanceCount: 3}},
+				})
+				cb(models.Route{
+					Space:  models.SpaceFields{Name: "my-space"},
+					Host:   "hostname-2",
+					Path:   "/foo",
+					Domain: models.DomainFields{Name: "example.com"},
+					Apps:   []models.ApplicationFields{{Name: "bora", InstanceCount: 1}},
+				})
+				cb(models.Route{
+					Space:  models.SpaceFields{Name: "my-space"},
+					Domain: models.DomainFields{GUID: "tcp-domain-guid", Name: "tcp.example.com"},
+					Port:   9090,
+				})
+				return nil
+			}
+
+			routeChecker.CheckStub = func(url string, _ time.Duration) routecheck.Result {
+				if url == "hostname-2.example.com/foo/health" {
+					return routecheck.Result{URL: "http://" + url, Err: &neturl.Error{Op: "Get", URL: "http://" + url, Err: errors.New("dial tcp: connection refused")}}
+				}
+				return routecheck.Result{
+					URL:               "https://" + url,
+					StatusCode:        200,
+					Latency:           42 * time.Millisecond,
+					CertificateExpiry: time.Now().Add(10*24*time.Hour + time.Hour),
+				}
+			}
+		})
+
+		It("requests every HTTP route and reports how it responded", func() {
+			Expect(runCommand("--check", "--check-path", "/health", "--timeout", "2")).To(BeFalse())
+
+			Expect(routeChecker.CheckCallCount()).To(Equal(2))
+			var urls []string
+			for i := 0; i < routeChecker.CheckCallCount(); i++ {
+				url, timeout := routeChecker.CheckArgsForCall(i)
+				Expect(timeout).To(Equal(2 * time.Second))
+				urls = append(urls, url)
+			}
+			Expect(urls).To(ConsistOf("hostname-1.example.com/health", "hostname-2.example.com/foo/health"))
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Checking", "2", "routes"},
+				[]string{"space", "route", "apps", "status", "latency", "certificate expires"},
+				[]string{"my-space", "https://hostname-1.example.com/health", "dora (3 instances)", "200", "42ms", "(10 days)"},
+				[]string{"my-space", "hostname-2.example.com/foo", "bora (1 instances)", "unreachable: dial tcp: connection refused"},
+				[]string{"my-space", "tcp.example.com:9090", "not checked (tcp)"},
+				[]string{"FAILED"},
+				[]string{"1 of 2 routes could not be reached"},
+			))
+		})
+
+		It("uses a five second timeout by default", func() {
+			runCommand("--check")
+
+			_, timeout := routeChecker.CheckArgsForCall(0)
+			Expect(timeout).To(Equal(5 * time.Second))
+		})
+	})
+
 	Context("when there are routes in different spaces", func() {
 		BeforeEach(func() {
 			routeRepo.ListAllRoutesStub = func(cb func(models.Route) bool) error {
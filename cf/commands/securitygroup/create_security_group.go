@@ -2,6 +2,7 @@ package securitygroup
 
 import (
 	"fmt"
+	"strings"
 
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
@@ -13,6 +14,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/util/json"
+	sgrules "code.cloudfoundry.org/cli/util/securitygroup"
 )
 
 type CreateSecurityGroup struct {
@@ -88,6 +90,11 @@ Valid json file example:
 ]`, map[string]interface{}{"JSONFile": pathToJSONFile}))
 	}
 
+	err = validateRules(cmd.ui, pathToJSONFile, rules)
+	if err != nil {
+		return err
+	}
+
 	cmd.ui.Say(T("Creating security group {{.security_group}} as {{.username}}",
 		map[string]interface{}{
 			"security_group": terminal.EntityNameColor(name),
@@ -114,3 +121,30 @@ Valid json file example:
 	cmd.ui.Ok()
 	return nil
 }
+
+// validateRules checks the rules read from a rules file before they are sent
+// to the Cloud Controller. Rules that would be rejected fail the command,
+// rules that are merely suspicious are displayed as warnings.
+func validateRules(ui terminal.UI, pathToJSONFile string, rules []map[string]interface{}) error {
+	problems := sgrules.ValidateRules(rules)
+
+	for _, warning := range problems.Warnings() {
+		ui.Warn(T("Warning: {{.Problem}}", map[string]interface{}{"Problem": warning.String()}))
+	}
+
+	ruleErrors := problems.Errors()
+	if len(ruleErrors) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(ruleErrors))
+	for i, ruleError := range ruleErrors {
+		messages[i] = "  " + ruleError.String()
+	}
+
+	return errors.New(T("Invalid security group rules in {{.JSONFile}}:\n{{.Problems}}",
+		map[string]interface{}{
+			"JSONFile": pathToJSONFile,
+			"Problems": strings.Join(messages, "\n"),
+		}))
+}
@@ -132,6 +132,37 @@ var _ = Describe("create-security-group command", func() {
 			})
 		})
 
+		Context("when the file specified has invalid rules", func() {
+			BeforeEach(func() {
+				tempFile.Write([]byte(`[{"protocol":"tcp","destination":"10.0.0.1"},{"protocol":"sctp","destination":"10.0.0.1"}]`))
+			})
+
+			It("fails without creating the security group", func() {
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Invalid security group rules in", tempFile.Name()},
+					[]string{"rule 1: ports are required for protocol tcp"},
+					[]string{"rule 2: protocol \"sctp\" must be one of tcp, udp, icmp or all"},
+				))
+				Expect(securityGroupRepo.CreateCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the file specified has suspicious rules", func() {
+			BeforeEach(func() {
+				tempFile.Write([]byte(`[{"protocol":"all","destination":"0.0.0.0/0"},{"protocol":"tcp","destination":"10.0.0.1","ports":"443"}]`))
+			})
+
+			It("warns about them and creates the security group", func() {
+				Expect(ui.WarnOutputs).To(ContainSubstrings(
+					[]string{"rule 1: destination 0.0.0.0/0 allows all traffic to every IPv4 address"},
+					[]string{"rule 2: is shadowed by rule 1"},
+				))
+				Expect(securityGroupRepo.CreateCallCount()).To(Equal(1))
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"OK"}))
+			})
+		})
+
 		Context("when the file specified has invalid json", func() {
 			BeforeEach(func() {
 				tempFile.Write([]byte(`[{noquote: thiswontwork}]`))
@@ -95,7 +95,7 @@ var _ = Describe("update-security-group command", func() {
 
 		Context("when the file specified has valid json", func() {
 			BeforeEach(func() {
-				tempFile.Write([]byte(`[{"protocol":"udp","port":"8080-9090","destination":"198.41.191.47/1"}]`))
+				tempFile.Write([]byte(`[{"protocol":"udp","ports":"8080-9090","destination":"198.41.191.47/1"}]`))
 			})
 
 			It("displays a message describing what its going to do", func() {
@@ -108,7 +108,7 @@ var _ = Describe("update-security-group command", func() {
 
 			It("updates the security group with those rules, obviously", func() {
 				jsonData := []map[string]interface{}{
-					{"protocol": "udp", "port": "8080-9090", "destination": "198.41.191.47/1"},
+					{"protocol": "udp", "ports": "8080-9090", "destination": "198.41.191.47/1"},
 				}
 
 				_, jsonArg := securityGroupRepo.UpdateArgsForCall(0)
@@ -131,6 +131,24 @@ var _ = Describe("update-security-group command", func() {
 				})
 			})
 
+			Context("when the file specified has invalid rules", func() {
+				BeforeEach(func() {
+					tempFile.Truncate(0)
+					tempFile.Seek(0, 0)
+					tempFile.Write([]byte(`[{"protocol":"udp","port":"8080","destination":"198.41.191.47"}]`))
+				})
+
+				It("fails without updating the security group", func() {
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"Invalid security group rules in", tempFile.Name()},
+						[]string{"rule 1: unknown field \"port\""},
+						[]string{"rule 1: ports are required for protocol udp"},
+					))
+					Expect(securityGroupRepo.UpdateCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when the file specified has invalid json", func() {
 				BeforeEach(func() {
 					tempFile.Write([]byte(`[{noquote: thiswontwork}]`))
@@ -2,6 +2,8 @@ package service
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/actors/servicebuilder"
 	"code.cloudfoundry.org/cli/cf/api"
@@ -32,8 +34,9 @@ func (cmd *CreateService) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["c"] = &flags.StringFlag{ShortName: "c", Usage: T("Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering.")}
 	fs["t"] = &flags.StringFlag{ShortName: "t", Usage: T("User provided tags")}
+	fs["wait"] = &flags.BoolFlag{Name: "wait", Usage: T("Wait for the broker to finish provisioning the service instance")}
 
-	baseUsage := T("CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [-t TAGS]")
+	baseUsage := T("CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [-t TAGS] [--wait]")
 	paramsUsage := T(`   Optionally provide service-specific configuration parameters in a valid JSON object in-line:
 
    CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c '{"name":"value","name":"value"}'
@@ -129,9 +132,17 @@ func (cmd *CreateService) Execute(c flags.FlagContext) error {
 
 	switch err.(type) {
 	case nil:
-		err := printSuccessMessageForServiceInstance(serviceInstanceName, cmd.serviceRepo, cmd.ui)
-		if err != nil {
-			return err
+		if c.Bool("wait") {
+			err = waitForServiceInstanceOperation(serviceInstanceName, cmd.serviceRepo, cmd.config, cmd.ui)
+			if err != nil {
+				return err
+			}
+			cmd.ui.Ok()
+		} else {
+			err = printSuccessMessageForServiceInstance(serviceInstanceName, cmd.serviceRepo, cmd.ui)
+			if err != nil {
+				return err
+			}
 		}
 
 		if !plan.Free {
@@ -182,3 +193,53 @@ func findPlanFromOfferings(offerings models.ServiceOfferings, name string) (plan
 	))
 	return
 }
+
+// waitForServiceInstanceOperation polls the last operation of a service
+// instance until the broker reports it finished, printing every state the
+// operation passes through. Once a delete succeeds the instance no longer
+// exists, in which case the ModelNotFoundError is returned to the caller.
+func waitForServiceInstanceOperation(serviceInstanceName string, serviceRepo api.ServiceRepository, config coreconfig.Reader, ui terminal.UI) error {
+	timeout := time.After(config.OverallPollingTimeout())
+	var lastSeen models.LastOperationFields
+
+	for {
+		instance, err := serviceRepo.FindInstanceByName(serviceInstanceName)
+		if err != nil {
+			return err
+		}
+
+		operation := instance.ServiceInstanceFields.LastOperation
+		if operation.State != "" && (operation.Type != lastSeen.Type || operation.State != lastSeen.State) {
+			ui.Say(T("{{.Operation}} {{.State}}",
+				map[string]interface{}{
+					"Operation": strings.Title(operation.Type),
+					"State":     operation.State,
+				}))
+			lastSeen = operation
+		}
+
+		switch operation.State {
+		case "in progress":
+		case "failed":
+			return errors.New(T("{{.Operation}} of service instance {{.ServiceInstanceName}} failed: {{.Description}}",
+				map[string]interface{}{
+					"Operation":           strings.Title(operation.Type),
+					"ServiceInstanceName": serviceInstanceName,
+					"Description":         operation.Description,
+				}))
+		default:
+			return nil
+		}
+
+		select {
+		case <-timeout:
+			return errors.New(T("Timed out waiting for {{.Operation}} of service instance {{.ServiceInstanceName}} to finish. Use '{{.ServiceCommand}}' to check operation status.",
+				map[string]interface{}{
+					"Operation":           operation.Type,
+					"ServiceInstanceName": serviceInstanceName,
+					"ServiceCommand":      terminal.CommandColor("cf service " + serviceInstanceName),
+				}))
+		case <-time.After(config.PollingInterval()):
+		}
+	}
+}
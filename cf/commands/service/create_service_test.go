@@ -245,6 +245,64 @@ var _ = Describe("create-service command", func() {
 		})
 	})
 
+	Context("when the --wait flag is passed", func() {
+		var operations []models.LastOperationFields
+
+		BeforeEach(func() {
+			config = fastPollingConfig{config}
+			serviceRepo.FindInstanceByNameStub = func(name string) (models.ServiceInstance, error) {
+				instance := models.ServiceInstance{}
+				instance.Name = name
+				instance.LastOperation = operations[serviceRepo.FindInstanceByNameCallCount()-1]
+				return instance, nil
+			}
+		})
+
+		Context("when the broker finishes provisioning", func() {
+			BeforeEach(func() {
+				operations = []models.LastOperationFields{
+					{Type: "create", State: "in progress"},
+					{Type: "create", State: "in progress"},
+					{Type: "create", State: "succeeded"},
+				}
+			})
+
+			It("polls until the operation succeeds and prints each state", func() {
+				Expect(callCreateService([]string{"cleardb", "spark", "my-cleardb-service", "--wait"})).To(BeTrue())
+
+				Expect(serviceRepo.FindInstanceByNameCallCount()).To(Equal(3))
+				Expect(serviceRepo.FindInstanceByNameArgsForCall(0)).To(Equal("my-cleardb-service"))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Creating service instance", "my-cleardb-service"},
+					[]string{"Create in progress"},
+					[]string{"Create succeeded"},
+					[]string{"OK"},
+				))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"to check operation status"}))
+			})
+		})
+
+		Context("when the broker fails to provision", func() {
+			BeforeEach(func() {
+				operations = []models.LastOperationFields{
+					{Type: "create", State: "in progress"},
+					{Type: "create", State: "failed", Description: "out of capacity"},
+				}
+			})
+
+			It("fails with the broker's description", func() {
+				Expect(callCreateService([]string{"cleardb", "spark", "my-cleardb-service", "--wait"})).To(BeFalse())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Create in progress"},
+					[]string{"Create failed"},
+					[]string{"FAILED"},
+					[]string{"Create of service instance my-cleardb-service failed: out of capacity"},
+				))
+			})
+		})
+	})
+
 	Describe("warning the user about paid services", func() {
 		It("does not warn the user when the service is free", func() {
 			callCreateService([]string{"cleardb", "spark", "my-free-cleardb-service"})
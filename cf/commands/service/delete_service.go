@@ -27,13 +27,14 @@ func init() {
 func (cmd *DeleteService) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Force deletion without confirmation")}
+	fs["wait"] = &flags.BoolFlag{Name: "wait", Usage: T("Wait for the broker to finish deprovisioning the service instance")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "delete-service",
 		ShortName:   "ds",
 		Description: T("Delete a service instance"),
 		Usage: []string{
-			T("CF_NAME delete-service SERVICE_INSTANCE [-f]"),
+			T("CF_NAME delete-service SERVICE_INSTANCE [-f] [--wait]"),
 		},
 		Flags: fs,
 	}
@@ -93,6 +94,15 @@ func (cmd *DeleteService) Execute(c flags.FlagContext) error {
 		return err
 	}
 
+	if c.Bool("wait") {
+		err = waitForServiceInstanceOperation(serviceName, cmd.serviceRepo, cmd.config, cmd.ui)
+		if _, ok := err.(*errors.ModelNotFoundError); !ok && err != nil {
+			return err
+		}
+		cmd.ui.Ok()
+		return nil
+	}
+
 	err = printSuccessMessageForServiceInstance(serviceName, cmd.serviceRepo, cmd.ui)
 	if err != nil {
 		cmd.ui.Ok()
@@ -146,6 +146,60 @@ var _ = Describe("delete-service command", func() {
 			})
 		})
 
+		Context("when the --wait flag is passed", func() {
+			BeforeEach(func() {
+				configRepo = fastPollingConfig{configRepo}
+				serviceInstance = models.ServiceInstance{}
+				serviceInstance.Name = "my-service"
+				serviceInstance.GUID = "my-service-guid"
+			})
+
+			Context("when the broker finishes deprovisioning", func() {
+				BeforeEach(func() {
+					serviceRepo.FindInstanceByNameStub = func(name string) (models.ServiceInstance, error) {
+						switch serviceRepo.FindInstanceByNameCallCount() {
+						case 1:
+							return serviceInstance, nil
+						case 2:
+							instance := serviceInstance
+							instance.LastOperation = models.LastOperationFields{Type: "delete", State: "in progress"}
+							return instance, nil
+						default:
+							return models.ServiceInstance{}, errors.NewModelNotFoundError("Service instance", name)
+						}
+					}
+				})
+
+				It("polls until the service instance is gone", func() {
+					Expect(runCommand("-f", "--wait", "my-service")).To(BeTrue())
+
+					Expect(serviceRepo.FindInstanceByNameCallCount()).To(Equal(3))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Deleting service", "my-service"},
+						[]string{"Delete in progress"},
+						[]string{"OK"},
+					))
+				})
+			})
+
+			Context("when the broker fails to deprovision", func() {
+				BeforeEach(func() {
+					serviceInstance.LastOperation = models.LastOperationFields{Type: "delete", State: "failed", Description: "instance is locked"}
+					serviceRepo.FindInstanceByNameReturns(serviceInstance, nil)
+				})
+
+				It("fails with the broker's description", func() {
+					Expect(runCommand("-f", "--wait", "my-service")).To(BeFalse())
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Delete failed"},
+						[]string{"FAILED"},
+						[]string{"Delete of service instance my-service failed: instance is locked"},
+					))
+				})
+			})
+		})
+
 		Context("when the service does not exist", func() {
 			BeforeEach(func() {
 				serviceRepo.FindInstanceByNameReturns(models.ServiceInstance{}, errors.NewModelNotFoundError("Service instance", "my-service"))
@@ -4,8 +4,12 @@ import (
 	"fmt"
 	"strings"
 
+	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/api/applications"
+	"code.cloudfoundry.org/cli/cf/api/spaces"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/models"
@@ -20,6 +24,9 @@ type ShowService struct {
 	pluginModel        *plugin_models.GetService_Model
 	pluginCall         bool
 	appRepo            applications.Repository
+	serviceRepo        api.ServiceRepository
+	spaceRepo          spaces.SpaceRepository
+	config             coreconfig.Reader
 }
 
 func init() {
@@ -64,6 +71,9 @@ func (cmd *ShowService) SetDependency(deps commandregistry.Dependency, pluginCal
 	cmd.pluginCall = pluginCall
 	cmd.pluginModel = deps.PluginModels.Service
 	cmd.appRepo = deps.RepoLocator.GetApplicationRepository()
+	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
+	cmd.spaceRepo = deps.RepoLocator.GetSpaceRepository()
+	cmd.config = deps.Config
 
 	return cmd
 }
@@ -126,6 +136,7 @@ func (cmd *ShowService) Execute(c flags.FlagContext) error {
 				map[string]interface{}{
 					"URL": terminal.EntityNameColor(serviceInstance.DashboardURL),
 				}))
+			cmd.showSharing(serviceInstance)
 			cmd.ui.Say("")
 			cmd.ui.Say(T("Last Operation"))
 			cmd.ui.Say(T("Status: {{.State}}",
@@ -151,6 +162,57 @@ func (cmd *ShowService) Execute(c flags.FlagContext) error {
 	return nil
 }
 
+// showSharing prints the space a shared service instance comes from when it
+// is viewed from another space, or the spaces the instance has been shared
+// with when it is viewed from its own space. Cloud controllers that predate
+// service instance sharing answer the v3 request with a 404, in which case
+// nothing is printed.
+func (cmd *ShowService) showSharing(serviceInstance models.ServiceInstance) {
+	if serviceInstance.SpaceGUID != "" && serviceInstance.SpaceGUID != cmd.config.SpaceFields().GUID {
+		space, err := cmd.spaceRepo.FindByGUID(serviceInstance.SpaceGUID)
+		if err != nil {
+			cmd.ui.Warn(T("Unable to retrieve the space this service instance is shared from: {{.Error}}",
+				map[string]interface{}{"Error": err.Error()}))
+			return
+		}
+		cmd.ui.Say(T("Shared from org/space: {{.OrgName}} / {{.SpaceName}}",
+			map[string]interface{}{
+				"OrgName":   terminal.EntityNameColor(space.Organization.Name),
+				"SpaceName": terminal.EntityNameColor(space.Name),
+			}))
+		return
+	}
+
+	spaceGUIDs, err := cmd.serviceRepo.GetSharedSpaceGUIDs(serviceInstance.GUID)
+	if err != nil {
+		if _, ok := err.(*errors.HTTPNotFoundError); !ok {
+			cmd.ui.Warn(T("Unable to retrieve the spaces this service instance is shared with: {{.Error}}",
+				map[string]interface{}{"Error": err.Error()}))
+		}
+		return
+	}
+	if len(spaceGUIDs) == 0 {
+		return
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Say(T("Shared with spaces:"))
+	table := cmd.ui.Table([]string{T("org"), T("space")})
+	for _, spaceGUID := range spaceGUIDs {
+		space, err := cmd.spaceRepo.FindByGUID(spaceGUID)
+		if err != nil {
+			cmd.ui.Warn(T("Unable to retrieve information for shared space GUID {{.SpaceGUID}}",
+				map[string]interface{}{"SpaceGUID": spaceGUID}))
+			continue
+		}
+		table.Add(space.Organization.Name, space.Name)
+	}
+	err = table.Print()
+	if err != nil {
+		cmd.ui.Warn(err.Error())
+	}
+}
+
 func InstanceStateToStatus(operationType string, state string, isUserProvidedService bool) string {
 	if isUserProvidedService {
 		return ""
@@ -1,6 +1,9 @@
 package service_test
 
 import (
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/util/testhelpers/configuration"
 	. "github.com/onsi/ginkgo"
@@ -24,3 +27,13 @@ type passingRequirement struct {
 func (r passingRequirement) Execute() error {
 	return nil
 }
+
+// fastPollingConfig polls async service operations without waiting between
+// polls, so that --wait tests run quickly.
+type fastPollingConfig struct {
+	coreconfig.Repository
+}
+
+func (fastPollingConfig) PollingInterval() time.Duration {
+	return time.Millisecond
+}
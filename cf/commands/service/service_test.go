@@ -2,13 +2,17 @@ package service_test
 
 import (
 	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/spaces/spacesfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands/service"
+	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
 	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
 
 	"fmt"
@@ -30,6 +34,8 @@ var _ = Describe("service command", func() {
 		targetedSpaceRequirement   requirements.Requirement
 		serviceInstanceRequirement *requirementsfakes.FakeServiceInstanceRequirement
 		pluginCall                 bool
+		serviceRepo                *apifakes.FakeServiceRepository
+		spaceRepo                  *spacesfakes.FakeSpaceRepository
 
 		cmd *service.ShowService
 	)
@@ -50,10 +56,17 @@ var _ = Describe("service command", func() {
 			return models.Application{}, fmt.Errorf("Called stubbed applications repo GetApp with incorrect app GUID\nExpected \"app1-guid\"\nGot \"%s\"\n", appGUID)
 		}
 
+		serviceRepo = new(apifakes.FakeServiceRepository)
+		spaceRepo = new(spacesfakes.FakeSpaceRepository)
+
 		deps = commandregistry.Dependency{
 			UI:           ui,
+			Config:       testconfig.NewRepositoryWithDefaults(),
 			PluginModels: &commandregistry.PluginModels{},
-			RepoLocator:  api.RepositoryLocator{}.SetApplicationRepository(appRepo),
+			RepoLocator: api.RepositoryLocator{}.
+				SetApplicationRepository(appRepo).
+				SetServiceRepository(serviceRepo).
+				SetSpaceRepository(spaceRepo),
 		}
 
 		cmd = &service.ShowService{}
@@ -281,6 +294,65 @@ var _ = Describe("service command", func() {
 				})
 			})
 
+			Context("when the service instance is shared from another space", func() {
+				BeforeEach(func() {
+					serviceInstance.SpaceGUID = "other-space-guid"
+					space := models.Space{}
+					space.Name = "other-space"
+					space.Organization = models.OrganizationFields{Name: "other-org"}
+					spaceRepo.FindByGUIDReturns(space, nil)
+
+					err := flagContext.Parse("service1")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("shows the space it is shared from", func() {
+					Expect(spaceRepo.FindByGUIDArgsForCall(0)).To(Equal("other-space-guid"))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Shared from org/space:", "other-org", "other-space"},
+					))
+					Expect(serviceRepo.GetSharedSpaceGUIDsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the service instance is shared with other spaces", func() {
+				BeforeEach(func() {
+					serviceInstance.SpaceGUID = "my-space-guid"
+					serviceRepo.GetSharedSpaceGUIDsReturns([]string{"space-1-guid"}, nil)
+					space := models.Space{}
+					space.Name = "space-1"
+					space.Organization = models.OrganizationFields{Name: "org-1"}
+					spaceRepo.FindByGUIDReturns(space, nil)
+
+					err := flagContext.Parse("service1")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("lists the spaces it is shared with", func() {
+					Expect(serviceRepo.GetSharedSpaceGUIDsArgsForCall(0)).To(Equal("service1-guid"))
+					Expect(spaceRepo.FindByGUIDArgsForCall(0)).To(Equal("space-1-guid"))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Shared with spaces:"},
+						[]string{"org", "space"},
+						[]string{"org-1", "space-1"},
+					))
+				})
+			})
+
+			Context("when the cloud controller does not support service instance sharing", func() {
+				BeforeEach(func() {
+					serviceRepo.GetSharedSpaceGUIDsReturns(nil, errors.NewHTTPError(404, "10000", "Unknown request"))
+
+					err := flagContext.Parse("service1")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("does not show any sharing information", func() {
+					Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Shared"}))
+					Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Unable to retrieve"}))
+				})
+			})
+
 			Context("when the guid flag is provided", func() {
 				BeforeEach(func() {
 					err := flagContext.Parse("--guid", "service1")
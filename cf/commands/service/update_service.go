@@ -31,7 +31,7 @@ func init() {
 }
 
 func (cmd *UpdateService) MetaData() commandregistry.CommandMetadata {
-	baseUsage := T("CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON] [-t TAGS]")
+	baseUsage := T("CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON] [-t TAGS] [--wait]")
 	paramsUsage := T(`   Optionally provide service-specific configuration parameters in a valid JSON object in-line.
    CF_NAME update-service -c '{"name":"value","name":"value"}'
 
@@ -52,6 +52,7 @@ func (cmd *UpdateService) MetaData() commandregistry.CommandMetadata {
 	fs["p"] = &flags.StringFlag{ShortName: "p", Usage: T("Change service plan for a service instance")}
 	fs["c"] = &flags.StringFlag{ShortName: "c", Usage: T("Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering.")}
 	fs["t"] = &flags.StringFlag{ShortName: "t", Usage: T("User provided tags")}
+	fs["wait"] = &flags.BoolFlag{Name: "wait", Usage: T("Wait for the broker to finish updating the service instance")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "update-service",
@@ -139,6 +140,16 @@ func (cmd *UpdateService) Execute(c flags.FlagContext) error {
 	if err != nil {
 		return err
 	}
+
+	if c.Bool("wait") {
+		err = waitForServiceInstanceOperation(serviceInstanceName, cmd.serviceRepo, cmd.config, cmd.ui)
+		if err != nil {
+			return err
+		}
+		cmd.ui.Ok()
+		return nil
+	}
+
 	err = printSuccessMessageForServiceInstance(serviceInstanceName, cmd.serviceRepo, cmd.ui)
 	if err != nil {
 		return err
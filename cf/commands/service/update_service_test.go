@@ -399,6 +399,62 @@ var _ = Describe("update-service command", func() {
 		})
 	})
 
+	Context("when the --wait flag is passed", func() {
+		var operations []models.LastOperationFields
+
+		BeforeEach(func() {
+			config = fastPollingConfig{config}
+			serviceRepo.FindInstanceByNameStub = func(name string) (models.ServiceInstance, error) {
+				instance := models.ServiceInstance{}
+				instance.Name = name
+				instance.GUID = "my-service-instance-guid"
+				instance.LastOperation = operations[serviceRepo.FindInstanceByNameCallCount()-1]
+				return instance, nil
+			}
+		})
+
+		Context("when the broker finishes updating", func() {
+			BeforeEach(func() {
+				operations = []models.LastOperationFields{
+					{Type: "create", State: "succeeded"},
+					{Type: "update", State: "in progress"},
+					{Type: "update", State: "succeeded"},
+				}
+			})
+
+			It("polls until the operation succeeds and prints each state", func() {
+				Expect(callUpdateService([]string{"-t", "tag1", "--wait", "my-service-instance"})).To(BeTrue())
+
+				Expect(serviceRepo.FindInstanceByNameCallCount()).To(Equal(3))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Updating service", "my-service-instance"},
+					[]string{"Update in progress"},
+					[]string{"Update succeeded"},
+					[]string{"OK"},
+				))
+			})
+		})
+
+		Context("when the broker fails to update", func() {
+			BeforeEach(func() {
+				operations = []models.LastOperationFields{
+					{Type: "create", State: "succeeded"},
+					{Type: "update", State: "failed", Description: "plan change not supported"},
+				}
+			})
+
+			It("fails with the broker's description", func() {
+				Expect(callUpdateService([]string{"-t", "tag1", "--wait", "my-service-instance"})).To(BeFalse())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Update failed"},
+					[]string{"FAILED"},
+					[]string{"Update of service instance my-service-instance failed: plan change not supported"},
+				))
+			})
+		})
+	})
+
 	Context("when service update is synchronous", func() {
 		Context("when the plan flag is passed", func() {
 			BeforeEach(func() {
@@ -0,0 +1,269 @@
+package servicebroker
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/actors"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+type ServiceBrokerCatalog struct {
+	ui     terminal.UI
+	config coreconfig.Reader
+	actor  actors.ServiceActor
+}
+
+type catalogService struct {
+	Label       string        `json:"label"`
+	GUID        string        `json:"guid"`
+	Description string        `json:"description"`
+	Plans       []catalogPlan `json:"plans"`
+}
+
+type catalogPlan struct {
+	Name        string         `json:"name"`
+	GUID        string         `json:"guid"`
+	DisplayName string         `json:"display_name,omitempty"`
+	Description string         `json:"description"`
+	Free        bool           `json:"free"`
+	Active      bool           `json:"active"`
+	Access      string         `json:"access"`
+	Orgs        []string       `json:"orgs"`
+	Bullets     []string       `json:"bullets,omitempty"`
+	Costs       []catalogCost  `json:"costs,omitempty"`
+	Schemas     catalogSchemas `json:"schemas"`
+}
+
+type catalogCost struct {
+	Amount map[string]float64 `json:"amount"`
+	Unit   string             `json:"unit"`
+}
+
+type catalogSchemas struct {
+	InstanceCreate map[string]interface{} `json:"service_instance_create,omitempty"`
+	InstanceUpdate map[string]interface{} `json:"service_instance_update,omitempty"`
+	BindingCreate  map[string]interface{} `json:"service_binding_create,omitempty"`
+}
+
+func init() {
+	commandregistry.Register(&ServiceBrokerCatalog{})
+}
+
+func (cmd *ServiceBrokerCatalog) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["json"] = &flags.BoolFlag{Name: "json", Usage: T("Output the catalog as JSON")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "service-broker-catalog",
+		Description: T("Show the catalog of a service broker, including plan access and parameter schemas"),
+		Usage: []string{
+			"CF_NAME service-broker-catalog SERVICE_BROKER [--json]",
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *ServiceBrokerCatalog) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	if len(fc.Args()) != 1 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires SERVICE_BROKER as argument\n\n") + commandregistry.Commands.CommandUsage("service-broker-catalog"))
+		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
+	}
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+	}
+
+	return reqs, nil
+}
+
+func (cmd *ServiceBrokerCatalog) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.actor = deps.ServiceHandler
+	return cmd
+}
+
+func (cmd *ServiceBrokerCatalog) Execute(c flags.FlagContext) error {
+	brokerName := c.Args()[0]
+	asJSON := c.Bool("json")
+
+	if !asJSON {
+		cmd.ui.Say(T("Getting catalog of service broker {{.Name}} as {{.Username}}...",
+			map[string]interface{}{
+				"Name":     terminal.EntityNameColor(brokerName),
+				"Username": terminal.EntityNameColor(cmd.config.Username()),
+			}))
+	}
+
+	brokers, err := cmd.actor.FilterBrokers(brokerName, "", "")
+	if err != nil {
+		return err
+	}
+
+	var services []catalogService
+	for _, broker := range brokers {
+		for _, service := range broker.Services {
+			services = append(services, newCatalogService(service))
+		}
+	}
+	sort.Slice(services, func(i, j int) bool { return services[i].Label < services[j].Label })
+
+	if asJSON {
+		if services == nil {
+			services = []catalogService{}
+		}
+		output, err := json.MarshalIndent(services, "", "  ")
+		if err != nil {
+			return err
+		}
+		cmd.ui.Say(string(output))
+		return nil
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if len(services) == 0 {
+		cmd.ui.Say(T("No services found"))
+		return nil
+	}
+
+	for _, service := range services {
+		cmd.printService(service)
+	}
+	return nil
+}
+
+func (cmd *ServiceBrokerCatalog) printService(service catalogService) {
+	cmd.ui.Say(T("service: {{.Label}}", map[string]interface{}{"Label": terminal.EntityNameColor(service.Label)}))
+	cmd.ui.Say(T("description: {{.Description}}", map[string]interface{}{"Description": service.Description}))
+
+	if len(service.Plans) == 0 {
+		cmd.ui.Say(T("plans: none"))
+		cmd.ui.Say("")
+		return
+	}
+
+	for _, plan := range service.Plans {
+		cmd.ui.Say("")
+		name := plan.Name
+		if plan.DisplayName != "" {
+			name = fmt.Sprintf("%s (%s)", plan.Name, plan.DisplayName)
+		}
+		cmd.ui.Say("   " + T("plan: {{.Name}}", map[string]interface{}{"Name": terminal.EntityNameColor(name)}))
+		cmd.ui.Say("   " + T("description: {{.Description}}", map[string]interface{}{"Description": plan.Description}))
+		cmd.ui.Say("   " + T("free: {{.Free}}", map[string]interface{}{"Free": formatBool(plan.Free)}))
+		cmd.ui.Say("   " + T("active: {{.Active}}", map[string]interface{}{"Active": formatBool(plan.Active)}))
+		cmd.ui.Say("   " + T("access: {{.Access}}", map[string]interface{}{"Access": T(plan.Access)}))
+		if len(plan.Orgs) > 0 {
+			cmd.ui.Say("   " + T("orgs: {{.Orgs}}", map[string]interface{}{"Orgs": strings.Join(plan.Orgs, ", ")}))
+		}
+		if len(plan.Costs) > 0 {
+			cmd.ui.Say("   " + T("costs: {{.Costs}}", map[string]interface{}{"Costs": formatCosts(plan.Costs)}))
+		}
+		for _, bullet := range plan.Bullets {
+			cmd.ui.Say("   - " + bullet)
+		}
+
+		cmd.printSchema(T("service instance create schema:"), plan.Schemas.InstanceCreate)
+		cmd.printSchema(T("service instance update schema:"), plan.Schemas.InstanceUpdate)
+		cmd.printSchema(T("service binding create schema:"), plan.Schemas.BindingCreate)
+	}
+	cmd.ui.Say("")
+}
+
+func (cmd *ServiceBrokerCatalog) printSchema(title string, schema map[string]interface{}) {
+	if len(schema) == 0 {
+		return
+	}
+
+	output, err := json.MarshalIndent(schema, "      ", "  ")
+	if err != nil {
+		return
+	}
+	cmd.ui.Say("   " + title)
+	cmd.ui.Say("      " + string(output))
+}
+
+func newCatalogService(service models.ServiceOffering) catalogService {
+	catalog := catalogService{
+		Label:       service.Label,
+		GUID:        service.GUID,
+		Description: service.Description,
+		Plans:       []catalogPlan{},
+	}
+
+	for _, plan := range service.Plans {
+		orgs := plan.OrgNames
+		if orgs == nil {
+			orgs = []string{}
+		}
+
+		var costs []catalogCost
+		for _, cost := range plan.Costs {
+			costs = append(costs, catalogCost{Amount: cost.Amount, Unit: cost.Unit})
+		}
+
+		catalog.Plans = append(catalog.Plans, catalogPlan{
+			Name:        plan.Name,
+			GUID:        plan.GUID,
+			DisplayName: plan.DisplayName,
+			Description: plan.Description,
+			Free:        plan.Free,
+			Active:      plan.Active,
+			Access:      planAccess(plan),
+			Orgs:        orgs,
+			Bullets:     plan.Bullets,
+			Costs:       costs,
+			Schemas: catalogSchemas{
+				InstanceCreate: plan.Schemas.InstanceCreate,
+				InstanceUpdate: plan.Schemas.InstanceUpdate,
+				BindingCreate:  plan.Schemas.BindingCreate,
+			},
+		})
+	}
+
+	return catalog
+}
+
+func planAccess(plan models.ServicePlanFields) string {
+	if plan.Public {
+		return "all"
+	}
+	if len(plan.OrgNames) > 0 {
+		return "limited"
+	}
+	return "none"
+}
+
+func formatBool(value bool) string {
+	if value {
+		return T("yes")
+	}
+	return T("no")
+}
+
+func formatCosts(costs []catalogCost) string {
+	var formatted []string
+	for _, cost := range costs {
+		currencies := make([]string, 0, len(cost.Amount))
+		for currency := range cost.Amount {
+			currencies = append(currencies, currency)
+		}
+		sort.Strings(currencies)
+
+		for _, currency := range currencies {
+			formatted = append(formatted, fmt.Sprintf("%.2f %s/%s", cost.Amount[currency], strings.ToUpper(currency), strings.ToLower(cost.Unit)))
+		}
+	}
+	return strings.Join(formatted, ", ")
+}
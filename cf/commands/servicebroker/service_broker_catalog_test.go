@@ -0,0 +1,152 @@
+package servicebroker_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/actors/actorsfakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+)
+
+var _ = Describe("service-broker-catalog command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		actor               *actorsfakes.FakeServiceActor
+		requirementsFactory *requirementsfakes.FakeFactory
+		configRepo          coreconfig.Repository
+		deps                commandregistry.Dependency
+	)
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.ServiceHandler = actor
+		deps.Config = configRepo
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("service-broker-catalog").SetDependency(deps, pluginCall))
+	}
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		actor = new(actorsfakes.FakeServiceActor)
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+		requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+
+		plan1 := models.ServicePlanFields{
+			Name:        "small",
+			GUID:        "small-guid",
+			DisplayName: "Small",
+			Description: "a small database",
+			Free:        false,
+			Active:      true,
+			OrgNames:    []string{"org-1", "org-2"},
+			Bullets:     []string{"1 GB storage"},
+			Costs:       []models.ServicePlanCost{{Amount: map[string]float64{"usd": 9.5}, Unit: "MONTHLY"}},
+			Schemas: models.ServicePlanSchemas{
+				BindingCreate: map[string]interface{}{"required": []interface{}{"role"}},
+			},
+		}
+		plan2 := models.ServicePlanFields{
+			Name:   "free",
+			GUID:   "free-guid",
+			Free:   true,
+			Public: true,
+		}
+
+		broker := models.ServiceBroker{
+			Name: "my-broker",
+			Services: []models.ServiceOffering{
+				{
+					ServiceOfferingFields: models.ServiceOfferingFields{Label: "mysql", GUID: "mysql-guid", Description: "MySQL databases"},
+					Plans:                 []models.ServicePlanFields{plan1, plan2},
+				},
+				{
+					ServiceOfferingFields: models.ServiceOfferingFields{Label: "cache", GUID: "cache-guid"},
+				},
+			},
+		}
+		actor.FilterBrokersReturns([]models.ServiceBroker{broker}, nil)
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("service-broker-catalog", args, requirementsFactory, updateCommandDependency, false, ui)
+	}
+
+	Describe("requirements", func() {
+		It("requires the user to be logged in", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Failing{Message: "not logged in"})
+			Expect(runCommand("my-broker")).To(BeFalse())
+		})
+
+		It("fails with usage when not provided a broker", func() {
+			runCommand()
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Requires SERVICE_BROKER as argument"},
+			))
+		})
+	})
+
+	It("shows the services and plans of the broker with their access and schemas", func() {
+		Expect(runCommand("my-broker")).To(BeTrue())
+
+		brokerName, serviceName, orgName := actor.FilterBrokersArgsForCall(0)
+		Expect(brokerName).To(Equal("my-broker"))
+		Expect(serviceName).To(BeEmpty())
+		Expect(orgName).To(BeEmpty())
+
+		Expect(ui.Outputs()).To(ContainSubstrings(
+			[]string{"Getting catalog of service broker", "my-broker", "my-user"},
+			[]string{"OK"},
+			[]string{"service:", "cache"},
+			[]string{"plans:", "none"},
+			[]string{"service:", "mysql"},
+			[]string{"description:", "MySQL databases"},
+			[]string{"plan:", "small (Small)"},
+			[]string{"free:", "no"},
+			[]string{"access:", "limited"},
+			[]string{"orgs:", "org-1, org-2"},
+			[]string{"costs:", "9.50 USD/monthly"},
+			[]string{"- 1 GB storage"},
+			[]string{"service binding create schema:"},
+			[]string{`"required": [`},
+			[]string{"plan:", "free"},
+			[]string{"access:", "all"},
+		))
+		Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"service instance create schema:"}))
+	})
+
+	It("outputs the catalog as JSON with --json", func() {
+		Expect(runCommand("--json", "my-broker")).To(BeTrue())
+		Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Getting catalog"}))
+
+		var catalog []map[string]interface{}
+		Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &catalog)).To(Succeed())
+		Expect(catalog).To(HaveLen(2))
+		Expect(catalog[1]["label"]).To(Equal("mysql"))
+
+		plans := catalog[1]["plans"].([]interface{})
+		small := plans[0].(map[string]interface{})
+		Expect(small["access"]).To(Equal("limited"))
+		Expect(small["orgs"]).To(Equal([]interface{}{"org-1", "org-2"}))
+		Expect(small["schemas"]).To(Equal(map[string]interface{}{
+			"service_binding_create": map[string]interface{}{"required": []interface{}{"role"}},
+		}))
+	})
+
+	It("returns the error when the broker cannot be found", func() {
+		actor.FilterBrokersReturns(nil, errors.New("Service Broker my-broker not found"))
+		Expect(runCommand("my-broker")).To(BeFalse())
+		Expect(ui.Outputs()).To(ContainSubstrings([]string{"FAILED"}, []string{"my-broker not found"}))
+	})
+})
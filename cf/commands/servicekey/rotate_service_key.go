@@ -0,0 +1,287 @@
+package servicekey
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/applications"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/application"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/flags"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util/json"
+
+	. "code.cloudfoundry.org/cli/cf/i18n"
+)
+
+const rotatedKeyTimestampFormat = "20060102150405"
+
+var rotatedKeySuffix = regexp.MustCompile(`-\d{14}$`)
+
+type RotateServiceKey struct {
+	ui                         terminal.UI
+	config                     coreconfig.Reader
+	serviceRepo                api.ServiceRepository
+	serviceKeyRepo             api.ServiceKeyRepository
+	userProvidedServiceRepo    api.UserProvidedServiceInstanceRepository
+	appRepo                    applications.Repository
+	appStagingWatcher          application.StagingWatcher
+	serviceInstanceRequirement requirements.ServiceInstanceRequirement
+	Sleep                      func(time.Duration)
+}
+
+func init() {
+	commandregistry.Register(&RotateServiceKey{})
+}
+
+func (cmd *RotateServiceKey) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["c"] = &flags.StringFlag{ShortName: "c", Usage: T("Valid JSON object containing service-specific configuration parameters for the new key, provided either in-line or in a file")}
+	fs["user-provided-service"] = &flags.StringFlag{Name: "user-provided-service", Usage: T("User-provided service instance embedding the key's credentials; it is updated with the new credentials and its bound apps are restaged")}
+	fs["grace-period"] = &flags.IntFlag{Name: "grace-period", Usage: T("Seconds to wait before deleting the old key, instead of asking for confirmation")}
+	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Delete the old key without confirmation")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "rotate-service-key",
+		Description: T("Replace a service key with a newly created one and delete the old key"),
+		Usage: []string{
+			T(`CF_NAME rotate-service-key SERVICE_INSTANCE SERVICE_KEY [-c PARAMETERS_AS_JSON] [--user-provided-service USER_PROVIDED_SERVICE] [--grace-period SECONDS] [-f]
+
+   The new key is named after SERVICE_KEY with a timestamp appended, for example mykey-20170102150405.
+   Rotating a previously rotated key replaces its timestamp instead of appending another one.`),
+		},
+		Examples: []string{
+			"CF_NAME rotate-service-key mydb mykey",
+			"CF_NAME rotate-service-key mydb mykey --user-provided-service mydb-creds --grace-period 300",
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *RotateServiceKey) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	if len(fc.Args()) != 2 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires SERVICE_INSTANCE and SERVICE_KEY as arguments\n\n") + commandregistry.Commands.CommandUsage("rotate-service-key"))
+		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 2)
+	}
+
+	if fc.Int("grace-period") < 0 {
+		cmd.ui.Failed(T("Incorrect Usage. --grace-period must not be negative\n\n") + commandregistry.Commands.CommandUsage("rotate-service-key"))
+		return nil, fmt.Errorf("Incorrect usage: negative grace period")
+	}
+
+	cmd.serviceInstanceRequirement = requirementsFactory.NewServiceInstanceRequirement(fc.Args()[0])
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		cmd.serviceInstanceRequirement,
+		requirementsFactory.NewTargetedSpaceRequirement(),
+	}
+
+	return reqs, nil
+}
+
+func (cmd *RotateServiceKey) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
+	cmd.serviceKeyRepo = deps.RepoLocator.GetServiceKeyRepository()
+	cmd.userProvidedServiceRepo = deps.RepoLocator.GetUserProvidedServiceInstanceRepository()
+	cmd.appRepo = deps.RepoLocator.GetApplicationRepository()
+	cmd.Sleep = time.Sleep
+
+	//get command from registry for dependency
+	commandDep := commandregistry.Commands.FindCommand("start")
+	commandDep = commandDep.SetDependency(deps, false)
+	cmd.appStagingWatcher = commandDep.(application.StagingWatcher)
+
+	return cmd
+}
+
+func (cmd *RotateServiceKey) Execute(c flags.FlagContext) error {
+	serviceInstance := cmd.serviceInstanceRequirement.GetServiceInstance()
+	serviceKeyName := c.Args()[1]
+
+	paramsMap, err := json.ParseJSONFromFileOrString(c.String("c"))
+	if err != nil {
+		return errors.New(T("Invalid configuration provided for -c flag. Please provide a valid JSON object or path to a file containing a valid JSON object."))
+	}
+
+	oldKey, err := cmd.serviceKeyRepo.GetServiceKey(serviceInstance.GUID, serviceKeyName)
+	if err != nil {
+		return err
+	}
+	if oldKey.Fields.GUID == "" {
+		return errors.New(T("No service key {{.ServiceKeyName}} found for service instance {{.ServiceInstanceName}}",
+			map[string]interface{}{
+				"ServiceKeyName":      serviceKeyName,
+				"ServiceInstanceName": serviceInstance.Name,
+			}))
+	}
+
+	newKeyName := rotatedKeyName(serviceKeyName, time.Now())
+
+	cmd.ui.Say(T("Creating service key {{.NewServiceKeyName}} to replace {{.ServiceKeyName}} for service instance {{.ServiceInstanceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"NewServiceKeyName":   terminal.EntityNameColor(newKeyName),
+			"ServiceKeyName":      terminal.EntityNameColor(serviceKeyName),
+			"ServiceInstanceName": terminal.EntityNameColor(serviceInstance.Name),
+			"CurrentUser":         terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	err = cmd.serviceKeyRepo.CreateServiceKey(serviceInstance.GUID, newKeyName, paramsMap)
+	if err != nil {
+		return err
+	}
+	cmd.ui.Ok()
+
+	if upsName := c.String("user-provided-service"); upsName != "" {
+		err = cmd.updateUserProvidedService(upsName, serviceInstance.GUID, newKeyName)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.ui.Say("")
+	if gracePeriod := c.Int("grace-period"); gracePeriod > 0 {
+		cmd.ui.Say(T("Waiting {{.GracePeriod}} seconds before deleting service key {{.ServiceKeyName}}...",
+			map[string]interface{}{
+				"GracePeriod":    gracePeriod,
+				"ServiceKeyName": terminal.EntityNameColor(serviceKeyName),
+			}))
+		cmd.Sleep(time.Duration(gracePeriod) * time.Second)
+	} else if !c.Bool("f") {
+		if !cmd.ui.ConfirmDelete(T("service key"), serviceKeyName) {
+			cmd.ui.Say(T("Service key {{.ServiceKeyName}} was kept. Use '{{.DeleteCommand}}' once its consumers have switched to {{.NewServiceKeyName}}.",
+				map[string]interface{}{
+					"ServiceKeyName":    terminal.EntityNameColor(serviceKeyName),
+					"NewServiceKeyName": terminal.EntityNameColor(newKeyName),
+					"DeleteCommand":     terminal.CommandColor(fmt.Sprintf("%s delete-service-key %s %s", cf.Name, serviceInstance.Name, serviceKeyName)),
+				}))
+			return nil
+		}
+	}
+
+	cmd.ui.Say(T("Deleting key {{.ServiceKeyName}} for service instance {{.ServiceInstanceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"ServiceKeyName":      terminal.EntityNameColor(serviceKeyName),
+			"ServiceInstanceName": terminal.EntityNameColor(serviceInstance.Name),
+			"CurrentUser":         terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	err = cmd.serviceKeyRepo.DeleteServiceKey(oldKey.Fields.GUID)
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Ok()
+	return nil
+}
+
+// updateUserProvidedService copies the credentials of the new key over the
+// credentials of the named user-provided service, keeping any credentials the
+// key does not provide, and restages every app bound to it.
+func (cmd *RotateServiceKey) updateUserProvidedService(upsName string, serviceInstanceGUID string, newKeyName string) error {
+	cmd.ui.Say("")
+	cmd.ui.Say(T("Updating user provided service {{.ServiceName}} with the credentials of service key {{.ServiceKeyName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"ServiceName":    terminal.EntityNameColor(upsName),
+			"ServiceKeyName": terminal.EntityNameColor(newKeyName),
+			"CurrentUser":    terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	newKey, err := cmd.serviceKeyRepo.GetServiceKey(serviceInstanceGUID, newKeyName)
+	if err != nil {
+		return err
+	}
+
+	upsInstance, err := cmd.serviceRepo.FindInstanceByName(upsName)
+	if err != nil {
+		return err
+	}
+	if !upsInstance.IsUserProvided() {
+		return errors.New(T("Service instance {{.ServiceName}} is not a user-provided service",
+			map[string]interface{}{"ServiceName": upsName}))
+	}
+
+	ups, err := cmd.findUserProvidedService(upsName)
+	if err != nil {
+		return err
+	}
+
+	credentials := make(map[string]interface{})
+	for name, value := range ups.Credentials {
+		credentials[name] = value
+	}
+	for name, value := range newKey.Credentials {
+		credentials[name] = value
+	}
+
+	upsInstance.Params = credentials
+	upsInstance.SysLogDrainURL = ups.SysLogDrainURL
+	upsInstance.RouteServiceURL = ups.RouteServiceURL
+
+	err = cmd.userProvidedServiceRepo.Update(upsInstance.ServiceInstanceFields)
+	if err != nil {
+		return err
+	}
+	cmd.ui.Ok()
+
+	for _, binding := range upsInstance.ServiceBindings {
+		err = cmd.restage(binding)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd *RotateServiceKey) findUserProvidedService(name string) (models.UserProvidedService, error) {
+	summaries, err := cmd.userProvidedServiceRepo.GetSummaries()
+	if err != nil {
+		return models.UserProvidedService{}, err
+	}
+
+	for _, entity := range summaries.Resources {
+		if entity.Name == name && entity.SpaceGUID == cmd.config.SpaceFields().GUID {
+			return entity.UserProvidedService, nil
+		}
+	}
+
+	return models.UserProvidedService{}, errors.NewModelNotFoundError("Service instance", name)
+}
+
+func (cmd *RotateServiceKey) restage(binding models.ServiceBindingFields) error {
+	app, err := cmd.appRepo.GetApp(binding.AppGUID)
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Say(T("Restaging app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     terminal.EntityNameColor(app.Name),
+			"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	app.PackageState = ""
+
+	_, err = cmd.appStagingWatcher.WatchStaging(app, cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name, func(app models.Application) (models.Application, error) {
+		return app, cmd.appRepo.CreateRestageRequest(app.GUID)
+	})
+	return err
+}
+
+func rotatedKeyName(serviceKeyName string, now time.Time) string {
+	baseName := rotatedKeySuffix.ReplaceAllString(serviceKeyName, "")
+	return baseName + "-" + now.UTC().Format(rotatedKeyTimestampFormat)
+}
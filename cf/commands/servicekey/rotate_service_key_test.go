@@ -0,0 +1,271 @@
+package servicekey_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/applications/applicationsfakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/servicekey"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/flags"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+)
+
+var _ = Describe("rotate-service-key command", func() {
+	var (
+		ui                      *testterm.FakeUI
+		config                  coreconfig.Repository
+		requirementsFactory     *requirementsfakes.FakeFactory
+		serviceRepo             *apifakes.FakeServiceRepository
+		serviceKeyRepo          *apifakes.FakeServiceKeyRepository
+		userProvidedServiceRepo *apifakes.FakeUserProvidedServiceInstanceRepository
+		appRepo                 *applicationsfakes.FakeRepository
+		stagingWatcher          *rotateStagingWatcher
+		originalStart           commandregistry.Command
+		deps                    commandregistry.Dependency
+		sleptFor                []time.Duration
+	)
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = config
+		deps.RepoLocator = deps.RepoLocator.SetServiceRepository(serviceRepo)
+		deps.RepoLocator = deps.RepoLocator.SetServiceKeyRepository(serviceKeyRepo)
+		deps.RepoLocator = deps.RepoLocator.SetUserProvidedServiceInstanceRepository(userProvidedServiceRepo)
+		deps.RepoLocator = deps.RepoLocator.SetApplicationRepository(appRepo)
+
+		//inject fake 'command dependency' into registry
+		commandregistry.Register(stagingWatcher)
+
+		cmd := commandregistry.Commands.FindCommand("rotate-service-key").SetDependency(deps, pluginCall).(*servicekey.RotateServiceKey)
+		cmd.Sleep = func(duration time.Duration) {
+			sleptFor = append(sleptFor, duration)
+		}
+		commandregistry.Commands.SetCommand(cmd)
+	}
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		config = testconfig.NewRepositoryWithDefaults()
+		serviceRepo = new(apifakes.FakeServiceRepository)
+		serviceKeyRepo = new(apifakes.FakeServiceKeyRepository)
+		userProvidedServiceRepo = new(apifakes.FakeUserProvidedServiceInstanceRepository)
+		appRepo = new(applicationsfakes.FakeRepository)
+		stagingWatcher = &rotateStagingWatcher{}
+		sleptFor = nil
+
+		originalStart = commandregistry.Commands.FindCommand("start")
+
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+		requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+		requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
+		serviceInstance := models.ServiceInstance{}
+		serviceInstance.GUID = "instance-guid"
+		serviceInstance.Name = "mydb"
+		serviceInstanceReq := new(requirementsfakes.FakeServiceInstanceRequirement)
+		serviceInstanceReq.GetServiceInstanceReturns(serviceInstance)
+		requirementsFactory.NewServiceInstanceRequirementReturns(serviceInstanceReq)
+
+		serviceKeyRepo.GetServiceKeyStub = func(instanceGUID string, keyName string) (models.ServiceKey, error) {
+			if keyName == "mykey" {
+				return models.ServiceKey{Fields: models.ServiceKeyFields{Name: "mykey", GUID: "old-key-guid"}}, nil
+			}
+			return models.ServiceKey{
+				Fields:      models.ServiceKeyFields{Name: keyName, GUID: "new-key-guid"},
+				Credentials: map[string]interface{}{"password": "new-password"},
+			}, nil
+		}
+	})
+
+	AfterEach(func() {
+		commandregistry.Register(originalStart)
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("rotate-service-key", args, requirementsFactory, updateCommandDependency, false, ui)
+	}
+
+	Describe("requirements", func() {
+		It("requires two arguments", func() {
+			Expect(runCommand("mydb")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Requires SERVICE_INSTANCE and SERVICE_KEY as arguments"},
+			))
+		})
+
+		It("fails when not logged in", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Failing{Message: "not logged in"})
+			Expect(runCommand("mydb", "mykey")).To(BeFalse())
+		})
+
+		It("fails with a negative grace period", func() {
+			Expect(runCommand("--grace-period", "-1", "mydb", "mykey")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"Incorrect Usage", "--grace-period must not be negative"}))
+		})
+	})
+
+	It("fails when the key does not exist", func() {
+		serviceKeyRepo.GetServiceKeyReturns(models.ServiceKey{}, nil)
+		serviceKeyRepo.GetServiceKeyStub = nil
+
+		Expect(runCommand("mydb", "mykey")).To(BeFalse())
+		Expect(serviceKeyRepo.CreateServiceKeyCallCount()).To(Equal(0))
+		Expect(ui.Outputs()).To(ContainSubstrings([]string{"FAILED"}, []string{"No service key mykey found for service instance mydb"}))
+	})
+
+	It("creates a timestamped key and deletes the old key after confirmation", func() {
+		ui.Inputs = []string{"y"}
+		Expect(runCommand("-c", `{"permissions":"read-only"}`, "mydb", "mykey")).To(BeTrue())
+
+		Expect(serviceKeyRepo.CreateServiceKeyCallCount()).To(Equal(1))
+		instanceGUID, newKeyName, params := serviceKeyRepo.CreateServiceKeyArgsForCall(0)
+		Expect(instanceGUID).To(Equal("instance-guid"))
+		Expect(newKeyName).To(MatchRegexp(`^mykey-\d{14}$`))
+		Expect(params).To(Equal(map[string]interface{}{"permissions": "read-only"}))
+
+		Expect(ui.Prompts).To(ContainSubstrings([]string{"Really delete the service key mykey"}))
+		Expect(serviceKeyRepo.DeleteServiceKeyCallCount()).To(Equal(1))
+		Expect(serviceKeyRepo.DeleteServiceKeyArgsForCall(0)).To(Equal("old-key-guid"))
+		Expect(ui.Outputs()).To(ContainSubstrings(
+			[]string{"Creating service key", newKeyName, "to replace", "mykey", "mydb", "my-user"},
+			[]string{"OK"},
+			[]string{"Deleting key", "mykey", "mydb"},
+			[]string{"OK"},
+		))
+	})
+
+	It("replaces the timestamp of a previously rotated key", func() {
+		serviceKeyRepo.GetServiceKeyReturns(models.ServiceKey{Fields: models.ServiceKeyFields{GUID: "old-key-guid"}}, nil)
+		serviceKeyRepo.GetServiceKeyStub = nil
+
+		Expect(runCommand("-f", "mydb", "mykey-20170102150405")).To(BeTrue())
+		_, newKeyName, _ := serviceKeyRepo.CreateServiceKeyArgsForCall(0)
+		Expect(newKeyName).To(MatchRegexp(`^mykey-\d{14}$`))
+		Expect(newKeyName).NotTo(Equal("mykey-20170102150405"))
+	})
+
+	It("keeps the old key when deletion is not confirmed", func() {
+		ui.Inputs = []string{"n"}
+		Expect(runCommand("mydb", "mykey")).To(BeTrue())
+
+		Expect(serviceKeyRepo.CreateServiceKeyCallCount()).To(Equal(1))
+		Expect(serviceKeyRepo.DeleteServiceKeyCallCount()).To(Equal(0))
+		Expect(ui.Outputs()).To(ContainSubstrings(
+			[]string{"Service key mykey was kept", "cf delete-service-key mydb mykey"},
+		))
+	})
+
+	It("deletes the old key without confirmation after the grace period", func() {
+		Expect(runCommand("--grace-period", "300", "mydb", "mykey")).To(BeTrue())
+
+		Expect(sleptFor).To(Equal([]time.Duration{300 * time.Second}))
+		Expect(ui.Prompts).To(BeEmpty())
+		Expect(serviceKeyRepo.DeleteServiceKeyCallCount()).To(Equal(1))
+		Expect(ui.Outputs()).To(ContainSubstrings([]string{"Waiting 300 seconds before deleting service key mykey"}))
+	})
+
+	It("returns the error when the new key cannot be created", func() {
+		serviceKeyRepo.CreateServiceKeyReturns(errors.New("create failed"))
+		Expect(runCommand("-f", "mydb", "mykey")).To(BeFalse())
+		Expect(serviceKeyRepo.DeleteServiceKeyCallCount()).To(Equal(0))
+	})
+
+	Context("when a user-provided service embeds the key", func() {
+		BeforeEach(func() {
+			ups := models.ServiceInstance{}
+			ups.GUID = "ups-guid"
+			ups.Name = "mydb-creds"
+			ups.ServiceBindings = []models.ServiceBindingFields{{AppGUID: "app-1-guid"}, {AppGUID: "app-2-guid"}}
+			serviceRepo.FindInstanceByNameReturns(ups, nil)
+
+			userProvidedServiceRepo.GetSummariesReturns(models.UserProvidedServiceSummary{
+				Resources: []models.UserProvidedServiceEntity{
+					{UserProvidedService: models.UserProvidedService{Name: "mydb-creds", SpaceGUID: "other-space-guid"}},
+					{UserProvidedService: models.UserProvidedService{
+						Name:           "mydb-creds",
+						SpaceGUID:      "my-space-guid",
+						SysLogDrainURL: "syslog://example.com",
+						Credentials:    map[string]interface{}{"password": "old-password", "host": "db.example.com"},
+					}},
+				},
+			}, nil)
+
+			appRepo.GetAppStub = func(guid string) (models.Application, error) {
+				app := models.Application{}
+				app.GUID = guid
+				app.Name = guid[:5]
+				return app, nil
+			}
+		})
+
+		It("updates its credentials and restages the bound apps", func() {
+			Expect(runCommand("-f", "--user-provided-service", "mydb-creds", "mydb", "mykey")).To(BeTrue())
+
+			Expect(serviceRepo.FindInstanceByNameArgsForCall(0)).To(Equal("mydb-creds"))
+			Expect(userProvidedServiceRepo.UpdateCallCount()).To(Equal(1))
+			fields := userProvidedServiceRepo.UpdateArgsForCall(0)
+			Expect(fields.GUID).To(Equal("ups-guid"))
+			Expect(fields.SysLogDrainURL).To(Equal("syslog://example.com"))
+			Expect(fields.Params).To(Equal(map[string]interface{}{"password": "new-password", "host": "db.example.com"}))
+
+			Expect(stagingWatcher.watched).To(Equal([]string{"app-1", "app-2"}))
+			Expect(appRepo.CreateRestageRequestCallCount()).To(Equal(2))
+			Expect(appRepo.CreateRestageRequestArgsForCall(1)).To(Equal("app-2-guid"))
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Updating user provided service mydb-creds with the credentials of service key"},
+				[]string{"Restaging app app-1"},
+				[]string{"Restaging app app-2"},
+				[]string{"Deleting key mykey"},
+			))
+		})
+
+		It("fails when the service instance is not user-provided", func() {
+			managed := models.ServiceInstance{}
+			managed.Name = "mydb-creds"
+			managed.ServicePlan = models.ServicePlanFields{GUID: "plan-guid"}
+			serviceRepo.FindInstanceByNameReturns(managed, nil)
+
+			Expect(runCommand("-f", "--user-provided-service", "mydb-creds", "mydb", "mykey")).To(BeFalse())
+			Expect(userProvidedServiceRepo.UpdateCallCount()).To(Equal(0))
+			Expect(serviceKeyRepo.DeleteServiceKeyCallCount()).To(Equal(0))
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"mydb-creds is not a user-provided service"}))
+		})
+	})
+})
+
+type rotateStagingWatcher struct {
+	watched []string
+}
+
+func (f *rotateStagingWatcher) WatchStaging(app models.Application, orgName, spaceName string, start func(models.Application) (models.Application, error)) (models.Application, error) {
+	f.watched = append(f.watched, app.Name)
+	return start(app)
+}
+
+func (f *rotateStagingWatcher) MetaData() commandregistry.CommandMetadata {
+	return commandregistry.CommandMetadata{Name: "start"}
+}
+
+func (f *rotateStagingWatcher) SetDependency(_ commandregistry.Dependency, _ bool) commandregistry.Command {
+	return f
+}
+
+func (f *rotateStagingWatcher) Requirements(_ requirements.Factory, _ flags.FlagContext) ([]requirements.Requirement, error) {
+	return []requirements.Requirement{}, nil
+}
+
+func (f *rotateStagingWatcher) Execute(_ flags.FlagContext) error {
+	return nil
+}
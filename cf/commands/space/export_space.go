@@ -0,0 +1,324 @@
+package space
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/environmentvariablegroups"
+	"code.cloudfoundry.org/cli/cf/api/spaces"
+	"code.cloudfoundry.org/cli/cf/api/stacks"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/manifest"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/terminal"
+
+	"gopkg.in/yaml.v2"
+)
+
+type ExportSpace struct {
+	ui                  terminal.UI
+	config              coreconfig.Reader
+	spaceRepo           spaces.SpaceRepository
+	appSummaryRepo      api.AppSummaryRepository
+	stackRepo           stacks.StackRepository
+	serviceSummaryRepo  api.ServiceSummaryRepository
+	userProvidedRepo    api.UserProvidedServiceInstanceRepository
+	routeRepo           api.RouteRepository
+	environmentVarGroup environmentvariablegroups.Repository
+	manifest            manifest.App
+}
+
+func init() {
+	commandregistry.Register(&ExportSpace{})
+}
+
+func (cmd *ExportSpace) MetaData() commandregistry.CommandMetadata {
+	primaryUsage := T("CF_NAME export-space BUNDLE_DIR")
+	tipUsage := T("TIP: Credentials of service instances are not exported. Recreate the space from the bundle with 'CF_NAME import-space'.")
+	return commandregistry.CommandMetadata{
+		Name:        "export-space",
+		Description: T("Export the apps, services, routes and security groups of the targeted space to a bundle directory"),
+		Usage: []string{
+			primaryUsage,
+			"\n\n",
+			tipUsage,
+		},
+	}
+}
+
+func (cmd *ExportSpace) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	if len(fc.Args()) != 1 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires BUNDLE_DIR as argument\n\n") + commandregistry.Commands.CommandUsage("export-space"))
+		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
+	}
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+	}
+
+	return reqs, nil
+}
+
+func (cmd *ExportSpace) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.spaceRepo = deps.RepoLocator.GetSpaceRepository()
+	cmd.appSummaryRepo = deps.RepoLocator.GetAppSummaryRepository()
+	cmd.stackRepo = deps.RepoLocator.GetStackRepository()
+	cmd.serviceSummaryRepo = deps.RepoLocator.GetServiceSummaryRepository()
+	cmd.userProvidedRepo = deps.RepoLocator.GetUserProvidedServiceInstanceRepository()
+	cmd.routeRepo = deps.RepoLocator.GetRouteRepository()
+	cmd.environmentVarGroup = deps.RepoLocator.GetEnvironmentVariableGroupsRepository()
+	cmd.manifest = deps.AppManifest
+	return cmd
+}
+
+func (cmd *ExportSpace) Execute(c flags.FlagContext) error {
+	dir := c.Args()[0]
+
+	cmd.ui.Say(T("Exporting space {{.SpaceName}} in org {{.OrgName}} to {{.Path}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"Path":        terminal.EntityNameColor(dir),
+			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return errors.New(T("Error creating bundle directory: ") + err.Error())
+	}
+
+	appCount, err := cmd.exportApps(dir)
+	if err != nil {
+		return err
+	}
+
+	var bundle spaceBundle
+
+	err = cmd.exportServices(&bundle)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.routeRepo.ListRoutes(func(route models.Route) bool {
+		bundle.Routes = append(bundle.Routes, bundleRoute{
+			Host:   route.Host,
+			Domain: route.Domain.Name,
+			Path:   route.Path,
+			Port:   route.Port,
+		})
+		return true
+	})
+	if err != nil {
+		return errors.New(T("Error getting routes: ") + err.Error())
+	}
+
+	space, err := cmd.spaceRepo.FindByName(cmd.config.SpaceFields().Name)
+	if err != nil {
+		return err
+	}
+	for _, group := range space.SecurityGroups {
+		bundle.SecurityGroups = append(bundle.SecurityGroups, bundleSecurityGroup{
+			Name:  group.Name,
+			Rules: group.Rules,
+		})
+	}
+
+	running, err := cmd.environmentVarGroup.ListRunning()
+	if err != nil {
+		return err
+	}
+	bundle.RunningEnvironmentVariables = environmentVariableMap(running)
+
+	staging, err := cmd.environmentVarGroup.ListStaging()
+	if err != nil {
+		return err
+	}
+	bundle.StagingEnvironmentVariables = environmentVariableMap(staging)
+
+	err = writeSpaceBundle(dir, bundle)
+	if err != nil {
+		return errors.New(T("Error writing space bundle: ") + err.Error())
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say(T("Exported {{.Apps}} apps, {{.Services}} service instances, {{.Routes}} routes and {{.SecurityGroups}} security groups",
+		map[string]interface{}{
+			"Apps":           appCount,
+			"Services":       len(bundle.Services) + len(bundle.UserProvidedServices),
+			"Routes":         len(bundle.Routes),
+			"SecurityGroups": len(bundle.SecurityGroups),
+		}))
+	cmd.ui.Say("")
+	return nil
+}
+
+func (cmd *ExportSpace) exportApps(dir string) (int, error) {
+	summaries, err := cmd.appSummaryRepo.GetSummariesInCurrentSpace()
+	if err != nil {
+		return 0, errors.New(T("Error getting application summary: ") + err.Error())
+	}
+	if len(summaries) == 0 {
+		return 0, nil
+	}
+
+	for _, summary := range summaries {
+		app, err := cmd.appSummaryRepo.GetSummary(summary.GUID)
+		if err != nil {
+			return 0, errors.New(T("Error getting application summary: ") + err.Error())
+		}
+
+		stack, err := cmd.stackRepo.FindByGUID(app.StackGUID)
+		if err != nil {
+			return 0, errors.New(T("Error retrieving stack: ") + err.Error())
+		}
+		app.Stack = &stack
+
+		err = manifest.AddApplication(cmd.manifest, app)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	f, err := os.Create(filepath.Join(dir, bundleManifestFile))
+	if err != nil {
+		return 0, errors.New(T("Error creating manifest file: ") + err.Error())
+	}
+	defer f.Close()
+
+	err = cmd.manifest.Save(f)
+	if err != nil {
+		return 0, errors.New(T("Error creating manifest file: ") + err.Error())
+	}
+
+	return len(summaries), nil
+}
+
+func (cmd *ExportSpace) exportServices(bundle *spaceBundle) error {
+	instances, err := cmd.serviceSummaryRepo.GetSummariesInCurrentSpace()
+	if err != nil {
+		return err
+	}
+
+	userProvided := map[string]bool{}
+	for _, instance := range instances {
+		if instance.IsUserProvided() {
+			userProvided[instance.Name] = true
+			continue
+		}
+
+		bundle.Services = append(bundle.Services, bundleService{
+			Name:    instance.Name,
+			Service: instance.ServiceOffering.Label,
+			Plan:    instance.ServicePlan.Name,
+		})
+	}
+
+	if len(userProvided) == 0 {
+		return nil
+	}
+
+	summaries, err := cmd.userProvidedRepo.GetSummaries()
+	if err != nil {
+		return err
+	}
+	for _, summary := range summaries.Resources {
+		if summary.SpaceGUID != cmd.config.SpaceFields().GUID || !userProvided[summary.Name] {
+			continue
+		}
+
+		bundle.UserProvidedServices = append(bundle.UserProvidedServices, bundleUserProvidedService{
+			Name:            summary.Name,
+			SyslogDrainURL:  summary.SysLogDrainURL,
+			RouteServiceURL: summary.RouteServiceURL,
+		})
+	}
+
+	return nil
+}
+
+func environmentVariableMap(variables []models.EnvironmentVariable) map[string]string {
+	if len(variables) == 0 {
+		return nil
+	}
+
+	envVars := map[string]string{}
+	for _, variable := range variables {
+		envVars[variable.Name] = variable.Value
+	}
+	return envVars
+}
+
+// A space bundle is a directory holding an app manifest written by the
+// manifest generator, and a space file describing everything else
+// export-space copies out of a space.
+const (
+	bundleManifestFile = "manifest.yml"
+	bundleSpaceFile    = "space.yml"
+)
+
+type spaceBundle struct {
+	UserProvidedServices        []bundleUserProvidedService `yaml:"user_provided_services,omitempty"`
+	Services                    []bundleService             `yaml:"services,omitempty"`
+	Routes                      []bundleRoute               `yaml:"routes,omitempty"`
+	SecurityGroups              []bundleSecurityGroup       `yaml:"security_groups,omitempty"`
+	RunningEnvironmentVariables map[string]string           `yaml:"running_environment_variables,omitempty"`
+	StagingEnvironmentVariables map[string]string           `yaml:"staging_environment_variables,omitempty"`
+}
+
+// Credentials are never exported, for user provided and managed service
+// instances alike.
+type bundleUserProvidedService struct {
+	Name            string `yaml:"name"`
+	SyslogDrainURL  string `yaml:"syslog_drain_url,omitempty"`
+	RouteServiceURL string `yaml:"route_service_url,omitempty"`
+}
+
+type bundleService struct {
+	Name    string `yaml:"name"`
+	Service string `yaml:"service"`
+	Plan    string `yaml:"plan"`
+}
+
+type bundleRoute struct {
+	Host   string `yaml:"host,omitempty"`
+	Domain string `yaml:"domain"`
+	Path   string `yaml:"path,omitempty"`
+	Port   int    `yaml:"port,omitempty"`
+}
+
+type bundleSecurityGroup struct {
+	Name  string                   `yaml:"name"`
+	Rules []map[string]interface{} `yaml:"rules,omitempty"`
+}
+
+func readSpaceBundle(dir string) (spaceBundle, error) {
+	var bundle spaceBundle
+
+	raw, err := ioutil.ReadFile(filepath.Join(dir, bundleSpaceFile))
+	if err != nil {
+		return bundle, err
+	}
+
+	err = yaml.Unmarshal(raw, &bundle)
+	return bundle, err
+}
+
+func writeSpaceBundle(dir string, bundle spaceBundle) error {
+	raw, err := yaml.Marshal(bundle)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(dir, bundleSpaceFile), raw, 0644)
+}
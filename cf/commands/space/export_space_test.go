@@ -0,0 +1,203 @@
+package space_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/environmentvariablegroups/environmentvariablegroupsfakes"
+	"code.cloudfoundry.org/cli/cf/api/spaces/spacesfakes"
+	"code.cloudfoundry.org/cli/cf/api/stacks/stacksfakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/manifest"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+)
+
+var _ = Describe("export-space command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		configRepo          coreconfig.Repository
+		requirementsFactory *requirementsfakes.FakeFactory
+		spaceRepo           *spacesfakes.FakeSpaceRepository
+		appSummaryRepo      *apifakes.FakeAppSummaryRepository
+		stackRepo           *stacksfakes.FakeStackRepository
+		serviceSummaryRepo  *apifakes.FakeServiceSummaryRepository
+		userProvidedRepo    *apifakes.FakeUserProvidedServiceInstanceRepository
+		routeRepo           *apifakes.FakeRouteRepository
+		envVarGroupRepo     *environmentvariablegroupsfakes.FakeRepository
+		deps                commandregistry.Dependency
+		bundleDir           string
+	)
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = configRepo
+		deps.RepoLocator = deps.RepoLocator.SetSpaceRepository(spaceRepo)
+		deps.RepoLocator = deps.RepoLocator.SetAppSummaryRepository(appSummaryRepo)
+		deps.RepoLocator = deps.RepoLocator.SetStackRepository(stackRepo)
+		deps.RepoLocator = deps.RepoLocator.SetServiceSummaryRepository(serviceSummaryRepo)
+		deps.RepoLocator = deps.RepoLocator.SetUserProvidedServiceInstanceRepository(userProvidedRepo)
+		deps.RepoLocator = deps.RepoLocator.SetRouteRepository(routeRepo)
+		deps.RepoLocator = deps.RepoLocator.SetEnvironmentVariableGroupsRepository(envVarGroupRepo)
+		deps.AppManifest = manifest.NewGenerator()
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("export-space").SetDependency(deps, pluginCall))
+	}
+
+	BeforeEach(func() {
+		ui = new(testterm.FakeUI)
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+		requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+		requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
+
+		spaceRepo = new(spacesfakes.FakeSpaceRepository)
+		appSummaryRepo = new(apifakes.FakeAppSummaryRepository)
+		stackRepo = new(stacksfakes.FakeStackRepository)
+		serviceSummaryRepo = new(apifakes.FakeServiceSummaryRepository)
+		userProvidedRepo = new(apifakes.FakeUserProvidedServiceInstanceRepository)
+		routeRepo = new(apifakes.FakeRouteRepository)
+		envVarGroupRepo = new(environmentvariablegroupsfakes.FakeRepository)
+
+		var err error
+		bundleDir, err = ioutil.TempDir("", "space-bundle")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(bundleDir)).To(Succeed())
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("export-space", args, requirementsFactory, updateCommandDependency, false, ui)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not provided a bundle directory", func() {
+			runCommand()
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Requires BUNDLE_DIR as argument"},
+			))
+		})
+
+		It("fails when a space is not targeted", func() {
+			requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Failing{Message: "not targeting space"})
+			Expect(runCommand(bundleDir)).To(BeFalse())
+		})
+	})
+
+	Context("when the space has apps, services, routes and security groups", func() {
+		BeforeEach(func() {
+			appSummaryRepo.GetSummariesInCurrentSpaceReturns([]models.Application{{ApplicationFields: models.ApplicationFields{GUID: "app-guid", Name: "my-app"}}}, nil)
+			app := models.Application{}
+			app.Name = "my-app"
+			app.Memory = 128
+			app.InstanceCount = 2
+			app.DiskQuota = 1024
+			app.StackGUID = "stack-guid"
+			app.HealthCheckType = "port"
+			app.Services = []models.ServicePlanSummary{{Name: "my-db"}}
+			appSummaryRepo.GetSummaryReturns(app, nil)
+			stackRepo.FindByGUIDReturns(models.Stack{Name: "cflinuxfs2"}, nil)
+
+			db := models.ServiceInstance{}
+			db.Name = "my-db"
+			db.ServicePlan = models.ServicePlanFields{GUID: "plan-guid", Name: "small"}
+			db.ServiceOffering = models.ServiceOfferingFields{Label: "mysql"}
+			logs := models.ServiceInstance{}
+			logs.Name = "my-logs"
+			serviceSummaryRepo.GetSummariesInCurrentSpaceReturns([]models.ServiceInstance{db, logs}, nil)
+			userProvidedRepo.GetSummariesReturns(models.UserProvidedServiceSummary{
+				Resources: []models.UserProvidedServiceEntity{
+					{UserProvidedService: models.UserProvidedService{Name: "my-logs", SpaceGUID: "my-space-guid", SysLogDrainURL: "syslog://example.com", Credentials: map[string]interface{}{"password": "secret"}}},
+					{UserProvidedService: models.UserProvidedService{Name: "my-logs", SpaceGUID: "other-space-guid"}},
+				},
+			}, nil)
+
+			routeRepo.ListRoutesStub = func(cb func(models.Route) bool) error {
+				cb(models.Route{Host: "my-app", Domain: models.DomainFields{Name: "example.com"}, Path: "/api"})
+				cb(models.Route{Domain: models.DomainFields{Name: "tcp.example.com"}, Port: 1024})
+				return nil
+			}
+
+			space := models.Space{}
+			space.SecurityGroups = []models.SecurityGroupFields{{
+				Name:  "my-group",
+				Rules: []map[string]interface{}{{"protocol": "tcp", "destination": "10.0.0.0/8", "ports": "443"}},
+			}}
+			spaceRepo.FindByNameReturns(space, nil)
+
+			envVarGroupRepo.ListRunningReturns([]models.EnvironmentVariable{{Name: "HTTP_PROXY", Value: "proxy"}}, nil)
+		})
+
+		It("writes the manifest and space file to the bundle directory", func() {
+			Expect(runCommand(bundleDir)).To(BeTrue())
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Exporting space", "my-space", "my-org", bundleDir, "my-user"},
+				[]string{"OK"},
+				[]string{"Exported 1 apps, 2 service instances, 2 routes and 1 security groups"},
+			))
+
+			Expect(appSummaryRepo.GetSummaryArgsForCall(0)).To(Equal("app-guid"))
+			Expect(stackRepo.FindByGUIDArgsForCall(0)).To(Equal("stack-guid"))
+			Expect(spaceRepo.FindByNameArgsForCall(0)).To(Equal("my-space"))
+
+			manifestContents, err := ioutil.ReadFile(filepath.Join(bundleDir, "manifest.yml"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(manifestContents)).To(ContainSubstring("name: my-app"))
+			Expect(string(manifestContents)).To(ContainSubstring("stack: cflinuxfs2"))
+			Expect(string(manifestContents)).To(ContainSubstring("- my-db"))
+
+			spaceContents, err := ioutil.ReadFile(filepath.Join(bundleDir, "space.yml"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(spaceContents)).To(MatchYAML(`
+user_provided_services:
+- name: my-logs
+  syslog_drain_url: syslog://example.com
+services:
+- name: my-db
+  service: mysql
+  plan: small
+routes:
+- host: my-app
+  domain: example.com
+  path: /api
+- domain: tcp.example.com
+  port: 1024
+security_groups:
+- name: my-group
+  rules:
+  - destination: 10.0.0.0/8
+    ports: "443"
+    protocol: tcp
+running_environment_variables:
+  HTTP_PROXY: proxy
+`))
+			Expect(string(spaceContents)).NotTo(ContainSubstring("secret"))
+		})
+	})
+
+	Context("when the space is empty", func() {
+		It("does not write a manifest", func() {
+			Expect(runCommand(bundleDir)).To(BeTrue())
+
+			_, err := os.Stat(filepath.Join(bundleDir, "manifest.yml"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+			Expect(userProvidedRepo.GetSummariesCallCount()).To(Equal(0))
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Exported 0 apps, 0 service instances, 0 routes and 0 security groups"},
+			))
+		})
+	})
+})
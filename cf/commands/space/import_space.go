@@ -0,0 +1,366 @@
+package space
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/actors"
+	"code.cloudfoundry.org/cli/cf/actors/servicebuilder"
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/applications"
+	"code.cloudfoundry.org/cli/cf/api/environmentvariablegroups"
+	"code.cloudfoundry.org/cli/cf/api/securitygroups"
+	sgbinder "code.cloudfoundry.org/cli/cf/api/securitygroups/spaces"
+	"code.cloudfoundry.org/cli/cf/api/stacks"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/manifest"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+type ImportSpace struct {
+	ui                  terminal.UI
+	config              coreconfig.Reader
+	appRepo             applications.Repository
+	stackRepo           stacks.StackRepository
+	domainRepo          api.DomainRepository
+	serviceRepo         api.ServiceRepository
+	serviceBindingRepo  api.ServiceBindingRepository
+	userProvidedRepo    api.UserProvidedServiceInstanceRepository
+	securityGroupRepo   securitygroups.SecurityGroupRepo
+	spaceBinder         sgbinder.SecurityGroupSpaceBinder
+	environmentVarGroup environmentvariablegroups.Repository
+	serviceBuilder      servicebuilder.ServiceBuilder
+	routeActor          actors.RouteActor
+	manifestRepo        manifest.Repository
+}
+
+func init() {
+	commandregistry.Register(&ImportSpace{})
+}
+
+func (cmd *ImportSpace) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["include-env-var-groups"] = &flags.BoolFlag{Name: "include-env-var-groups", Usage: T("Also replace the running and staging environment variable groups, which apply to every app in the foundation")}
+
+	primaryUsage := T("CF_NAME import-space BUNDLE_DIR [--include-env-var-groups]")
+	tipUsage := T("TIP: Apps are created without application bits. Push them with 'CF_NAME push -f BUNDLE_DIR/manifest.yml'.")
+	return commandregistry.CommandMetadata{
+		Name:        "import-space",
+		Description: T("Recreate the apps, services, routes and security groups of a bundle from export-space in the targeted space"),
+		Usage: []string{
+			primaryUsage,
+			"\n\n",
+			tipUsage,
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *ImportSpace) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	if len(fc.Args()) != 1 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires BUNDLE_DIR as argument\n\n") + commandregistry.Commands.CommandUsage("import-space"))
+		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
+	}
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+	}
+
+	return reqs, nil
+}
+
+func (cmd *ImportSpace) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.appRepo = deps.RepoLocator.GetApplicationRepository()
+	cmd.stackRepo = deps.RepoLocator.GetStackRepository()
+	cmd.domainRepo = deps.RepoLocator.GetDomainRepository()
+	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
+	cmd.serviceBindingRepo = deps.RepoLocator.GetServiceBindingRepository()
+	cmd.userProvidedRepo = deps.RepoLocator.GetUserProvidedServiceInstanceRepository()
+	cmd.securityGroupRepo = deps.RepoLocator.GetSecurityGroupRepository()
+	cmd.spaceBinder = deps.RepoLocator.GetSecurityGroupSpaceBinder()
+	cmd.environmentVarGroup = deps.RepoLocator.GetEnvironmentVariableGroupsRepository()
+	cmd.serviceBuilder = deps.ServiceBuilder
+	cmd.routeActor = deps.RouteActor
+	cmd.manifestRepo = deps.ManifestRepo
+	return cmd
+}
+
+func (cmd *ImportSpace) Execute(c flags.FlagContext) error {
+	dir := c.Args()[0]
+
+	bundle, err := readSpaceBundle(dir)
+	if err != nil {
+		return errors.New(T("Error reading space bundle: ") + err.Error())
+	}
+
+	cmd.ui.Say(T("Importing space bundle {{.Path}} into org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"Path":        terminal.EntityNameColor(dir),
+			"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+	cmd.ui.Say("")
+
+	for _, group := range bundle.SecurityGroups {
+		err = cmd.importSecurityGroup(group)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, service := range bundle.UserProvidedServices {
+		err = cmd.importUserProvidedService(service)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, service := range bundle.Services {
+		err = cmd.importService(service)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, route := range bundle.Routes {
+		domain, err := cmd.domainRepo.FindByNameInOrg(route.Domain, cmd.config.OrganizationFields().GUID)
+		if err != nil {
+			return err
+		}
+
+		_, err = cmd.routeActor.FindOrCreateRoute(route.Host, domain, route.Path, route.Port, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cmd.importApps(dir)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("include-env-var-groups") {
+		err = cmd.importEnvironmentVariableGroups(bundle)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+	if len(bundle.UserProvidedServices) > 0 {
+		cmd.ui.Warn(T("Credentials of user provided service instances were not exported. Set them with '{{.Command}}'.",
+			map[string]interface{}{"Command": terminal.CommandColor(cf.Name + " update-user-provided-service")}))
+	}
+	return nil
+}
+
+func (cmd *ImportSpace) importSecurityGroup(bundleGroup bundleSecurityGroup) error {
+	group, err := cmd.securityGroupRepo.Read(bundleGroup.Name)
+	switch err.(type) {
+	case nil:
+	case *cferrors.ModelNotFoundError:
+		cmd.ui.Say(T("Creating security group {{.Name}}...", map[string]interface{}{
+			"Name": terminal.EntityNameColor(bundleGroup.Name),
+		}))
+		err = cmd.securityGroupRepo.Create(bundleGroup.Name, bundleGroup.Rules)
+		if err != nil {
+			return err
+		}
+
+		group, err = cmd.securityGroupRepo.Read(bundleGroup.Name)
+		if err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	cmd.ui.Say(T("Binding security group {{.Name}}...", map[string]interface{}{
+		"Name": terminal.EntityNameColor(bundleGroup.Name),
+	}))
+	return cmd.spaceBinder.BindSpace(group.GUID, cmd.config.SpaceFields().GUID)
+}
+
+func (cmd *ImportSpace) importUserProvidedService(service bundleUserProvidedService) error {
+	exists, err := cmd.serviceInstanceExists(service.Name)
+	if err != nil || exists {
+		return err
+	}
+
+	cmd.ui.Say(T("Creating user provided service {{.Name}}...", map[string]interface{}{
+		"Name": terminal.EntityNameColor(service.Name),
+	}))
+	return cmd.userProvidedRepo.Create(service.Name, service.SyslogDrainURL, service.RouteServiceURL, map[string]interface{}{})
+}
+
+func (cmd *ImportSpace) importService(service bundleService) error {
+	exists, err := cmd.serviceInstanceExists(service.Name)
+	if err != nil || exists {
+		return err
+	}
+
+	cmd.ui.Say(T("Creating service instance {{.Name}} of service {{.Service}} with plan {{.Plan}}...", map[string]interface{}{
+		"Name":    terminal.EntityNameColor(service.Name),
+		"Service": terminal.EntityNameColor(service.Service),
+		"Plan":    terminal.EntityNameColor(service.Plan),
+	}))
+
+	offerings, err := cmd.serviceBuilder.GetServicesByNameForSpaceWithPlans(cmd.config.SpaceFields().GUID, service.Service)
+	if err != nil {
+		return err
+	}
+
+	for _, offering := range offerings {
+		for _, plan := range offering.Plans {
+			if plan.Name == service.Plan {
+				return cmd.serviceRepo.CreateServiceInstance(service.Name, plan.GUID, nil, nil)
+			}
+		}
+	}
+
+	return errors.New(T("Could not find plan with name {{.ServicePlanName}}",
+		map[string]interface{}{"ServicePlanName": service.Plan},
+	))
+}
+
+func (cmd *ImportSpace) serviceInstanceExists(name string) (bool, error) {
+	_, err := cmd.serviceRepo.FindInstanceByName(name)
+	switch err.(type) {
+	case nil:
+		cmd.ui.Say(T("Service instance {{.Name}} already exists", map[string]interface{}{
+			"Name": terminal.EntityNameColor(name),
+		}))
+		return true, nil
+	case *cferrors.ModelNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (cmd *ImportSpace) importApps(dir string) error {
+	manifestPath := filepath.Join(dir, bundleManifestFile)
+	if _, err := os.Stat(manifestPath); os.IsNotExist(err) {
+		return nil
+	}
+
+	m, err := cmd.manifestRepo.ReadManifest(manifestPath)
+	if err != nil {
+		return err
+	}
+
+	apps, err := m.Applications()
+	if err != nil {
+		return errors.New(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+	}
+
+	for _, params := range apps {
+		err = cmd.importApp(params)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd *ImportSpace) importApp(params models.AppParams) error {
+	_, err := cmd.appRepo.Read(*params.Name)
+	switch err.(type) {
+	case nil:
+		cmd.ui.Say(T("App {{.AppName}} already exists", map[string]interface{}{
+			"AppName": terminal.EntityNameColor(*params.Name),
+		}))
+		return nil
+	case *cferrors.ModelNotFoundError:
+	default:
+		return err
+	}
+
+	cmd.ui.Say(T("Creating app {{.AppName}}...", map[string]interface{}{
+		"AppName": terminal.EntityNameColor(*params.Name),
+	}))
+
+	if params.StackName != nil {
+		stack, err := cmd.stackRepo.FindByName(*params.StackName)
+		if err != nil {
+			return err
+		}
+		params.StackGUID = &stack.GUID
+	}
+
+	spaceGUID := cmd.config.SpaceFields().GUID
+	params.SpaceGUID = &spaceGUID
+
+	app, err := cmd.appRepo.Create(params)
+	if err != nil {
+		return err
+	}
+
+	for _, route := range params.Routes {
+		err = cmd.routeActor.FindAndBindRoute(route.Route, app, models.AppParams{})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, serviceName := range params.ServicesToBind {
+		instance, err := cmd.serviceRepo.FindInstanceByName(serviceName)
+		if err != nil {
+			return err
+		}
+
+		cmd.ui.Say(T("Binding service {{.ServiceName}} to app {{.AppName}}...", map[string]interface{}{
+			"ServiceName": terminal.EntityNameColor(serviceName),
+			"AppName":     terminal.EntityNameColor(app.Name),
+		}))
+		err = cmd.serviceBindingRepo.Create(instance.GUID, app.GUID, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd *ImportSpace) importEnvironmentVariableGroups(bundle spaceBundle) error {
+	cmd.ui.Say(T("Setting the running and staging environment variable groups..."))
+
+	running, err := json.Marshal(environmentVariableGroup(bundle.RunningEnvironmentVariables))
+	if err != nil {
+		return err
+	}
+	err = cmd.environmentVarGroup.SetRunning(string(running))
+	if err != nil {
+		return err
+	}
+
+	staging, err := json.Marshal(environmentVariableGroup(bundle.StagingEnvironmentVariables))
+	if err != nil {
+		return err
+	}
+	return cmd.environmentVarGroup.SetStaging(string(staging))
+}
+
+// environmentVariableGroup keeps an empty group from being sent as null.
+func environmentVariableGroup(envVars map[string]string) map[string]string {
+	if envVars == nil {
+		return map[string]string{}
+	}
+	return envVars
+}
@@ -0,0 +1,299 @@
+package space_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/cf/actors/actorsfakes"
+	"code.cloudfoundry.org/cli/cf/actors/servicebuilder/servicebuilderfakes"
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/applications/applicationsfakes"
+	"code.cloudfoundry.org/cli/cf/api/environmentvariablegroups/environmentvariablegroupsfakes"
+	"code.cloudfoundry.org/cli/cf/api/securitygroups/securitygroupsfakes"
+	sgbinderfakes "code.cloudfoundry.org/cli/cf/api/securitygroups/spaces/spacesfakes"
+	"code.cloudfoundry.org/cli/cf/api/stacks/stacksfakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/manifest"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+)
+
+var _ = Describe("import-space command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		configRepo          coreconfig.Repository
+		requirementsFactory *requirementsfakes.FakeFactory
+		appRepo             *applicationsfakes.FakeRepository
+		stackRepo           *stacksfakes.FakeStackRepository
+		domainRepo          *apifakes.FakeDomainRepository
+		serviceRepo         *apifakes.FakeServiceRepository
+		serviceBindingRepo  *apifakes.FakeServiceBindingRepository
+		userProvidedRepo    *apifakes.FakeUserProvidedServiceInstanceRepository
+		securityGroupRepo   *securitygroupsfakes.FakeSecurityGroupRepo
+		spaceBinder         *sgbinderfakes.FakeSecurityGroupSpaceBinder
+		envVarGroupRepo     *environmentvariablegroupsfakes.FakeRepository
+		serviceBuilder      *servicebuilderfakes.FakeServiceBuilder
+		routeActor          *actorsfakes.FakeRouteActor
+		deps                commandregistry.Dependency
+		bundleDir           string
+	)
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = configRepo
+		deps.RepoLocator = deps.RepoLocator.SetApplicationRepository(appRepo)
+		deps.RepoLocator = deps.RepoLocator.SetStackRepository(stackRepo)
+		deps.RepoLocator = deps.RepoLocator.SetDomainRepository(domainRepo)
+		deps.RepoLocator = deps.RepoLocator.SetServiceRepository(serviceRepo)
+		deps.RepoLocator = deps.RepoLocator.SetServiceBindingRepository(serviceBindingRepo)
+		deps.RepoLocator = deps.RepoLocator.SetUserProvidedServiceInstanceRepository(userProvidedRepo)
+		deps.RepoLocator = deps.RepoLocator.SetSecurityGroupRepository(securityGroupRepo)
+		deps.RepoLocator = deps.RepoLocator.SetSecurityGroupSpaceBinder(spaceBinder)
+		deps.RepoLocator = deps.RepoLocator.SetEnvironmentVariableGroupsRepository(envVarGroupRepo)
+		deps.ServiceBuilder = serviceBuilder
+		deps.RouteActor = routeActor
+		deps.ManifestRepo = manifest.NewDiskRepository()
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("import-space").SetDependency(deps, pluginCall))
+	}
+
+	writeBundleFile := func(name string, contents string) {
+		Expect(ioutil.WriteFile(filepath.Join(bundleDir, name), []byte(contents), 0644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ui = new(testterm.FakeUI)
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+		requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+		requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
+
+		appRepo = new(applicationsfakes.FakeRepository)
+		stackRepo = new(stacksfakes.FakeStackRepository)
+		domainRepo = new(apifakes.FakeDomainRepository)
+		serviceRepo = new(apifakes.FakeServiceRepository)
+		serviceBindingRepo = new(apifakes.FakeServiceBindingRepository)
+		userProvidedRepo = new(apifakes.FakeUserProvidedServiceInstanceRepository)
+		securityGroupRepo = new(securitygroupsfakes.FakeSecurityGroupRepo)
+		spaceBinder = new(sgbinderfakes.FakeSecurityGroupSpaceBinder)
+		envVarGroupRepo = new(environmentvariablegroupsfakes.FakeRepository)
+		serviceBuilder = new(servicebuilderfakes.FakeServiceBuilder)
+		routeActor = new(actorsfakes.FakeRouteActor)
+
+		var err error
+		bundleDir, err = ioutil.TempDir("", "space-bundle")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(bundleDir)).To(Succeed())
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("import-space", args, requirementsFactory, updateCommandDependency, false, ui)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not provided a bundle directory", func() {
+			runCommand()
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Requires BUNDLE_DIR as argument"},
+			))
+		})
+
+		It("fails when a space is not targeted", func() {
+			requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Failing{Message: "not targeting space"})
+			Expect(runCommand(bundleDir)).To(BeFalse())
+		})
+	})
+
+	Context("when the bundle has no space file", func() {
+		It("fails", func() {
+			Expect(runCommand(bundleDir)).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Error reading space bundle"},
+			))
+		})
+	})
+
+	Context("when the bundle is complete", func() {
+		BeforeEach(func() {
+			writeBundleFile("space.yml", `
+user_provided_services:
+- name: my-logs
+  syslog_drain_url: syslog://example.com
+services:
+- name: my-db
+  service: mysql
+  plan: small
+- name: my-cache
+  service: redis
+  plan: large
+routes:
+- host: my-app
+  domain: example.com
+  path: /api
+security_groups:
+- name: my-group
+  rules:
+  - destination: 10.0.0.0/8
+    protocol: tcp
+running_environment_variables:
+  HTTP_PROXY: proxy
+`)
+			writeBundleFile("manifest.yml", `
+applications:
+- name: my-app
+  memory: 128M
+  instances: 2
+  stack: cflinuxfs2
+  services:
+  - my-db
+  routes:
+  - route: my-app.example.com/api
+`)
+
+			securityGroupRepo.ReadStub = func(name string) (models.SecurityGroup, error) {
+				if securityGroupRepo.CreateCallCount() == 0 {
+					return models.SecurityGroup{}, errors.NewModelNotFoundError("security group", name)
+				}
+				return models.SecurityGroup{SecurityGroupFields: models.SecurityGroupFields{GUID: "group-guid"}}, nil
+			}
+
+			serviceRepo.FindInstanceByNameStub = func(name string) (models.ServiceInstance, error) {
+				if name == "my-cache" || (name == "my-db" && serviceRepo.CreateServiceInstanceCallCount() > 0) {
+					instance := models.ServiceInstance{}
+					instance.GUID = name + "-guid"
+					return instance, nil
+				}
+				return models.ServiceInstance{}, errors.NewModelNotFoundError("service instance", name)
+			}
+			serviceBuilder.GetServicesByNameForSpaceWithPlansReturns(models.ServiceOfferings{{
+				Plans: []models.ServicePlanFields{{Name: "medium", GUID: "medium-guid"}, {Name: "small", GUID: "small-guid"}},
+			}}, nil)
+
+			domainRepo.FindByNameInOrgReturns(models.DomainFields{GUID: "domain-guid", Name: "example.com"}, nil)
+
+			appRepo.ReadReturns(models.Application{}, errors.NewModelNotFoundError("app", "my-app"))
+			stackRepo.FindByNameReturns(models.Stack{GUID: "stack-guid"}, nil)
+			createdApp := models.Application{}
+			createdApp.GUID = "app-guid"
+			createdApp.Name = "my-app"
+			appRepo.CreateReturns(createdApp, nil)
+		})
+
+		It("recreates the space from the bundle", func() {
+			Expect(runCommand(bundleDir)).To(BeTrue())
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Importing space bundle", bundleDir, "my-org", "my-space", "my-user"},
+				[]string{"Creating security group", "my-group"},
+				[]string{"Binding security group", "my-group"},
+				[]string{"Creating user provided service", "my-logs"},
+				[]string{"Creating service instance", "my-db", "mysql", "small"},
+				[]string{"Service instance", "my-cache", "already exists"},
+				[]string{"Creating app", "my-app"},
+				[]string{"Binding service", "my-db", "my-app"},
+				[]string{"OK"},
+				[]string{"Credentials of user provided service instances were not exported"},
+			))
+
+			name, rules := securityGroupRepo.CreateArgsForCall(0)
+			Expect(name).To(Equal("my-group"))
+			Expect(rules).To(Equal([]map[string]interface{}{{"destination": "10.0.0.0/8", "protocol": "tcp"}}))
+			groupGUID, spaceGUID := spaceBinder.BindSpaceArgsForCall(0)
+			Expect(groupGUID).To(Equal("group-guid"))
+			Expect(spaceGUID).To(Equal("my-space-guid"))
+
+			upsName, drainURL, routeServiceURL, _ := userProvidedRepo.CreateArgsForCall(0)
+			Expect(upsName).To(Equal("my-logs"))
+			Expect(drainURL).To(Equal("syslog://example.com"))
+			Expect(routeServiceURL).To(BeEmpty())
+
+			Expect(serviceRepo.CreateServiceInstanceCallCount()).To(Equal(1))
+			serviceSpaceGUID, serviceLabel := serviceBuilder.GetServicesByNameForSpaceWithPlansArgsForCall(0)
+			Expect(serviceSpaceGUID).To(Equal("my-space-guid"))
+			Expect(serviceLabel).To(Equal("mysql"))
+			instanceName, planGUID, _, _ := serviceRepo.CreateServiceInstanceArgsForCall(0)
+			Expect(instanceName).To(Equal("my-db"))
+			Expect(planGUID).To(Equal("small-guid"))
+
+			domainName, orgGUID := domainRepo.FindByNameInOrgArgsForCall(0)
+			Expect(domainName).To(Equal("example.com"))
+			Expect(orgGUID).To(Equal("my-org-guid"))
+			host, domain, path, port, randomPort := routeActor.FindOrCreateRouteArgsForCall(0)
+			Expect(host).To(Equal("my-app"))
+			Expect(domain.GUID).To(Equal("domain-guid"))
+			Expect(path).To(Equal("/api"))
+			Expect(port).To(BeZero())
+			Expect(randomPort).To(BeFalse())
+
+			Expect(stackRepo.FindByNameArgsForCall(0)).To(Equal("cflinuxfs2"))
+			params := appRepo.CreateArgsForCall(0)
+			Expect(*params.Name).To(Equal("my-app"))
+			Expect(*params.Memory).To(Equal(int64(128)))
+			Expect(*params.StackGUID).To(Equal("stack-guid"))
+			Expect(*params.SpaceGUID).To(Equal("my-space-guid"))
+
+			routeName, app, _ := routeActor.FindAndBindRouteArgsForCall(0)
+			Expect(routeName).To(Equal("my-app.example.com/api"))
+			Expect(app.GUID).To(Equal("app-guid"))
+
+			instanceGUID, appGUID, _ := serviceBindingRepo.CreateArgsForCall(0)
+			Expect(instanceGUID).To(Equal("my-db-guid"))
+			Expect(appGUID).To(Equal("app-guid"))
+
+			Expect(envVarGroupRepo.SetRunningCallCount()).To(BeZero())
+			Expect(envVarGroupRepo.SetStagingCallCount()).To(BeZero())
+		})
+
+		Context("when the app already exists", func() {
+			BeforeEach(func() {
+				appRepo.ReadReturns(models.Application{}, nil)
+			})
+
+			It("leaves it alone", func() {
+				Expect(runCommand(bundleDir)).To(BeTrue())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"App", "my-app", "already exists"},
+				))
+				Expect(appRepo.CreateCallCount()).To(BeZero())
+				Expect(routeActor.FindAndBindRouteCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the plan does not exist", func() {
+			BeforeEach(func() {
+				serviceBuilder.GetServicesByNameForSpaceWithPlansReturns(models.ServiceOfferings{}, nil)
+			})
+
+			It("fails", func() {
+				Expect(runCommand(bundleDir)).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Could not find plan with name small"},
+				))
+				Expect(appRepo.CreateCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when --include-env-var-groups is passed", func() {
+			It("replaces the environment variable groups", func() {
+				Expect(runCommand("--include-env-var-groups", bundleDir)).To(BeTrue())
+				Expect(envVarGroupRepo.SetRunningArgsForCall(0)).To(MatchJSON(`{"HTTP_PROXY": "proxy"}`))
+				Expect(envVarGroupRepo.SetStagingArgsForCall(0)).To(MatchJSON(`{}`))
+			})
+		})
+	})
+})
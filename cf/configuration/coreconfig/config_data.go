@@ -39,6 +39,7 @@ type Data struct {
 	ColorEnabled             string
 	Locale                   string
 	PluginRepos              []models.PluginRepo
+	CurlTemplates            []models.CurlTemplate
 	MinCLIVersion            string
 	MinRecommendedCLIVersion string
 }
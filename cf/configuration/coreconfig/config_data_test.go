@@ -52,6 +52,17 @@ var _ = Describe("V3 Config files", func() {
 			"URL": "http://repo.com"
 		}
 		],
+		"CurlTemplates": [
+		{
+			"Name": "all-apps",
+			"Method": "GET",
+			"Path": "/v2/apps",
+			"Headers": ["Accept: application/json"],
+			"Body": "",
+			"AllPages": true,
+			"Select": ".resources[].entity.name"
+		}
+		],
 		"MinCLIVersion": "6.0.0",
 		"MinRecommendedCLIVersion": "6.9.0"
 	}`
@@ -147,6 +158,16 @@ var _ = Describe("V3 Config files", func() {
 						URL:  "http://repo.com",
 					},
 				},
+				CurlTemplates: []models.CurlTemplate{
+					{
+						Name:     "all-apps",
+						Method:   "GET",
+						Path:     "/v2/apps",
+						Headers:  []string{"Accept: application/json"},
+						AllPages: true,
+						Select:   ".resources[].entity.name",
+					},
+				},
 			}
 
 			jsonData, err := data.JSONMarshalV3()
@@ -198,6 +219,16 @@ var _ = Describe("V3 Config files", func() {
 						URL:  "http://repo.com",
 					},
 				},
+				CurlTemplates: []models.CurlTemplate{
+					{
+						Name:     "all-apps",
+						Method:   "GET",
+						Path:     "/v2/apps",
+						Headers:  []string{"Accept: application/json"},
+						AllPages: true,
+						Select:   ".resources[].entity.name",
+					},
+				},
 			}
 
 			actualData := coreconfig.NewData()
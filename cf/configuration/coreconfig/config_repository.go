@@ -2,6 +2,7 @@ package coreconfig
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/configuration"
 	"code.cloudfoundry.org/cli/cf/models"
@@ -9,6 +10,15 @@ import (
 	"github.com/blang/semver"
 )
 
+const (
+	// DefaultPollingInterval is the time between polls of an async operation.
+	DefaultPollingInterval = 5 * time.Second
+
+	// DefaultOverallPollingTimeout is the maximum time to wait for an async
+	// operation when no AsyncTimeout is configured.
+	DefaultOverallPollingTimeout = time.Duration(1<<63 - 1) // math.MaxInt64
+)
+
 type ConfigRepository struct {
 	CFCLIVersion string
 	data         *Data
@@ -87,6 +97,8 @@ type Reader interface {
 	CLIVersion() string
 
 	AsyncTimeout() uint
+	PollingInterval() time.Duration
+	OverallPollingTimeout() time.Duration
 	Trace() string
 
 	ColorEnabled() string
@@ -94,6 +106,8 @@ type Reader interface {
 	Locale() string
 
 	PluginRepos() []models.PluginRepo
+
+	CurlTemplates() []models.CurlTemplate
 }
 
 //go:generate counterfeiter . ReadWriter
@@ -123,6 +137,8 @@ type ReadWriter interface {
 	SetLocale(string)
 	SetPluginRepo(models.PluginRepo)
 	UnSetPluginRepo(int)
+	SetCurlTemplate(models.CurlTemplate)
+	UnSetCurlTemplate(string)
 	SetCLIVersion(string)
 }
 
@@ -393,6 +409,22 @@ func (c *ConfigRepository) AsyncTimeout() (timeout uint) {
 	return
 }
 
+// PollingInterval returns the time between polls of an async operation.
+func (c *ConfigRepository) PollingInterval() time.Duration {
+	return DefaultPollingInterval
+}
+
+// OverallPollingTimeout returns how long to wait for an async operation to
+// finish. It is AsyncTimeout minutes, or DefaultOverallPollingTimeout when no
+// timeout is configured.
+func (c *ConfigRepository) OverallPollingTimeout() time.Duration {
+	timeout := c.AsyncTimeout()
+	if timeout == 0 {
+		return DefaultOverallPollingTimeout
+	}
+	return time.Duration(timeout) * time.Minute
+}
+
 func (c *ConfigRepository) Trace() (trace string) {
 	c.read(func() {
 		trace = c.data.Trace
@@ -421,6 +453,13 @@ func (c *ConfigRepository) PluginRepos() (repos []models.PluginRepo) {
 	return
 }
 
+func (c *ConfigRepository) CurlTemplates() (templates []models.CurlTemplate) {
+	c.read(func() {
+		templates = c.data.CurlTemplates
+	})
+	return
+}
+
 // SETTERS
 
 func (c *ConfigRepository) ClearSession() {
@@ -563,3 +602,28 @@ func (c *ConfigRepository) UnSetPluginRepo(index int) {
 		c.data.PluginRepos = append(c.data.PluginRepos[:index], c.data.PluginRepos[index+1:]...)
 	})
 }
+
+// SetCurlTemplate saves the template, replacing any template with the same
+// name.
+func (c *ConfigRepository) SetCurlTemplate(template models.CurlTemplate) {
+	c.write(func() {
+		for i, existing := range c.data.CurlTemplates {
+			if existing.Name == template.Name {
+				c.data.CurlTemplates[i] = template
+				return
+			}
+		}
+		c.data.CurlTemplates = append(c.data.CurlTemplates, template)
+	})
+}
+
+func (c *ConfigRepository) UnSetCurlTemplate(name string) {
+	c.write(func() {
+		for i, existing := range c.data.CurlTemplates {
+			if existing.Name == name {
+				c.data.CurlTemplates = append(c.data.CurlTemplates[:i], c.data.CurlTemplates[i+1:]...)
+				return
+			}
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/configuration"
 	"code.cloudfoundry.org/cli/cf/configuration/configurationfakes"
@@ -110,6 +111,17 @@ var _ = Describe("Configuration Repository", func() {
 		Expect(config.PluginRepos()[0].Name).To(Equal("repo"))
 		Expect(config.PluginRepos()[0].URL).To(Equal("nowhere.com"))
 
+		config.SetCurlTemplate(models.CurlTemplate{Name: "apps", Path: "/v2/apps"})
+		config.SetCurlTemplate(models.CurlTemplate{Name: "orgs", Path: "/v2/orgs"})
+		config.SetCurlTemplate(models.CurlTemplate{Name: "apps", Path: "/v3/apps", AllPages: true})
+		Expect(config.CurlTemplates()).To(Equal([]models.CurlTemplate{
+			{Name: "apps", Path: "/v3/apps", AllPages: true},
+			{Name: "orgs", Path: "/v2/orgs"},
+		}))
+
+		config.UnSetCurlTemplate("apps")
+		Expect(config.CurlTemplates()).To(Equal([]models.CurlTemplate{{Name: "orgs", Path: "/v2/orgs"}}))
+
 		s, _ := semver.Make("3.1")
 		Expect(config.IsMinAPIVersion(s)).To(Equal(false))
 
@@ -197,6 +209,24 @@ var _ = Describe("Configuration Repository", func() {
 		})
 	})
 
+	Describe("OverallPollingTimeout", func() {
+		Context("when AsyncTimeout is set", func() {
+			BeforeEach(func() {
+				config.SetAsyncTimeout(3)
+			})
+
+			It("returns the timeout in minutes", func() {
+				Expect(config.OverallPollingTimeout()).To(Equal(3 * time.Minute))
+			})
+		})
+
+		Context("when AsyncTimeout is not set", func() {
+			It("returns the default timeout", func() {
+				Expect(config.OverallPollingTimeout()).To(Equal(coreconfig.DefaultOverallPollingTimeout))
+			})
+		})
+	})
+
 	Describe("NewRepositoryFromFilepath", func() {
 		var configPath string
 
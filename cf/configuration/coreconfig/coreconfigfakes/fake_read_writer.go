@@ -3,6 +3,7 @@ package coreconfigfakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
@@ -176,6 +177,18 @@ type FakeReadWriter struct {
 	asyncTimeoutReturns     struct {
 		result1 uint
 	}
+	PollingIntervalStub        func() time.Duration
+	pollingIntervalMutex       sync.RWMutex
+	pollingIntervalArgsForCall []struct{}
+	pollingIntervalReturns     struct {
+		result1 time.Duration
+	}
+	OverallPollingTimeoutStub        func() time.Duration
+	overallPollingTimeoutMutex       sync.RWMutex
+	overallPollingTimeoutArgsForCall []struct{}
+	overallPollingTimeoutReturns     struct {
+		result1 time.Duration
+	}
 	TraceStub        func() string
 	traceMutex       sync.RWMutex
 	traceArgsForCall []struct{}
@@ -200,6 +213,12 @@ type FakeReadWriter struct {
 	pluginReposReturns     struct {
 		result1 []models.PluginRepo
 	}
+	CurlTemplatesStub        func() []models.CurlTemplate
+	curlTemplatesMutex       sync.RWMutex
+	curlTemplatesArgsForCall []struct{}
+	curlTemplatesReturns     struct {
+		result1 []models.CurlTemplate
+	}
 	ClearSessionStub          func()
 	clearSessionMutex         sync.RWMutex
 	clearSessionArgsForCall   []struct{}
@@ -313,6 +332,16 @@ type FakeReadWriter struct {
 	unSetPluginRepoArgsForCall []struct {
 		arg1 int
 	}
+	SetCurlTemplateStub        func(arg1 models.CurlTemplate)
+	setCurlTemplateMutex       sync.RWMutex
+	setCurlTemplateArgsForCall []struct {
+		arg1 models.CurlTemplate
+	}
+	UnSetCurlTemplateStub        func(arg1 string)
+	unSetCurlTemplateMutex       sync.RWMutex
+	unSetCurlTemplateArgsForCall []struct {
+		arg1 string
+	}
 	SetCLIVersionStub        func(string)
 	setCLIVersionMutex       sync.RWMutex
 	setCLIVersionArgsForCall []struct {
@@ -1013,6 +1042,56 @@ func (fake *FakeReadWriter) AsyncTimeoutReturns(result1 uint) {
 	}{result1}
 }
 
+func (fake *FakeReadWriter) PollingInterval() time.Duration {
+	fake.pollingIntervalMutex.Lock()
+	fake.pollingIntervalArgsForCall = append(fake.pollingIntervalArgsForCall, struct{}{})
+	fake.recordInvocation("PollingInterval", []interface{}{})
+	fake.pollingIntervalMutex.Unlock()
+	if fake.PollingIntervalStub != nil {
+		return fake.PollingIntervalStub()
+	} else {
+		return fake.pollingIntervalReturns.result1
+	}
+}
+
+func (fake *FakeReadWriter) PollingIntervalCallCount() int {
+	fake.pollingIntervalMutex.RLock()
+	defer fake.pollingIntervalMutex.RUnlock()
+	return len(fake.pollingIntervalArgsForCall)
+}
+
+func (fake *FakeReadWriter) PollingIntervalReturns(result1 time.Duration) {
+	fake.PollingIntervalStub = nil
+	fake.pollingIntervalReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeReadWriter) OverallPollingTimeout() time.Duration {
+	fake.overallPollingTimeoutMutex.Lock()
+	fake.overallPollingTimeoutArgsForCall = append(fake.overallPollingTimeoutArgsForCall, struct{}{})
+	fake.recordInvocation("OverallPollingTimeout", []interface{}{})
+	fake.overallPollingTimeoutMutex.Unlock()
+	if fake.OverallPollingTimeoutStub != nil {
+		return fake.OverallPollingTimeoutStub()
+	} else {
+		return fake.overallPollingTimeoutReturns.result1
+	}
+}
+
+func (fake *FakeReadWriter) OverallPollingTimeoutCallCount() int {
+	fake.overallPollingTimeoutMutex.RLock()
+	defer fake.overallPollingTimeoutMutex.RUnlock()
+	return len(fake.overallPollingTimeoutArgsForCall)
+}
+
+func (fake *FakeReadWriter) OverallPollingTimeoutReturns(result1 time.Duration) {
+	fake.OverallPollingTimeoutStub = nil
+	fake.overallPollingTimeoutReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
 func (fake *FakeReadWriter) Trace() string {
 	fake.traceMutex.Lock()
 	fake.traceArgsForCall = append(fake.traceArgsForCall, struct{}{})
@@ -1113,6 +1192,31 @@ func (fake *FakeReadWriter) PluginReposReturns(result1 []models.PluginRepo) {
 	}{result1}
 }
 
+func (fake *FakeReadWriter) CurlTemplates() []models.CurlTemplate {
+	fake.curlTemplatesMutex.Lock()
+	fake.curlTemplatesArgsForCall = append(fake.curlTemplatesArgsForCall, struct{}{})
+	fake.recordInvocation("CurlTemplates", []interface{}{})
+	fake.curlTemplatesMutex.Unlock()
+	if fake.CurlTemplatesStub != nil {
+		return fake.CurlTemplatesStub()
+	} else {
+		return fake.curlTemplatesReturns.result1
+	}
+}
+
+func (fake *FakeReadWriter) CurlTemplatesCallCount() int {
+	fake.curlTemplatesMutex.RLock()
+	defer fake.curlTemplatesMutex.RUnlock()
+	return len(fake.curlTemplatesArgsForCall)
+}
+
+func (fake *FakeReadWriter) CurlTemplatesReturns(result1 []models.CurlTemplate) {
+	fake.CurlTemplatesStub = nil
+	fake.curlTemplatesReturns = struct {
+		result1 []models.CurlTemplate
+	}{result1}
+}
+
 func (fake *FakeReadWriter) ClearSession() {
 	fake.clearSessionMutex.Lock()
 	fake.clearSessionArgsForCall = append(fake.clearSessionArgsForCall, struct{}{})
@@ -1657,6 +1761,54 @@ func (fake *FakeReadWriter) UnSetPluginRepoArgsForCall(i int) int {
 	return fake.unSetPluginRepoArgsForCall[i].arg1
 }
 
+func (fake *FakeReadWriter) SetCurlTemplate(arg1 models.CurlTemplate) {
+	fake.setCurlTemplateMutex.Lock()
+	fake.setCurlTemplateArgsForCall = append(fake.setCurlTemplateArgsForCall, struct {
+		arg1 models.CurlTemplate
+	}{arg1})
+	fake.recordInvocation("SetCurlTemplate", []interface{}{arg1})
+	fake.setCurlTemplateMutex.Unlock()
+	if fake.SetCurlTemplateStub != nil {
+		fake.SetCurlTemplateStub(arg1)
+	}
+}
+
+func (fake *FakeReadWriter) SetCurlTemplateCallCount() int {
+	fake.setCurlTemplateMutex.RLock()
+	defer fake.setCurlTemplateMutex.RUnlock()
+	return len(fake.setCurlTemplateArgsForCall)
+}
+
+func (fake *FakeReadWriter) SetCurlTemplateArgsForCall(i int) models.CurlTemplate {
+	fake.setCurlTemplateMutex.RLock()
+	defer fake.setCurlTemplateMutex.RUnlock()
+	return fake.setCurlTemplateArgsForCall[i].arg1
+}
+
+func (fake *FakeReadWriter) UnSetCurlTemplate(arg1 string) {
+	fake.unSetCurlTemplateMutex.Lock()
+	fake.unSetCurlTemplateArgsForCall = append(fake.unSetCurlTemplateArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UnSetCurlTemplate", []interface{}{arg1})
+	fake.unSetCurlTemplateMutex.Unlock()
+	if fake.UnSetCurlTemplateStub != nil {
+		fake.UnSetCurlTemplateStub(arg1)
+	}
+}
+
+func (fake *FakeReadWriter) UnSetCurlTemplateCallCount() int {
+	fake.unSetCurlTemplateMutex.RLock()
+	defer fake.unSetCurlTemplateMutex.RUnlock()
+	return len(fake.unSetCurlTemplateArgsForCall)
+}
+
+func (fake *FakeReadWriter) UnSetCurlTemplateArgsForCall(i int) string {
+	fake.unSetCurlTemplateMutex.RLock()
+	defer fake.unSetCurlTemplateMutex.RUnlock()
+	return fake.unSetCurlTemplateArgsForCall[i].arg1
+}
+
 func (fake *FakeReadWriter) SetCLIVersion(arg1 string) {
 	fake.setCLIVersionMutex.Lock()
 	fake.setCLIVersionArgsForCall = append(fake.setCLIVersionArgsForCall, struct {
@@ -1738,6 +1890,10 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.cLIVersionMutex.RUnlock()
 	fake.asyncTimeoutMutex.RLock()
 	defer fake.asyncTimeoutMutex.RUnlock()
+	fake.pollingIntervalMutex.RLock()
+	defer fake.pollingIntervalMutex.RUnlock()
+	fake.overallPollingTimeoutMutex.RLock()
+	defer fake.overallPollingTimeoutMutex.RUnlock()
 	fake.traceMutex.RLock()
 	defer fake.traceMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
@@ -1746,6 +1902,8 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.localeMutex.RUnlock()
 	fake.pluginReposMutex.RLock()
 	defer fake.pluginReposMutex.RUnlock()
+	fake.curlTemplatesMutex.RLock()
+	defer fake.curlTemplatesMutex.RUnlock()
 	fake.clearSessionMutex.RLock()
 	defer fake.clearSessionMutex.RUnlock()
 	fake.setAPIEndpointMutex.RLock()
@@ -1792,6 +1950,10 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.setPluginRepoMutex.RUnlock()
 	fake.unSetPluginRepoMutex.RLock()
 	defer fake.unSetPluginRepoMutex.RUnlock()
+	fake.setCurlTemplateMutex.RLock()
+	defer fake.setCurlTemplateMutex.RUnlock()
+	fake.unSetCurlTemplateMutex.RLock()
+	defer fake.unSetCurlTemplateMutex.RUnlock()
 	fake.setCLIVersionMutex.RLock()
 	defer fake.setCLIVersionMutex.RUnlock()
 	return fake.invocations
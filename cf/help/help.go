@@ -160,6 +160,8 @@ func newAppPresenter() appPresenter {
 					presentCommand("disable-ssh"),
 					presentCommand("ssh-enabled"),
 					presentCommand("ssh"),
+					presentCommand("scp"),
+					presentCommand("ssh-replay"),
 				},
 			},
 		}, {
@@ -179,6 +181,7 @@ func newAppPresenter() appPresenter {
 					presentCommand("service-keys"),
 					presentCommand("service-key"),
 					presentCommand("delete-service-key"),
+					presentCommand("rotate-service-key"),
 				}, {
 					presentCommand("bind-service"),
 					presentCommand("unbind-service"),
@@ -200,6 +203,8 @@ func newAppPresenter() appPresenter {
 					presentCommand("create-org"),
 					presentCommand("delete-org"),
 					presentCommand("rename-org"),
+				}, {
+					presentCommand("audit-events"),
 				},
 			},
 		}, {
@@ -216,6 +221,9 @@ func newAppPresenter() appPresenter {
 					presentCommand("allow-space-ssh"),
 					presentCommand("disallow-space-ssh"),
 					presentCommand("space-ssh-allowed"),
+				}, {
+					presentCommand("export-space"),
+					presentCommand("import-space"),
 				},
 			},
 		}, {
@@ -312,6 +320,7 @@ func newAppPresenter() appPresenter {
 					presentCommand("delete-service-auth-token"),
 				}, {
 					presentCommand("service-brokers"),
+					presentCommand("service-broker-catalog"),
 					presentCommand("create-service-broker"),
 					presentCommand("update-service-broker"),
 					presentCommand("delete-service-broker"),
@@ -3,6 +3,7 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"code.cloudfoundry.org/cli/cf/models"
 
@@ -261,3 +262,85 @@ func (m *appManifest) addApplication(name string) {
 		},
 	})
 }
+
+// AddApplication records the current settings of app in m, the way
+// create-app-manifest writes them.
+func AddApplication(m App, app models.Application) error {
+	m.Memory(app.Name, app.Memory)
+	m.Instances(app.Name, app.InstanceCount)
+	if app.Stack != nil {
+		m.Stack(app.Name, app.Stack.Name)
+	}
+
+	if len(app.AppPorts) > 0 {
+		m.AppPorts(app.Name, app.AppPorts)
+	}
+
+	if app.Command != "" {
+		m.StartCommand(app.Name, app.Command)
+	}
+
+	if app.BuildpackURL != "" {
+		m.BuildpackURL(app.Name, app.BuildpackURL)
+	}
+
+	if len(app.Services) > 0 {
+		for _, service := range app.Services {
+			m.Service(app.Name, service.Name)
+		}
+	}
+
+	if app.HealthCheckTimeout > 0 {
+		m.HealthCheckTimeout(app.Name, app.HealthCheckTimeout)
+	}
+
+	if app.HealthCheckType != "port" {
+		m.HealthCheckType(app.Name, app.HealthCheckType)
+	}
+
+	if app.HealthCheckType == "http" &&
+		app.HealthCheckHTTPEndpoint != "" &&
+		app.HealthCheckHTTPEndpoint != "/" {
+		m.HealthCheckHTTPEndpoint(app.Name, app.HealthCheckHTTPEndpoint)
+	}
+
+	if len(app.EnvironmentVars) > 0 {
+		sorted := sortedEnvVarKeys(app.EnvironmentVars)
+		for _, envVarKey := range sorted {
+			switch app.EnvironmentVars[envVarKey].(type) {
+			default:
+				return errors.New(T("Failed to create manifest, unable to parse environment variable: ") + envVarKey)
+			case float64:
+				//json.Unmarshal turn all numbers to float64
+				value := int(app.EnvironmentVars[envVarKey].(float64))
+				m.EnvironmentVars(app.Name, envVarKey, fmt.Sprintf("%d", value))
+			case bool:
+				m.EnvironmentVars(app.Name, envVarKey, fmt.Sprintf("%t", app.EnvironmentVars[envVarKey].(bool)))
+			case string:
+				m.EnvironmentVars(app.Name, envVarKey, app.EnvironmentVars[envVarKey].(string))
+			}
+		}
+	}
+
+	if len(app.Routes) > 0 {
+		for i := 0; i < len(app.Routes); i++ {
+			m.Route(app.Name, app.Routes[i].Host, app.Routes[i].Domain.Name, app.Routes[i].Path, app.Routes[i].Port)
+		}
+	}
+
+	if app.DiskQuota != 0 {
+		m.DiskQuota(app.Name, app.DiskQuota)
+	}
+
+	return nil
+}
+
+func sortedEnvVarKeys(vars map[string]interface{}) []string {
+	var varsAry []string
+	for k := range vars {
+		varsAry = append(varsAry, k)
+	}
+	sort.Strings(varsAry)
+
+	return varsAry
+}
@@ -3,10 +3,16 @@ package models
 import "time"
 
 type EventFields struct {
-	GUID        string
-	Name        string
-	Timestamp   time.Time
-	Description string
-	Actor       string
-	ActorName   string
+	GUID             string
+	Name             string
+	Timestamp        time.Time
+	Description      string
+	Actor            string
+	ActorType        string
+	ActorName        string
+	Actee            string
+	ActeeType        string
+	ActeeName        string
+	SpaceGUID        string
+	OrganizationGUID string
 }
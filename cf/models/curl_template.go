@@ -0,0 +1,11 @@
+package models
+
+type CurlTemplate struct {
+	Name     string
+	Method   string
+	Path     string
+	Headers  []string
+	Body     string
+	AllPages bool
+	Select   string
+}
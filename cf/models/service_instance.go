@@ -25,6 +25,7 @@ type ServiceInstanceUpdateRequest struct {
 type ServiceInstanceFields struct {
 	GUID             string
 	Name             string
+	SpaceGUID        string
 	LastOperation    LastOperationFields
 	SysLogDrainURL   string
 	RouteServiceURL  string
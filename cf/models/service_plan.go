@@ -9,6 +9,23 @@ type ServicePlanFields struct {
 	Active              bool
 	ServiceOfferingGUID string
 	OrgNames            []string
+	DisplayName         string
+	Bullets             []string
+	Costs               []ServicePlanCost
+	Schemas             ServicePlanSchemas
+}
+
+type ServicePlanCost struct {
+	Amount map[string]float64
+	Unit   string
+}
+
+// ServicePlanSchemas holds the JSON schemas a broker publishes for the
+// parameters of a plan. A schema is nil when the broker does not publish it.
+type ServicePlanSchemas struct {
+	InstanceCreate map[string]interface{}
+	InstanceUpdate map[string]interface{}
+	BindingCreate  map[string]interface{}
 }
 
 type ServicePlan struct {
@@ -3,6 +3,7 @@ package net
 import (
 	"io"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -10,10 +11,22 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
+const progressBarWidth = 20
+
+// ProgressDirection is the direction of the transfer a ProgressReader reports
+// on. It defaults to ProgressUpload.
+type ProgressDirection int
+
+const (
+	ProgressUpload ProgressDirection = iota
+	ProgressDownload
+)
+
 type ProgressReader struct {
 	ioReadSeeker   io.ReadSeeker
 	bytesRead      int64
 	total          int64
+	direction      ProgressDirection
 	quit           chan bool
 	ui             terminal.UI
 	outputInterval time.Duration
@@ -64,22 +77,49 @@ func (progressReader *ProgressReader) Seek(offset int64, whence int) (int64, err
 func (progressReader *ProgressReader) printProgress(quit chan bool) {
 	timer := time.NewTicker(progressReader.outputInterval)
 
+	verb, done := "uploaded", "Done uploading"
+	if progressReader.direction == ProgressDownload {
+		verb, done = "downloaded", "Done downloading"
+	}
+
 	for {
 		select {
 		case <-quit:
 			//The spaces are there to ensure we overwrite the entire line
 			//before using the terminal printer to output Done Uploading
-			progressReader.ui.PrintCapturingNoOutput("\r                             ")
-			progressReader.ui.Say("\rDone uploading")
+			progressReader.ui.PrintCapturingNoOutput("\r%s", strings.Repeat(" ", 64))
+			progressReader.ui.Say("\r" + done)
 			return
 		case <-timer.C:
 			progressReader.mutex.RLock()
-			progressReader.ui.PrintCapturingNoOutput("\r%s uploaded...", formatters.ByteSize(progressReader.bytesRead))
+			progressReader.ui.PrintCapturingNoOutput(
+				"\r%s %3d%% %s of %s %s...",
+				progressBar(progressReader.bytesRead, progressReader.total),
+				progressReader.bytesRead*100/progressReader.total,
+				formatters.ByteSize(progressReader.bytesRead),
+				formatters.ByteSize(progressReader.total),
+				verb,
+			)
 			progressReader.mutex.RUnlock()
 		}
 	}
 }
 
+// progressBar renders read out of total as a fixed width bar, e.g.
+// [=========>          ].
+func progressBar(read int64, total int64) string {
+	filled := int(read * progressBarWidth / total)
+	bar := strings.Repeat("=", filled)
+	if filled < progressBarWidth {
+		bar += ">" + strings.Repeat(" ", progressBarWidth-filled-1)
+	}
+	return "[" + bar + "]"
+}
+
 func (progressReader *ProgressReader) SetTotalSize(size int64) {
 	progressReader.total = size
 }
+
+func (progressReader *ProgressReader) SetDirection(direction ProgressDirection) {
+	progressReader.direction = direction
+}
@@ -1,6 +1,7 @@
 package net_test
 
 import (
+	"fmt"
 	"os"
 	"time"
 
@@ -44,13 +45,36 @@ var _ = Describe("ProgressReader", func() {
 		}
 
 		Expect(ui.SayCallCount()).To(Equal(1))
-		Expect(ui.SayArgsForCall(0)).To(ContainSubstring("\rDone "))
+		Expect(ui.SayArgsForCall(0)).To(Equal("\rDone uploading"))
 
 		Expect(ui.PrintCapturingNoOutputCallCount()).To(BeNumerically(">", 0))
-		status, _ := ui.PrintCapturingNoOutputArgsForCall(0)
-		Expect(status).To(ContainSubstring("uploaded..."))
-		status, _ = ui.PrintCapturingNoOutputArgsForCall(ui.PrintCapturingNoOutputCallCount() - 1)
-		Expect(status).To(Equal("\r                             "))
+		status, args := ui.PrintCapturingNoOutputArgsForCall(0)
+		Expect(fmt.Sprintf(status, args...)).To(MatchRegexp(`^\r\[=*>? *\] +\d+% \S+ of \S+ uploaded\.\.\.$`))
+		status, args = ui.PrintCapturingNoOutputArgsForCall(ui.PrintCapturingNoOutputCallCount() - 1)
+		Expect(fmt.Sprintf(status, args...)).To(MatchRegexp(`^\r +$`))
+	})
+
+	Context("when the direction is download", func() {
+		BeforeEach(func() {
+			progressReader.SetDirection(ProgressDownload)
+		})
+
+		It("reports the bytes downloaded", func() {
+			for {
+				time.Sleep(50 * time.Microsecond)
+				_, err := progressReader.Read(b)
+				if err != nil {
+					break
+				}
+			}
+
+			Expect(ui.SayCallCount()).To(Equal(1))
+			Expect(ui.SayArgsForCall(0)).To(Equal("\rDone downloading"))
+
+			Expect(ui.PrintCapturingNoOutputCallCount()).To(BeNumerically(">", 0))
+			status, args := ui.PrintCapturingNoOutputArgsForCall(0)
+			Expect(fmt.Sprintf(status, args...)).To(MatchRegexp(`^\r\[=*>? *\] +\d+% \S+ of \S+ downloaded\.\.\.$`))
+		})
 	})
 
 	It("reads the correct number of bytes", func() {
@@ -13,17 +13,29 @@ var _ = Describe("BuildpackRequirement", func() {
 		buildpack := models.Buildpack{Name: "my-buildpack"}
 		buildpackRepo := &apifakes.OldFakeBuildpackRepository{FindByNameBuildpack: buildpack}
 
-		buildpackReq := NewBuildpackRequirement("my-buildpack", buildpackRepo)
+		buildpackReq := NewBuildpackRequirement("my-buildpack", "", buildpackRepo)
 
 		Expect(buildpackReq.Execute()).NotTo(HaveOccurred())
 		Expect(buildpackRepo.FindByNameName).To(Equal("my-buildpack"))
 		Expect(buildpackReq.GetBuildpack()).To(Equal(buildpack))
 	})
 
+	It("finds the buildpack by name and stack when a stack is given", func() {
+		buildpack := models.Buildpack{Name: "my-buildpack", Stack: "my-stack"}
+		buildpackRepo := &apifakes.OldFakeBuildpackRepository{FindByNameBuildpack: buildpack}
+
+		buildpackReq := NewBuildpackRequirement("my-buildpack", "my-stack", buildpackRepo)
+
+		Expect(buildpackReq.Execute()).NotTo(HaveOccurred())
+		Expect(buildpackRepo.FindByNameName).To(Equal("my-buildpack"))
+		Expect(buildpackRepo.FindByNameAndStackStack).To(Equal("my-stack"))
+		Expect(buildpackReq.GetBuildpack()).To(Equal(buildpack))
+	})
+
 	It("fails when the buildpack cannot be found", func() {
 		buildpackRepo := &apifakes.OldFakeBuildpackRepository{FindByNameNotFound: true}
 
-		err := NewBuildpackRequirement("foo", buildpackRepo).Execute()
+		err := NewBuildpackRequirement("foo", "", buildpackRepo).Execute()
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring("Buildpack foo not found"))
 	})
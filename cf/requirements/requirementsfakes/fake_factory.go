@@ -98,10 +98,11 @@ type FakeFactory struct {
 	newUserRequirementReturns struct {
 		result1 requirements.UserRequirement
 	}
-	NewBuildpackRequirementStub        func(buildpack string) requirements.BuildpackRequirement
+	NewBuildpackRequirementStub        func(buildpack, stack string) requirements.BuildpackRequirement
 	newBuildpackRequirementMutex       sync.RWMutex
 	newBuildpackRequirementArgsForCall []struct {
 		buildpack string
+		stack     string
 	}
 	newBuildpackRequirementReturns struct {
 		result1 requirements.BuildpackRequirement
@@ -518,15 +519,16 @@ func (fake *FakeFactory) NewUserRequirementReturns(result1 requirements.UserRequ
 	}{result1}
 }
 
-func (fake *FakeFactory) NewBuildpackRequirement(buildpack string) requirements.BuildpackRequirement {
+func (fake *FakeFactory) NewBuildpackRequirement(buildpack string, stack string) requirements.BuildpackRequirement {
 	fake.newBuildpackRequirementMutex.Lock()
 	fake.newBuildpackRequirementArgsForCall = append(fake.newBuildpackRequirementArgsForCall, struct {
 		buildpack string
-	}{buildpack})
-	fake.recordInvocation("NewBuildpackRequirement", []interface{}{buildpack})
+		stack     string
+	}{buildpack, stack})
+	fake.recordInvocation("NewBuildpackRequirement", []interface{}{buildpack, stack})
 	fake.newBuildpackRequirementMutex.Unlock()
 	if fake.NewBuildpackRequirementStub != nil {
-		return fake.NewBuildpackRequirementStub(buildpack)
+		return fake.NewBuildpackRequirementStub(buildpack, stack)
 	} else {
 		return fake.newBuildpackRequirementReturns.result1
 	}
@@ -538,10 +540,10 @@ func (fake *FakeFactory) NewBuildpackRequirementCallCount() int {
 	return len(fake.newBuildpackRequirementArgsForCall)
 }
 
-func (fake *FakeFactory) NewBuildpackRequirementArgsForCall(i int) string {
+func (fake *FakeFactory) NewBuildpackRequirementArgsForCall(i int) (string, string) {
 	fake.newBuildpackRequirementMutex.RLock()
 	defer fake.newBuildpackRequirementMutex.RUnlock()
-	return fake.newBuildpackRequirementArgsForCall[i].buildpack
+	return fake.newBuildpackRequirementArgsForCall[i].buildpack, fake.newBuildpackRequirementArgsForCall[i].stack
 }
 
 func (fake *FakeFactory) NewBuildpackRequirementReturns(result1 requirements.BuildpackRequirement) {
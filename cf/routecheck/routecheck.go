@@ -0,0 +1,134 @@
+package routecheck
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+)
+
+//go:generate counterfeiter . Checker
+
+// Checker makes a request against a route and reports how it responded.
+type Checker interface {
+	Check(url string, timeout time.Duration) Result
+}
+
+// Result is the outcome of requesting a route. CertificateExpiry is only set
+// when the route was reached over HTTPS. Err is set when no response was
+// received, in which case StatusCode is 0.
+type Result struct {
+	URL               string
+	StatusCode        int
+	Latency           time.Duration
+	CertificateExpiry time.Time
+	Err               error
+}
+
+type httpChecker struct {
+	transport *http.Transport
+}
+
+func NewChecker(skipSSLValidation bool) Checker {
+	return httpChecker{
+		transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: skipSSLValidation},
+		},
+	}
+}
+
+// Check requests https://url, falling back to http://url when the route
+// refuses the connection or does not speak TLS. Other HTTPS errors, such as
+// an invalid certificate, are reported. Redirects are not followed so that
+// the route's own response is reported.
+func (checker httpChecker) Check(url string, timeout time.Duration) Result {
+	client := &http.Client{
+		Transport: checker.transport,
+		Timeout:   timeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	result := checker.request(client, "https://"+url)
+	if shouldFallBackToHTTP(result.Err) {
+		result = checker.request(client, "http://"+url)
+	}
+	return result
+}
+
+// shouldFallBackToHTTP returns true when the HTTPS request failed because the
+// connection was refused or because the route answered the TLS handshake
+// with something other than TLS.
+func shouldFallBackToHTTP(err error) bool {
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+
+	// net/http replaces the tls.RecordHeaderError with this error when the
+	// route answers with plain HTTP.
+	if err != nil && err.Error() == "http: server gave HTTP response to HTTPS client" {
+		return true
+	}
+
+	switch e := err.(type) {
+	case tls.RecordHeaderError:
+		return true
+	case *net.OpError:
+		sysErr, ok := e.Err.(*os.SyscallError)
+		return ok && sysErr.Err == syscall.ECONNREFUSED
+	default:
+		return false
+	}
+}
+
+func (checker httpChecker) request(client *http.Client, url string) Result {
+	result := Result{URL: url}
+
+	start := time.Now()
+	response, err := client.Get(url)
+	result.Latency = time.Since(start)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	defer response.Body.Close()
+
+	result.StatusCode = response.StatusCode
+	if response.TLS != nil && len(response.TLS.PeerCertificates) > 0 {
+		result.CertificateExpiry = response.TLS.PeerCertificates[0].NotAfter
+	}
+	return result
+}
+
+// CheckAll checks every url, at most concurrency at a time. Results are
+// returned in the same order as urls.
+func CheckAll(checker Checker, urls []string, timeout time.Duration, concurrency int) []Result {
+	results := make([]Result, len(urls))
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	semaphore := make(chan struct{}, concurrency)
+
+	wg := &sync.WaitGroup{}
+	for i, url := range urls {
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(i int, url string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			results[i] = checker.Check(url, timeout)
+		}(i, url)
+	}
+	wg.Wait()
+
+	return results
+}
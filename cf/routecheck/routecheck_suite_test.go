@@ -0,0 +1,13 @@
+package routecheck_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestRoutecheck(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Routecheck Suite")
+}
@@ -0,0 +1,183 @@
+package routecheck_test
+
+import (
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/routecheck"
+	"code.cloudfoundry.org/cli/cf/routecheck/routecheckfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Checker", func() {
+	var checker routecheck.Checker
+
+	BeforeEach(func() {
+		checker = routecheck.NewChecker(true)
+	})
+
+	Context("when the route serves HTTPS", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/health"))
+				w.WriteHeader(http.StatusAccepted)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("reports the status code, latency and certificate expiry", func() {
+			host := strings.TrimPrefix(server.URL, "https://")
+			result := checker.Check(host+"/health", time.Second)
+
+			Expect(result.Err).ToNot(HaveOccurred())
+			Expect(result.URL).To(Equal(server.URL + "/health"))
+			Expect(result.StatusCode).To(Equal(http.StatusAccepted))
+			Expect(result.Latency).To(BeNumerically(">", 0))
+			Expect(result.CertificateExpiry).To(Equal(server.Certificate().NotAfter))
+		})
+	})
+
+	Context("when the route's certificate is not trusted", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			checker = routecheck.NewChecker(false)
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+			server.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("reports the certificate error without falling back to HTTP", func() {
+			host := strings.TrimPrefix(server.URL, "https://")
+			result := checker.Check(host, time.Second)
+
+			Expect(result.Err).To(MatchError(ContainSubstring("x509")))
+			Expect(result.URL).To(Equal(server.URL))
+			Expect(result.StatusCode).To(Equal(0))
+		})
+	})
+
+	Context("when the route refuses the connection", func() {
+		var host string
+
+		BeforeEach(func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+			host = listener.Addr().String()
+			Expect(listener.Close()).To(Succeed())
+		})
+
+		It("falls back to HTTP and reports its error", func() {
+			result := checker.Check(host, time.Second)
+
+			Expect(result.Err).To(HaveOccurred())
+			Expect(result.URL).To(Equal("http://" + host))
+		})
+	})
+
+	Context("when the route only serves HTTP", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "/elsewhere", http.StatusFound)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("falls back to HTTP and does not follow redirects", func() {
+			host := strings.TrimPrefix(server.URL, "http://")
+			result := checker.Check(host, time.Second)
+
+			Expect(result.Err).ToNot(HaveOccurred())
+			Expect(result.URL).To(Equal(server.URL))
+			Expect(result.StatusCode).To(Equal(http.StatusFound))
+			Expect(result.CertificateExpiry.IsZero()).To(BeTrue())
+		})
+	})
+
+	Context("when the route does not respond in time", func() {
+		var (
+			server  *httptest.Server
+			release chan struct{}
+		)
+
+		BeforeEach(func() {
+			release = make(chan struct{})
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				<-release
+			}))
+		})
+
+		AfterEach(func() {
+			close(release)
+			server.Close()
+		})
+
+		It("returns an error", func() {
+			host := strings.TrimPrefix(server.URL, "http://")
+			result := checker.Check(host, 50*time.Millisecond)
+
+			Expect(result.Err).To(HaveOccurred())
+			Expect(result.StatusCode).To(Equal(0))
+		})
+	})
+})
+
+var _ = Describe("CheckAll", func() {
+	It("checks every url concurrently and returns the results in order", func() {
+		checker := new(routecheckfakes.FakeChecker)
+
+		var (
+			mutex   sync.Mutex
+			running int
+			maximum int
+		)
+		checker.CheckStub = func(url string, timeout time.Duration) routecheck.Result {
+			mutex.Lock()
+			running++
+			if running > maximum {
+				maximum = running
+			}
+			mutex.Unlock()
+
+			time.Sleep(20 * time.Millisecond)
+
+			mutex.Lock()
+			running--
+			mutex.Unlock()
+
+			return routecheck.Result{URL: "https://" + url, StatusCode: 200}
+		}
+
+		results := routecheck.CheckAll(checker, []string{"a.example.com", "b.example.com", "c.example.com"}, 3*time.Second, 2)
+
+		Expect(results).To(HaveLen(3))
+		Expect(results[0].URL).To(Equal("https://a.example.com"))
+		Expect(results[1].URL).To(Equal("https://b.example.com"))
+		Expect(results[2].URL).To(Equal("https://c.example.com"))
+		Expect(maximum).To(Equal(2))
+
+		_, timeout := checker.CheckArgsForCall(0)
+		Expect(timeout).To(Equal(3 * time.Second))
+	})
+})
@@ -0,0 +1,97 @@
+// This file was generated by counterfeiter
+package routecheckfakes
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/routecheck"
+)
+
+type FakeChecker struct {
+	CheckStub        func(url string, timeout time.Duration) routecheck.Result
+	checkMutex       sync.RWMutex
+	checkArgsForCall []struct {
+		url     string
+		timeout time.Duration
+	}
+	checkReturns struct {
+		result1 routecheck.Result
+	}
+	checkReturnsOnCall map[int]struct {
+		result1 routecheck.Result
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeChecker) Check(url string, timeout time.Duration) routecheck.Result {
+	fake.checkMutex.Lock()
+	ret, specificReturn := fake.checkReturnsOnCall[len(fake.checkArgsForCall)]
+	fake.checkArgsForCall = append(fake.checkArgsForCall, struct {
+		url     string
+		timeout time.Duration
+	}{url, timeout})
+	fake.recordInvocation("Check", []interface{}{url, timeout})
+	fake.checkMutex.Unlock()
+	if fake.CheckStub != nil {
+		return fake.CheckStub(url, timeout)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.checkReturns.result1
+}
+
+func (fake *FakeChecker) CheckCallCount() int {
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	return len(fake.checkArgsForCall)
+}
+
+func (fake *FakeChecker) CheckArgsForCall(i int) (string, time.Duration) {
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	return fake.checkArgsForCall[i].url, fake.checkArgsForCall[i].timeout
+}
+
+func (fake *FakeChecker) CheckReturns(result1 routecheck.Result) {
+	fake.CheckStub = nil
+	fake.checkReturns = struct {
+		result1 routecheck.Result
+	}{result1}
+}
+
+func (fake *FakeChecker) CheckReturnsOnCall(i int, result1 routecheck.Result) {
+	fake.CheckStub = nil
+	if fake.checkReturnsOnCall == nil {
+		fake.checkReturnsOnCall = make(map[int]struct {
+			result1 routecheck.Result
+		})
+	}
+	fake.checkReturnsOnCall[i] = struct {
+		result1 routecheck.Result
+	}{result1}
+}
+
+func (fake *FakeChecker) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.checkMutex.RLock()
+	defer fake.checkMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeChecker) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ routecheck.Checker = new(FakeChecker)
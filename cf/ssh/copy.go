@@ -0,0 +1,223 @@
+package sshCmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"github.com/pkg/sftp"
+)
+
+// ProgressWrapper wraps the source of a single file transfer so that the
+// caller can report progress. size is the total number of bytes that will be
+// read from source.
+type ProgressWrapper func(source io.ReadSeeker, size int64) io.Reader
+
+func (c *secureShell) Copy(copyOpts *options.SCPOptions, progress ProgressWrapper) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	inPipe, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.RequestSubsystem("sftp")
+	if err != nil {
+		return fmt.Errorf("SFTP subsystem request failed: %s", err.Error())
+	}
+
+	client, err := sftp.NewClientPipe(outPipe, inPipe)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	copier := &fileCopier{
+		client:    client,
+		progress:  progress,
+		recursive: copyOpts.Recursive,
+	}
+
+	if copyOpts.Direction == options.CopyFromContainer {
+		return copier.download(copyOpts.RemotePath, copyOpts.LocalPath)
+	}
+	return copier.upload(copyOpts.LocalPath, copyOpts.RemotePath)
+}
+
+type fileCopier struct {
+	client    *sftp.Client
+	progress  ProgressWrapper
+	recursive bool
+}
+
+func (f *fileCopier) download(remotePath string, localPath string) error {
+	remoteInfo, err := f.client.Stat(remotePath)
+	if err != nil {
+		return fmt.Errorf("%s: %s", remotePath, err.Error())
+	}
+
+	if localInfo, statErr := os.Stat(localPath); statErr == nil && localInfo.IsDir() {
+		localPath = filepath.Join(localPath, path.Base(remotePath))
+	}
+
+	if remoteInfo.IsDir() {
+		if !f.recursive {
+			return fmt.Errorf("%s: is a directory (use -r to copy directories)", remotePath)
+		}
+		return f.downloadDirectory(remotePath, localPath, remoteInfo.Mode())
+	}
+
+	return f.downloadFile(remotePath, localPath, remoteInfo)
+}
+
+func (f *fileCopier) downloadDirectory(remotePath string, localPath string, mode os.FileMode) error {
+	err := os.MkdirAll(localPath, mode.Perm()|0700)
+	if err != nil {
+		return err
+	}
+
+	entries, err := f.client.ReadDir(remotePath)
+	if err != nil {
+		return fmt.Errorf("%s: %s", remotePath, err.Error())
+	}
+
+	for _, entry := range entries {
+		remoteEntry := path.Join(remotePath, entry.Name())
+		localEntry := filepath.Join(localPath, entry.Name())
+
+		switch {
+		case entry.IsDir():
+			err = f.downloadDirectory(remoteEntry, localEntry, entry.Mode())
+		case entry.Mode().IsRegular():
+			err = f.downloadFile(remoteEntry, localEntry, entry)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *fileCopier) downloadFile(remotePath string, localPath string, remoteInfo os.FileInfo) error {
+	source, err := f.client.Open(remotePath)
+	if err != nil {
+		return fmt.Errorf("%s: %s", remotePath, err.Error())
+	}
+	defer source.Close()
+
+	destination, err := os.OpenFile(localPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, remoteInfo.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer destination.Close()
+
+	_, err = io.Copy(destination, f.wrap(source, remoteInfo.Size()))
+	return err
+}
+
+func (f *fileCopier) upload(localPath string, remotePath string) error {
+	localInfo, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+
+	if remoteInfo, statErr := f.client.Stat(remotePath); statErr == nil && remoteInfo.IsDir() {
+		remotePath = path.Join(remotePath, filepath.Base(localPath))
+	}
+
+	if localInfo.IsDir() {
+		if !f.recursive {
+			return fmt.Errorf("%s: is a directory (use -r to copy directories)", localPath)
+		}
+		return f.uploadDirectory(localPath, remotePath, localInfo.Mode())
+	}
+
+	return f.uploadFile(localPath, remotePath, localInfo)
+}
+
+func (f *fileCopier) uploadDirectory(localPath string, remotePath string, mode os.FileMode) error {
+	if _, err := f.client.Stat(remotePath); err != nil {
+		err = f.client.Mkdir(remotePath)
+		if err != nil {
+			return fmt.Errorf("%s: %s", remotePath, err.Error())
+		}
+		_ = f.client.Chmod(remotePath, mode.Perm())
+	}
+
+	entries, err := readLocalDir(localPath)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		localEntry := filepath.Join(localPath, entry.Name())
+		remoteEntry := path.Join(remotePath, entry.Name())
+
+		switch {
+		case entry.IsDir():
+			err = f.uploadDirectory(localEntry, remoteEntry, entry.Mode())
+		case entry.Mode().IsRegular():
+			err = f.uploadFile(localEntry, remoteEntry, entry)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *fileCopier) uploadFile(localPath string, remotePath string, localInfo os.FileInfo) error {
+	source, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	destination, err := f.client.Create(remotePath)
+	if err != nil {
+		return fmt.Errorf("%s: %s", remotePath, err.Error())
+	}
+	defer destination.Close()
+
+	_, err = io.Copy(destination, f.wrap(source, localInfo.Size()))
+	if err != nil {
+		return err
+	}
+
+	return destination.Chmod(localInfo.Mode().Perm())
+}
+
+func (f *fileCopier) wrap(source io.ReadSeeker, size int64) io.Reader {
+	if f.progress == nil {
+		return source
+	}
+	return f.progress(source, size)
+}
+
+func readLocalDir(dirPath string) ([]os.FileInfo, error) {
+	dir, err := os.Open(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	defer dir.Close()
+
+	return dir.Readdir(-1)
+}
@@ -0,0 +1,125 @@
+package sshCmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"code.cloudfoundry.org/cli/cf/configuration/confighelpers"
+)
+
+const sha256FingerprintPrefix = "SHA256:"
+
+//go:generate counterfeiter . KnownHosts
+
+// KnownHosts stores the host key fingerprints that have been trusted for the
+// SSH endpoints of a single Cloud Controller API endpoint.
+//
+// Pin returns the fingerprint pinned for the SSH endpoint. When none is
+// pinned yet, it pins the fingerprint returned by trust; an error from trust
+// is returned as is and nothing is pinned. Concurrent connections sharing a
+// KnownHosts call trust at most once per endpoint.
+type KnownHosts interface {
+	Pin(sshEndpoint string, trust func() (string, error)) (string, error)
+}
+
+//go:generate counterfeiter . HostKeyPrompter
+
+type HostKeyPrompter interface {
+	Warn(message string, args ...interface{})
+	Confirm(message string) bool
+}
+
+// knownHostsFile keeps one "API_ENDPOINT SSH_ENDPOINT FINGERPRINT" entry per
+// line, where FINGERPRINT is either SHA256:<base64>, or a colon separated MD5
+// or SHA1 hex fingerprint as reported by the Cloud Controller.
+type knownHostsFile struct {
+	path        string
+	apiEndpoint string
+	mutex       *sync.Mutex
+}
+
+func NewKnownHostsFile(path string, apiEndpoint string) KnownHosts {
+	return &knownHostsFile{
+		path:        path,
+		apiEndpoint: apiEndpoint,
+		mutex:       &sync.Mutex{},
+	}
+}
+
+func DefaultKnownHostsPath() (string, error) {
+	configPath, err := confighelpers.DefaultFilePath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(filepath.Dir(configPath), "known_hosts"), nil
+}
+
+func (k *knownHostsFile) Pin(sshEndpoint string, trust func() (string, error)) (string, error) {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	pinned, found, err := k.lookup(sshEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("Unable to read known hosts: %s", err.Error())
+	}
+	if found {
+		return pinned, nil
+	}
+
+	fingerprint, err := trust()
+	if err != nil {
+		return "", err
+	}
+
+	err = k.add(sshEndpoint, fingerprint)
+	if err != nil {
+		return "", fmt.Errorf("Unable to update known hosts: %s", err.Error())
+	}
+	return fingerprint, nil
+}
+
+func (k *knownHostsFile) lookup(sshEndpoint string) (string, bool, error) {
+	file, err := os.Open(k.path)
+	if os.IsNotExist(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		if fields[0] == k.apiEndpoint && fields[1] == sshEndpoint {
+			return fields[2], true, nil
+		}
+	}
+
+	return "", false, scanner.Err()
+}
+
+func (k *knownHostsFile) add(sshEndpoint string, fingerprint string) error {
+	err := os.MkdirAll(filepath.Dir(k.path), 0700)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(k.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "%s %s %s\n", k.apiEndpoint, sshEndpoint, fingerprint)
+	return err
+}
@@ -0,0 +1,156 @@
+package sshCmd_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"code.cloudfoundry.org/cli/cf/ssh"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("KnownHosts", func() {
+	var (
+		tempDir    string
+		path       string
+		knownHosts sshCmd.KnownHosts
+
+		trustCallCount int
+		trust          func() (string, error)
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "known-hosts")
+		Expect(err).NotTo(HaveOccurred())
+
+		path = filepath.Join(tempDir, ".cf", "known_hosts")
+		knownHosts = sshCmd.NewKnownHostsFile(path, "https://api.example.com")
+
+		trustCallCount = 0
+		trust = func() (string, error) {
+			trustCallCount++
+			return "SHA256:abc", nil
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	Describe("Pin", func() {
+		Context("when the file does not exist", func() {
+			It("trusts the host and creates the file with the entry", func() {
+				pinned, err := knownHosts.Pin("ssh.example.com:2222", trust)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pinned).To(Equal("SHA256:abc"))
+				Expect(trustCallCount).To(Equal(1))
+
+				contents, err := ioutil.ReadFile(path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("https://api.example.com ssh.example.com:2222 SHA256:abc\n"))
+			})
+
+			It("creates the file readable only by the user", func() {
+				_, err := knownHosts.Pin("ssh.example.com:2222", trust)
+				Expect(err).NotTo(HaveOccurred())
+
+				info, err := os.Stat(path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+			})
+		})
+
+		Context("when the file has entries", func() {
+			BeforeEach(func() {
+				err := os.MkdirAll(filepath.Dir(path), 0700)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = ioutil.WriteFile(path, []byte(`# comment
+https://api.other.com ssh.example.com:2222 SHA256:other
+https://api.example.com ssh.example.com:2222 SHA256:sp/jrLuj66r+yrLDUKZdJU5tdzt4mq/UaSiNBjpgr+8
+malformed line
+`), 0600)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns the fingerprint pinned for the API endpoint without trusting the host again", func() {
+				pinned, err := knownHosts.Pin("ssh.example.com:2222", trust)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pinned).To(Equal("SHA256:sp/jrLuj66r+yrLDUKZdJU5tdzt4mq/UaSiNBjpgr+8"))
+				Expect(trustCallCount).To(Equal(0))
+			})
+
+			It("appends an entry for other SSH endpoints", func() {
+				pinned, err := knownHosts.Pin("ssh.example.com:22", trust)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pinned).To(Equal("SHA256:abc"))
+				Expect(trustCallCount).To(Equal(1))
+
+				contents, err := ioutil.ReadFile(path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(HaveSuffix("malformed line\nhttps://api.example.com ssh.example.com:22 SHA256:abc\n"))
+			})
+		})
+
+		Context("when the host is not trusted", func() {
+			It("returns the error and pins nothing", func() {
+				_, err := knownHosts.Pin("ssh.example.com:2222", func() (string, error) {
+					return "", errors.New("Host key verification failed.")
+				})
+				Expect(err).To(MatchError("Host key verification failed."))
+
+				_, err = os.Stat(path)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when the file cannot be read", func() {
+			BeforeEach(func() {
+				err := os.MkdirAll(path, 0700)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error without trusting the host", func() {
+				_, err := knownHosts.Pin("ssh.example.com:2222", trust)
+				Expect(err).To(MatchError(HavePrefix("Unable to read known hosts: ")))
+				Expect(trustCallCount).To(Equal(0))
+			})
+		})
+
+		Context("when connections pin the same host concurrently", func() {
+			It("trusts and pins the host once", func() {
+				mutex := &sync.Mutex{}
+				concurrentTrust := func() (string, error) {
+					mutex.Lock()
+					defer mutex.Unlock()
+					return trust()
+				}
+
+				wg := &sync.WaitGroup{}
+				for i := 0; i < 10; i++ {
+					wg.Add(1)
+					go func() {
+						defer GinkgoRecover()
+						defer wg.Done()
+
+						pinned, err := knownHosts.Pin("ssh.example.com:2222", concurrentTrust)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(pinned).To(Equal("SHA256:abc"))
+					}()
+				}
+				wg.Wait()
+
+				Expect(trustCallCount).To(Equal(1))
+
+				contents, err := ioutil.ReadFile(path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("https://api.example.com ssh.example.com:2222 SHA256:abc\n"))
+			})
+		})
+	})
+})
@@ -0,0 +1,69 @@
+package options
+
+import (
+	"errors"
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/flags"
+)
+
+type CopyDirection int
+
+const (
+	CopyToContainer CopyDirection = iota
+	CopyFromContainer
+)
+
+type SCPOptions struct {
+	SSHOptions
+	Direction  CopyDirection
+	LocalPath  string
+	RemotePath string
+	Recursive  bool
+}
+
+func NewSCPOptions(fc flags.FlagContext) (*SCPOptions, error) {
+	scpOptions := &SCPOptions{}
+
+	scpOptions.Index = uint(fc.Int("i"))
+	scpOptions.SkipHostValidation = fc.Bool("k")
+	scpOptions.Recursive = fc.Bool("r")
+
+	source, destination := fc.Args()[0], fc.Args()[1]
+	sourceApp, sourcePath, sourceIsRemote := splitRemotePath(source)
+	destinationApp, destinationPath, destinationIsRemote := splitRemotePath(destination)
+
+	switch {
+	case sourceIsRemote && destinationIsRemote:
+		return scpOptions, errors.New("Copying files between two application containers is not supported")
+	case sourceIsRemote:
+		scpOptions.Direction = CopyFromContainer
+		scpOptions.AppName = sourceApp
+		scpOptions.RemotePath = sourcePath
+		scpOptions.LocalPath = destination
+	case destinationIsRemote:
+		scpOptions.Direction = CopyToContainer
+		scpOptions.AppName = destinationApp
+		scpOptions.RemotePath = destinationPath
+		scpOptions.LocalPath = source
+	default:
+		return scpOptions, errors.New("Either SOURCE or DESTINATION must be an application path in the form APP_NAME:PATH")
+	}
+
+	if scpOptions.RemotePath == "" {
+		scpOptions.RemotePath = "."
+	}
+
+	return scpOptions, nil
+}
+
+// splitRemotePath splits an APP_NAME:PATH argument. Single letter prefixes
+// are treated as Windows drive letters rather than app names.
+func splitRemotePath(arg string) (string, string, bool) {
+	parts := strings.SplitN(arg, ":", 2)
+	if len(parts) != 2 || len(parts[0]) < 2 || strings.ContainsAny(parts[0], `/\`) {
+		return "", "", false
+	}
+
+	return parts[0], parts[1], true
+}
@@ -0,0 +1,104 @@
+package options_test
+
+import (
+	"code.cloudfoundry.org/cli/cf/flags"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SCPOptions", func() {
+	var (
+		opts       *options.SCPOptions
+		args       []string
+		parseError error
+		fc         flags.FlagContext
+	)
+
+	BeforeEach(func() {
+		fc = flags.New()
+		fc.NewIntFlag("app-instance-index", "i", "")
+		fc.NewBoolFlag("recursive", "r", "")
+		fc.NewBoolFlag("skip-host-validation", "k", "")
+
+		args = []string{}
+		parseError = nil
+	})
+
+	JustBeforeEach(func() {
+		err := fc.Parse(args...)
+		Expect(err).NotTo(HaveOccurred())
+
+		opts, parseError = options.NewSCPOptions(fc)
+	})
+
+	Context("when the source is in the container", func() {
+		BeforeEach(func() {
+			args = append(args, "app-1:/home/vcap/heap.hprof", "./heap.hprof")
+		})
+
+		It("copies from the container", func() {
+			Expect(parseError).NotTo(HaveOccurred())
+			Expect(opts.AppName).To(Equal("app-1"))
+			Expect(opts.Direction).To(Equal(options.CopyFromContainer))
+			Expect(opts.RemotePath).To(Equal("/home/vcap/heap.hprof"))
+			Expect(opts.LocalPath).To(Equal("./heap.hprof"))
+		})
+	})
+
+	Context("when the destination is in the container", func() {
+		BeforeEach(func() {
+			args = append(args, "-i", "3", "-r", "-k", "config", "app-1:")
+		})
+
+		It("copies to the container", func() {
+			Expect(parseError).NotTo(HaveOccurred())
+			Expect(opts.AppName).To(Equal("app-1"))
+			Expect(opts.Direction).To(Equal(options.CopyToContainer))
+			Expect(opts.LocalPath).To(Equal("config"))
+		})
+
+		It("defaults the remote path to the working directory", func() {
+			Expect(opts.RemotePath).To(Equal("."))
+		})
+
+		It("populates the flag options", func() {
+			Expect(opts.Index).To(Equal(uint(3)))
+			Expect(opts.Recursive).To(BeTrue())
+			Expect(opts.SkipHostValidation).To(BeTrue())
+		})
+	})
+
+	Context("when the local path is a windows path", func() {
+		BeforeEach(func() {
+			args = append(args, `C:\Users\vcap\file`, "app-1:/tmp")
+		})
+
+		It("does not treat the drive letter as an app name", func() {
+			Expect(parseError).NotTo(HaveOccurred())
+			Expect(opts.AppName).To(Equal("app-1"))
+			Expect(opts.LocalPath).To(Equal(`C:\Users\vcap\file`))
+		})
+	})
+
+	Context("when both paths are in containers", func() {
+		BeforeEach(func() {
+			args = append(args, "app-1:/tmp/file", "app-2:/tmp/file")
+		})
+
+		It("returns an error", func() {
+			Expect(parseError).To(MatchError("Copying files between two application containers is not supported"))
+		})
+	})
+
+	Context("when neither path is in a container", func() {
+		BeforeEach(func() {
+			args = append(args, "./file", "/tmp/file")
+		})
+
+		It("returns an error", func() {
+			Expect(parseError).To(MatchError("Either SOURCE or DESTINATION must be an application path in the form APP_NAME:PATH"))
+		})
+	})
+})
@@ -1,8 +1,10 @@
 package options
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/flags"
 )
@@ -16,19 +18,30 @@ const (
 	RequestTTYForce
 )
 
+const (
+	DefaultAllInstancesConcurrency = 10
+	DefaultAllInstancesTimeout     = 5 * time.Minute
+)
+
 type ForwardSpec struct {
 	ListenAddress  string
 	ConnectAddress string
 }
 
 type SSHOptions struct {
-	AppName             string
-	Command             []string
-	Index               uint
-	SkipHostValidation  bool
-	SkipRemoteExecution bool
-	TerminalRequest     TTYRequest
-	ForwardSpecs        []ForwardSpec
+	AppName                 string
+	Command                 []string
+	Index                   uint
+	SkipHostValidation      bool
+	SkipRemoteExecution     bool
+	TerminalRequest         TTYRequest
+	ForwardSpecs            []ForwardSpec
+	RemoteForwardSpecs      []ForwardSpec
+	DynamicForwardAddresses []string
+	RecordDir               string
+	AllInstances            bool
+	Concurrency             int
+	Timeout                 time.Duration
 }
 
 func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
@@ -42,7 +55,7 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 
 	if fc.IsSet("L") {
 		for _, arg := range fc.StringSlice("L") {
-			forwardSpec, err := sshOptions.parseLocalForwardingSpec(arg)
+			forwardSpec, err := sshOptions.parseForwardingSpec(arg, "local")
 			if err != nil {
 				return sshOptions, err
 			}
@@ -50,6 +63,26 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 		}
 	}
 
+	if fc.IsSet("R") {
+		for _, arg := range fc.StringSlice("R") {
+			forwardSpec, err := sshOptions.parseForwardingSpec(arg, "remote")
+			if err != nil {
+				return sshOptions, err
+			}
+			sshOptions.RemoteForwardSpecs = append(sshOptions.RemoteForwardSpecs, *forwardSpec)
+		}
+	}
+
+	if fc.IsSet("D") {
+		for _, arg := range fc.StringSlice("D") {
+			listenAddress, err := sshOptions.parseDynamicForwardingSpec(arg)
+			if err != nil {
+				return sshOptions, err
+			}
+			sshOptions.DynamicForwardAddresses = append(sshOptions.DynamicForwardAddresses, listenAddress)
+		}
+	}
+
 	if fc.IsSet("t") && fc.Bool("t") {
 		sshOptions.TerminalRequest = RequestTTYYes
 	}
@@ -62,21 +95,64 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 		sshOptions.TerminalRequest = RequestTTYNo
 	}
 
-	return sshOptions, nil
-}
+	sshOptions.RecordDir = fc.String("record")
+	if sshOptions.RecordDir != "" && sshOptions.SkipRemoteExecution {
+		return sshOptions, errors.New("--record cannot be used with --skip-remote-execution")
+	}
 
-func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error) {
-	arg = strings.TrimSpace(arg)
+	sshOptions.AllInstances = fc.Bool("all-instances")
+	sshOptions.Concurrency = DefaultAllInstancesConcurrency
+	sshOptions.Timeout = DefaultAllInstancesTimeout
 
-	parts := []string{}
-	for remainder := arg; remainder != ""; {
-		part, r, err := tokenizeForward(remainder)
+	if fc.IsSet("concurrency") {
+		if fc.Int("concurrency") < 1 {
+			return sshOptions, errors.New("Value for flag 'concurrency' must be greater than zero")
+		}
+		sshOptions.Concurrency = fc.Int("concurrency")
+	}
+
+	if fc.IsSet("timeout") {
+		if fc.Int("timeout") < 1 {
+			return sshOptions, errors.New("Value for flag 'timeout' must be greater than zero")
+		}
+		sshOptions.Timeout = time.Duration(fc.Int("timeout")) * time.Second
+	}
+
+	if sshOptions.AllInstances {
+		err := sshOptions.validateAllInstances(fc)
 		if err != nil {
-			return nil, err
+			return sshOptions, err
 		}
+	}
 
-		parts = append(parts, part)
-		remainder = r
+	return sshOptions, nil
+}
+
+func (o *SSHOptions) validateAllInstances(fc flags.FlagContext) error {
+	switch {
+	case len(o.Command) == 0:
+		return errors.New("--all-instances requires a command to run with -c")
+	case fc.IsSet("i"):
+		return errors.New("--all-instances cannot be used with --app-instance-index")
+	case o.SkipRemoteExecution:
+		return errors.New("--all-instances cannot be used with --skip-remote-execution")
+	case len(o.ForwardSpecs) > 0 || len(o.RemoteForwardSpecs) > 0 || len(o.DynamicForwardAddresses) > 0:
+		return errors.New("--all-instances cannot be used with port forwarding")
+	case o.TerminalRequest == RequestTTYYes || o.TerminalRequest == RequestTTYForce:
+		return errors.New("--all-instances cannot be used with pseudo-tty allocation")
+	case o.RecordDir != "":
+		return errors.New("--all-instances cannot be used with --record")
+	}
+
+	return nil
+}
+
+func (o *SSHOptions) parseForwardingSpec(arg string, direction string) (*ForwardSpec, error) {
+	arg = strings.TrimSpace(arg)
+
+	parts, err := tokenizeForwardSpec(arg)
+	if err != nil {
+		return nil, err
 	}
 
 	forwardSpec := &ForwardSpec{}
@@ -91,12 +167,48 @@ func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error)
 		forwardSpec.ListenAddress = fmt.Sprintf("localhost:%s", parts[0])
 		forwardSpec.ConnectAddress = fmt.Sprintf("%s:%s", parts[1], parts[2])
 	default:
-		return nil, fmt.Errorf("Unable to parse local forwarding argument: %q", arg)
+		return nil, fmt.Errorf("Unable to parse %s forwarding argument: %q", direction, arg)
 	}
 
 	return forwardSpec, nil
 }
 
+func (o *SSHOptions) parseDynamicForwardingSpec(arg string) (string, error) {
+	arg = strings.TrimSpace(arg)
+
+	parts, err := tokenizeForwardSpec(arg)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(parts) {
+	case 2:
+		if parts[0] == "*" {
+			parts[0] = ""
+		}
+		return fmt.Sprintf("%s:%s", parts[0], parts[1]), nil
+	case 1:
+		return fmt.Sprintf("localhost:%s", parts[0]), nil
+	default:
+		return "", fmt.Errorf("Unable to parse dynamic forwarding argument: %q", arg)
+	}
+}
+
+func tokenizeForwardSpec(arg string) ([]string, error) {
+	parts := []string{}
+	for remainder := arg; remainder != ""; {
+		part, r, err := tokenizeForward(remainder)
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, part)
+		remainder = r
+	}
+
+	return parts, nil
+}
+
 func tokenizeForward(arg string) (string, string, error) {
 	switch arg[0] {
 	case ':':
@@ -1,6 +1,8 @@
 package options_test
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/cf/flags"
 	"code.cloudfoundry.org/cli/cf/ssh/options"
 
@@ -20,6 +22,8 @@ var _ = Describe("SSHOptions", func() {
 		BeforeEach(func() {
 			fc = flags.New()
 			fc.NewStringSliceFlag("L", "", "")
+			fc.NewStringSliceFlag("R", "", "")
+			fc.NewStringSliceFlag("D", "", "")
 			fc.NewStringSliceFlag("command", "c", "")
 			fc.NewIntFlag("app-instance-index", "i", "")
 			fc.NewBoolFlag("skip-host-validation", "k", "")
@@ -27,6 +31,10 @@ var _ = Describe("SSHOptions", func() {
 			fc.NewBoolFlag("request-pseudo-tty", "t", "")
 			fc.NewBoolFlag("force-pseudo-tty", "tt", "")
 			fc.NewBoolFlag("disable-pseudo-tty", "T", "")
+			fc.NewBoolFlag("all-instances", "", "")
+			fc.NewIntFlag("concurrency", "", "")
+			fc.NewIntFlag("timeout", "", "")
+			fc.NewStringFlag("record", "", "")
 
 			args = []string{}
 			parseError = nil
@@ -281,6 +289,215 @@ var _ = Describe("SSHOptions", func() {
 			})
 		})
 
+		Context("when remote port forwarding is requested", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name")
+			})
+
+			Context("without an explicit bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "9999:localhost:8888")
+				})
+
+				It("sets the remote forward spec", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.RemoteForwardSpecs).To(ConsistOf(options.ForwardSpec{ListenAddress: "localhost:9999", ConnectAddress: "localhost:8888"}))
+					Expect(opts.ForwardSpecs).To(BeEmpty())
+				})
+			})
+
+			Context("with an explicit bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "0.0.0.0:9999:localhost:8888")
+				})
+
+				It("sets the remote forward spec", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.RemoteForwardSpecs).To(ConsistOf(options.ForwardSpec{ListenAddress: "0.0.0.0:9999", ConnectAddress: "localhost:8888"}))
+				})
+			})
+
+			Context("when the argument cannot be parsed", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "9999")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError(`Unable to parse remote forwarding argument: "9999"`))
+				})
+			})
+
+			Context("when multiple remote port forward options are specified", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "9999:localhost:8888")
+					args = append(args, "-R", "8080:localhost:80")
+				})
+
+				It("sets the remote forward specs", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.RemoteForwardSpecs).To(ConsistOf(
+						options.ForwardSpec{ListenAddress: "localhost:9999", ConnectAddress: "localhost:8888"},
+						options.ForwardSpec{ListenAddress: "localhost:8080", ConnectAddress: "localhost:80"},
+					))
+				})
+			})
+		})
+
+		Context("when dynamic port forwarding is requested", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name")
+			})
+
+			Context("without an explicit bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "1080")
+				})
+
+				It("sets the dynamic forward address", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.DynamicForwardAddresses).To(ConsistOf("localhost:1080"))
+				})
+			})
+
+			Context("with an explicit bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "[::1]:1080")
+				})
+
+				It("sets the dynamic forward address", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.DynamicForwardAddresses).To(ConsistOf("[::1]:1080"))
+				})
+			})
+
+			Context("with * as the bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "*:1080")
+				})
+
+				It("sets the dynamic forward address", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.DynamicForwardAddresses).To(ConsistOf(":1080"))
+				})
+			})
+
+			Context("when the argument cannot be parsed", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "localhost:1080:remote:80")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError(`Unable to parse dynamic forwarding argument: "localhost:1080:remote:80"`))
+				})
+			})
+		})
+
+		Context("when --all-instances is specified", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name", "--all-instances", "-c", "uptime")
+			})
+
+			It("enables all instances mode with the default limits", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.AllInstances).To(BeTrue())
+				Expect(opts.Concurrency).To(Equal(options.DefaultAllInstancesConcurrency))
+				Expect(opts.Timeout).To(Equal(options.DefaultAllInstancesTimeout))
+			})
+
+			Context("with --concurrency and --timeout", func() {
+				BeforeEach(func() {
+					args = append(args, "--concurrency", "3", "--timeout", "30")
+				})
+
+				It("populates the limits", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.Concurrency).To(Equal(3))
+					Expect(opts.Timeout).To(Equal(30 * time.Second))
+				})
+			})
+
+			Context("with a concurrency less than one", func() {
+				BeforeEach(func() {
+					args = append(args, "--concurrency", "0")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("Value for flag 'concurrency' must be greater than zero"))
+				})
+			})
+
+			Context("without a command", func() {
+				BeforeEach(func() {
+					args = []string{"app-name", "--all-instances"}
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--all-instances requires a command to run with -c"))
+				})
+			})
+
+			Context("with an instance index", func() {
+				BeforeEach(func() {
+					args = append(args, "-i", "1")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--all-instances cannot be used with --app-instance-index"))
+				})
+			})
+
+			Context("with port forwarding", func() {
+				BeforeEach(func() {
+					args = append(args, "-L", "9999:localhost:8080")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--all-instances cannot be used with port forwarding"))
+				})
+			})
+
+			Context("with pseudo-tty allocation", func() {
+				BeforeEach(func() {
+					args = append(args, "-t")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--all-instances cannot be used with pseudo-tty allocation"))
+				})
+			})
+
+			Context("with --record", func() {
+				BeforeEach(func() {
+					args = append(args, "--record", "/tmp/recordings")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--all-instances cannot be used with --record"))
+				})
+			})
+		})
+
+		Context("when --record is specified", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name", "--record", "/tmp/recordings")
+			})
+
+			It("records to the directory", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.RecordDir).To(Equal("/tmp/recordings"))
+			})
+
+			Context("with -N", func() {
+				BeforeEach(func() {
+					args = append(args, "-N")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--record cannot be used with --skip-remote-execution"))
+				})
+			})
+		})
+
 		Context("when -N is specified", func() {
 			BeforeEach(func() {
 				args = append(args, "app-name", "-N")
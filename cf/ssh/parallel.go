@@ -0,0 +1,166 @@
+package sshCmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+)
+
+// InstanceResult is the outcome of running a command on a single instance.
+// Err is set when the command could not be run to completion, in which case
+// ExitCode is -1.
+type InstanceResult struct {
+	Index    uint
+	ExitCode int
+	Err      error
+}
+
+type SecureShellFactory func() (SecureShell, error)
+
+// RunOnInstances runs opts.Command on every instance in indexes, at most
+// opts.Concurrency at a time, giving each command opts.Timeout to finish.
+// Output lines are prefixed with the instance index. Results are returned in
+// the same order as indexes.
+func RunOnInstances(newShell SecureShellFactory, opts *options.SSHOptions, indexes []uint, stdout io.Writer, stderr io.Writer) []InstanceResult {
+	results := make([]InstanceResult, len(indexes))
+	outputMutex := &sync.Mutex{}
+
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	semaphore := make(chan struct{}, concurrency)
+
+	wg := &sync.WaitGroup{}
+	for i, index := range indexes {
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(i int, index uint) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			instanceOpts := *opts
+			instanceOpts.Index = index
+
+			prefix := fmt.Sprintf("[%d] ", index)
+			instanceStdout := newPrefixWriter(stdout, prefix, outputMutex)
+			instanceStderr := newPrefixWriter(stderr, prefix, outputMutex)
+
+			results[i] = runOnInstance(newShell, &instanceOpts, instanceStdout, instanceStderr)
+
+			instanceStdout.Flush()
+			instanceStderr.Flush()
+		}(i, index)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func runOnInstance(newShell SecureShellFactory, opts *options.SSHOptions, stdout io.Writer, stderr io.Writer) InstanceResult {
+	result := InstanceResult{Index: opts.Index, ExitCode: -1}
+
+	shell, err := newShell()
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	err = shell.Connect(opts)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- shell.ExecuteCommand(stdout, stderr)
+	}()
+
+	select {
+	case err = <-done:
+		_ = shell.Close()
+	case <-time.After(opts.Timeout):
+		// Closing the connection ends the command. Wait for it so that nothing
+		// is written to stdout or stderr after this returns.
+		_ = shell.Close()
+		<-done
+		result.Err = fmt.Errorf("timed out after %s", opts.Timeout)
+		return result
+	}
+
+	switch e := err.(type) {
+	case nil:
+		result.ExitCode = 0
+	case *ssh.ExitError:
+		result.ExitCode = e.ExitStatus()
+	default:
+		result.Err = err
+	}
+
+	return result
+}
+
+type prefixWriter struct {
+	dest   io.Writer
+	prefix []byte
+	mutex  *sync.Mutex
+	buffer []byte
+}
+
+// newPrefixWriter returns a writer that prepends prefix to every line written
+// to dest. Writers sharing a mutex never interleave partial lines.
+func newPrefixWriter(dest io.Writer, prefix string, mutex *sync.Mutex) *prefixWriter {
+	return &prefixWriter{
+		dest:   dest,
+		prefix: []byte(prefix),
+		mutex:  mutex,
+	}
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.buffer = append(w.buffer, p...)
+	for {
+		newline := bytes.IndexByte(w.buffer, '\n')
+		if newline < 0 {
+			break
+		}
+
+		err := w.writeLine(w.buffer[:newline+1])
+		w.buffer = w.buffer[newline+1:]
+		if err != nil {
+			return len(p), err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Flush writes any buffered partial line, terminating it with a newline.
+func (w *prefixWriter) Flush() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if len(w.buffer) == 0 {
+		return nil
+	}
+
+	line := append(w.buffer, '\n')
+	w.buffer = nil
+	return w.writeLine(line)
+}
+
+func (w *prefixWriter) writeLine(line []byte) error {
+	_, err := w.dest.Write(append(append([]byte{}, w.prefix...), line...))
+	return err
+}
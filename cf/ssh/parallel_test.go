@@ -0,0 +1,206 @@
+package sshCmd_test
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RunOnInstances", func() {
+	var (
+		opts    *options.SSHOptions
+		indexes []uint
+		stdout  *gbytes.Buffer
+		stderr  *gbytes.Buffer
+
+		shellsMutex sync.Mutex
+		shells      []*sshfakes.FakeSecureShell
+		newShell    sshCmd.SecureShellFactory
+		configure   func(shell *sshfakes.FakeSecureShell)
+
+		results []sshCmd.InstanceResult
+	)
+
+	BeforeEach(func() {
+		opts = &options.SSHOptions{
+			AppName:     "app-1",
+			Command:     []string{"uptime"},
+			Concurrency: 2,
+			Timeout:     time.Second,
+		}
+		indexes = []uint{0, 1, 2}
+		stdout = gbytes.NewBuffer()
+		stderr = gbytes.NewBuffer()
+
+		shells = nil
+		configure = func(shell *sshfakes.FakeSecureShell) {
+			shell.ExecuteCommandStub = func(stdout io.Writer, stderr io.Writer) error {
+				_, _ = stdout.Write([]byte("first line\nsecond "))
+				_, _ = stdout.Write([]byte("line\npartial"))
+				_, _ = stderr.Write([]byte("warning\n"))
+				return nil
+			}
+		}
+		newShell = func() (sshCmd.SecureShell, error) {
+			shell := new(sshfakes.FakeSecureShell)
+			configure(shell)
+
+			shellsMutex.Lock()
+			shells = append(shells, shell)
+			shellsMutex.Unlock()
+
+			return shell, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		results = sshCmd.RunOnInstances(newShell, opts, indexes, stdout, stderr)
+	})
+
+	It("connects to every instance with its own shell", func() {
+		Expect(shells).To(HaveLen(3))
+
+		var connected []uint
+		for _, shell := range shells {
+			Expect(shell.ConnectCallCount()).To(Equal(1))
+			connectOpts := shell.ConnectArgsForCall(0)
+			Expect(connectOpts.Command).To(Equal([]string{"uptime"}))
+			connected = append(connected, connectOpts.Index)
+
+			Expect(shell.ExecuteCommandCallCount()).To(Equal(1))
+			Expect(shell.CloseCallCount()).To(Equal(1))
+		}
+		Expect(connected).To(ConsistOf(uint(0), uint(1), uint(2)))
+	})
+
+	It("does not modify the original options", func() {
+		Expect(opts.Index).To(Equal(uint(0)))
+	})
+
+	It("prefixes every line of output with the instance index", func() {
+		output := string(stdout.Contents())
+		for _, prefix := range []string{"[0] ", "[1] ", "[2] "} {
+			Expect(output).To(ContainSubstring(prefix + "first line\n"))
+			Expect(output).To(ContainSubstring(prefix + "second line\n"))
+			Expect(output).To(ContainSubstring(prefix + "partial\n"))
+		}
+		Expect(string(stderr.Contents())).To(ContainSubstring("[1] warning\n"))
+	})
+
+	It("returns a result for every instance in order", func() {
+		Expect(results).To(Equal([]sshCmd.InstanceResult{
+			{Index: 0, ExitCode: 0},
+			{Index: 1, ExitCode: 0},
+			{Index: 2, ExitCode: 0},
+		}))
+	})
+
+	Context("when more instances than the concurrency limit are targeted", func() {
+		var (
+			running    int
+			maxRunning int
+		)
+
+		BeforeEach(func() {
+			opts.Concurrency = 2
+			indexes = []uint{0, 1, 2, 3, 4, 5}
+			running, maxRunning = 0, 0
+
+			configure = func(shell *sshfakes.FakeSecureShell) {
+				shell.ExecuteCommandStub = func(io.Writer, io.Writer) error {
+					shellsMutex.Lock()
+					running++
+					if running > maxRunning {
+						maxRunning = running
+					}
+					shellsMutex.Unlock()
+
+					time.Sleep(10 * time.Millisecond)
+
+					shellsMutex.Lock()
+					running--
+					shellsMutex.Unlock()
+					return nil
+				}
+			}
+		})
+
+		It("never runs more than the limit at once", func() {
+			Expect(results).To(HaveLen(6))
+			Expect(maxRunning).To(BeNumerically("<=", 2))
+		})
+	})
+
+	Context("when connecting to an instance fails", func() {
+		BeforeEach(func() {
+			configure = func(shell *sshfakes.FakeSecureShell) {
+				shell.ConnectStub = func(opts *options.SSHOptions) error {
+					if opts.Index == 1 {
+						return errors.New("instance not running")
+					}
+					return nil
+				}
+			}
+		})
+
+		It("reports the error for that instance only", func() {
+			Expect(results[0]).To(Equal(sshCmd.InstanceResult{Index: 0, ExitCode: 0}))
+			Expect(results[1].ExitCode).To(Equal(-1))
+			Expect(results[1].Err).To(MatchError("instance not running"))
+			Expect(results[2]).To(Equal(sshCmd.InstanceResult{Index: 2, ExitCode: 0}))
+		})
+	})
+
+	Context("when the command fails to run", func() {
+		BeforeEach(func() {
+			configure = func(shell *sshfakes.FakeSecureShell) {
+				shell.ExecuteCommandReturns(errors.New("session failed"))
+			}
+		})
+
+		It("reports the error", func() {
+			Expect(results[0].ExitCode).To(Equal(-1))
+			Expect(results[0].Err).To(MatchError("session failed"))
+		})
+	})
+
+	Context("when the command does not finish before the timeout", func() {
+		BeforeEach(func() {
+			opts.Timeout = 10 * time.Millisecond
+			indexes = []uint{4}
+
+			configure = func(shell *sshfakes.FakeSecureShell) {
+				closed := make(chan struct{})
+				shell.CloseStub = func() error {
+					close(closed)
+					return nil
+				}
+				shell.ExecuteCommandStub = func(stdout io.Writer, stderr io.Writer) error {
+					<-closed
+					_, _ = stdout.Write([]byte("output after close"))
+					return errors.New("connection closed")
+				}
+			}
+		})
+
+		It("reports a timeout and closes the connection", func() {
+			Expect(results[0].Index).To(Equal(uint(4)))
+			Expect(results[0].ExitCode).To(Equal(-1))
+			Expect(results[0].Err).To(MatchError("timed out after 10ms"))
+			Expect(shells[0].CloseCallCount()).To(Equal(1))
+		})
+
+		It("waits for the command to end before flushing its output", func() {
+			Expect(stdout).To(gbytes.Say(`\[4\] output after close\n`))
+		})
+	})
+})
@@ -0,0 +1,219 @@
+package sshCmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Session recordings use the asciinema v2 file format: a JSON header line
+// followed by one JSON array per event of the form [elapsed, type, data].
+const (
+	recordingVersion     = 2
+	recordingEventInput  = "i"
+	recordingEventOutput = "o"
+	recordingEventResize = "r"
+)
+
+type recordingHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// Recorder writes terminal input, output and resize events to an asciinema
+// compatible recording. Writes never fail so that a broken recording does not
+// interrupt the session; the first error is returned by Close.
+type Recorder struct {
+	mutex    sync.Mutex
+	writer   io.WriteCloser
+	now      func() time.Time
+	start    time.Time
+	partials map[string][]byte
+	err      error
+}
+
+func NewRecorder(writer io.WriteCloser, width int, height int, title string, env map[string]string, now func() time.Time) (*Recorder, error) {
+	start := now()
+
+	header, err := json.Marshal(recordingHeader{
+		Version:   recordingVersion,
+		Width:     width,
+		Height:    height,
+		Timestamp: start.Unix(),
+		Title:     title,
+		Env:       env,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(writer, "%s\n", header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recorder{
+		writer:   writer,
+		now:      now,
+		start:    start,
+		partials: map[string][]byte{},
+	}, nil
+}
+
+// Write records p as terminal output.
+func (r *Recorder) Write(p []byte) (int, error) {
+	r.record(recordingEventOutput, p)
+	return len(p), nil
+}
+
+// Input returns a writer that records everything written to it as terminal
+// input.
+func (r *Recorder) Input() io.Writer {
+	return recorderInput{recorder: r}
+}
+
+type recorderInput struct {
+	recorder *Recorder
+}
+
+func (i recorderInput) Write(p []byte) (int, error) {
+	i.recorder.record(recordingEventInput, p)
+	return len(p), nil
+}
+
+func (r *Recorder) record(eventType string, p []byte) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	data := append(r.partials[eventType], p...)
+
+	// hold back an incomplete multi-byte character until the rest arrives so
+	// that every event is valid UTF-8
+	complete := len(data)
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				complete = i
+			}
+			break
+		}
+	}
+
+	r.partials[eventType] = append([]byte{}, data[complete:]...)
+	if complete > 0 {
+		r.writeEvent(eventType, string(data[:complete]))
+	}
+}
+
+func (r *Recorder) Resize(width int, height int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.writeEvent(recordingEventResize, fmt.Sprintf("%dx%d", width, height))
+}
+
+func (r *Recorder) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, eventType := range []string{recordingEventOutput, recordingEventInput} {
+		if len(r.partials[eventType]) > 0 {
+			r.writeEvent(eventType, string(r.partials[eventType]))
+			delete(r.partials, eventType)
+		}
+	}
+
+	err := r.writer.Close()
+	if r.err != nil {
+		return r.err
+	}
+	return err
+}
+
+func (r *Recorder) writeEvent(eventType string, data string) {
+	if r.err != nil {
+		return
+	}
+
+	elapsed := r.now().Sub(r.start).Seconds()
+	event, err := json.Marshal([]interface{}{elapsed, eventType, data})
+	if err != nil {
+		r.err = err
+		return
+	}
+
+	_, r.err = fmt.Fprintf(r.writer, "%s\n", event)
+}
+
+// Replay writes the output events of a recording to output, sleeping between
+// events to reproduce the original timing divided by speed. Input and resize
+// events are skipped, since typed input is echoed back as output.
+func Replay(recording io.Reader, output io.Writer, speed float64, sleep func(time.Duration)) error {
+	if speed <= 0 {
+		return errors.New("Replay speed must be greater than zero")
+	}
+
+	scanner := bufio.NewScanner(recording)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	if !scanner.Scan() {
+		if scanner.Err() != nil {
+			return scanner.Err()
+		}
+		return errors.New("Recording is empty")
+	}
+
+	var header recordingHeader
+	err := json.Unmarshal(scanner.Bytes(), &header)
+	if err != nil {
+		return fmt.Errorf("Invalid recording header: %s", err.Error())
+	}
+	if header.Version != recordingVersion {
+		return fmt.Errorf("Unsupported recording version: %d", header.Version)
+	}
+
+	var previous float64
+	for line := 2; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var event []interface{}
+		err = json.Unmarshal(scanner.Bytes(), &event)
+		if err != nil || len(event) != 3 {
+			return fmt.Errorf("Invalid recording event on line %d", line)
+		}
+
+		elapsed, timeOK := event[0].(float64)
+		eventType, typeOK := event[1].(string)
+		data, dataOK := event[2].(string)
+		if !timeOK || !typeOK || !dataOK {
+			return fmt.Errorf("Invalid recording event on line %d", line)
+		}
+
+		if eventType != recordingEventOutput {
+			continue
+		}
+
+		if elapsed > previous {
+			sleep(time.Duration((elapsed - previous) / speed * float64(time.Second)))
+			previous = elapsed
+		}
+
+		_, err = io.WriteString(output, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,166 @@
+package sshCmd_test
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/ssh"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type closingBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *closingBuffer) Close() error {
+	b.closed = true
+	return nil
+}
+
+var _ = Describe("Recording", func() {
+	var (
+		output  *closingBuffer
+		current time.Time
+		now     func() time.Time
+	)
+
+	BeforeEach(func() {
+		output = &closingBuffer{}
+		current = time.Unix(1500000000, 0)
+		now = func() time.Time { return current }
+	})
+
+	Describe("Recorder", func() {
+		var recorder *sshCmd.Recorder
+
+		BeforeEach(func() {
+			var err error
+			recorder, err = sshCmd.NewRecorder(output, 80, 24, "cf ssh my-app -i 0", map[string]string{"TERM": "xterm"}, now)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("writes an asciinema v2 header", func() {
+			Expect(output.String()).To(Equal(`{"version":2,"width":80,"height":24,"timestamp":1500000000,"title":"cf ssh my-app -i 0","env":{"TERM":"xterm"}}` + "\n"))
+		})
+
+		It("records output and resize events relative to the start", func() {
+			current = current.Add(1500 * time.Millisecond)
+			n, err := recorder.Write([]byte("$ ls\r\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(Equal(6))
+
+			current = current.Add(time.Second)
+			recorder.Resize(120, 40)
+
+			Expect(recorder.Close()).To(Succeed())
+			Expect(output.closed).To(BeTrue())
+
+			lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+			Expect(lines[1:]).To(Equal([]string{
+				`[1.5,"o","$ ls\r\n"]`,
+				`[2.5,"r","120x40"]`,
+			}))
+		})
+
+		It("records input events", func() {
+			current = current.Add(time.Second)
+			n, err := recorder.Input().Write([]byte("ls\r"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(Equal(3))
+
+			current = current.Add(500 * time.Millisecond)
+			_, _ = recorder.Write([]byte("ls\r\n"))
+
+			Expect(recorder.Close()).To(Succeed())
+
+			lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+			Expect(lines[1:]).To(Equal([]string{
+				`[1,"i","ls\r"]`,
+				`[1.5,"o","ls\r\n"]`,
+			}))
+		})
+
+		It("does not split multi-byte characters across events", func() {
+			snowman := []byte("☃")
+			_, _ = recorder.Write(append([]byte("a"), snowman[:1]...))
+			_, _ = recorder.Write(snowman[1:])
+			Expect(recorder.Close()).To(Succeed())
+
+			lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+			Expect(lines[1:]).To(Equal([]string{
+				`[0,"o","a"]`,
+				`[0,"o","☃"]`,
+			}))
+		})
+	})
+
+	Describe("Replay", func() {
+		var (
+			recording string
+			replayed  *bytes.Buffer
+			sleeps    []time.Duration
+			speed     float64
+			replayErr error
+		)
+
+		BeforeEach(func() {
+			recording = `{"version":2,"width":80,"height":24,"timestamp":1500000000}
+[0.5,"o","hello "]
+[0.8,"i","w"]
+[1.0,"r","100x30"]
+[2.5,"o","world"]
+`
+			replayed = &bytes.Buffer{}
+			sleeps = nil
+			speed = 1
+		})
+
+		JustBeforeEach(func() {
+			replayErr = sshCmd.Replay(strings.NewReader(recording), replayed, speed, func(d time.Duration) {
+				sleeps = append(sleeps, d)
+			})
+		})
+
+		It("writes only the output events with the recorded timing", func() {
+			Expect(replayErr).NotTo(HaveOccurred())
+			Expect(replayed.String()).To(Equal("hello world"))
+			Expect(sleeps).To(Equal([]time.Duration{500 * time.Millisecond, 2 * time.Second}))
+		})
+
+		Context("when a speed is provided", func() {
+			BeforeEach(func() {
+				speed = 2
+			})
+
+			It("scales the delays", func() {
+				Expect(sleeps).To(Equal([]time.Duration{250 * time.Millisecond, time.Second}))
+			})
+		})
+
+		Context("when the recording has an unsupported version", func() {
+			BeforeEach(func() {
+				recording = `{"version":1,"width":80,"height":24}`
+			})
+
+			It("returns an error", func() {
+				Expect(replayErr).To(MatchError("Unsupported recording version: 1"))
+			})
+		})
+
+		Context("when an event is malformed", func() {
+			BeforeEach(func() {
+				recording = `{"version":2,"width":80,"height":24}
+["o","missing time"]
+`
+			})
+
+			It("returns an error with the line number", func() {
+				Expect(replayErr).To(MatchError("Invalid recording event on line 2"))
+			})
+		})
+	})
+})
@@ -0,0 +1,116 @@
+package sshCmd
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+// Subset of the SOCKS5 protocol (RFC 1928) needed to act as an unauthenticated
+// CONNECT proxy for dynamic port forwarding.
+const (
+	socksVersion = 0x05
+
+	socksAuthNone         = 0x00
+	socksAuthNoAcceptable = 0xff
+
+	socksCommandConnect = 0x01
+
+	socksAddressIPv4   = 0x01
+	socksAddressDomain = 0x03
+	socksAddressIPv6   = 0x04
+
+	socksReplySucceeded               = 0x00
+	socksReplyHostUnreachable         = 0x04
+	socksReplyCommandNotSupported     = 0x07
+	socksReplyAddressTypeNotSupported = 0x08
+)
+
+// socksHandshake negotiates a SOCKS5 CONNECT request on conn and returns the
+// address the client asked to connect to. The caller is responsible for
+// sending the final reply with socksReply.
+func socksHandshake(conn io.ReadWriter) (string, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return "", err
+	}
+	if header[0] != socksVersion {
+		return "", fmt.Errorf("Unsupported SOCKS version: %d", header[0])
+	}
+
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return "", err
+	}
+
+	noAuthOffered := false
+	for _, method := range methods {
+		if method == socksAuthNone {
+			noAuthOffered = true
+			break
+		}
+	}
+	if !noAuthOffered {
+		_, _ = conn.Write([]byte{socksVersion, socksAuthNoAcceptable})
+		return "", errors.New("SOCKS client does not support unauthenticated connections")
+	}
+
+	if _, err := conn.Write([]byte{socksVersion, socksAuthNone}); err != nil {
+		return "", err
+	}
+
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return "", err
+	}
+	if request[0] != socksVersion {
+		return "", fmt.Errorf("Unsupported SOCKS version: %d", request[0])
+	}
+	if request[1] != socksCommandConnect {
+		_ = socksReply(conn, socksReplyCommandNotSupported)
+		return "", fmt.Errorf("Unsupported SOCKS command: %d", request[1])
+	}
+
+	var host string
+	switch request[3] {
+	case socksAddressIPv4, socksAddressIPv6:
+		ip := make(net.IP, net.IPv4len)
+		if request[3] == socksAddressIPv6 {
+			ip = make(net.IP, net.IPv6len)
+		}
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return "", err
+		}
+		host = ip.String()
+	case socksAddressDomain:
+		length := make([]byte, 1)
+		if _, err := io.ReadFull(conn, length); err != nil {
+			return "", err
+		}
+		domain := make([]byte, length[0])
+		if _, err := io.ReadFull(conn, domain); err != nil {
+			return "", err
+		}
+		host = string(domain)
+	default:
+		_ = socksReply(conn, socksReplyAddressTypeNotSupported)
+		return "", fmt.Errorf("Unsupported SOCKS address type: %d", request[3])
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), nil
+}
+
+// socksReply sends a SOCKS5 reply with the given status. The bound address is
+// always reported as 0.0.0.0:0 since the real one lives in the container.
+func socksReply(conn io.Writer, status byte) error {
+	_, err := conn.Write([]byte{socksVersion, status, 0x00, socksAddressIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
@@ -11,6 +11,7 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"sync"
@@ -37,7 +38,11 @@ const (
 type SecureShell interface {
 	Connect(opts *options.SSHOptions) error
 	InteractiveSession() error
+	ExecuteCommand(stdout io.Writer, stderr io.Writer) error
 	LocalPortForward() error
+	RemotePortForward() error
+	DynamicPortForward() error
+	Copy(copyOpts *options.SCPOptions, progress ProgressWrapper) error
 	Wait() error
 	Close() error
 }
@@ -54,6 +59,7 @@ type SecureClient interface {
 	NewSession() (SecureSession, error)
 	Conn() ssh.Conn
 	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
 	Wait() error
 	Close() error
 }
@@ -69,6 +75,7 @@ type ListenerFactory interface {
 type SecureSession interface {
 	RequestPty(term string, height, width int, termModes ssh.TerminalModes) error
 	SendRequest(name string, wantReply bool, payload []byte) (bool, error)
+	RequestSubsystem(subsystem string) error
 	StdinPipe() (io.WriteCloser, error)
 	StdoutPipe() (io.Reader, error)
 	StderrPipe() (io.Reader, error)
@@ -87,10 +94,14 @@ type secureShell struct {
 	sshEndpointFingerprint string
 	sshEndpoint            string
 	token                  string
+	knownHosts             KnownHosts
+	hostKeyPrompter        HostKeyPrompter
 	secureClient           SecureClient
 	opts                   *options.SSHOptions
+	recorder               *Recorder
 
-	localListeners []net.Listener
+	localListeners  []net.Listener
+	remoteListeners []net.Listener
 }
 
 func NewSecureShell(
@@ -102,6 +113,8 @@ func NewSecureShell(
 	sshEndpointFingerprint string,
 	sshEndpoint string,
 	token string,
+	knownHosts KnownHosts,
+	hostKeyPrompter HostKeyPrompter,
 ) SecureShell {
 	return &secureShell{
 		secureDialer:      secureDialer,
@@ -112,7 +125,10 @@ func NewSecureShell(
 		sshEndpointFingerprint: sshEndpointFingerprint,
 		sshEndpoint:            sshEndpoint,
 		token:                  token,
+		knownHosts:             knownHosts,
+		hostKeyPrompter:        hostKeyPrompter,
 		localListeners:         []net.Listener{},
+		remoteListeners:        []net.Listener{},
 	}
 }
 
@@ -127,7 +143,7 @@ func (c *secureShell) Connect(opts *options.SSHOptions) error {
 		Auth: []ssh.AuthMethod{
 			ssh.Password(c.token),
 		},
-		HostKeyCallback: fingerprintCallback(opts, c.sshEndpointFingerprint),
+		HostKeyCallback: c.hostKeyCallback(opts),
 	}
 
 	secureClient, err := c.secureDialer.Dial("tcp", c.sshEndpoint, clientConfig)
@@ -144,6 +160,9 @@ func (c *secureShell) Close() error {
 	for _, listener := range c.localListeners {
 		_ = listener.Close()
 	}
+	for _, listener := range c.remoteListeners {
+		_ = listener.Close()
+	}
 	return c.secureClient.Close()
 }
 
@@ -155,13 +174,47 @@ func (c *secureShell) LocalPortForward() error {
 		}
 		c.localListeners = append(c.localListeners, listener)
 
-		go c.localForwardAcceptLoop(listener, forwardSpec.ConnectAddress)
+		connectAddress := forwardSpec.ConnectAddress
+		go c.forwardAcceptLoop(listener, func(conn net.Conn) {
+			c.handleForwardConnection(conn, connectAddress, c.secureClient.Dial)
+		})
 	}
 
 	return nil
 }
 
-func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+func (c *secureShell) RemotePortForward() error {
+	for _, forwardSpec := range c.opts.RemoteForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", forwardSpec.ListenAddress)
+		if err != nil {
+			return err
+		}
+		c.remoteListeners = append(c.remoteListeners, listener)
+
+		connectAddress := forwardSpec.ConnectAddress
+		go c.forwardAcceptLoop(listener, func(conn net.Conn) {
+			c.handleForwardConnection(conn, connectAddress, net.Dial)
+		})
+	}
+
+	return nil
+}
+
+func (c *secureShell) DynamicPortForward() error {
+	for _, listenAddress := range c.opts.DynamicForwardAddresses {
+		listener, err := c.listenerFactory.Listen("tcp", listenAddress)
+		if err != nil {
+			return err
+		}
+		c.localListeners = append(c.localListeners, listener)
+
+		go c.forwardAcceptLoop(listener, c.handleDynamicForwardConnection)
+	}
+
+	return nil
+}
+
+func (c *secureShell) forwardAcceptLoop(listener net.Listener, handleConnection func(net.Conn)) {
 	defer listener.Close()
 
 	for {
@@ -174,20 +227,50 @@ func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go handleConnection(conn)
+	}
+}
+
+type dialFunc func(network, address string) (net.Conn, error)
+
+func (c *secureShell) handleForwardConnection(conn net.Conn, targetAddr string, dial dialFunc) {
+	defer conn.Close()
+
+	target, err := dial("tcp", targetAddr)
+	if err != nil {
+		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		return
 	}
+	defer target.Close()
+
+	pipeConnections(conn, target)
 }
 
-func (c *secureShell) handleForwardConnection(conn net.Conn, targetAddr string) {
+func (c *secureShell) handleDynamicForwardConnection(conn net.Conn) {
 	defer conn.Close()
 
+	targetAddr, err := socksHandshake(conn)
+	if err != nil {
+		return
+	}
+
 	target, err := c.secureClient.Dial("tcp", targetAddr)
 	if err != nil {
+		_ = socksReply(conn, socksReplyHostUnreachable)
 		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
 		return
 	}
 	defer target.Close()
 
+	err = socksReply(conn, socksReplySucceeded)
+	if err != nil {
+		return
+	}
+
+	pipeConnections(conn, target)
+}
+
+func pipeConnections(conn net.Conn, target net.Conn) {
 	wg := &sync.WaitGroup{}
 	wg.Add(2)
 
@@ -209,9 +292,7 @@ func copyAndDone(wg *sync.WaitGroup, dest io.Writer, src io.Reader) {
 	wg.Done()
 }
 
-func (c *secureShell) InteractiveSession() error {
-	var err error
-
+func (c *secureShell) InteractiveSession() (err error) {
 	secureClient := c.secureClient
 	opts := c.opts
 
@@ -241,6 +322,36 @@ func (c *secureShell) InteractiveSession() error {
 	stdinFd, stdinIsTerminal := c.terminalHelper.GetFdInfo(stdin)
 	stdoutFd, stdoutIsTerminal := c.terminalHelper.GetFdInfo(stdout)
 
+	var input io.Reader = stdin
+
+	if opts.RecordDir != "" {
+		var recordingPath string
+		recordingPath, err = c.startRecording(opts, stdoutFd)
+		if err != nil {
+			return fmt.Errorf("Unable to record session: %s", err.Error())
+		}
+		sessionStderr := stderr
+		defer func() {
+			closeErr := c.recorder.Close()
+			if closeErr == nil {
+				return
+			}
+
+			// the session's own error takes precedence so that its exit status
+			// is kept
+			if err == nil {
+				err = fmt.Errorf("Unable to save session recording: %s", closeErr.Error())
+			} else {
+				fmt.Fprintf(sessionStderr, "Unable to save session recording: %s\n", closeErr.Error())
+			}
+		}()
+
+		fmt.Fprintf(stderr, "Recording session to %s\n", recordingPath)
+		input = io.TeeReader(stdin, c.recorder.Input())
+		stdout = io.MultiWriter(stdout, c.recorder)
+		stderr = io.MultiWriter(stderr, c.recorder)
+	}
+
 	if c.shouldAllocateTerminal(opts, stdinIsTerminal) {
 		modes := ssh.TerminalModes{
 			ssh.ECHO:          1,
@@ -278,7 +389,7 @@ func (c *secureShell) InteractiveSession() error {
 	wg := &sync.WaitGroup{}
 	wg.Add(2)
 
-	go copyAndClose(nil, inPipe, stdin)
+	go copyAndClose(nil, inPipe, input)
 	go copyAndDone(wg, stdout, outPipe)
 	go copyAndDone(wg, stderr, errPipe)
 
@@ -313,6 +424,75 @@ func (c *secureShell) InteractiveSession() error {
 	return result
 }
 
+func (c *secureShell) startRecording(opts *options.SSHOptions, terminalFd uintptr) (string, error) {
+	err := os.MkdirAll(opts.RecordDir, 0700)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	name := fmt.Sprintf("%s-%d-%s.cast", opts.AppName, opts.Index, now.UTC().Format("20060102T150405Z"))
+	path := filepath.Join(opts.RecordDir, name)
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return "", err
+	}
+
+	width, height := c.getWindowDimensions(terminalFd)
+	env := map[string]string{
+		"TERM":  c.terminalType(),
+		"SHELL": os.Getenv("SHELL"),
+	}
+	title := fmt.Sprintf("cf ssh %s -i %d", opts.AppName, opts.Index)
+
+	c.recorder, err = NewRecorder(file, width, height, title, env, time.Now)
+	if err != nil {
+		file.Close()
+		return "", err
+	}
+
+	return path, nil
+}
+
+func (c *secureShell) ExecuteCommand(stdout io.Writer, stderr io.Writer) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.Start(strings.Join(c.opts.Command, " "))
+	if err != nil {
+		return err
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndDone(wg, stdout, outPipe)
+	go copyAndDone(wg, stderr, errPipe)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	result := session.Wait()
+	wg.Wait()
+	return result
+}
+
 func (c *secureShell) Wait() error {
 	keepaliveStopCh := make(chan struct{})
 	defer close(keepaliveStopCh)
@@ -380,6 +560,67 @@ func fingerprintCallback(opts *options.SSHOptions, expectedFingerprint string) h
 	}
 }
 
+// hostKeyCallback verifies the host key against the fingerprint pinned in the
+// known hosts store. Hosts seen for the first time are checked against the
+// Cloud Controller fingerprint, or confirmed by the user when there is none,
+// and then pinned. Checking and pinning is a single known hosts operation
+// because connections to all instances share the store.
+func (c *secureShell) hostKeyCallback(opts *options.SSHOptions) hostKeyCallback {
+	verifyAdvertised := fingerprintCallback(opts, c.sshEndpointFingerprint)
+	if verifyAdvertised == nil || c.knownHosts == nil {
+		return verifyAdvertised
+	}
+
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		pinned, err := c.knownHosts.Pin(c.sshEndpoint, func() (string, error) {
+			fingerprint := sha256FingerprintPrefix + base64Sha256Fingerprint(key)
+
+			if c.sshEndpointFingerprint == "" && c.hostKeyPrompter != nil {
+				if !c.hostKeyPrompter.Confirm(fmt.Sprintf("The authenticity of host %q can't be established.\nThe fingerprint of the received key is %q.\nAre you sure you want to continue connecting?", c.sshEndpoint, fingerprint)) {
+					return "", errors.New("Host key verification failed.")
+				}
+				return fingerprint, nil
+			}
+
+			err := verifyAdvertised(hostname, remote, key)
+			if err != nil {
+				return "", err
+			}
+			return fingerprint, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return c.verifyPinnedFingerprint(pinned, key)
+	}
+}
+
+func (c *secureShell) verifyPinnedFingerprint(pinned string, key ssh.PublicKey) error {
+	var fingerprint string
+
+	switch {
+	case strings.HasPrefix(pinned, sha256FingerprintPrefix):
+		fingerprint = sha256FingerprintPrefix + base64Sha256Fingerprint(key)
+	case len(pinned) == base64Sha256FingerprintLength:
+		fingerprint = base64Sha256Fingerprint(key)
+	case len(pinned) == hexSha1FingerprintLength:
+		fingerprint = hexSha1Fingerprint(key)
+	case len(pinned) == md5FingerprintLength:
+		fingerprint = md5Fingerprint(key)
+	default:
+		return errors.New("Unsupported host key fingerprint format in known hosts")
+	}
+
+	if fingerprint != pinned {
+		if c.hostKeyPrompter != nil {
+			c.hostKeyPrompter.Warn("WARNING: The host key for %q has changed since it was last trusted.\nThe pinned fingerprint is %q but the received key has fingerprint %q.\nIf this change is expected, remove the entry from the known hosts file.", c.sshEndpoint, pinned, fingerprint)
+		}
+		return fmt.Errorf("Host key verification failed.\n\nThe fingerprint of the received key was %q.", fingerprint)
+	}
+	return nil
+}
+
 func (c *secureShell) shouldAllocateTerminal(opts *options.SSHOptions, stdinIsTerminal bool) bool {
 	switch opts.TerminalRequest {
 	case options.RequestTTYForce:
@@ -419,6 +660,10 @@ func (c *secureShell) resize(resized <-chan os.Signal, session SecureSession, te
 
 		_, _ = session.SendRequest("window-change", false, ssh.Marshal(message))
 
+		if c.recorder != nil {
+			c.recorder.Resize(width, height)
+		}
+
 		previousWidth = width
 		previousHeight = height
 	}
@@ -479,6 +724,9 @@ func (sc *secureClient) Wait() error    { return sc.client.Wait() }
 func (sc *secureClient) Dial(n, addr string) (net.Conn, error) {
 	return sc.client.Dial(n, addr)
 }
+func (sc *secureClient) Listen(n, addr string) (net.Listener, error) {
+	return sc.client.Listen(n, addr)
+}
 func (sc *secureClient) NewSession() (SecureSession, error) {
 	return sc.client.NewSession()
 }
@@ -10,8 +10,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"syscall"
 	"time"
 
@@ -30,6 +34,8 @@ import (
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/docker/docker/pkg/term"
 	"github.com/kr/pty"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/pkg/sftp"
 	"golang.org/x/crypto/ssh"
 
 	. "github.com/onsi/ginkgo"
@@ -56,6 +62,8 @@ var _ = Describe("SSH", func() {
 		sshEndpointFingerprint string
 		sshEndpoint            string
 		token                  string
+		knownHosts             sshCmd.KnownHosts
+		hostKeyPrompter        sshCmd.HostKeyPrompter
 	)
 
 	BeforeEach(func() {
@@ -71,6 +79,8 @@ var _ = Describe("SSH", func() {
 		sshEndpoint = ""
 		sshEndpointFingerprint = ""
 		token = ""
+		knownHosts = nil
+		hostKeyPrompter = nil
 
 		fakeConnection = new(fake_ssh.FakeConn)
 		fakeSecureClient = new(sshfakes.FakeSecureClient)
@@ -111,6 +121,8 @@ var _ = Describe("SSH", func() {
 			sshEndpointFingerprint,
 			sshEndpoint,
 			token,
+			knownHosts,
+			hostKeyPrompter,
 		)
 	})
 
@@ -317,6 +329,140 @@ var _ = Describe("SSH", func() {
 					Eventually(err).Should(MatchError(MatchRegexp("Unsupported host key fingerprint format")))
 				})
 			})
+
+			Context("when a known hosts store is provided", func() {
+				var (
+					fakeKnownHosts      *sshfakes.FakeKnownHosts
+					fakeHostKeyPrompter *sshfakes.FakeHostKeyPrompter
+
+					pinnedFingerprint string
+					addedFingerprints []string
+				)
+
+				BeforeEach(func() {
+					fakeKnownHosts = new(sshfakes.FakeKnownHosts)
+					fakeHostKeyPrompter = new(sshfakes.FakeHostKeyPrompter)
+					knownHosts = fakeKnownHosts
+					hostKeyPrompter = fakeHostKeyPrompter
+
+					pinnedFingerprint = ""
+					addedFingerprints = nil
+					fakeKnownHosts.PinStub = func(sshEndpoint string, trust func() (string, error)) (string, error) {
+						if pinnedFingerprint != "" {
+							return pinnedFingerprint, nil
+						}
+
+						fingerprint, err := trust()
+						if err != nil {
+							return "", err
+						}
+						addedFingerprints = append(addedFingerprints, fingerprint)
+						return fingerprint, nil
+					}
+
+					sshEndpointFingerprint = "41:ce:56:e6:9c:42:a9:c6:9e:68:ac:e3:4d:f6:38:79"
+				})
+
+				Context("when the host has not been seen before", func() {
+					It("verifies against the advertised fingerprint and pins the SHA256 fingerprint", func() {
+						err := callback("", addr, TestHostKey.PublicKey())
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeKnownHosts.PinCallCount()).To(Equal(1))
+						endpoint, _ := fakeKnownHosts.PinArgsForCall(0)
+						Expect(endpoint).To(Equal("ssh.example.com:22"))
+						Expect(addedFingerprints).To(ConsistOf("SHA256:sp/jrLuj66r+yrLDUKZdJU5tdzt4mq/UaSiNBjpgr+8"))
+					})
+
+					Context("when the advertised fingerprint does not match", func() {
+						BeforeEach(func() {
+							sshEndpointFingerprint = "00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00"
+						})
+
+						It("does not pin the key", func() {
+							err := callback("", addr, TestHostKey.PublicKey())
+							Expect(err).To(MatchError(MatchRegexp("Host key verification failed\\.")))
+							Expect(addedFingerprints).To(BeEmpty())
+						})
+					})
+
+					Context("when no fingerprint is advertised", func() {
+						BeforeEach(func() {
+							sshEndpointFingerprint = ""
+						})
+
+						It("asks the user to trust the key", func() {
+							fakeHostKeyPrompter.ConfirmReturns(true)
+
+							err := callback("", addr, TestHostKey.PublicKey())
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(fakeHostKeyPrompter.ConfirmCallCount()).To(Equal(1))
+							Expect(fakeHostKeyPrompter.ConfirmArgsForCall(0)).To(ContainSubstring("SHA256:sp/jrLuj66r+yrLDUKZdJU5tdzt4mq/UaSiNBjpgr+8"))
+							Expect(addedFingerprints).To(HaveLen(1))
+						})
+
+						It("fails when the user does not trust the key", func() {
+							fakeHostKeyPrompter.ConfirmReturns(false)
+
+							err := callback("", addr, TestHostKey.PublicKey())
+							Expect(err).To(MatchError("Host key verification failed."))
+							Expect(addedFingerprints).To(BeEmpty())
+						})
+					})
+				})
+
+				Context("when the SHA256 fingerprint pinned for the host matches", func() {
+					BeforeEach(func() {
+						pinnedFingerprint = "SHA256:sp/jrLuj66r+yrLDUKZdJU5tdzt4mq/UaSiNBjpgr+8"
+					})
+
+					It("accepts the key without pinning it again", func() {
+						err := callback("", addr, TestHostKey.PublicKey())
+						Expect(err).NotTo(HaveOccurred())
+						Expect(addedFingerprints).To(BeEmpty())
+					})
+				})
+
+				Context("when the MD5 fingerprint pinned for the host matches", func() {
+					BeforeEach(func() {
+						pinnedFingerprint = "41:ce:56:e6:9c:42:a9:c6:9e:68:ac:e3:4d:f6:38:79"
+					})
+
+					It("accepts the key", func() {
+						err := callback("", addr, TestHostKey.PublicKey())
+						Expect(err).NotTo(HaveOccurred())
+					})
+				})
+
+				Context("when the fingerprint pinned for the host has changed", func() {
+					BeforeEach(func() {
+						pinnedFingerprint = "SHA256:0000000000000000000000000000000000000000000"
+					})
+
+					It("warns the user and fails even if the advertised fingerprint matches", func() {
+						err := callback("", addr, TestHostKey.PublicKey())
+						Expect(err).To(MatchError(MatchRegexp("Host key verification failed\\.")))
+
+						Expect(fakeHostKeyPrompter.WarnCallCount()).To(Equal(1))
+						message, _ := fakeHostKeyPrompter.WarnArgsForCall(0)
+						Expect(message).To(ContainSubstring("has changed"))
+						Expect(addedFingerprints).To(BeEmpty())
+					})
+				})
+
+				Context("when the known hosts store fails", func() {
+					BeforeEach(func() {
+						fakeKnownHosts.PinStub = nil
+						fakeKnownHosts.PinReturns("", errors.New("Unable to read known hosts: permission denied"))
+					})
+
+					It("returns the error", func() {
+						err := callback("", addr, TestHostKey.PublicKey())
+						Expect(err).To(MatchError("Unable to read known hosts: permission denied"))
+					})
+				})
+			})
 		})
 
 		Context("when the skip host validation flag is set", func() {
@@ -558,6 +704,67 @@ var _ = Describe("SSH", func() {
 			})
 		})
 
+		Context("when recording is requested", func() {
+			var (
+				recordDir string
+				stderr    *gbytes.Buffer
+			)
+
+			BeforeEach(func() {
+				var err error
+				recordDir, err = ioutil.TempDir("", "ssh-recordings")
+				Expect(err).NotTo(HaveOccurred())
+				opts.RecordDir = filepath.Join(recordDir, "sessions")
+
+				stdin := ioutil.NopCloser(strings.NewReader("whoami\n"))
+				stderr = gbytes.NewBuffer()
+
+				fakeTerminalHelper.GetFdInfoStub = terminalHelper.GetFdInfo
+				fakeTerminalHelper.StdStreamsReturns(stdin, gbytes.NewBuffer(), stderr)
+				fakeTerminalHelper.GetWinsizeReturns(&term.Winsize{Width: 80, Height: 24}, nil)
+				terminalHelper = fakeTerminalHelper
+
+				// produce output only once all of stdin has been sent, so the
+				// events are recorded in a predictable order
+				stdinClosed := make(chan struct{})
+				stdinPipe.CloseStub = func() error {
+					close(stdinClosed)
+					return nil
+				}
+				stdout := strings.NewReader("hello from the container")
+				stdoutPipe := &fake_io.FakeReader{}
+				stdoutPipe.ReadStub = func(p []byte) (int, error) {
+					<-stdinClosed
+					return stdout.Read(p)
+				}
+				fakeSecureSession.StdoutPipeReturns(stdoutPipe, nil)
+			})
+
+			AfterEach(func() {
+				os.RemoveAll(recordDir)
+			})
+
+			It("writes the session input and output to an asciinema recording", func() {
+				Expect(sessionError).NotTo(HaveOccurred())
+
+				files, err := filepath.Glob(filepath.Join(recordDir, "sessions", "app-name-2-*.cast"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(files).To(HaveLen(1))
+
+				contents, err := ioutil.ReadFile(files[0])
+				Expect(err).NotTo(HaveOccurred())
+
+				lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+				Expect(lines).To(HaveLen(3))
+				Expect(lines[0]).To(ContainSubstring(`"version":2`))
+				Expect(lines[0]).To(ContainSubstring(`"title":"cf ssh app-name -i 2"`))
+				Expect(lines[1]).To(MatchRegexp(`^\[[0-9.e-]+,"i","whoami\\n"\]$`))
+				Expect(lines[2]).To(MatchRegexp(`^\[[0-9.e-]+,"o","hello from the container"\]$`))
+
+				Expect(stderr).To(gbytes.Say("Recording session to %s", regexp.QuoteMeta(files[0])))
+			})
+		})
+
 		Context("when a terminal is forced", func() {
 			BeforeEach(func() {
 				opts.TerminalRequest = options.RequestTTYForce
@@ -1165,6 +1372,525 @@ var _ = Describe("SSH", func() {
 		})
 	})
 
+	Describe("RemotePortForward", func() {
+		var (
+			opts               *options.SSHOptions
+			remoteForwardError error
+
+			echoAddress  string
+			echoListener net.Listener
+
+			remoteAddress  string
+			remoteListener net.Listener
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			echoAddress = echoListener.Addr().String()
+
+			go func(listener net.Listener) {
+				for {
+					conn, acceptErr := listener.Accept()
+					if acceptErr != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn)
+						conn.Close()
+					}()
+				}
+			}(echoListener)
+
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			remoteAddress = remoteListener.Addr().String()
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			opts = &options.SSHOptions{
+				AppName: "app-1",
+				RemoteForwardSpecs: []options.ForwardSpec{{
+					ListenAddress:  "localhost:9999",
+					ConnectAddress: echoAddress,
+				}},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			remoteForwardError = secureShell.RemotePortForward()
+		})
+
+		AfterEach(func() {
+			err := secureShell.Close()
+			Expect(err).NotTo(HaveOccurred())
+
+			echoListener.Close()
+		})
+
+		It("asks the secure client to listen on the remote listen address", func() {
+			Expect(remoteForwardError).NotTo(HaveOccurred())
+
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:9999"))
+		})
+
+		It("copies data between the remote connection and the local connect address", func() {
+			conn, err := net.Dial("tcp", remoteAddress)
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			msg := "Hello from the container\n"
+			_, err = conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+		})
+
+		Context("when the secure shell is closed", func() {
+			It("closes the remote listeners", func() {
+				Expect(secureShell.Close()).To(Succeed())
+
+				_, err := remoteListener.Accept()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("when listen fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(remoteForwardError).To(MatchError("tcpip-forward request denied by peer"))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", func() {
+		var (
+			opts                *options.SSHOptions
+			dynamicForwardError error
+
+			echoAddress  string
+			echoListener net.Listener
+
+			proxyAddress string
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			echoAddress = echoListener.Addr().String()
+
+			go func(listener net.Listener) {
+				for {
+					conn, acceptErr := listener.Accept()
+					if acceptErr != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn)
+						conn.Close()
+					}()
+				}
+			}(echoListener)
+
+			proxyListener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			proxyAddress = proxyListener.Addr().String()
+			fakeListenerFactory.ListenReturns(proxyListener, nil)
+
+			fakeSecureClient.DialStub = net.Dial
+
+			opts = &options.SSHOptions{
+				AppName:                 "app-1",
+				DynamicForwardAddresses: []string{"localhost:1080"},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			dynamicForwardError = secureShell.DynamicPortForward()
+		})
+
+		AfterEach(func() {
+			err := secureShell.Close()
+			Expect(err).NotTo(HaveOccurred())
+
+			echoListener.Close()
+		})
+
+		socksConnect := func(request []byte) (net.Conn, []byte) {
+			conn, err := net.Dial("tcp", proxyAddress)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = conn.Write([]byte{0x05, 0x01, 0x00})
+			Expect(err).NotTo(HaveOccurred())
+
+			method := make([]byte, 2)
+			_, err = io.ReadFull(conn, method)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(method).To(Equal([]byte{0x05, 0x00}))
+
+			_, err = conn.Write(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			reply := make([]byte, 10)
+			_, err = io.ReadFull(conn, reply)
+			Expect(err).NotTo(HaveOccurred())
+
+			return conn, reply
+		}
+
+		connectRequest := func(addressType byte, host []byte, port int) []byte {
+			request := []byte{0x05, 0x01, 0x00, addressType}
+			request = append(request, host...)
+			return append(request, byte(port>>8), byte(port))
+		}
+
+		It("listens on the dynamic forward address", func() {
+			Expect(dynamicForwardError).NotTo(HaveOccurred())
+
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+		})
+
+		Context("when the client requests an IPv4 address", func() {
+			It("dials the requested address through the secure client and copies data", func() {
+				echoTCPAddress := echoListener.Addr().(*net.TCPAddr)
+				conn, reply := socksConnect(connectRequest(0x01, echoTCPAddress.IP.To4(), echoTCPAddress.Port))
+				defer conn.Close()
+
+				Expect(reply[1]).To(Equal(byte(0x00)))
+
+				Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+				network, addr := fakeSecureClient.DialArgsForCall(0)
+				Expect(network).To(Equal("tcp"))
+				Expect(addr).To(Equal(echoAddress))
+
+				msg := "Hello through the proxy\n"
+				_, err := conn.Write([]byte(msg))
+				Expect(err).NotTo(HaveOccurred())
+
+				response := make([]byte, len(msg))
+				_, err = io.ReadFull(conn, response)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(response)).To(Equal(msg))
+			})
+		})
+
+		Context("when the client requests a domain name", func() {
+			BeforeEach(func() {
+				fakeSecureClient.DialStub = nil
+				fakeSecureClient.DialReturns(nil, errors.New("no such host"))
+			})
+
+			It("dials the domain name through the secure client", func() {
+				host := []byte("internal.apps.local")
+				conn, reply := socksConnect(connectRequest(0x03, append([]byte{byte(len(host))}, host...), 8080))
+				defer conn.Close()
+
+				Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+				_, addr := fakeSecureClient.DialArgsForCall(0)
+				Expect(addr).To(Equal("internal.apps.local:8080"))
+
+				Expect(reply[1]).To(Equal(byte(0x04)))
+			})
+		})
+
+		Context("when the client requests an unsupported command", func() {
+			It("replies with command not supported", func() {
+				request := connectRequest(0x01, []byte{127, 0, 0, 1}, 80)
+				request[1] = 0x02
+				conn, reply := socksConnect(request)
+				defer conn.Close()
+
+				Expect(reply[1]).To(Equal(byte(0x07)))
+				Expect(fakeSecureClient.DialCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when listen fails", func() {
+			BeforeEach(func() {
+				fakeListenerFactory.ListenReturns(nil, errors.New("failure is an option"))
+			})
+
+			It("returns the error", func() {
+				Expect(dynamicForwardError).To(MatchError("failure is an option"))
+			})
+		})
+	})
+
+	Describe("ExecuteCommand", func() {
+		var (
+			opts           *options.SSHOptions
+			stdout, stderr *gbytes.Buffer
+			executeError   error
+		)
+
+		BeforeEach(func() {
+			opts = &options.SSHOptions{
+				AppName: "app-1",
+				Command: []string{"cat", "/proc/loadavg"},
+			}
+			stdout = gbytes.NewBuffer()
+			stderr = gbytes.NewBuffer()
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+
+			fakeSecureSession.StdoutPipeReturns(strings.NewReader("0.01 0.02 0.03"), nil)
+			fakeSecureSession.StderrPipeReturns(strings.NewReader("warning"), nil)
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			executeError = secureShell.ExecuteCommand(stdout, stderr)
+		})
+
+		It("starts the command without requesting a pty", func() {
+			Expect(executeError).NotTo(HaveOccurred())
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("cat /proc/loadavg"))
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StdinPipeCallCount()).To(Equal(0))
+		})
+
+		It("copies the session output to the provided writers", func() {
+			Expect(stdout).To(gbytes.Say("0.01 0.02 0.03"))
+			Expect(stderr).To(gbytes.Say("warning"))
+		})
+
+		It("waits for the session and closes it", func() {
+			Expect(fakeSecureSession.WaitCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		Context("when the session returns an error", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("exit status 2"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeError).To(MatchError("exit status 2"))
+			})
+		})
+
+		Context("when the command cannot be started", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StartReturns(errors.New("start failed"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeError).To(MatchError("start failed"))
+			})
+		})
+	})
+
+	Describe("Copy", func() {
+		var (
+			opts      *options.SCPOptions
+			progress  sshCmd.ProgressWrapper
+			copyError error
+
+			localDir  string
+			remoteDir string
+
+			sftpServer *sftp.Server
+		)
+
+		BeforeEach(func() {
+			var err error
+			localDir, err = ioutil.TempDir("", "scp-local")
+			Expect(err).NotTo(HaveOccurred())
+			remoteDir, err = ioutil.TempDir("", "scp-remote")
+			Expect(err).NotTo(HaveOccurred())
+
+			clientReader, serverWriter := io.Pipe()
+			serverReader, clientWriter := io.Pipe()
+
+			server, err := sftp.NewServer(struct {
+				io.Reader
+				io.WriteCloser
+			}{serverReader, serverWriter})
+			Expect(err).NotTo(HaveOccurred())
+			go func() {
+				server.Serve()
+				serverWriter.Close()
+			}()
+			sftpServer = server
+
+			fakeSecureSession.StdinPipeReturns(clientWriter, nil)
+			fakeSecureSession.StdoutPipeReturns(clientReader, nil)
+
+			progress = nil
+
+			opts = &options.SCPOptions{
+				SSHOptions: options.SSHOptions{AppName: "app-1"},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(&opts.SSHOptions)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			copyError = secureShell.Copy(opts, progress)
+		})
+
+		AfterEach(func() {
+			secureShell.Close()
+			sftpServer.Close()
+			os.RemoveAll(localDir)
+			os.RemoveAll(remoteDir)
+		})
+
+		It("requests the sftp subsystem", func() {
+			Expect(fakeSecureClient.NewSessionCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.RequestSubsystemCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.RequestSubsystemArgsForCall(0)).To(Equal("sftp"))
+		})
+
+		Context("when session allocation fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.NewSessionReturns(nil, errors.New("no session"))
+			})
+
+			It("returns an error", func() {
+				Expect(copyError).To(MatchError("SSH session allocation failed: no session"))
+			})
+		})
+
+		Context("when the sftp subsystem request fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.RequestSubsystemReturns(errors.New("denied"))
+			})
+
+			It("returns an error", func() {
+				Expect(copyError).To(MatchError("SFTP subsystem request failed: denied"))
+			})
+		})
+
+		Context("when copying a file to the container", func() {
+			var wrappedSizes []int64
+
+			BeforeEach(func() {
+				err := ioutil.WriteFile(filepath.Join(localDir, "hello.txt"), []byte("hello world"), 0640)
+				Expect(err).NotTo(HaveOccurred())
+
+				opts.Direction = options.CopyToContainer
+				opts.LocalPath = filepath.Join(localDir, "hello.txt")
+				opts.RemotePath = remoteDir
+
+				wrappedSizes = nil
+				progress = func(source io.ReadSeeker, size int64) io.Reader {
+					wrappedSizes = append(wrappedSizes, size)
+					return source
+				}
+			})
+
+			It("uploads the file into the remote directory", func() {
+				Expect(copyError).NotTo(HaveOccurred())
+
+				contents, err := ioutil.ReadFile(filepath.Join(remoteDir, "hello.txt"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("hello world"))
+			})
+
+			It("reports progress with the size of the file", func() {
+				Expect(wrappedSizes).To(Equal([]int64{11}))
+			})
+		})
+
+		Context("when copying a directory to the container", func() {
+			BeforeEach(func() {
+				err := os.MkdirAll(filepath.Join(localDir, "src", "nested"), 0755)
+				Expect(err).NotTo(HaveOccurred())
+				err = ioutil.WriteFile(filepath.Join(localDir, "src", "nested", "file.txt"), []byte("nested"), 0644)
+				Expect(err).NotTo(HaveOccurred())
+
+				opts.Direction = options.CopyToContainer
+				opts.LocalPath = filepath.Join(localDir, "src")
+				opts.RemotePath = filepath.Join(remoteDir, "dest")
+			})
+
+			Context("without the recursive option", func() {
+				It("returns an error", func() {
+					Expect(copyError).To(MatchError(ContainSubstring("is a directory")))
+				})
+			})
+
+			Context("with the recursive option", func() {
+				BeforeEach(func() {
+					opts.Recursive = true
+				})
+
+				It("uploads the directory tree", func() {
+					Expect(copyError).NotTo(HaveOccurred())
+
+					contents, err := ioutil.ReadFile(filepath.Join(remoteDir, "dest", "nested", "file.txt"))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(contents)).To(Equal("nested"))
+				})
+			})
+		})
+
+		Context("when copying a file from the container", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(filepath.Join(remoteDir, "remote.txt"), []byte("from the container"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+
+				opts.Direction = options.CopyFromContainer
+				opts.RemotePath = filepath.Join(remoteDir, "remote.txt")
+				opts.LocalPath = filepath.Join(localDir, "local.txt")
+			})
+
+			It("downloads the file", func() {
+				Expect(copyError).NotTo(HaveOccurred())
+
+				contents, err := ioutil.ReadFile(filepath.Join(localDir, "local.txt"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("from the container"))
+			})
+		})
+
+		Context("when the remote file does not exist", func() {
+			BeforeEach(func() {
+				opts.Direction = options.CopyFromContainer
+				opts.RemotePath = filepath.Join(remoteDir, "missing.txt")
+				opts.LocalPath = localDir
+			})
+
+			It("returns an error", func() {
+				Expect(copyError).To(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var opts *options.SSHOptions
 		var waitErr error
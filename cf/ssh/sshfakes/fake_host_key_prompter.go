@@ -0,0 +1,127 @@
+// This file was generated by counterfeiter
+package sshfakes
+
+import (
+	"sync"
+
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+)
+
+type FakeHostKeyPrompter struct {
+	WarnStub        func(message string, args ...interface{})
+	warnMutex       sync.RWMutex
+	warnArgsForCall []struct {
+		message string
+		args    []interface{}
+	}
+	ConfirmStub        func(message string) bool
+	confirmMutex       sync.RWMutex
+	confirmArgsForCall []struct {
+		message string
+	}
+	confirmReturns struct {
+		result1 bool
+	}
+	confirmReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeHostKeyPrompter) Warn(message string, args ...interface{}) {
+	fake.warnMutex.Lock()
+	fake.warnArgsForCall = append(fake.warnArgsForCall, struct {
+		message string
+		args    []interface{}
+	}{message, args})
+	fake.recordInvocation("Warn", []interface{}{message, args})
+	fake.warnMutex.Unlock()
+	if fake.WarnStub != nil {
+		fake.WarnStub(message, args...)
+	}
+}
+
+func (fake *FakeHostKeyPrompter) WarnCallCount() int {
+	fake.warnMutex.RLock()
+	defer fake.warnMutex.RUnlock()
+	return len(fake.warnArgsForCall)
+}
+
+func (fake *FakeHostKeyPrompter) WarnArgsForCall(i int) (string, []interface{}) {
+	fake.warnMutex.RLock()
+	defer fake.warnMutex.RUnlock()
+	return fake.warnArgsForCall[i].message, fake.warnArgsForCall[i].args
+}
+
+func (fake *FakeHostKeyPrompter) Confirm(message string) bool {
+	fake.confirmMutex.Lock()
+	ret, specificReturn := fake.confirmReturnsOnCall[len(fake.confirmArgsForCall)]
+	fake.confirmArgsForCall = append(fake.confirmArgsForCall, struct {
+		message string
+	}{message})
+	fake.recordInvocation("Confirm", []interface{}{message})
+	fake.confirmMutex.Unlock()
+	if fake.ConfirmStub != nil {
+		return fake.ConfirmStub(message)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.confirmReturns.result1
+}
+
+func (fake *FakeHostKeyPrompter) ConfirmCallCount() int {
+	fake.confirmMutex.RLock()
+	defer fake.confirmMutex.RUnlock()
+	return len(fake.confirmArgsForCall)
+}
+
+func (fake *FakeHostKeyPrompter) ConfirmArgsForCall(i int) string {
+	fake.confirmMutex.RLock()
+	defer fake.confirmMutex.RUnlock()
+	return fake.confirmArgsForCall[i].message
+}
+
+func (fake *FakeHostKeyPrompter) ConfirmReturns(result1 bool) {
+	fake.ConfirmStub = nil
+	fake.confirmReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeHostKeyPrompter) ConfirmReturnsOnCall(i int, result1 bool) {
+	fake.ConfirmStub = nil
+	if fake.confirmReturnsOnCall == nil {
+		fake.confirmReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.confirmReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeHostKeyPrompter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.warnMutex.RLock()
+	defer fake.warnMutex.RUnlock()
+	fake.confirmMutex.RLock()
+	defer fake.confirmMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeHostKeyPrompter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ sshCmd.HostKeyPrompter = new(FakeHostKeyPrompter)
@@ -0,0 +1,101 @@
+// This file was generated by counterfeiter
+package sshfakes
+
+import (
+	"sync"
+
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+)
+
+type FakeKnownHosts struct {
+	PinStub        func(sshEndpoint string, trust func() (string, error)) (string, error)
+	pinMutex       sync.RWMutex
+	pinArgsForCall []struct {
+		sshEndpoint string
+		trust       func() (string, error)
+	}
+	pinReturns struct {
+		result1 string
+		result2 error
+	}
+	pinReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeKnownHosts) Pin(sshEndpoint string, trust func() (string, error)) (string, error) {
+	fake.pinMutex.Lock()
+	ret, specificReturn := fake.pinReturnsOnCall[len(fake.pinArgsForCall)]
+	fake.pinArgsForCall = append(fake.pinArgsForCall, struct {
+		sshEndpoint string
+		trust       func() (string, error)
+	}{sshEndpoint, trust})
+	fake.recordInvocation("Pin", []interface{}{sshEndpoint, trust})
+	fake.pinMutex.Unlock()
+	if fake.PinStub != nil {
+		return fake.PinStub(sshEndpoint, trust)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.pinReturns.result1, fake.pinReturns.result2
+}
+
+func (fake *FakeKnownHosts) PinCallCount() int {
+	fake.pinMutex.RLock()
+	defer fake.pinMutex.RUnlock()
+	return len(fake.pinArgsForCall)
+}
+
+func (fake *FakeKnownHosts) PinArgsForCall(i int) (string, func() (string, error)) {
+	fake.pinMutex.RLock()
+	defer fake.pinMutex.RUnlock()
+	return fake.pinArgsForCall[i].sshEndpoint, fake.pinArgsForCall[i].trust
+}
+
+func (fake *FakeKnownHosts) PinReturns(result1 string, result2 error) {
+	fake.PinStub = nil
+	fake.pinReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKnownHosts) PinReturnsOnCall(i int, result1 string, result2 error) {
+	fake.PinStub = nil
+	if fake.pinReturnsOnCall == nil {
+		fake.pinReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.pinReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKnownHosts) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pinMutex.RLock()
+	defer fake.pinMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeKnownHosts) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ sshCmd.KnownHosts = new(FakeKnownHosts)
@@ -10,7 +10,7 @@ import (
 )
 
 type FakeSecureSession struct {
-	RequestPtyStub        func(term string, height, width int, termModes ssh.TerminalModes) error
+	RequestPtyStub        func(term string, height int, width int, termModes ssh.TerminalModes) error
 	requestPtyMutex       sync.RWMutex
 	requestPtyArgsForCall []struct {
 		term      string
@@ -21,6 +21,9 @@ type FakeSecureSession struct {
 	requestPtyReturns struct {
 		result1 error
 	}
+	requestPtyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SendRequestStub        func(name string, wantReply bool, payload []byte) (bool, error)
 	sendRequestMutex       sync.RWMutex
 	sendRequestArgsForCall []struct {
@@ -32,6 +35,21 @@ type FakeSecureSession struct {
 		result1 bool
 		result2 error
 	}
+	sendRequestReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	RequestSubsystemStub        func(subsystem string) error
+	requestSubsystemMutex       sync.RWMutex
+	requestSubsystemArgsForCall []struct {
+		subsystem string
+	}
+	requestSubsystemReturns struct {
+		result1 error
+	}
+	requestSubsystemReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StdinPipeStub        func() (io.WriteCloser, error)
 	stdinPipeMutex       sync.RWMutex
 	stdinPipeArgsForCall []struct{}
@@ -39,6 +57,10 @@ type FakeSecureSession struct {
 		result1 io.WriteCloser
 		result2 error
 	}
+	stdinPipeReturnsOnCall map[int]struct {
+		result1 io.WriteCloser
+		result2 error
+	}
 	StdoutPipeStub        func() (io.Reader, error)
 	stdoutPipeMutex       sync.RWMutex
 	stdoutPipeArgsForCall []struct{}
@@ -46,6 +68,10 @@ type FakeSecureSession struct {
 		result1 io.Reader
 		result2 error
 	}
+	stdoutPipeReturnsOnCall map[int]struct {
+		result1 io.Reader
+		result2 error
+	}
 	StderrPipeStub        func() (io.Reader, error)
 	stderrPipeMutex       sync.RWMutex
 	stderrPipeArgsForCall []struct{}
@@ -53,6 +79,10 @@ type FakeSecureSession struct {
 		result1 io.Reader
 		result2 error
 	}
+	stderrPipeReturnsOnCall map[int]struct {
+		result1 io.Reader
+		result2 error
+	}
 	StartStub        func(command string) error
 	startMutex       sync.RWMutex
 	startArgsForCall []struct {
@@ -61,30 +91,43 @@ type FakeSecureSession struct {
 	startReturns struct {
 		result1 error
 	}
+	startReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ShellStub        func() error
 	shellMutex       sync.RWMutex
 	shellArgsForCall []struct{}
 	shellReturns     struct {
 		result1 error
 	}
+	shellReturnsOnCall map[int]struct {
+		result1 error
+	}
 	WaitStub        func() error
 	waitMutex       sync.RWMutex
 	waitArgsForCall []struct{}
 	waitReturns     struct {
 		result1 error
 	}
+	waitReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CloseStub        func() error
 	closeMutex       sync.RWMutex
 	closeArgsForCall []struct{}
 	closeReturns     struct {
 		result1 error
 	}
+	closeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
 func (fake *FakeSecureSession) RequestPty(term string, height int, width int, termModes ssh.TerminalModes) error {
 	fake.requestPtyMutex.Lock()
+	ret, specificReturn := fake.requestPtyReturnsOnCall[len(fake.requestPtyArgsForCall)]
 	fake.requestPtyArgsForCall = append(fake.requestPtyArgsForCall, struct {
 		term      string
 		height    int
@@ -95,9 +138,11 @@ func (fake *FakeSecureSession) RequestPty(term string, height int, width int, te
 	fake.requestPtyMutex.Unlock()
 	if fake.RequestPtyStub != nil {
 		return fake.RequestPtyStub(term, height, width, termModes)
-	} else {
-		return fake.requestPtyReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.requestPtyReturns.result1
 }
 
 func (fake *FakeSecureSession) RequestPtyCallCount() int {
@@ -119,6 +164,18 @@ func (fake *FakeSecureSession) RequestPtyReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureSession) RequestPtyReturnsOnCall(i int, result1 error) {
+	fake.RequestPtyStub = nil
+	if fake.requestPtyReturnsOnCall == nil {
+		fake.requestPtyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestPtyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureSession) SendRequest(name string, wantReply bool, payload []byte) (bool, error) {
 	var payloadCopy []byte
 	if payload != nil {
@@ -126,6 +183,7 @@ func (fake *FakeSecureSession) SendRequest(name string, wantReply bool, payload
 		copy(payloadCopy, payload)
 	}
 	fake.sendRequestMutex.Lock()
+	ret, specificReturn := fake.sendRequestReturnsOnCall[len(fake.sendRequestArgsForCall)]
 	fake.sendRequestArgsForCall = append(fake.sendRequestArgsForCall, struct {
 		name      string
 		wantReply bool
@@ -135,9 +193,11 @@ func (fake *FakeSecureSession) SendRequest(name string, wantReply bool, payload
 	fake.sendRequestMutex.Unlock()
 	if fake.SendRequestStub != nil {
 		return fake.SendRequestStub(name, wantReply, payload)
-	} else {
-		return fake.sendRequestReturns.result1, fake.sendRequestReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.sendRequestReturns.result1, fake.sendRequestReturns.result2
 }
 
 func (fake *FakeSecureSession) SendRequestCallCount() int {
@@ -160,16 +220,81 @@ func (fake *FakeSecureSession) SendRequestReturns(result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeSecureSession) SendRequestReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.SendRequestStub = nil
+	if fake.sendRequestReturnsOnCall == nil {
+		fake.sendRequestReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.sendRequestReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecureSession) RequestSubsystem(subsystem string) error {
+	fake.requestSubsystemMutex.Lock()
+	ret, specificReturn := fake.requestSubsystemReturnsOnCall[len(fake.requestSubsystemArgsForCall)]
+	fake.requestSubsystemArgsForCall = append(fake.requestSubsystemArgsForCall, struct {
+		subsystem string
+	}{subsystem})
+	fake.recordInvocation("RequestSubsystem", []interface{}{subsystem})
+	fake.requestSubsystemMutex.Unlock()
+	if fake.RequestSubsystemStub != nil {
+		return fake.RequestSubsystemStub(subsystem)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.requestSubsystemReturns.result1
+}
+
+func (fake *FakeSecureSession) RequestSubsystemCallCount() int {
+	fake.requestSubsystemMutex.RLock()
+	defer fake.requestSubsystemMutex.RUnlock()
+	return len(fake.requestSubsystemArgsForCall)
+}
+
+func (fake *FakeSecureSession) RequestSubsystemArgsForCall(i int) string {
+	fake.requestSubsystemMutex.RLock()
+	defer fake.requestSubsystemMutex.RUnlock()
+	return fake.requestSubsystemArgsForCall[i].subsystem
+}
+
+func (fake *FakeSecureSession) RequestSubsystemReturns(result1 error) {
+	fake.RequestSubsystemStub = nil
+	fake.requestSubsystemReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureSession) RequestSubsystemReturnsOnCall(i int, result1 error) {
+	fake.RequestSubsystemStub = nil
+	if fake.requestSubsystemReturnsOnCall == nil {
+		fake.requestSubsystemReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestSubsystemReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureSession) StdinPipe() (io.WriteCloser, error) {
 	fake.stdinPipeMutex.Lock()
+	ret, specificReturn := fake.stdinPipeReturnsOnCall[len(fake.stdinPipeArgsForCall)]
 	fake.stdinPipeArgsForCall = append(fake.stdinPipeArgsForCall, struct{}{})
 	fake.recordInvocation("StdinPipe", []interface{}{})
 	fake.stdinPipeMutex.Unlock()
 	if fake.StdinPipeStub != nil {
 		return fake.StdinPipeStub()
-	} else {
-		return fake.stdinPipeReturns.result1, fake.stdinPipeReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.stdinPipeReturns.result1, fake.stdinPipeReturns.result2
 }
 
 func (fake *FakeSecureSession) StdinPipeCallCount() int {
@@ -186,16 +311,33 @@ func (fake *FakeSecureSession) StdinPipeReturns(result1 io.WriteCloser, result2
 	}{result1, result2}
 }
 
+func (fake *FakeSecureSession) StdinPipeReturnsOnCall(i int, result1 io.WriteCloser, result2 error) {
+	fake.StdinPipeStub = nil
+	if fake.stdinPipeReturnsOnCall == nil {
+		fake.stdinPipeReturnsOnCall = make(map[int]struct {
+			result1 io.WriteCloser
+			result2 error
+		})
+	}
+	fake.stdinPipeReturnsOnCall[i] = struct {
+		result1 io.WriteCloser
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSecureSession) StdoutPipe() (io.Reader, error) {
 	fake.stdoutPipeMutex.Lock()
+	ret, specificReturn := fake.stdoutPipeReturnsOnCall[len(fake.stdoutPipeArgsForCall)]
 	fake.stdoutPipeArgsForCall = append(fake.stdoutPipeArgsForCall, struct{}{})
 	fake.recordInvocation("StdoutPipe", []interface{}{})
 	fake.stdoutPipeMutex.Unlock()
 	if fake.StdoutPipeStub != nil {
 		return fake.StdoutPipeStub()
-	} else {
-		return fake.stdoutPipeReturns.result1, fake.stdoutPipeReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.stdoutPipeReturns.result1, fake.stdoutPipeReturns.result2
 }
 
 func (fake *FakeSecureSession) StdoutPipeCallCount() int {
@@ -212,16 +354,33 @@ func (fake *FakeSecureSession) StdoutPipeReturns(result1 io.Reader, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeSecureSession) StdoutPipeReturnsOnCall(i int, result1 io.Reader, result2 error) {
+	fake.StdoutPipeStub = nil
+	if fake.stdoutPipeReturnsOnCall == nil {
+		fake.stdoutPipeReturnsOnCall = make(map[int]struct {
+			result1 io.Reader
+			result2 error
+		})
+	}
+	fake.stdoutPipeReturnsOnCall[i] = struct {
+		result1 io.Reader
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSecureSession) StderrPipe() (io.Reader, error) {
 	fake.stderrPipeMutex.Lock()
+	ret, specificReturn := fake.stderrPipeReturnsOnCall[len(fake.stderrPipeArgsForCall)]
 	fake.stderrPipeArgsForCall = append(fake.stderrPipeArgsForCall, struct{}{})
 	fake.recordInvocation("StderrPipe", []interface{}{})
 	fake.stderrPipeMutex.Unlock()
 	if fake.StderrPipeStub != nil {
 		return fake.StderrPipeStub()
-	} else {
-		return fake.stderrPipeReturns.result1, fake.stderrPipeReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.stderrPipeReturns.result1, fake.stderrPipeReturns.result2
 }
 
 func (fake *FakeSecureSession) StderrPipeCallCount() int {
@@ -238,8 +397,23 @@ func (fake *FakeSecureSession) StderrPipeReturns(result1 io.Reader, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeSecureSession) StderrPipeReturnsOnCall(i int, result1 io.Reader, result2 error) {
+	fake.StderrPipeStub = nil
+	if fake.stderrPipeReturnsOnCall == nil {
+		fake.stderrPipeReturnsOnCall = make(map[int]struct {
+			result1 io.Reader
+			result2 error
+		})
+	}
+	fake.stderrPipeReturnsOnCall[i] = struct {
+		result1 io.Reader
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSecureSession) Start(command string) error {
 	fake.startMutex.Lock()
+	ret, specificReturn := fake.startReturnsOnCall[len(fake.startArgsForCall)]
 	fake.startArgsForCall = append(fake.startArgsForCall, struct {
 		command string
 	}{command})
@@ -247,9 +421,11 @@ func (fake *FakeSecureSession) Start(command string) error {
 	fake.startMutex.Unlock()
 	if fake.StartStub != nil {
 		return fake.StartStub(command)
-	} else {
-		return fake.startReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.startReturns.result1
 }
 
 func (fake *FakeSecureSession) StartCallCount() int {
@@ -271,16 +447,31 @@ func (fake *FakeSecureSession) StartReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureSession) StartReturnsOnCall(i int, result1 error) {
+	fake.StartStub = nil
+	if fake.startReturnsOnCall == nil {
+		fake.startReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.startReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureSession) Shell() error {
 	fake.shellMutex.Lock()
+	ret, specificReturn := fake.shellReturnsOnCall[len(fake.shellArgsForCall)]
 	fake.shellArgsForCall = append(fake.shellArgsForCall, struct{}{})
 	fake.recordInvocation("Shell", []interface{}{})
 	fake.shellMutex.Unlock()
 	if fake.ShellStub != nil {
 		return fake.ShellStub()
-	} else {
-		return fake.shellReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.shellReturns.result1
 }
 
 func (fake *FakeSecureSession) ShellCallCount() int {
@@ -296,16 +487,31 @@ func (fake *FakeSecureSession) ShellReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureSession) ShellReturnsOnCall(i int, result1 error) {
+	fake.ShellStub = nil
+	if fake.shellReturnsOnCall == nil {
+		fake.shellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.shellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureSession) Wait() error {
 	fake.waitMutex.Lock()
+	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
 	fake.waitArgsForCall = append(fake.waitArgsForCall, struct{}{})
 	fake.recordInvocation("Wait", []interface{}{})
 	fake.waitMutex.Unlock()
 	if fake.WaitStub != nil {
 		return fake.WaitStub()
-	} else {
-		return fake.waitReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.waitReturns.result1
 }
 
 func (fake *FakeSecureSession) WaitCallCount() int {
@@ -321,16 +527,31 @@ func (fake *FakeSecureSession) WaitReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureSession) WaitReturnsOnCall(i int, result1 error) {
+	fake.WaitStub = nil
+	if fake.waitReturnsOnCall == nil {
+		fake.waitReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.waitReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureSession) Close() error {
 	fake.closeMutex.Lock()
+	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
 	fake.closeArgsForCall = append(fake.closeArgsForCall, struct{}{})
 	fake.recordInvocation("Close", []interface{}{})
 	fake.closeMutex.Unlock()
 	if fake.CloseStub != nil {
 		return fake.CloseStub()
-	} else {
-		return fake.closeReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.closeReturns.result1
 }
 
 func (fake *FakeSecureSession) CloseCallCount() int {
@@ -346,6 +567,18 @@ func (fake *FakeSecureSession) CloseReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureSession) CloseReturnsOnCall(i int, result1 error) {
+	fake.CloseStub = nil
+	if fake.closeReturnsOnCall == nil {
+		fake.closeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.closeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureSession) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -353,6 +586,8 @@ func (fake *FakeSecureSession) Invocations() map[string][][]interface{} {
 	defer fake.requestPtyMutex.RUnlock()
 	fake.sendRequestMutex.RLock()
 	defer fake.sendRequestMutex.RUnlock()
+	fake.requestSubsystemMutex.RLock()
+	defer fake.requestSubsystemMutex.RUnlock()
 	fake.stdinPipeMutex.RLock()
 	defer fake.stdinPipeMutex.RUnlock()
 	fake.stdoutPipeMutex.RLock()
@@ -2,9 +2,10 @@
 package sshfakes
 
 import (
+	"io"
 	"sync"
 
-	"code.cloudfoundry.org/cli/cf/ssh"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
 	"code.cloudfoundry.org/cli/cf/ssh/options"
 )
 
@@ -17,36 +18,94 @@ type FakeSecureShell struct {
 	connectReturns struct {
 		result1 error
 	}
+	connectReturnsOnCall map[int]struct {
+		result1 error
+	}
 	InteractiveSessionStub        func() error
 	interactiveSessionMutex       sync.RWMutex
 	interactiveSessionArgsForCall []struct{}
 	interactiveSessionReturns     struct {
 		result1 error
 	}
+	interactiveSessionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ExecuteCommandStub        func(stdout io.Writer, stderr io.Writer) error
+	executeCommandMutex       sync.RWMutex
+	executeCommandArgsForCall []struct {
+		stdout io.Writer
+		stderr io.Writer
+	}
+	executeCommandReturns struct {
+		result1 error
+	}
+	executeCommandReturnsOnCall map[int]struct {
+		result1 error
+	}
 	LocalPortForwardStub        func() error
 	localPortForwardMutex       sync.RWMutex
 	localPortForwardArgsForCall []struct{}
 	localPortForwardReturns     struct {
 		result1 error
 	}
+	localPortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemotePortForwardStub        func() error
+	remotePortForwardMutex       sync.RWMutex
+	remotePortForwardArgsForCall []struct{}
+	remotePortForwardReturns     struct {
+		result1 error
+	}
+	remotePortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DynamicPortForwardStub        func() error
+	dynamicPortForwardMutex       sync.RWMutex
+	dynamicPortForwardArgsForCall []struct{}
+	dynamicPortForwardReturns     struct {
+		result1 error
+	}
+	dynamicPortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CopyStub        func(copyOpts *options.SCPOptions, progress sshCmd.ProgressWrapper) error
+	copyMutex       sync.RWMutex
+	copyArgsForCall []struct {
+		copyOpts *options.SCPOptions
+		progress sshCmd.ProgressWrapper
+	}
+	copyReturns struct {
+		result1 error
+	}
+	copyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	WaitStub        func() error
 	waitMutex       sync.RWMutex
 	waitArgsForCall []struct{}
 	waitReturns     struct {
 		result1 error
 	}
+	waitReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CloseStub        func() error
 	closeMutex       sync.RWMutex
 	closeArgsForCall []struct{}
 	closeReturns     struct {
 		result1 error
 	}
+	closeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
 func (fake *FakeSecureShell) Connect(opts *options.SSHOptions) error {
 	fake.connectMutex.Lock()
+	ret, specificReturn := fake.connectReturnsOnCall[len(fake.connectArgsForCall)]
 	fake.connectArgsForCall = append(fake.connectArgsForCall, struct {
 		opts *options.SSHOptions
 	}{opts})
@@ -54,9 +113,11 @@ func (fake *FakeSecureShell) Connect(opts *options.SSHOptions) error {
 	fake.connectMutex.Unlock()
 	if fake.ConnectStub != nil {
 		return fake.ConnectStub(opts)
-	} else {
-		return fake.connectReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.connectReturns.result1
 }
 
 func (fake *FakeSecureShell) ConnectCallCount() int {
@@ -78,16 +139,31 @@ func (fake *FakeSecureShell) ConnectReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShell) ConnectReturnsOnCall(i int, result1 error) {
+	fake.ConnectStub = nil
+	if fake.connectReturnsOnCall == nil {
+		fake.connectReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.connectReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) InteractiveSession() error {
 	fake.interactiveSessionMutex.Lock()
+	ret, specificReturn := fake.interactiveSessionReturnsOnCall[len(fake.interactiveSessionArgsForCall)]
 	fake.interactiveSessionArgsForCall = append(fake.interactiveSessionArgsForCall, struct{}{})
 	fake.recordInvocation("InteractiveSession", []interface{}{})
 	fake.interactiveSessionMutex.Unlock()
 	if fake.InteractiveSessionStub != nil {
 		return fake.InteractiveSessionStub()
-	} else {
-		return fake.interactiveSessionReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.interactiveSessionReturns.result1
 }
 
 func (fake *FakeSecureShell) InteractiveSessionCallCount() int {
@@ -103,16 +179,80 @@ func (fake *FakeSecureShell) InteractiveSessionReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShell) InteractiveSessionReturnsOnCall(i int, result1 error) {
+	fake.InteractiveSessionStub = nil
+	if fake.interactiveSessionReturnsOnCall == nil {
+		fake.interactiveSessionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.interactiveSessionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) ExecuteCommand(stdout io.Writer, stderr io.Writer) error {
+	fake.executeCommandMutex.Lock()
+	ret, specificReturn := fake.executeCommandReturnsOnCall[len(fake.executeCommandArgsForCall)]
+	fake.executeCommandArgsForCall = append(fake.executeCommandArgsForCall, struct {
+		stdout io.Writer
+		stderr io.Writer
+	}{stdout, stderr})
+	fake.recordInvocation("ExecuteCommand", []interface{}{stdout, stderr})
+	fake.executeCommandMutex.Unlock()
+	if fake.ExecuteCommandStub != nil {
+		return fake.ExecuteCommandStub(stdout, stderr)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.executeCommandReturns.result1
+}
+
+func (fake *FakeSecureShell) ExecuteCommandCallCount() int {
+	fake.executeCommandMutex.RLock()
+	defer fake.executeCommandMutex.RUnlock()
+	return len(fake.executeCommandArgsForCall)
+}
+
+func (fake *FakeSecureShell) ExecuteCommandArgsForCall(i int) (io.Writer, io.Writer) {
+	fake.executeCommandMutex.RLock()
+	defer fake.executeCommandMutex.RUnlock()
+	return fake.executeCommandArgsForCall[i].stdout, fake.executeCommandArgsForCall[i].stderr
+}
+
+func (fake *FakeSecureShell) ExecuteCommandReturns(result1 error) {
+	fake.ExecuteCommandStub = nil
+	fake.executeCommandReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) ExecuteCommandReturnsOnCall(i int, result1 error) {
+	fake.ExecuteCommandStub = nil
+	if fake.executeCommandReturnsOnCall == nil {
+		fake.executeCommandReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.executeCommandReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) LocalPortForward() error {
 	fake.localPortForwardMutex.Lock()
+	ret, specificReturn := fake.localPortForwardReturnsOnCall[len(fake.localPortForwardArgsForCall)]
 	fake.localPortForwardArgsForCall = append(fake.localPortForwardArgsForCall, struct{}{})
 	fake.recordInvocation("LocalPortForward", []interface{}{})
 	fake.localPortForwardMutex.Unlock()
 	if fake.LocalPortForwardStub != nil {
 		return fake.LocalPortForwardStub()
-	} else {
-		return fake.localPortForwardReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.localPortForwardReturns.result1
 }
 
 func (fake *FakeSecureShell) LocalPortForwardCallCount() int {
@@ -128,16 +268,160 @@ func (fake *FakeSecureShell) LocalPortForwardReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShell) LocalPortForwardReturnsOnCall(i int, result1 error) {
+	fake.LocalPortForwardStub = nil
+	if fake.localPortForwardReturnsOnCall == nil {
+		fake.localPortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.localPortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) RemotePortForward() error {
+	fake.remotePortForwardMutex.Lock()
+	ret, specificReturn := fake.remotePortForwardReturnsOnCall[len(fake.remotePortForwardArgsForCall)]
+	fake.remotePortForwardArgsForCall = append(fake.remotePortForwardArgsForCall, struct{}{})
+	fake.recordInvocation("RemotePortForward", []interface{}{})
+	fake.remotePortForwardMutex.Unlock()
+	if fake.RemotePortForwardStub != nil {
+		return fake.RemotePortForwardStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.remotePortForwardReturns.result1
+}
+
+func (fake *FakeSecureShell) RemotePortForwardCallCount() int {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	return len(fake.remotePortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShell) RemotePortForwardReturns(result1 error) {
+	fake.RemotePortForwardStub = nil
+	fake.remotePortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) RemotePortForwardReturnsOnCall(i int, result1 error) {
+	fake.RemotePortForwardStub = nil
+	if fake.remotePortForwardReturnsOnCall == nil {
+		fake.remotePortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.remotePortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) DynamicPortForward() error {
+	fake.dynamicPortForwardMutex.Lock()
+	ret, specificReturn := fake.dynamicPortForwardReturnsOnCall[len(fake.dynamicPortForwardArgsForCall)]
+	fake.dynamicPortForwardArgsForCall = append(fake.dynamicPortForwardArgsForCall, struct{}{})
+	fake.recordInvocation("DynamicPortForward", []interface{}{})
+	fake.dynamicPortForwardMutex.Unlock()
+	if fake.DynamicPortForwardStub != nil {
+		return fake.DynamicPortForwardStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.dynamicPortForwardReturns.result1
+}
+
+func (fake *FakeSecureShell) DynamicPortForwardCallCount() int {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	return len(fake.dynamicPortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShell) DynamicPortForwardReturns(result1 error) {
+	fake.DynamicPortForwardStub = nil
+	fake.dynamicPortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) DynamicPortForwardReturnsOnCall(i int, result1 error) {
+	fake.DynamicPortForwardStub = nil
+	if fake.dynamicPortForwardReturnsOnCall == nil {
+		fake.dynamicPortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.dynamicPortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) Copy(copyOpts *options.SCPOptions, progress sshCmd.ProgressWrapper) error {
+	fake.copyMutex.Lock()
+	ret, specificReturn := fake.copyReturnsOnCall[len(fake.copyArgsForCall)]
+	fake.copyArgsForCall = append(fake.copyArgsForCall, struct {
+		copyOpts *options.SCPOptions
+		progress sshCmd.ProgressWrapper
+	}{copyOpts, progress})
+	fake.recordInvocation("Copy", []interface{}{copyOpts, progress})
+	fake.copyMutex.Unlock()
+	if fake.CopyStub != nil {
+		return fake.CopyStub(copyOpts, progress)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.copyReturns.result1
+}
+
+func (fake *FakeSecureShell) CopyCallCount() int {
+	fake.copyMutex.RLock()
+	defer fake.copyMutex.RUnlock()
+	return len(fake.copyArgsForCall)
+}
+
+func (fake *FakeSecureShell) CopyArgsForCall(i int) (*options.SCPOptions, sshCmd.ProgressWrapper) {
+	fake.copyMutex.RLock()
+	defer fake.copyMutex.RUnlock()
+	return fake.copyArgsForCall[i].copyOpts, fake.copyArgsForCall[i].progress
+}
+
+func (fake *FakeSecureShell) CopyReturns(result1 error) {
+	fake.CopyStub = nil
+	fake.copyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) CopyReturnsOnCall(i int, result1 error) {
+	fake.CopyStub = nil
+	if fake.copyReturnsOnCall == nil {
+		fake.copyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.copyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) Wait() error {
 	fake.waitMutex.Lock()
+	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
 	fake.waitArgsForCall = append(fake.waitArgsForCall, struct{}{})
 	fake.recordInvocation("Wait", []interface{}{})
 	fake.waitMutex.Unlock()
 	if fake.WaitStub != nil {
 		return fake.WaitStub()
-	} else {
-		return fake.waitReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.waitReturns.result1
 }
 
 func (fake *FakeSecureShell) WaitCallCount() int {
@@ -153,16 +437,31 @@ func (fake *FakeSecureShell) WaitReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShell) WaitReturnsOnCall(i int, result1 error) {
+	fake.WaitStub = nil
+	if fake.waitReturnsOnCall == nil {
+		fake.waitReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.waitReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) Close() error {
 	fake.closeMutex.Lock()
+	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
 	fake.closeArgsForCall = append(fake.closeArgsForCall, struct{}{})
 	fake.recordInvocation("Close", []interface{}{})
 	fake.closeMutex.Unlock()
 	if fake.CloseStub != nil {
 		return fake.CloseStub()
-	} else {
-		return fake.closeReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.closeReturns.result1
 }
 
 func (fake *FakeSecureShell) CloseCallCount() int {
@@ -178,6 +477,18 @@ func (fake *FakeSecureShell) CloseReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShell) CloseReturnsOnCall(i int, result1 error) {
+	fake.CloseStub = nil
+	if fake.closeReturnsOnCall == nil {
+		fake.closeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.closeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -185,8 +496,16 @@ func (fake *FakeSecureShell) Invocations() map[string][][]interface{} {
 	defer fake.connectMutex.RUnlock()
 	fake.interactiveSessionMutex.RLock()
 	defer fake.interactiveSessionMutex.RUnlock()
+	fake.executeCommandMutex.RLock()
+	defer fake.executeCommandMutex.RUnlock()
 	fake.localPortForwardMutex.RLock()
 	defer fake.localPortForwardMutex.RUnlock()
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	fake.copyMutex.RLock()
+	defer fake.copyMutex.RUnlock()
 	fake.waitMutex.RLock()
 	defer fake.waitMutex.RUnlock()
 	fake.closeMutex.RLock()
@@ -19,16 +19,22 @@ type commandList struct {
 	AddPluginRepo                      plugin.AddPluginRepoCommand                  `command:"add-plugin-repo" description:"Add a new plugin repository"`
 	AllowSpaceSSH                      v2.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
 	Api                                v2.ApiCommand                                `command:"api" description:"Set or view target api url"`
+	ApplyFoundationConfig              v2.ApplyFoundationConfigCommand              `command:"apply-foundation-config" description:"Create the orgs, spaces, roles, quotas, isolation segments and security group bindings described in a YAML file"`
 	Apps                               v2.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
 	App                                v2.AppCommand                                `command:"app" description:"Display health and status for app"`
+	AuditEvents                        v2.AuditEventsCommand                        `command:"audit-events" description:"Show audit events of an org, space or actor"`
 	Auth                               v2.AuthCommand                               `command:"auth" description:"Authenticate user non-interactively"`
 	BindRouteService                   v2.BindRouteServiceCommand                   `command:"bind-route-service" alias:"brs" description:"Bind a service instance to an HTTP route"`
 	BindRunningSecurityGroup           v2.BindRunningSecurityGroupCommand           `command:"bind-running-security-group" description:"Bind a security group to the list of security groups to be used for running applications"`
 	BindSecurityGroup                  v2.BindSecurityGroupCommand                  `command:"bind-security-group" description:"Bind a security group to a particular space, or all existing spaces of an org"`
 	BindService                        v2.BindServiceCommand                        `command:"bind-service" alias:"bs" description:"Bind a service instance to an app"`
 	BindStagingSecurityGroup           v2.BindStagingSecurityGroupCommand           `command:"bind-staging-security-group" description:"Bind a security group to the list of security groups to be used for staging applications"`
+	BuildpackUsage                     v2.BuildpackUsageCommand                     `command:"buildpack-usage" description:"Show which buildpacks, buildpack versions and stacks apps are staged with"`
 	Buildpacks                         v2.BuildpacksCommand                         `command:"buildpacks" description:"List all buildpacks"`
+	Canary                             v2.CanaryCommand                             `command:"canary" description:"Gradually move traffic from an app to a canary app, then promote or abort"`
+	ChangeStack                        v2.ChangeStackCommand                        `command:"change-stack" description:"Move an app, or every app in the targeted space, to another stack and restage it"`
 	CheckRoute                         v2.CheckRouteCommand                         `command:"check-route" description:"Perform a simple check to determine whether a route currently exists or not"`
+	CleanupSpace                       v2.CleanupSpaceCommand                       `command:"cleanup-space" description:"Find and delete unused routes, service instances, service keys, stopped apps and packages in the targeted space"`
 	Config                             v2.ConfigCommand                             `command:"config" description:"Write default values to the config"`
 	CopySource                         v2.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application (and restarts that application)"`
 	CreateAppManifest                  v2.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
@@ -78,11 +84,15 @@ type commandList struct {
 	EnableSSH                          v2.EnableSSHCommand                          `command:"enable-ssh" description:"Enable ssh for the application"`
 	Env                                v2.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
 	Events                             v2.EventsCommand                             `command:"events" description:"Show recent app events"`
+	ExportSpace                        v2.ExportSpaceCommand                        `command:"export-space" description:"Export the apps, services, routes and security groups of the targeted space to a bundle directory"`
+	ExplainEgress                      v2.ExplainEgressCommand                      `command:"explain-egress" description:"Explain whether security groups allow egress traffic from a space to a destination"`
 	FeatureFlags                       v2.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status of each flag-able feature"`
 	FeatureFlag                        v2.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
 	Files                              v2.FilesCommand                              `command:"files" alias:"f" description:"Print out a list of files in a directory or the contents of a specific file of an app running on the DEA backend"`
 	GetHealthCheck                     v2.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
+	ImportSpace                        v2.ImportSpaceCommand                        `command:"import-space" description:"Recreate the apps, services, routes and security groups of a bundle from export-space in the targeted space"`
+	ImportUsers                        v2.ImportUsersCommand                        `command:"import-users" description:"Create users and assign org and space roles from a CSV file"`
 	InstallPlugin                      plugin.InstallPluginCommand                  `command:"install-plugin" description:"Install CLI plugin"`
 	IsolationSegments                  v3.IsolationSegmentsCommand                  `command:"isolation-segments" description:"List all isolation segments"`
 	ListPluginRepos                    plugin.ListPluginReposCommand                `command:"list-plugin-repos" description:"List all the added plugin repositories"`
@@ -94,6 +104,7 @@ type commandList struct {
 	MigrateServiceInstances            v2.MigrateServiceInstancesCommand            `command:"migrate-service-instances" description:"Migrate service instances from one service plan to another"`
 	OauthToken                         v2.OauthTokenCommand                         `command:"oauth-token" description:"Retrieve and display the OAuth token for the current session"`
 	Orgs                               v2.OrgsCommand                               `command:"orgs" alias:"o" description:"List all orgs"`
+	OrgUsage                           v2.OrgUsageCommand                           `command:"org-usage" description:"Show how much of its quota an org is using"`
 	OrgUsers                           v2.OrgUsersCommand                           `command:"org-users" description:"Show org users by role"`
 	Org                                v2.OrgCommand                                `command:"org" description:"Show org info"`
 	Passwd                             v2.PasswdCommand                             `command:"passwd" alias:"pw" description:"Change user password"`
@@ -115,16 +126,20 @@ type commandList struct {
 	Restage                            v2.RestageCommand                            `command:"restage" alias:"rg" description:"Recreate the app's executable artifact using the latest pushed app files and the latest environment (variables, service bindings, buildpack, stack, etc.)"`
 	RestartAppInstance                 v2.RestartAppInstanceCommand                 `command:"restart-app-instance" description:"Terminate the running application Instance at the given index and instantiate a new instance of the application with the same index"`
 	Restart                            v2.RestartCommand                            `command:"restart" alias:"rs" description:"Stop all instances of the app, then start them again. This may cause downtime."`
+	RotateServiceKey                   v2.RotateServiceKeyCommand                   `command:"rotate-service-key" description:"Replace a service key with a newly created one and delete the old key"`
 	RouterGroups                       v2.RouterGroupsCommand                       `command:"router-groups" description:"List router groups"`
 	Routes                             v2.RoutesCommand                             `command:"routes" alias:"r" description:"List all routes in the current space or the current organization"`
 	RunningEnvironmentVariableGroup    v2.RunningEnvironmentVariableGroupCommand    `command:"running-environment-variable-group" alias:"revg" description:"Retrieve the contents of the running environment variable group"`
 	RunningSecurityGroups              v2.RunningSecurityGroupsCommand              `command:"running-security-groups" description:"List security groups in the set of security groups for running applications"`
 	RunTask                            v3.RunTaskCommand                            `command:"run-task" alias:"rt" description:"Run a one-off task on an app"`
 	Scale                              v2.ScaleCommand                              `command:"scale" description:"Change or view the instance count, disk space limit, and memory limit for an app"`
+	SCP                                v2.SCPCommand                                `command:"scp" description:"Copy files to or from an application container instance"`
 	SecurityGroups                     v2.SecurityGroupsCommand                     `command:"security-groups" description:"List all security groups"`
 	SecurityGroup                      v2.SecurityGroupCommand                      `command:"security-group" description:"Show a single security group"`
 	ServiceAccess                      v2.ServiceAccessCommand                      `command:"service-access" description:"List service access settings"`
 	ServiceAuthTokens                  v2.ServiceAuthTokensCommand                  `command:"service-auth-tokens" description:"List service auth tokens"`
+	ServiceBinding                     v2.ServiceBindingCommand                     `command:"service-binding" description:"Show the parameters and credentials of a service binding"`
+	ServiceBrokerCatalog               v2.ServiceBrokerCatalogCommand               `command:"service-broker-catalog" description:"Show the catalog of a service broker, including plan access and parameter schemas"`
 	ServiceBrokers                     v2.ServiceBrokersCommand                     `command:"service-brokers" description:"List service brokers"`
 	ServiceKeys                        v2.ServiceKeysCommand                        `command:"service-keys" alias:"sk" description:"List keys for a service instance"`
 	ServiceKey                         v2.ServiceKeyCommand                         `command:"service-key" description:"Show service key info"`
@@ -140,14 +155,17 @@ type commandList struct {
 	SetSpaceRole                       v2.SetSpaceRoleCommand                       `command:"set-space-role" description:"Assign a space role to a user"`
 	SetStagingEnvironmentVariableGroup v2.SetStagingEnvironmentVariableGroupCommand `command:"set-staging-environment-variable-group" alias:"ssevg" description:"Pass parameters as JSON to create a staging environment variable group"`
 	SharePrivateDomain                 v2.SharePrivateDomainCommand                 `command:"share-private-domain" description:"Share a private domain with an org"`
+	ShareService                       v3.ShareServiceCommand                       `command:"share-service" description:"Share a service instance with another space"`
 	SpaceQuotas                        v2.SpaceQuotasCommand                        `command:"space-quotas" description:"List available space resource quotas"`
 	SpaceQuota                         v2.SpaceQuotaCommand                         `command:"space-quota" description:"Show space quota info"`
 	SpaceSSHAllowed                    v2.SpaceSSHAllowedCommand                    `command:"space-ssh-allowed" description:"Reports whether SSH is allowed in a space"`
 	Spaces                             v2.SpacesCommand                             `command:"spaces" description:"List all spaces in an org"`
+	SpaceUsage                         v2.SpaceUsageCommand                         `command:"space-usage" description:"Show how much of its quota a space is using"`
 	SpaceUsers                         v2.SpaceUsersCommand                         `command:"space-users" description:"Show space users by role"`
 	Space                              v2.SpaceCommand                              `command:"space" description:"Show space info"`
 	SSHCode                            v2.SSHCodeCommand                            `command:"ssh-code" description:"Get a one time password for ssh clients"`
 	SSHEnabled                         v2.SSHEnabledCommand                         `command:"ssh-enabled" description:"Reports whether SSH is enabled on an application container instance"`
+	SSHReplay                          v2.SSHReplayCommand                          `command:"ssh-replay" description:"Replay a session recorded with 'cf ssh --record'"`
 	SSH                                v2.SSHCommand                                `command:"ssh" description:"SSH to an application container instance"`
 	Stacks                             v2.StacksCommand                             `command:"stacks" description:"List all stacks (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	Stack                              v2.StackCommand                              `command:"stack" description:"Show information for a stack (a stack is a pre-built file system, including an operating system, that can run apps)"`
@@ -170,6 +188,7 @@ type commandList struct {
 	UnsetSpaceQuota                    v2.UnsetSpaceQuotaCommand                    `command:"unset-space-quota" description:"Unassign a quota from a space"`
 	UnsetSpaceRole                     v2.UnsetSpaceRoleCommand                     `command:"unset-space-role" description:"Remove a space role from a user"`
 	UnsharePrivateDomain               v2.UnsharePrivateDomainCommand               `command:"unshare-private-domain" description:"Unshare a private domain with an org"`
+	UnshareService                     v3.UnshareServiceCommand                     `command:"unshare-service" description:"Unshare a shared service instance from a space"`
 	UpdateBuildpack                    v2.UpdateBuildpackCommand                    `command:"update-buildpack" description:"Update a buildpack"`
 	UpdateQuota                        v2.UpdateQuotaCommand                        `command:"update-quota" description:"Update an existing resource quota"`
 	UpdateSecurityGroup                v2.UpdateSecurityGroupCommand                `command:"update-security-group" description:"Update a security group"`
@@ -12,14 +12,14 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "APPS:",
 		CommandList: [][]string{
 			{"apps", "app"},
-			{"push", "scale", "delete", "rename"},
+			{"push", "scale", "delete", "rename", "canary"},
 			{"start", "stop", "restart", "restage", "restart-app-instance"},
 			{"run-task", "tasks", "terminate-task"},
 			{"events", "files", "logs"},
 			{"env", "set-env", "unset-env"},
-			{"stacks", "stack"},
+			{"stacks", "stack", "change-stack"},
 			{"copy-source", "create-app-manifest"},
-			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh", "scp", "ssh-replay"},
 		},
 	},
 	{
@@ -27,8 +27,9 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"marketplace", "services", "service"},
 			{"create-service", "update-service", "delete-service", "rename-service"},
-			{"create-service-key", "service-keys", "service-key", "delete-service-key"},
-			{"bind-service", "unbind-service"},
+			{"create-service-key", "service-keys", "service-key", "delete-service-key", "rotate-service-key"},
+			{"bind-service", "unbind-service", "service-binding"},
+			{"share-service", "unshare-service"},
 			{"bind-route-service", "unbind-route-service"},
 			{"create-user-provided-service", "update-user-provided-service"},
 		},
@@ -38,6 +39,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"orgs", "org"},
 			{"create-org", "delete-org", "rename-org"},
+			{"audit-events"},
 		},
 	},
 	{
@@ -46,6 +48,8 @@ var HelpCategoryList = []HelpCategory{
 			{"spaces", "space"},
 			{"create-space", "delete-space", "rename-space"},
 			{"allow-space-ssh", "disallow-space-ssh", "space-ssh-allowed"},
+			{"export-space", "import-space"},
+			{"cleanup-space"},
 		},
 	},
 	{
@@ -65,12 +69,13 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "BUILDPACKS:",
 		CommandList: [][]string{
 			{"buildpacks", "create-buildpack", "update-buildpack", "rename-buildpack", "delete-buildpack"},
+			{"buildpack-usage"},
 		},
 	},
 	{
 		CategoryName: "USER ADMIN:",
 		CommandList: [][]string{
-			{"create-user", "delete-user"},
+			{"create-user", "delete-user", "import-users"},
 			{"org-users", "set-org-role", "unset-org-role"},
 			{"space-users", "set-space-role", "unset-space-role"},
 		},
@@ -79,14 +84,17 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "ORG ADMIN:",
 		CommandList: [][]string{
 			{"quotas", "quota", "set-quota"},
+			{"org-usage"},
 			{"create-quota", "delete-quota", "update-quota"},
 			{"share-private-domain", "unshare-private-domain"},
+			{"apply-foundation-config"},
 		},
 	},
 	{
 		CategoryName: "SPACE ADMIN:",
 		CommandList: [][]string{
 			{"space-quotas", "space-quota"},
+			{"space-usage"},
 			{"create-space-quota", "update-space-quota", "delete-space-quota"},
 			{"set-space-quota", "unset-space-quota"},
 		},
@@ -95,7 +103,7 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "SERVICE ADMIN:",
 		CommandList: [][]string{
 			{"service-auth-tokens", "create-service-auth-token", "update-service-auth-token", "delete-service-auth-token"},
-			{"service-brokers", "create-service-broker", "update-service-broker", "delete-service-broker", "rename-service-broker"},
+			{"service-brokers", "service-broker-catalog", "create-service-broker", "update-service-broker", "delete-service-broker", "rename-service-broker"},
 			{"migrate-service-instances", "purge-service-offering", "purge-service-instance"},
 			{"service-access", "enable-service-access", "disable-service-access"},
 		},
@@ -106,6 +114,7 @@ var HelpCategoryList = []HelpCategory{
 			{"security-group", "security-groups", "create-security-group", "update-security-group", "delete-security-group", "bind-security-group", "unbind-security-group"},
 			{"bind-staging-security-group", "staging-security-groups", "unbind-staging-security-group"},
 			{"bind-running-security-group", "running-security-groups", "unbind-running-security-group"},
+			{"explain-egress"},
 		},
 	},
 	{
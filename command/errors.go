@@ -1,6 +1,9 @@
 package command
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type APIRequestError struct {
 	Err error
@@ -129,6 +132,22 @@ func (e ServiceInstanceNotFoundError) Translate(translate func(string, ...interf
 	})
 }
 
+type ServiceBindingNotFoundError struct {
+	AppName             string
+	ServiceInstanceName string
+}
+
+func (e ServiceBindingNotFoundError) Error() string {
+	return "App {{.AppName}} is not bound to service {{.ServiceInstance}}"
+}
+
+func (e ServiceBindingNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":         e.AppName,
+		"ServiceInstance": e.ServiceInstanceName,
+	})
+}
+
 type APINotFoundError struct {
 	URL string
 }
@@ -173,6 +192,20 @@ func (e RequiredArgumentError) Translate(translate func(string, ...interface{})
 	})
 }
 
+type ArgumentCombinationError struct {
+	Args []string
+}
+
+func (e ArgumentCombinationError) Error() string {
+	return "Incorrect Usage: The following arguments cannot be used together: {{.Args}}"
+}
+
+func (e ArgumentCombinationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Args": strings.Join(e.Args, ", "),
+	})
+}
+
 type ThreeRequiredArgumentsError struct {
 	ArgumentName1 string
 	ArgumentName2 string
@@ -47,6 +47,7 @@ var _ = Describe("Translatable Errors", func() {
 		// Actor errors.
 		Entry("ApplicationNotFoundError", ApplicationNotFoundError{}),
 		Entry("ServiceInstanceNotFoundError", ServiceInstanceNotFoundError{}),
+		Entry("ServiceBindingNotFoundError", ServiceBindingNotFoundError{}),
 
 		// Parse errors.
 		Entry("ParseArgumentError", ParseArgumentError{}),
@@ -37,7 +37,7 @@ type Organization struct {
 }
 
 type APIPath struct {
-	Path string `positional-arg-name:"PATH" required:"true" description:"The API endpoint"`
+	Path string `positional-arg-name:"PATH" description:"The API endpoint"`
 }
 
 type PluginRepoName struct {
@@ -60,6 +60,11 @@ type ServiceBroker struct {
 	ServiceBroker string `positional-arg-name:"SERVICE_BROKER" required:"true" description:"The service broker"`
 }
 
+type ExplainEgressArgs struct {
+	Space       string            `positional-arg-name:"SPACE" required:"true" description:"The space the traffic originates from"`
+	Destination EgressDestination `positional-arg-name:"DEST:PORT" required:"true" description:"The destination IPv4 address and port"`
+}
+
 type Space struct {
 	Space string `positional-arg-name:"SPACE" required:"true" description:"The space"`
 }
@@ -153,6 +158,15 @@ type UnsetEnvironmentArgs struct {
 	EnvironmentVariableName string `positional-arg-name:"ENV_VAR_NAME" required:"true" description:"The environment variable name"`
 }
 
+type SCPArgs struct {
+	Source      string `positional-arg-name:"SOURCE" required:"true" description:"The local path or APP_NAME:PATH to copy from"`
+	Destination string `positional-arg-name:"DESTINATION" required:"true" description:"The local path or APP_NAME:PATH to copy to"`
+}
+
+type SSHReplayArgs struct {
+	File string `positional-arg-name:"FILE" required:"true" description:"The session recording to replay"`
+}
+
 type CopySourceArgs struct {
 	SourceAppName string `positional-arg-name:"SOURCE-APP" required:"true" description:"The old application name"`
 	TargetAppName string `positional-arg-name:"TARGET-NAME" required:"true" description:"The new application name"`
@@ -306,3 +320,25 @@ type SpaceIsolationArgs struct {
 type ResetSpaceIsolationArgs struct {
 	SpaceName string `positional-arg-name:"SPACE_NAME" required:"true" description:"The space name"`
 }
+
+type FoundationConfigArgs struct {
+	PathToConfig PathWithExistenceCheck `positional-arg-name:"PATH_TO_CONFIG" required:"true" description:"Path to a YAML file describing orgs and spaces"`
+}
+
+type ImportUsersArgs struct {
+	PathToCSV PathWithExistenceCheck `positional-arg-name:"FILE.csv" required:"true" description:"Path to a CSV file with username, password, origin, org, space and role columns"`
+}
+
+type SpaceBundleArgs struct {
+	BundleDir string `positional-arg-name:"BUNDLE_DIR" required:"true" description:"Directory holding the space bundle"`
+}
+
+type CanaryArgs struct {
+	AppName       string `positional-arg-name:"APP_NAME" required:"true" description:"The application currently serving the routes"`
+	CanaryAppName string `positional-arg-name:"CANARY_APP_NAME" required:"true" description:"The application to release"`
+}
+
+type ChangeStackArgs struct {
+	AppName string `positional-arg-name:"APP_NAME" description:"The application name, omitted when using --all"`
+	Stack   string `positional-arg-name:"STACK" description:"The stack to move the application to"`
+}
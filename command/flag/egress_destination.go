@@ -0,0 +1,62 @@
+package flag
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type EgressDestination struct {
+	IP   net.IP
+	Port int
+}
+
+func (d *EgressDestination) UnmarshalFlag(val string) error {
+	invalid := &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: "DEST:PORT must be an IPv4 address and a port, such as 10.0.0.5:443",
+	}
+
+	host, portString, err := net.SplitHostPort(val)
+	if err != nil {
+		return invalid
+	}
+
+	ip := net.ParseIP(host).To4()
+	if ip == nil {
+		return invalid
+	}
+
+	port, err := strconv.Atoi(portString)
+	if err != nil || port < 1 || port > 65535 {
+		return invalid
+	}
+
+	d.IP = ip
+	d.Port = port
+	return nil
+}
+
+type EgressProtocol struct {
+	Protocol string
+}
+
+func (_ EgressProtocol) Complete(prefix string) []flags.Completion {
+	return completions([]string{"tcp", "udp"}, prefix, false)
+}
+
+func (p *EgressProtocol) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "tcp", "udp":
+		p.Protocol = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `PROTOCOL must be "tcp" or "udp"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package flag_test
+
+import (
+	"net"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EgressDestination", func() {
+	var destination EgressDestination
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			destination = EgressDestination{}
+		})
+
+		It("sets the IP and port", func() {
+			err := destination.UnmarshalFlag("10.0.0.5:443")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(destination.IP.Equal(net.ParseIP("10.0.0.5"))).To(BeTrue())
+			Expect(destination.Port).To(Equal(443))
+		})
+
+		DescribeTable("errors on invalid destinations",
+			func(val string) {
+				err := destination.UnmarshalFlag(val)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "DEST:PORT must be an IPv4 address and a port, such as 10.0.0.5:443",
+				}))
+			},
+			Entry("missing port", "10.0.0.5"),
+			Entry("hostname", "example.com:443"),
+			Entry("IPv6 address", "[::1]:443"),
+			Entry("non numeric port", "10.0.0.5:https"),
+			Entry("port out of range", "10.0.0.5:65536"),
+		)
+	})
+})
+
+var _ = Describe("EgressProtocol", func() {
+	var protocol EgressProtocol
+
+	Describe("Complete", func() {
+		It("completes the supported protocols", func() {
+			Expect(protocol.Complete("")).To(Equal([]flags.Completion{{Item: "tcp"}, {Item: "udp"}}))
+			Expect(protocol.Complete("U")).To(Equal([]flags.Completion{{Item: "udp"}}))
+		})
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			protocol = EgressProtocol{}
+		})
+
+		It("downcases and sets the protocol", func() {
+			Expect(protocol.UnmarshalFlag("UDP")).To(Succeed())
+			Expect(protocol.Protocol).To(Equal("udp"))
+		})
+
+		It("errors on other protocols", func() {
+			err := protocol.UnmarshalFlag("icmp")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `PROTOCOL must be "tcp" or "udp"`,
+			}))
+		})
+	})
+})
@@ -0,0 +1,28 @@
+package flag
+
+import (
+	flags "github.com/jessevdk/go-flags"
+
+	"code.cloudfoundry.org/cli/util/json"
+)
+
+// JSONOrFileWithValidation is a JSON object provided either in-line or as the
+// path to a file containing it.
+type JSONOrFileWithValidation map[string]interface{}
+
+func (_ JSONOrFileWithValidation) Complete(prefix string) []flags.Completion {
+	return completeWithTilde(prefix)
+}
+
+func (p *JSONOrFileWithValidation) UnmarshalFlag(pathOrJSON string) error {
+	jsonMap, err := json.ParseJSONFromFileOrString(pathOrJSON)
+	if err != nil || jsonMap == nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "Invalid configuration provided for -c flag. Please provide a valid JSON object or path to a file containing a valid JSON object.",
+		}
+	}
+
+	*p = JSONOrFileWithValidation(jsonMap)
+	return nil
+}
@@ -0,0 +1,62 @@
+package flag_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JSONOrFileWithValidation", func() {
+	var jsonOrFile JSONOrFileWithValidation
+
+	BeforeEach(func() {
+		jsonOrFile = JSONOrFileWithValidation{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when the value is an in-line JSON object", func() {
+			It("parses the object", func() {
+				err := jsonOrFile.UnmarshalFlag(`{"permissions": "read-only"}`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jsonOrFile).To(Equal(JSONOrFileWithValidation{"permissions": "read-only"}))
+			})
+		})
+
+		Context("when the value is a path to a file containing a JSON object", func() {
+			var path string
+
+			BeforeEach(func() {
+				tempFile, err := ioutil.TempFile("", "")
+				Expect(err).ToNot(HaveOccurred())
+				_, err = tempFile.WriteString(`{"permissions": "read-write"}`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tempFile.Close()).To(Succeed())
+				path = tempFile.Name()
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(path)).To(Succeed())
+			})
+
+			It("parses the object in the file", func() {
+				err := jsonOrFile.UnmarshalFlag(path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jsonOrFile).To(Equal(JSONOrFileWithValidation{"permissions": "read-write"}))
+			})
+		})
+
+		Context("when the value is not a JSON object", func() {
+			It("returns an error", func() {
+				err := jsonOrFile.UnmarshalFlag(`["not", "an", "object"]`)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "Invalid configuration provided for -c flag. Please provide a valid JSON object or path to a file containing a valid JSON object.",
+				}))
+			})
+		})
+	})
+})
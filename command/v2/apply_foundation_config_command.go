@@ -0,0 +1,151 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . ApplyFoundationConfigActor
+
+type ApplyFoundationConfigActor interface {
+	CreatePlan(config foundationaction.Config) ([]foundationaction.Change, foundationaction.Warnings, error)
+	ApplyChange(change foundationaction.Change) (foundationaction.Warnings, error)
+}
+
+type ApplyFoundationConfigCommand struct {
+	RequiredArgs    flag.FoundationConfigArgs `positional-args:"yes"`
+	DryRun          bool                      `long:"dry-run" description:"Show the changes that would be made without making them"`
+	usage           interface{}               `usage:"CF_NAME apply-foundation-config PATH_TO_CONFIG [--dry-run]\n\n   The config file describes orgs and their spaces. Orgs, spaces, roles,\n   isolation segments and security group bindings that are missing are added;\n   nothing is removed. Quotas, isolation segments and security groups must\n   already exist.\n\n   orgs:\n   - name: my-org\n     quota: default\n     isolation_segments: [my-segment]\n     managers: [alice]\n     billing_managers: [bob]\n     auditors: [carol]\n     spaces:\n     - name: development\n       isolation_segment: my-segment\n       managers: [alice]\n       developers: [dave, erin]\n       auditors: [carol]\n       security_groups: [public_networks]"`
+	relatedCommands interface{}               `related_commands:"bind-security-group, create-org, create-space, enable-org-isolation, set-org-role, set-quota, set-space-isolation-segment, set-space-role"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyFoundationConfigActor
+}
+
+func (cmd *ApplyFoundationConfigCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	ccClientV3, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = foundationaction.NewActor(v2action.NewActor(ccClient, uaaClient), v3action.NewActor(ccClientV3, config))
+	return nil
+}
+
+func (cmd ApplyFoundationConfigCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	foundationConfig, err := foundationaction.ReadConfig(string(cmd.RequiredArgs.PathToConfig))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Planning changes from {{.Path}} as {{.Username}}...", map[string]interface{}{
+		"Path":     cmd.RequiredArgs.PathToConfig,
+		"Username": user.Name,
+	})
+
+	changes, warnings, err := cmd.Actor.CreatePlan(foundationConfig)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return cmd.handleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(changes) == 0 {
+		cmd.UI.DisplayText("No changes needed.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.UI.DisplayText("Plan:")
+	for _, change := range changes {
+		cmd.UI.DisplayText("  " + cmd.describeChange(change))
+	}
+
+	if cmd.DryRun {
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Applying {{.Count}} changes...", map[string]interface{}{
+		"Count": len(changes),
+	})
+	for _, change := range changes {
+		cmd.UI.DisplayText("  " + cmd.describeChange(change))
+		warnings, err = cmd.Actor.ApplyChange(change)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return cmd.handleError(err)
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ApplyFoundationConfigCommand) describeChange(change foundationaction.Change) string {
+	values := map[string]interface{}{
+		"Organization":     change.Organization,
+		"Space":            change.Space,
+		"Quota":            change.Quota,
+		"IsolationSegment": change.IsolationSegment,
+		"SecurityGroup":    change.SecurityGroup,
+		"Username":         change.Username,
+	}
+
+	switch change.Type {
+	case foundationaction.CreateOrganization:
+		return cmd.UI.TranslateText("create org {{.Organization}}", values)
+	case foundationaction.SetOrganizationQuota:
+		return cmd.UI.TranslateText("set quota of org {{.Organization}} to {{.Quota}}", values)
+	case foundationaction.EnableOrganizationIsolation:
+		return cmd.UI.TranslateText("entitle org {{.Organization}} to isolation segment {{.IsolationSegment}}", values)
+	case foundationaction.SetOrganizationRole:
+		values["Role"] = change.OrganizationRole
+		return cmd.UI.TranslateText("give {{.Username}} role {{.Role}} in org {{.Organization}}", values)
+	case foundationaction.CreateSpace:
+		return cmd.UI.TranslateText("create space {{.Space}} in org {{.Organization}}", values)
+	case foundationaction.SetSpaceIsolationSegment:
+		return cmd.UI.TranslateText("set isolation segment of space {{.Space}} in org {{.Organization}} to {{.IsolationSegment}}", values)
+	case foundationaction.SetSpaceRole:
+		values["Role"] = change.SpaceRole
+		return cmd.UI.TranslateText("give {{.Username}} role {{.Role}} in space {{.Space}} of org {{.Organization}}", values)
+	case foundationaction.BindSecurityGroup:
+		return cmd.UI.TranslateText("bind security group {{.SecurityGroup}} to space {{.Space}} in org {{.Organization}}", values)
+	}
+
+	return string(change.Type)
+}
+
+func (ApplyFoundationConfigCommand) handleError(err error) error {
+	if e, ok := err.(v3action.IsolationSegmentNotFoundError); ok {
+		return sharedV3.IsolationSegmentNotFoundError{Name: e.Name}
+	}
+	return shared.HandleError(err)
+}
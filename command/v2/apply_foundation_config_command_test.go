@@ -0,0 +1,187 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-foundation-config Command", func() {
+	var (
+		cmd             ApplyFoundationConfigCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeApplyFoundationConfigActor
+		binaryName      string
+		configPath      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeApplyFoundationConfigActor)
+
+		cmd = ApplyFoundationConfigCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		file, err := ioutil.TempFile("", "foundation-config")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = file.WriteString("orgs:\n- name: some-org\n  spaces:\n  - name: some-space\n    developers: [bob]\n")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(file.Close()).To(Succeed())
+		configPath = file.Name()
+		cmd.RequiredArgs.PathToConfig = flag.PathWithExistenceCheck(configPath)
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(configPath)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the plan has changes", func() {
+		var changes []foundationaction.Change
+
+		BeforeEach(func() {
+			changes = []foundationaction.Change{
+				{Type: foundationaction.CreateSpace, Organization: "some-org", Space: "some-space"},
+				{Type: foundationaction.SetSpaceRole, Organization: "some-org", Space: "some-space", Username: "bob", SpaceRole: v2action.SpaceDeveloperRole},
+			}
+			fakeActor.CreatePlanReturns(changes, foundationaction.Warnings{"plan-warning"}, nil)
+			fakeActor.ApplyChangeReturns(foundationaction.Warnings{"apply-warning"}, nil)
+		})
+
+		It("displays the plan and applies each change", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Planning changes from %s as some-user\\.\\.\\.", configPath))
+			Expect(testUI.Out).To(Say("Plan:"))
+			Expect(testUI.Out).To(Say("  create space some-space in org some-org"))
+			Expect(testUI.Out).To(Say("  give bob role SpaceDeveloper in space some-space of org some-org"))
+			Expect(testUI.Out).To(Say("Applying 2 changes\\.\\.\\."))
+			Expect(testUI.Out).To(Say("  create space some-space in org some-org"))
+			Expect(testUI.Out).To(Say("  give bob role SpaceDeveloper in space some-space of org some-org"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("plan-warning"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+
+			Expect(fakeActor.CreatePlanArgsForCall(0)).To(Equal(foundationaction.Config{
+				Organizations: []foundationaction.OrganizationConfig{
+					{
+						Name: "some-org",
+						Spaces: []foundationaction.SpaceConfig{
+							{Name: "some-space", Developers: []string{"bob"}},
+						},
+					},
+				},
+			}))
+			Expect(fakeActor.ApplyChangeCallCount()).To(Equal(2))
+			Expect(fakeActor.ApplyChangeArgsForCall(0)).To(Equal(changes[0]))
+			Expect(fakeActor.ApplyChangeArgsForCall(1)).To(Equal(changes[1]))
+		})
+
+		Context("when --dry-run is passed", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("displays the plan without applying it", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("Plan:"))
+				Expect(testUI.Out).NotTo(Say("Applying"))
+				Expect(fakeActor.ApplyChangeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when applying a change fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("apply error")
+				fakeActor.ApplyChangeReturns(foundationaction.Warnings{"apply-warning"}, expectedErr)
+			})
+
+			It("stops and returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeActor.ApplyChangeCallCount()).To(Equal(1))
+				Expect(testUI.Err).To(Say("apply-warning"))
+			})
+		})
+	})
+
+	Context("when there is nothing to change", func() {
+		BeforeEach(func() {
+			fakeActor.CreatePlanReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No changes needed\\."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Context("when the config references a missing isolation segment", func() {
+		BeforeEach(func() {
+			fakeActor.CreatePlanReturns(nil, nil, v3action.IsolationSegmentNotFoundError{Name: "some-segment"})
+		})
+
+		It("returns an IsolationSegmentNotFoundError", func() {
+			Expect(executeErr).To(MatchError(sharedV3.IsolationSegmentNotFoundError{Name: "some-segment"}))
+		})
+	})
+
+	Context("when the config file is invalid", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(configPath, []byte("orgs:\n- spaces: []\n"), 0600)).To(Succeed())
+		})
+
+		It("returns an InvalidConfigError without planning", func() {
+			Expect(executeErr).To(MatchError(foundationaction.InvalidConfigError{Path: configPath, Message: "every org must have a name"}))
+			Expect(fakeActor.CreatePlanCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,33 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+)
+
+type AuditEventsCommand struct {
+	Org             string      `long:"org" description:"Org to show events for, defaults to the targeted org"`
+	Space           string      `long:"space" description:"Space in the org to show events for"`
+	Actor           string      `long:"actor" description:"Only show events caused by this actor, given as a name or GUID"`
+	Types           []string    `long:"type" description:"Only show events of this type, flag can be specified multiple times"`
+	From            string      `long:"from" description:"Only show events at or after this time, given as YYYY-MM-DD or RFC3339"`
+	To              string      `long:"to" description:"Only show events at or before this time, given as YYYY-MM-DD or RFC3339"`
+	Output          string      `long:"output" default:"table" description:"Output format: table, json or csv"`
+	File            string      `long:"file" description:"Write the json or csv output to this file instead of the terminal"`
+	Follow          bool        `long:"follow" description:"Keep polling for new events and show them as they arrive"`
+	Notify          string      `long:"notify" description:"Local command to run for every new event while following; the event is passed in CF_EVENT_* environment variables"`
+	NotifyOn        []string    `long:"notify-on" description:"Only run the notify command for events of this type, wildcards such as audit.app.* are allowed, flag can be specified multiple times"`
+	usage           interface{} `usage:"CF_NAME audit-events [--org ORG] [--space SPACE] [--actor ACTOR] [--type TYPE]... [--from TIME] [--to TIME] [--output table|json|csv] [--file PATH] [--follow [--notify COMMAND] [--notify-on EVENT_TYPE]...]\n\nEXAMPLES:\n   CF_NAME audit-events --space development --type audit.app.update --type audit.app.delete-request\n   CF_NAME audit-events --org my-org --from 2017-01-01 --to 2017-03-31 --output csv --file q1-events.csv\n   CF_NAME audit-events --space production --follow --notify 'notify-send \"$CF_EVENT_TYPE\" \"$CF_EVENT_ACTEE_NAME\"' --notify-on app.crash"`
+	relatedCommands interface{} `related_commands:"events, org, space"`
+}
+
+func (_ AuditEventsCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (_ AuditEventsCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
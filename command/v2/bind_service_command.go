@@ -1,25 +1,84 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . BindServiceActor
+
+type BindServiceActor interface {
+	BindServiceBySpace(appName string, serviceInstanceName string, spaceGUID string, parameters map[string]interface{}) (v2action.Warnings, error)
+}
+
 type BindServiceCommand struct {
-	RequiredArgs     flag.BindServiceArgs `positional-args:"yes"`
-	ParametersAsJSON string               `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
-	usage            interface{}          `usage:"CF_NAME bind-service APP_NAME SERVICE_INSTANCE [-c PARAMETERS_AS_JSON]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line:\n\n   CF_NAME bind-service APP_NAME SERVICE_INSTANCE -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. \n   The path to the parameters file can be an absolute or relative path to a file.\n   CF_NAME bind-service APP_NAME SERVICE_INSTANCE -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"permissions\": \"read-only\"\n   }\n\nEXAMPLES:\n   Linux/Mac:\n      CF_NAME bind-service myapp mydb -c '{\"permissions\":\"read-only\"}'\n\n   Windows Command Line:\n      CF_NAME bind-service myapp mydb -c \"{\\\"permissions\\\":\\\"read-only\\\"}\"\n\n   Windows PowerShell:\n      CF_NAME bind-service myapp mydb -c '{\\\"permissions\\\":\\\"read-only\\\"}'\n\n   CF_NAME bind-service myapp mydb -c ~/workspace/tmp/instance_config.json"`
-	relatedCommands  interface{}          `related_commands:"services"`
+	RequiredArgs     flag.BindServiceArgs          `positional-args:"yes"`
+	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
+	usage            interface{}                   `usage:"CF_NAME bind-service APP_NAME SERVICE_INSTANCE [-c PARAMETERS_AS_JSON]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line:\n\n   CF_NAME bind-service APP_NAME SERVICE_INSTANCE -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. \n   The path to the parameters file can be an absolute or relative path to a file.\n   CF_NAME bind-service APP_NAME SERVICE_INSTANCE -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"permissions\": \"read-only\"\n   }\n\nEXAMPLES:\n   Linux/Mac:\n      CF_NAME bind-service myapp mydb -c '{\"permissions\":\"read-only\"}'\n\n   Windows Command Line:\n      CF_NAME bind-service myapp mydb -c \"{\\\"permissions\\\":\\\"read-only\\\"}\"\n\n   Windows PowerShell:\n      CF_NAME bind-service myapp mydb -c '{\\\"permissions\\\":\\\"read-only\\\"}'\n\n   CF_NAME bind-service myapp mydb -c ~/workspace/tmp/instance_config.json"`
+	relatedCommands  interface{}                   `related_commands:"services"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       BindServiceActor
 }
 
-func (_ BindServiceCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *BindServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ BindServiceCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd BindServiceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Binding service {{.ServiceName}} to app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ServiceName": cmd.RequiredArgs.ServiceInstanceName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	warnings, err := cmd.Actor.BindServiceBySpace(cmd.RequiredArgs.AppName, cmd.RequiredArgs.ServiceInstanceName, space.GUID, cmd.ParametersAsJSON)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.ServiceAlreadyBoundError); ok {
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayWarning("App {{.AppName}} is already bound to {{.ServiceName}}.", map[string]interface{}{
+				"AppName":     cmd.RequiredArgs.AppName,
+				"ServiceName": cmd.RequiredArgs.ServiceInstanceName,
+			})
+			return nil
+		}
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use '{{.BinaryName}} restage {{.AppName}}' to ensure your env variable changes take effect", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+		"AppName":    cmd.RequiredArgs.AppName,
+	})
+
 	return nil
 }
@@ -0,0 +1,158 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("bind-service Command", func() {
+	var (
+		cmd             BindServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeBindServiceActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeBindServiceActor)
+
+		cmd = BindServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.ServiceInstanceName = "some-service"
+		cmd.ParametersAsJSON = map[string]interface{}{"permissions": "read-only"}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and an org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		})
+
+		Context("when getting the current user returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("got bananapants??")
+				fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when getting the current user succeeds", func() {
+			BeforeEach(func() {
+				fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			})
+
+			Context("when binding succeeds", func() {
+				BeforeEach(func() {
+					fakeActor.BindServiceBySpaceReturns(v2action.Warnings{"foo", "bar"}, nil)
+				})
+
+				It("binds the service with the parameters and displays OK and a restage tip", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Binding service some-service to app some-app in org some-org / space some-space as some-user..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("TIP: Use 'faceman restage some-app' to ensure your env variable changes take effect"))
+					Expect(testUI.Err).To(Say("foo"))
+					Expect(testUI.Err).To(Say("bar"))
+
+					Expect(fakeActor.BindServiceBySpaceCallCount()).To(Equal(1))
+					appName, serviceInstanceName, spaceGUID, parameters := fakeActor.BindServiceBySpaceArgsForCall(0)
+					Expect(appName).To(Equal("some-app"))
+					Expect(serviceInstanceName).To(Equal("some-service"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(parameters).To(Equal(map[string]interface{}{"permissions": "read-only"}))
+				})
+			})
+
+			Context("when the app is already bound to the service", func() {
+				BeforeEach(func() {
+					fakeActor.BindServiceBySpaceReturns(v2action.Warnings{"foo"}, v2action.ServiceAlreadyBoundError{})
+				})
+
+				It("displays OK and a warning", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("foo"))
+					Expect(testUI.Err).To(Say("App some-app is already bound to some-service."))
+					Expect(testUI.Out).NotTo(Say("TIP"))
+				})
+			})
+
+			Context("when the parameters do not match the binding schema", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = v2action.ServiceBindingParametersInvalidError{Reasons: []string{"'permissions' is required"}}
+					fakeActor.BindServiceBySpaceReturns(v2action.Warnings{"foo"}, expectedErr)
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("foo"))
+					Expect(testUI.Out).NotTo(Say("OK"))
+				})
+			})
+
+			Context("when the service instance does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.BindServiceBySpaceReturns(nil, v2action.ServiceInstanceNotFoundError{Name: "some-service"})
+				})
+
+				It("returns a translatable error", func() {
+					Expect(executeErr).To(MatchError(command.ServiceInstanceNotFoundError{Name: "some-service"}))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,154 @@
+package v2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . BuildpackUsageActor
+
+type BuildpackUsageActor interface {
+	GetBuildpackUsage() ([]v2action.BuildpackUsage, v2action.Warnings, error)
+}
+
+type BuildpackUsageCommand struct {
+	Output          string      `long:"output" default:"table" description:"Output format: table or json"`
+	usage           interface{} `usage:"CF_NAME buildpack-usage [--output table|json]\n\n   Lists every app you can see, grouped by the buildpack, buildpack version and stack it was staged with.\n\nEXAMPLES:\n   CF_NAME buildpack-usage\n   CF_NAME buildpack-usage --output json > buildpack-usage.json"`
+	relatedCommands interface{} `related_commands:"buildpacks, restage, stacks"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       BuildpackUsageActor
+}
+
+type buildpackUsageJSON struct {
+	Buildpack    string                          `json:"buildpack"`
+	Version      string                          `json:"version"`
+	Stack        string                          `json:"stack"`
+	Applications []buildpackUsageApplicationJSON `json:"applications"`
+}
+
+type buildpackUsageApplicationJSON struct {
+	Name              string `json:"name"`
+	Organization      string `json:"organization"`
+	Space             string `json:"space"`
+	DetectedBuildpack string `json:"detected_buildpack"`
+	PackageState      string `json:"package_state"`
+}
+
+func (cmd *BuildpackUsageCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	return nil
+}
+
+func (cmd BuildpackUsageCommand) Execute(args []string) error {
+	if cmd.Output != "table" && cmd.Output != "json" {
+		return command.ParseArgumentError{
+			ArgumentName: "--output",
+			ExpectedType: "table or json",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Output == "table" {
+		cmd.UI.DisplayTextWithFlavor("Getting buildpack usage of all apps as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	usages, warnings, err := cmd.Actor.GetBuildpackUsage()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Output == "json" {
+		return cmd.displayJSON(usages)
+	}
+
+	if len(usages) == 0 {
+		cmd.UI.DisplayText("No apps found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("buildpack"),
+			cmd.UI.TranslateText("version"),
+			cmd.UI.TranslateText("stack"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("package state"),
+		},
+	}
+	for _, usage := range usages {
+		for _, app := range usage.Applications {
+			table = append(table, []string{
+				usage.Buildpack,
+				usage.Version,
+				usage.Stack,
+				app.OrganizationName,
+				app.SpaceName,
+				app.Name,
+				string(app.PackageState),
+			})
+		}
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
+
+func (cmd BuildpackUsageCommand) displayJSON(usages []v2action.BuildpackUsage) error {
+	output := make([]buildpackUsageJSON, 0, len(usages))
+	for _, usage := range usages {
+		group := buildpackUsageJSON{
+			Buildpack:    usage.Buildpack,
+			Version:      usage.Version,
+			Stack:        usage.Stack,
+			Applications: make([]buildpackUsageApplicationJSON, 0, len(usage.Applications)),
+		}
+		for _, app := range usage.Applications {
+			group.Applications = append(group.Applications, buildpackUsageApplicationJSON{
+				Name:              app.Name,
+				Organization:      app.OrganizationName,
+				Space:             app.SpaceName,
+				DetectedBuildpack: app.DetectedBuildpack,
+				PackageState:      string(app.PackageState),
+			})
+		}
+		output = append(output, group)
+	}
+
+	body, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayText("{{.JSON}}", map[string]interface{}{"JSON": string(body)})
+	return nil
+}
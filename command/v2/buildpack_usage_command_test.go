@@ -0,0 +1,173 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("buildpack-usage Command", func() {
+	var (
+		cmd             BuildpackUsageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeBuildpackUsageActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeBuildpackUsageActor)
+
+		cmd = BuildpackUsageCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Output:      "table",
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetBuildpackUsageReturns(
+			[]v2action.BuildpackUsage{
+				{
+					Buildpack: "ruby",
+					Version:   "1.6.28",
+					Stack:     "cflinuxfs2",
+					Applications: []v2action.BuildpackUsageApplication{
+						{Name: "app-1", OrganizationName: "org-1", SpaceName: "space-1", DetectedBuildpack: "ruby 1.6.28", PackageState: ccv2.ApplicationPackageStaged},
+						{Name: "app-2", OrganizationName: "org-2", SpaceName: "space-2", DetectedBuildpack: "ruby 1.6.28", PackageState: ccv2.ApplicationPackageFailed},
+					},
+				},
+				{
+					Buildpack: "staticfile",
+					Version:   "1.4.0",
+					Stack:     "cflinuxfs2",
+					Applications: []v2action.BuildpackUsageApplication{
+						{Name: "app-3", OrganizationName: "org-1", SpaceName: "space-1", DetectedBuildpack: "staticfile 1.4.0", PackageState: ccv2.ApplicationPackageStaged},
+					},
+				},
+			},
+			v2action.Warnings{"usage-warning"},
+			nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the output format is invalid", func() {
+		BeforeEach(func() {
+			cmd.Output = "csv"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "--output",
+				ExpectedType: "table or json",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			config, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(config).To(Equal(fakeConfig))
+			Expect(targetedOrganizationRequired).To(BeFalse())
+			Expect(targetedSpaceRequired).To(BeFalse())
+		})
+	})
+
+	Context("when the output format is table", func() {
+		It("displays every app grouped by buildpack, version and stack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting buildpack usage of all apps as some-user..."))
+			Expect(testUI.Out).To(Say(`buildpack\s+version\s+stack\s+org\s+space\s+app\s+package state`))
+			Expect(testUI.Out).To(Say(`ruby\s+1\.6\.28\s+cflinuxfs2\s+org-1\s+space-1\s+app-1\s+STAGED`))
+			Expect(testUI.Out).To(Say(`ruby\s+1\.6\.28\s+cflinuxfs2\s+org-2\s+space-2\s+app-2\s+FAILED`))
+			Expect(testUI.Out).To(Say(`staticfile\s+1\.4\.0\s+cflinuxfs2\s+org-1\s+space-1\s+app-3\s+STAGED`))
+			Expect(testUI.Err).To(Say("usage-warning"))
+		})
+
+		Context("when there are no apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetBuildpackUsageReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No apps found."))
+			})
+		})
+	})
+
+	Context("when the output format is json", func() {
+		BeforeEach(func() {
+			cmd.Output = "json"
+		})
+
+		It("displays only the usage as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Getting buildpack usage"))
+
+			var output []map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &output)).To(Succeed())
+			Expect(output).To(HaveLen(2))
+			Expect(output[0]).To(HaveKeyWithValue("buildpack", "ruby"))
+			Expect(output[0]).To(HaveKeyWithValue("version", "1.6.28"))
+			Expect(output[0]).To(HaveKeyWithValue("stack", "cflinuxfs2"))
+			Expect(output[0]["applications"]).To(ConsistOf(
+				map[string]interface{}{"name": "app-1", "organization": "org-1", "space": "space-1", "detected_buildpack": "ruby 1.6.28", "package_state": "STAGED"},
+				map[string]interface{}{"name": "app-2", "organization": "org-2", "space": "space-2", "detected_buildpack": "ruby 1.6.28", "package_state": "FAILED"},
+			))
+			Expect(testUI.Err).To(Say("usage-warning"))
+		})
+
+		Context("when there are no apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetBuildpackUsageReturns(nil, nil, nil)
+			})
+
+			It("displays an empty list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`\[\]`))
+			})
+		})
+	})
+
+	Context("when getting the usage fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetBuildpackUsageReturns(nil, v2action.Warnings{"usage-warning"}, errors.New("usage error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("usage error"))
+			Expect(testUI.Err).To(Say("usage-warning"))
+		})
+	})
+})
@@ -0,0 +1,250 @@
+package v2
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . CanaryActor
+
+type CanaryActor interface {
+	AbortCanary(config pushaction.CanaryConfig) (pushaction.Warnings, error)
+	ConvertToCanaryConfig(currentAppName string, canaryAppName string, spaceGUID string, totalInstances int) (pushaction.CanaryConfig, pushaction.Warnings, error)
+	GetCanaryHealth(config pushaction.CanaryConfig) (pushaction.CanaryHealth, pushaction.Warnings, error)
+	MapCanaryRoutes(config pushaction.CanaryConfig) (pushaction.Warnings, error)
+	PromoteCanary(config pushaction.CanaryConfig) (pushaction.Warnings, error)
+	ScaleCanary(config pushaction.CanaryConfig, percentage int) (pushaction.CanaryStep, pushaction.Warnings, error)
+}
+
+type CanaryCommand struct {
+	RequiredArgs    flag.CanaryArgs `positional-args:"yes"`
+	Steps           string          `long:"steps" default:"10,25,50" description:"Comma separated percentages of instances to move to the canary, one step at a time"`
+	Interval        int             `long:"interval" default:"60" description:"Seconds to watch the canary's instances after each step"`
+	Instances       int             `short:"i" description:"Total number of instances shared by both apps (Default: the instances of APP_NAME)"`
+	usage           interface{}     `usage:"CF_NAME canary APP_NAME CANARY_APP_NAME [--steps PERCENT,...] [--interval SECONDS] [-i INSTANCES]\n\n   Maps the routes of APP_NAME to CANARY_APP_NAME and moves instances from APP_NAME to\n   CANARY_APP_NAME in steps. After each step the canary's instances are watched for\n   the given interval. If any of them crash, or a step fails, the release is aborted\n   and APP_NAME is restored; otherwise CANARY_APP_NAME is promoted and APP_NAME is unmapped and stopped.\n\nEXAMPLES:\n   CF_NAME push my-app-v2 --no-route\n   CF_NAME canary my-app my-app-v2 --steps 10,50 --interval 120"`
+	relatedCommands interface{}     `related_commands:"map-route, push, scale"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CanaryActor
+}
+
+func (cmd *CanaryCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = pushaction.NewActor(v2action.NewActor(ccClient, uaaClient))
+	return nil
+}
+
+func (cmd CanaryCommand) Execute(args []string) error {
+	steps, err := cmd.parseSteps()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Interval < 0 {
+		return command.ParseArgumentError{
+			ArgumentName: "--interval",
+			ExpectedType: "a non-negative integer",
+		}
+	}
+
+	if cmd.Instances < 0 {
+		return command.ParseArgumentError{
+			ArgumentName: "-i",
+			ExpectedType: "a positive integer",
+		}
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Starting canary release of {{.CanaryAppName}} alongside {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"CanaryAppName": cmd.RequiredArgs.CanaryAppName,
+		"AppName":       cmd.RequiredArgs.AppName,
+		"OrgName":       cmd.Config.TargetedOrganization().Name,
+		"SpaceName":     cmd.Config.TargetedSpace().Name,
+		"Username":      user.Name,
+	})
+
+	config, warnings, err := cmd.Actor.ConvertToCanaryConfig(cmd.RequiredArgs.AppName, cmd.RequiredArgs.CanaryAppName, cmd.Config.TargetedSpace().GUID, cmd.Instances)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayText("Mapping routes of {{.AppName}} to {{.CanaryAppName}}...", map[string]interface{}{
+		"AppName":       cmd.RequiredArgs.AppName,
+		"CanaryAppName": cmd.RequiredArgs.CanaryAppName,
+	})
+	warnings, err = cmd.Actor.MapCanaryRoutes(config)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		// Some of the routes may have been mapped before the failure.
+		return cmd.abortAfterError(config, err)
+	}
+
+	for i, percentage := range steps {
+		step, warnings, err := cmd.Actor.ScaleCanary(config, percentage)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return cmd.abortAfterError(config, err)
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Step {{.Step}} of {{.Steps}}: {{.Percentage}}% on canary ({{.CanaryInstances}} instances of {{.CanaryAppName}}, {{.CurrentInstances}} instances of {{.AppName}})", map[string]interface{}{
+			"Step":             i + 1,
+			"Steps":            len(steps),
+			"Percentage":       step.Percentage,
+			"CanaryInstances":  step.CanaryInstances,
+			"CanaryAppName":    cmd.RequiredArgs.CanaryAppName,
+			"CurrentInstances": step.CurrentInstances,
+			"AppName":          cmd.RequiredArgs.AppName,
+		})
+
+		healthy, err := cmd.watchCanary(config, step)
+		if err != nil {
+			return cmd.abortAfterError(config, err)
+		}
+
+		if !healthy {
+			err = cmd.abort(config)
+			if err != nil {
+				return err
+			}
+			return shared.CanaryAbortedError{
+				CanaryAppName: cmd.RequiredArgs.CanaryAppName,
+				AppName:       cmd.RequiredArgs.AppName,
+			}
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Promoting {{.CanaryAppName}}, unmapping and stopping {{.AppName}}...", map[string]interface{}{
+		"CanaryAppName": cmd.RequiredArgs.CanaryAppName,
+		"AppName":       cmd.RequiredArgs.AppName,
+	})
+	warnings, err = cmd.Actor.PromoteCanary(config)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return cmd.abortAfterError(config, err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// watchCanary polls the canary's instances for the configured interval. The
+// canary is unhealthy when any instance crashes, or when not all of its
+// instances are running by the end of the interval.
+func (cmd CanaryCommand) watchCanary(config pushaction.CanaryConfig, step pushaction.CanaryStep) (bool, error) {
+	cmd.UI.DisplayText("Watching {{.CanaryAppName}} for {{.Interval}} seconds...", map[string]interface{}{
+		"CanaryAppName": cmd.RequiredArgs.CanaryAppName,
+		"Interval":      cmd.Interval,
+	})
+
+	deadline := time.Now().Add(time.Duration(cmd.Interval) * time.Second)
+	for {
+		health, warnings, err := cmd.Actor.GetCanaryHealth(config)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return false, err
+		}
+
+		if health.Failed() {
+			cmd.UI.DisplayWarning("{{.Crashed}} instances of {{.CanaryAppName}} crashed.", map[string]interface{}{
+				"Crashed":       health.Crashed,
+				"CanaryAppName": cmd.RequiredArgs.CanaryAppName,
+			})
+			return false, nil
+		}
+
+		if !time.Now().Before(deadline) {
+			if health.Running < step.CanaryInstances {
+				cmd.UI.DisplayWarning("Only {{.Running}} of {{.Instances}} instances of {{.CanaryAppName}} are running.", map[string]interface{}{
+					"Running":       health.Running,
+					"Instances":     step.CanaryInstances,
+					"CanaryAppName": cmd.RequiredArgs.CanaryAppName,
+				})
+				return false, nil
+			}
+
+			cmd.UI.DisplayText("{{.Running}} of {{.Instances}} instances running", map[string]interface{}{
+				"Running":   health.Running,
+				"Instances": step.CanaryInstances,
+			})
+			return true, nil
+		}
+
+		time.Sleep(cmd.Config.PollingInterval())
+	}
+}
+
+// abort restores the current application and stops the canary.
+func (cmd CanaryCommand) abort(config pushaction.CanaryConfig) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Aborting, restoring {{.AppName}} and stopping {{.CanaryAppName}}...", map[string]interface{}{
+		"AppName":       cmd.RequiredArgs.AppName,
+		"CanaryAppName": cmd.RequiredArgs.CanaryAppName,
+	})
+
+	warnings, err := cmd.Actor.AbortCanary(config)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	return nil
+}
+
+// abortAfterError aborts the release once any route may be shared with the
+// canary, so that a failed step does not leave traffic split between the two
+// applications, and then returns the original error.
+func (cmd CanaryCommand) abortAfterError(config pushaction.CanaryConfig, err error) error {
+	abortErr := cmd.abort(config)
+	if abortErr != nil {
+		return abortErr
+	}
+	return shared.HandleError(err)
+}
+
+func (cmd CanaryCommand) parseSteps() ([]int, error) {
+	invalidSteps := command.ParseArgumentError{
+		ArgumentName: "--steps",
+		ExpectedType: "increasing percentages between 1 and 99 separated by commas",
+	}
+
+	var steps []int
+	for _, value := range strings.Split(cmd.Steps, ",") {
+		percentage, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || percentage < 1 || percentage > 99 {
+			return nil, invalidSteps
+		}
+		if len(steps) > 0 && percentage <= steps[len(steps)-1] {
+			return nil, invalidSteps
+		}
+		steps = append(steps, percentage)
+	}
+	return steps, nil
+}
@@ -0,0 +1,296 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("canary Command", func() {
+	var (
+		cmd             CanaryCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCanaryActor
+		canaryConfig    pushaction.CanaryConfig
+		canaryInstances int
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCanaryActor)
+
+		cmd = CanaryCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Steps:       "25,50",
+			Interval:    0,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.CanaryAppName = "some-app-v2"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.PollingIntervalReturns(time.Millisecond)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+		canaryConfig = pushaction.CanaryConfig{TotalInstances: 4}
+		fakeActor.ConvertToCanaryConfigReturns(canaryConfig, pushaction.Warnings{"config-warning"}, nil)
+		fakeActor.MapCanaryRoutesReturns(pushaction.Warnings{"map-warning"}, nil)
+		fakeActor.ScaleCanaryStub = func(_ pushaction.CanaryConfig, percentage int) (pushaction.CanaryStep, pushaction.Warnings, error) {
+			canaryInstances = percentage * 4 / 100
+			return pushaction.CanaryStep{
+				Percentage:       percentage,
+				CanaryInstances:  canaryInstances,
+				CurrentInstances: 4 - canaryInstances,
+			}, pushaction.Warnings{"scale-warning"}, nil
+		}
+		fakeActor.GetCanaryHealthStub = func(pushaction.CanaryConfig) (pushaction.CanaryHealth, pushaction.Warnings, error) {
+			return pushaction.CanaryHealth{Running: canaryInstances}, nil, nil
+		}
+		fakeActor.PromoteCanaryReturns(pushaction.Warnings{"promote-warning"}, nil)
+		fakeActor.AbortCanaryReturns(pushaction.Warnings{"abort-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the steps are invalid", func() {
+		BeforeEach(func() {
+			cmd.Steps = "50,25"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "--steps",
+				ExpectedType: "increasing percentages between 1 and 99 separated by commas",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a step is 100 percent", func() {
+		BeforeEach(func() {
+			cmd.Steps = "50,100"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(command.ParseArgumentError{}))
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			config, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(config).To(Equal(fakeConfig))
+			Expect(targetedOrganizationRequired).To(BeTrue())
+			Expect(targetedSpaceRequired).To(BeTrue())
+		})
+	})
+
+	Context("when the canary app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.ConvertToCanaryConfigReturns(pushaction.CanaryConfig{}, pushaction.Warnings{"config-warning"}, v2action.ApplicationNotFoundError{Name: "some-app-v2"})
+		})
+
+		It("returns an ApplicationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app-v2"}))
+			Expect(testUI.Err).To(Say("config-warning"))
+			Expect(fakeActor.MapCanaryRoutesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the canary stays healthy", func() {
+		BeforeEach(func() {
+			cmd.Instances = 8
+		})
+
+		It("maps the routes, scales through every step and promotes the canary", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Starting canary release of some-app-v2 alongside some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("Mapping routes of some-app to some-app-v2..."))
+			Expect(testUI.Out).To(Say(`Step 1 of 2: %d%% on canary \(1 instances of some-app-v2, 3 instances of some-app\)`, 25))
+			Expect(testUI.Out).To(Say("Watching some-app-v2 for 0 seconds..."))
+			Expect(testUI.Out).To(Say("1 of 1 instances running"))
+			Expect(testUI.Out).To(Say(`Step 2 of 2: %d%% on canary \(2 instances of some-app-v2, 2 instances of some-app\)`, 50))
+			Expect(testUI.Out).To(Say("2 of 2 instances running"))
+			Expect(testUI.Out).To(Say("Promoting some-app-v2, unmapping and stopping some-app..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(testUI.Err).To(Say("config-warning"))
+			Expect(testUI.Err).To(Say("map-warning"))
+			Expect(testUI.Err).To(Say("scale-warning"))
+			Expect(testUI.Err).To(Say("promote-warning"))
+
+			Expect(fakeActor.ConvertToCanaryConfigCallCount()).To(Equal(1))
+			currentAppName, canaryAppName, spaceGUID, totalInstances := fakeActor.ConvertToCanaryConfigArgsForCall(0)
+			Expect(currentAppName).To(Equal("some-app"))
+			Expect(canaryAppName).To(Equal("some-app-v2"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(totalInstances).To(Equal(8))
+
+			Expect(fakeActor.MapCanaryRoutesCallCount()).To(Equal(1))
+			Expect(fakeActor.ScaleCanaryCallCount()).To(Equal(2))
+			_, percentage := fakeActor.ScaleCanaryArgsForCall(1)
+			Expect(percentage).To(Equal(50))
+			Expect(fakeActor.PromoteCanaryCallCount()).To(Equal(1))
+			Expect(fakeActor.PromoteCanaryArgsForCall(0)).To(Equal(canaryConfig))
+			Expect(fakeActor.AbortCanaryCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a canary instance crashes", func() {
+		BeforeEach(func() {
+			fakeActor.GetCanaryHealthReturns(pushaction.CanaryHealth{Running: 1, Crashed: 1}, pushaction.Warnings{"health-warning"}, nil)
+		})
+
+		It("aborts the release", func() {
+			Expect(executeErr).To(MatchError(shared.CanaryAbortedError{
+				CanaryAppName: "some-app-v2",
+				AppName:       "some-app",
+			}))
+
+			Expect(testUI.Err).To(Say("health-warning"))
+			Expect(testUI.Err).To(Say("1 instances of some-app-v2 crashed."))
+			Expect(testUI.Out).To(Say("Aborting, restoring some-app and stopping some-app-v2..."))
+			Expect(testUI.Err).To(Say("abort-warning"))
+
+			Expect(fakeActor.ScaleCanaryCallCount()).To(Equal(1))
+			Expect(fakeActor.AbortCanaryCallCount()).To(Equal(1))
+			Expect(fakeActor.PromoteCanaryCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the canary instances are not running by the end of the interval", func() {
+		BeforeEach(func() {
+			fakeActor.GetCanaryHealthReturns(pushaction.CanaryHealth{Starting: 1}, nil, nil)
+		})
+
+		It("aborts the release", func() {
+			Expect(executeErr).To(MatchError(shared.CanaryAbortedError{
+				CanaryAppName: "some-app-v2",
+				AppName:       "some-app",
+			}))
+
+			Expect(testUI.Err).To(Say("Only 0 of 1 instances of some-app-v2 are running."))
+			Expect(fakeActor.AbortCanaryCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when watching for longer than one poll", func() {
+		BeforeEach(func() {
+			cmd.Steps = "50"
+			cmd.Interval = 1
+			fakeActor.GetCanaryHealthStub = func(pushaction.CanaryConfig) (pushaction.CanaryHealth, pushaction.Warnings, error) {
+				if fakeActor.GetCanaryHealthCallCount() < 3 {
+					return pushaction.CanaryHealth{Starting: 2}, nil, nil
+				}
+				return pushaction.CanaryHealth{Running: 2}, nil, nil
+			}
+		})
+
+		It("polls until the interval has passed", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetCanaryHealthCallCount()).To(BeNumerically(">", 2))
+			Expect(fakeActor.PromoteCanaryCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when mapping the routes fails", func() {
+		BeforeEach(func() {
+			fakeActor.MapCanaryRoutesReturns(pushaction.Warnings{"map-warning"}, errors.New("map failed"))
+		})
+
+		It("aborts the release to unmap any routes already mapped and returns the error", func() {
+			Expect(executeErr).To(MatchError("map failed"))
+			Expect(testUI.Err).To(Say("map-warning"))
+			Expect(testUI.Out).To(Say("Aborting, restoring some-app and stopping some-app-v2..."))
+			Expect(fakeActor.AbortCanaryCallCount()).To(Equal(1))
+			Expect(fakeActor.AbortCanaryArgsForCall(0)).To(Equal(canaryConfig))
+			Expect(fakeActor.ScaleCanaryCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when scaling fails", func() {
+		BeforeEach(func() {
+			fakeActor.ScaleCanaryReturns(pushaction.CanaryStep{}, pushaction.Warnings{"scale-warning"}, errors.New("scale failed"))
+			fakeActor.ScaleCanaryStub = nil
+		})
+
+		It("aborts the release and returns the error", func() {
+			Expect(executeErr).To(MatchError("scale failed"))
+			Expect(testUI.Err).To(Say("scale-warning"))
+			Expect(testUI.Out).To(Say("Aborting, restoring some-app and stopping some-app-v2..."))
+			Expect(testUI.Err).To(Say("abort-warning"))
+			Expect(fakeActor.AbortCanaryCallCount()).To(Equal(1))
+			Expect(fakeActor.AbortCanaryArgsForCall(0)).To(Equal(canaryConfig))
+			Expect(fakeActor.PromoteCanaryCallCount()).To(Equal(0))
+		})
+
+		Context("when aborting fails", func() {
+			BeforeEach(func() {
+				fakeActor.AbortCanaryReturns(pushaction.Warnings{"abort-warning"}, errors.New("abort failed"))
+			})
+
+			It("returns the abort error", func() {
+				Expect(executeErr).To(MatchError("abort failed"))
+				Expect(testUI.Err).To(Say("abort-warning"))
+			})
+		})
+	})
+
+	Context("when getting the canary health fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetCanaryHealthReturns(pushaction.CanaryHealth{}, pushaction.Warnings{"health-warning"}, errors.New("health failed"))
+		})
+
+		It("aborts the release and returns the error", func() {
+			Expect(executeErr).To(MatchError("health failed"))
+			Expect(testUI.Err).To(Say("health-warning"))
+			Expect(testUI.Out).To(Say("Aborting, restoring some-app and stopping some-app-v2..."))
+			Expect(fakeActor.AbortCanaryCallCount()).To(Equal(1))
+			Expect(fakeActor.PromoteCanaryCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when promoting the canary fails", func() {
+		BeforeEach(func() {
+			fakeActor.PromoteCanaryReturns(pushaction.Warnings{"promote-warning"}, errors.New("promote failed"))
+		})
+
+		It("aborts the release and returns the error", func() {
+			Expect(executeErr).To(MatchError("promote failed"))
+			Expect(testUI.Err).To(Say("promote-warning"))
+			Expect(testUI.Out).To(Say("Aborting, restoring some-app and stopping some-app-v2..."))
+			Expect(fakeActor.AbortCanaryCallCount()).To(Equal(1))
+		})
+	})
+})
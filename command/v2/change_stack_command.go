@@ -0,0 +1,252 @@
+package v2
+
+import (
+	"github.com/cloudfoundry/noaa/consumer"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . ChangeStackActor
+
+type ChangeStackActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	GetStack(guid string) (v2action.Stack, v2action.Warnings, error)
+	GetStackByName(name string) (v2action.Stack, v2action.Warnings, error)
+	StartApplication(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error)
+	UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
+}
+
+type ChangeStackCommand struct {
+	RequiredArgs        flag.ChangeStackArgs `positional-args:"yes"`
+	All                 bool                 `long:"all" description:"Move every app in the targeted space that is not already on STACK"`
+	usage               interface{}          `usage:"CF_NAME change-stack APP_NAME STACK\n   CF_NAME change-stack --all STACK\n\n   Started apps are restaged on STACK. If staging or starting fails, the app is moved back\n   to its original stack and restaged. Stopped apps are staged on STACK the next time they are started.\n\nEXAMPLES:\n   CF_NAME change-stack my-app cflinuxfs3\n   CF_NAME change-stack --all cflinuxfs3"`
+	envCFStagingTimeout interface{}          `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}          `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	relatedCommands     interface{}          `related_commands:"buildpack-usage, restage, stack, stacks"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ChangeStackActor
+	NOAAClient  *consumer.Consumer
+}
+
+func (cmd *ChangeStackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+
+	return nil
+}
+
+func (cmd ChangeStackCommand) Execute(args []string) error {
+	appName, stackName, err := cmd.parseArguments()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.All {
+		cmd.UI.DisplayTextWithFlavor("Changing stack of all apps in org {{.OrgName}} / space {{.SpaceName}} to {{.Stack}} as {{.CurrentUser}}...",
+			map[string]interface{}{
+				"OrgName":     cmd.Config.TargetedOrganization().Name,
+				"SpaceName":   cmd.Config.TargetedSpace().Name,
+				"Stack":       stackName,
+				"CurrentUser": user.Name,
+			})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Changing stack of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} to {{.Stack}} as {{.CurrentUser}}...",
+			map[string]interface{}{
+				"AppName":     appName,
+				"OrgName":     cmd.Config.TargetedOrganization().Name,
+				"SpaceName":   cmd.Config.TargetedSpace().Name,
+				"Stack":       stackName,
+				"CurrentUser": user.Name,
+			})
+	}
+
+	stack, warnings, err := cmd.Actor.GetStackByName(stackName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	apps, err := cmd.getApplications(appName)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(apps) == 0 {
+		cmd.UI.DisplayText("No apps found.")
+		return nil
+	}
+
+	var revertedApps, failedApps []string
+	for _, app := range apps {
+		cmd.UI.DisplayNewline()
+		reverted, err := cmd.changeStack(app, stack)
+		if err != nil {
+			if !cmd.All {
+				return err
+			}
+			cmd.UI.DisplayError(err)
+			failedApps = append(failedApps, app.Name)
+			continue
+		}
+		if reverted {
+			revertedApps = append(revertedApps, app.Name)
+		}
+	}
+
+	if len(failedApps) > 0 {
+		return shared.StackChangeFailedError{AppNames: failedApps, RevertedAppNames: revertedApps, Stack: stack.Name}
+	}
+	if len(revertedApps) > 0 {
+		return shared.StackChangeRevertedError{AppNames: revertedApps, Stack: stack.Name}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// parseArguments returns the app and stack names. With --all the only
+// positional argument is the stack.
+func (cmd ChangeStackCommand) parseArguments() (string, string, error) {
+	if cmd.All {
+		if cmd.RequiredArgs.Stack != "" {
+			return "", "", command.ArgumentCombinationError{Args: []string{"--all", "APP_NAME"}}
+		}
+		if cmd.RequiredArgs.AppName == "" {
+			return "", "", command.RequiredArgumentError{ArgumentName: "STACK"}
+		}
+		return "", cmd.RequiredArgs.AppName, nil
+	}
+
+	if cmd.RequiredArgs.AppName == "" {
+		return "", "", command.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	}
+	if cmd.RequiredArgs.Stack == "" {
+		return "", "", command.RequiredArgumentError{ArgumentName: "STACK"}
+	}
+	return cmd.RequiredArgs.AppName, cmd.RequiredArgs.Stack, nil
+}
+
+func (cmd ChangeStackCommand) getApplications(appName string) ([]v2action.Application, error) {
+	if cmd.All {
+		apps, warnings, err := cmd.Actor.GetApplicationsBySpace(cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		return apps, err
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return nil, err
+	}
+	return []v2action.Application{app}, nil
+}
+
+// changeStack moves the app to the stack. When the app fails to stage or
+// start on the new stack, it is moved back to its original stack and true is
+// returned.
+func (cmd ChangeStackCommand) changeStack(app v2action.Application, stack v2action.Stack) (bool, error) {
+	if app.StackGUID == stack.GUID {
+		cmd.UI.DisplayText("App {{.AppName}} is already on stack {{.Stack}}",
+			map[string]interface{}{
+				"AppName": app.Name,
+				"Stack":   stack.Name,
+			})
+		return false, nil
+	}
+
+	originalStack, warnings, err := cmd.Actor.GetStack(app.StackGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return false, shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayText("Changing stack of app {{.AppName}} from {{.OriginalStack}} to {{.Stack}}...",
+		map[string]interface{}{
+			"AppName":       app.Name,
+			"OriginalStack": originalStack.Name,
+			"Stack":         stack.Name,
+		})
+
+	err = cmd.moveToStack(app, stack.GUID)
+	switch err.(type) {
+	case nil:
+		if !app.Started() {
+			cmd.UI.DisplayText("App {{.AppName}} is stopped. It will be staged on {{.Stack}} the next time it is started.",
+				map[string]interface{}{
+					"AppName": app.Name,
+					"Stack":   stack.Name,
+				})
+		}
+		return false, nil
+	case shared.StagingFailedError, shared.StagingFailedNoAppDetectedError, shared.StagingTimeoutError,
+		shared.UnsuccessfulStartError, shared.StartupTimeoutError:
+		cmd.UI.DisplayWarning("Restaging app {{.AppName}} on {{.Stack}} failed. Moving it back to {{.OriginalStack}}...",
+			map[string]interface{}{
+				"AppName":       app.Name,
+				"Stack":         stack.Name,
+				"OriginalStack": originalStack.Name,
+			})
+		err = cmd.moveToStack(app, originalStack.GUID)
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	default:
+		return false, err
+	}
+}
+
+// moveToStack sets the stack of the app. A started app is stopped in the
+// same update and then started again, which restages it on the stack.
+func (cmd ChangeStackCommand) moveToStack(app v2action.Application, stackGUID string) error {
+	update := v2action.Application{
+		GUID:      app.GUID,
+		StackGUID: stackGUID,
+	}
+	if app.Started() {
+		update.State = ccv2.ApplicationStopped
+	}
+
+	_, warnings, err := cmd.Actor.UpdateApplication(update)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !app.Started() {
+		return nil
+	}
+
+	messages, logErrs, appStarting, apiWarnings, errs := cmd.Actor.StartApplication(app, cmd.NOAAClient, cmd.Config)
+	return shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appStarting, apiWarnings, errs)
+}
@@ -0,0 +1,387 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("change-stack Command", func() {
+	var (
+		cmd             ChangeStackCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeChangeStackActor
+		stagingErrs     []error
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeChangeStackActor)
+
+		cmd = ChangeStackCommand{
+			RequiredArgs: flag.ChangeStackArgs{AppName: "some-app", Stack: "new-stack"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+		fakeActor.GetStackByNameReturns(v2action.Stack{GUID: "new-stack-guid", Name: "new-stack"}, v2action.Warnings{"stack-warning"}, nil)
+		fakeActor.GetStackReturns(v2action.Stack{GUID: "old-stack-guid", Name: "old-stack"}, nil, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app", StackGUID: "old-stack-guid", State: ccv2.ApplicationStarted},
+			v2action.Warnings{"app-warning"},
+			nil)
+		fakeActor.UpdateApplicationReturns(v2action.Application{}, v2action.Warnings{"update-warning"}, nil)
+
+		// Each call to StartApplication fails with the next error in stagingErrs,
+		// or succeeds once they run out.
+		stagingErrs = nil
+		fakeActor.StartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+			messages := make(chan *v2action.LogMessage)
+			logErrs := make(chan error)
+			appStart := make(chan bool)
+			warnings := make(chan string)
+			errs := make(chan error)
+
+			var stagingErr error
+			if len(stagingErrs) > 0 {
+				stagingErr, stagingErrs = stagingErrs[0], stagingErrs[1:]
+			}
+
+			go func() {
+				if stagingErr != nil {
+					errs <- stagingErr
+				}
+				close(messages)
+				close(logErrs)
+				close(appStart)
+				close(warnings)
+				close(errs)
+			}()
+
+			return messages, logErrs, appStart, warnings, errs
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the stack is missing", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Stack = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "STACK"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --all is used with an app name", func() {
+		BeforeEach(func() {
+			cmd.All = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Args: []string{"--all", "APP_NAME"}}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoTargetedSpaceError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NoTargetedSpaceError{BinaryName: "faceman"}))
+
+			config, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(config).To(Equal(fakeConfig))
+			Expect(targetedOrganizationRequired).To(BeTrue())
+			Expect(targetedSpaceRequired).To(BeTrue())
+		})
+	})
+
+	Context("when the stack does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetStackByNameReturns(v2action.Stack{}, v2action.Warnings{"stack-warning"}, v2action.StackNotFoundError{Name: "new-stack"})
+		})
+
+		It("returns a StackNotFoundError", func() {
+			Expect(executeErr).To(MatchError(shared.StackNotFoundError{Name: "new-stack"}))
+			Expect(testUI.Err).To(Say("stack-warning"))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app is started", func() {
+		It("moves the app to the stack and restages it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Changing stack of app some-app in org some-org / space some-space to new-stack as some-user..."))
+			Expect(testUI.Out).To(Say("Changing stack of app some-app from old-stack to new-stack..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("stack-warning"))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("update-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(fakeActor.GetStackByNameArgsForCall(0)).To(Equal("new-stack"))
+			Expect(fakeActor.GetStackArgsForCall(0)).To(Equal("old-stack-guid"))
+
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(1))
+			Expect(fakeActor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{
+				GUID:      "some-app-guid",
+				StackGUID: "new-stack-guid",
+				State:     ccv2.ApplicationStopped,
+			}))
+
+			Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+			app, _, config := fakeActor.StartApplicationArgsForCall(0)
+			Expect(app.GUID).To(Equal("some-app-guid"))
+			Expect(config).To(Equal(fakeConfig))
+		})
+
+		Context("when staging on the new stack fails", func() {
+			BeforeEach(func() {
+				stagingErrs = []error{v2action.StagingFailedError{Reason: "no compatible buildpack"}}
+			})
+
+			It("moves the app back to its original stack and restages it", func() {
+				Expect(executeErr).To(MatchError(shared.StackChangeRevertedError{AppNames: []string{"some-app"}, Stack: "new-stack"}))
+				Expect(testUI.Err).To(Say("Restaging app some-app on new-stack failed. Moving it back to old-stack..."))
+
+				Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(2))
+				Expect(fakeActor.UpdateApplicationArgsForCall(1)).To(Equal(v2action.Application{
+					GUID:      "some-app-guid",
+					StackGUID: "old-stack-guid",
+					State:     ccv2.ApplicationStopped,
+				}))
+				Expect(fakeActor.StartApplicationCallCount()).To(Equal(2))
+			})
+
+			Context("when restaging on the original stack fails too", func() {
+				BeforeEach(func() {
+					stagingErrs = append(stagingErrs, v2action.StagingTimeoutError{Name: "some-app"})
+				})
+
+				It("returns the staging error", func() {
+					Expect(executeErr).To(MatchError(shared.StagingTimeoutError{AppName: "some-app"}))
+				})
+			})
+		})
+
+		Context("when the app fails to start after staging", func() {
+			BeforeEach(func() {
+				stagingErrs = []error{v2action.ApplicationInstanceCrashedError{Name: "some-app"}}
+			})
+
+			It("moves the app back to its original stack and restages it", func() {
+				Expect(executeErr).To(MatchError(shared.StackChangeRevertedError{AppNames: []string{"some-app"}, Stack: "new-stack"}))
+				Expect(testUI.Err).To(Say("Restaging app some-app on new-stack failed. Moving it back to old-stack..."))
+
+				Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(2))
+				Expect(fakeActor.UpdateApplicationArgsForCall(1).StackGUID).To(Equal("old-stack-guid"))
+				Expect(fakeActor.StartApplicationCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the app does not start in time after staging", func() {
+			BeforeEach(func() {
+				stagingErrs = []error{v2action.StartupTimeoutError{Name: "some-app"}}
+			})
+
+			It("moves the app back to its original stack and restages it", func() {
+				Expect(executeErr).To(MatchError(shared.StackChangeRevertedError{AppNames: []string{"some-app"}, Stack: "new-stack"}))
+				Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(2))
+				Expect(fakeActor.UpdateApplicationArgsForCall(1).StackGUID).To(Equal("old-stack-guid"))
+			})
+		})
+
+		Context("when updating the app fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationReturns(v2action.Application{}, v2action.Warnings{"update-warning"}, errors.New("update error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("update error"))
+				Expect(testUI.Err).To(Say("update-warning"))
+				Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the app is stopped", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v2action.Application{GUID: "some-app-guid", Name: "some-app", StackGUID: "old-stack-guid", State: ccv2.ApplicationStopped},
+				nil,
+				nil)
+		})
+
+		It("only moves the app to the stack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("App some-app is stopped. It will be staged on new-stack the next time it is started."))
+
+			Expect(fakeActor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{
+				GUID:      "some-app-guid",
+				StackGUID: "new-stack-guid",
+			}))
+			Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app is already on the stack", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v2action.Application{GUID: "some-app-guid", Name: "some-app", StackGUID: "new-stack-guid", State: ccv2.ApplicationStarted},
+				nil,
+				nil)
+		})
+
+		It("leaves the app alone", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("App some-app is already on stack new-stack"))
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+			Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --all is used", func() {
+		BeforeEach(func() {
+			cmd.All = true
+			cmd.RequiredArgs = flag.ChangeStackArgs{AppName: "new-stack"}
+
+			fakeActor.GetApplicationsBySpaceReturns(
+				[]v2action.Application{
+					{GUID: "app-guid-1", Name: "app-1", StackGUID: "old-stack-guid", State: ccv2.ApplicationStarted},
+					{GUID: "app-guid-2", Name: "app-2", StackGUID: "new-stack-guid", State: ccv2.ApplicationStarted},
+					{GUID: "app-guid-3", Name: "app-3", StackGUID: "old-stack-guid", State: ccv2.ApplicationStarted},
+				},
+				v2action.Warnings{"apps-warning"},
+				nil)
+		})
+
+		It("moves every app in the space that is not on the stack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Changing stack of all apps in org some-org / space some-space to new-stack as some-user..."))
+			Expect(testUI.Out).To(Say("Changing stack of app app-1 from old-stack to new-stack..."))
+			Expect(testUI.Out).To(Say("App app-2 is already on stack new-stack"))
+			Expect(testUI.Out).To(Say("Changing stack of app app-3 from old-stack to new-stack..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("apps-warning"))
+
+			Expect(fakeActor.GetStackByNameArgsForCall(0)).To(Equal("new-stack"))
+			Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+
+			Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(2))
+			Expect(fakeActor.UpdateApplicationArgsForCall(0).GUID).To(Equal("app-guid-1"))
+			Expect(fakeActor.UpdateApplicationArgsForCall(1).GUID).To(Equal("app-guid-3"))
+			Expect(fakeActor.StartApplicationCallCount()).To(Equal(2))
+		})
+
+		Context("when staging one of the apps fails", func() {
+			BeforeEach(func() {
+				stagingErrs = []error{v2action.StagingFailedNoAppDetectedError{Reason: "no app detected"}}
+			})
+
+			It("moves that app back and continues with the rest", func() {
+				Expect(executeErr).To(MatchError(shared.StackChangeRevertedError{AppNames: []string{"app-1"}, Stack: "new-stack"}))
+
+				Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(3))
+				Expect(fakeActor.UpdateApplicationArgsForCall(1)).To(Equal(v2action.Application{
+					GUID:      "app-guid-1",
+					StackGUID: "old-stack-guid",
+					State:     ccv2.ApplicationStopped,
+				}))
+				Expect(fakeActor.UpdateApplicationArgsForCall(2).GUID).To(Equal("app-guid-3"))
+			})
+		})
+
+		Context("when changing the stack of one of the apps fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationStub = func(app v2action.Application) (v2action.Application, v2action.Warnings, error) {
+					if app.GUID == "app-guid-1" {
+						return v2action.Application{}, nil, errors.New("update error")
+					}
+					return v2action.Application{}, nil, nil
+				}
+			})
+
+			It("reports the error and continues with the rest", func() {
+				Expect(executeErr).To(MatchError(shared.StackChangeFailedError{AppNames: []string{"app-1"}, Stack: "new-stack"}))
+				Expect(testUI.Err).To(Say("update error"))
+				Expect(testUI.Out).To(Say("Changing stack of app app-3 from old-stack to new-stack..."))
+
+				Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(2))
+				Expect(fakeActor.UpdateApplicationArgsForCall(1).GUID).To(Equal("app-guid-3"))
+				Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+			})
+
+			Context("when another app is moved back", func() {
+				BeforeEach(func() {
+					stagingErrs = []error{v2action.StagingFailedError{Reason: "no compatible buildpack"}}
+				})
+
+				It("reports both", func() {
+					Expect(executeErr).To(MatchError(shared.StackChangeFailedError{
+						AppNames:         []string{"app-1"},
+						RevertedAppNames: []string{"app-3"},
+						Stack:            "new-stack",
+					}))
+				})
+			})
+		})
+
+		Context("when there are no apps in the space", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationsBySpaceReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No apps found."))
+			})
+		})
+
+		Context("when no stack is given", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs = flag.ChangeStackArgs{}
+			})
+
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "STACK"}))
+			})
+		})
+	})
+})
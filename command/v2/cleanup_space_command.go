@@ -0,0 +1,368 @@
+package v2
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . CleanupSpaceActor
+
+type CleanupSpaceActor interface {
+	DeleteApplication(guid string) (v2action.Warnings, error)
+	DeleteRoute(routeGUID string) (v2action.Warnings, error)
+	DeleteServiceInstance(serviceInstanceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	DeleteServiceKey(serviceKeyGUID string) (v2action.Warnings, error)
+	GetOrphanedRoutesBySpace(spaceGUID string) ([]v2action.Route, v2action.Warnings, error)
+	GetServiceKeysByServiceInstance(serviceInstanceGUID string) ([]v2action.ServiceKey, v2action.Warnings, error)
+	GetStoppedApplicationsBySpace(spaceGUID string, notUpdatedSince time.Time) ([]v2action.Application, v2action.Warnings, error)
+	GetUnboundServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . CleanupSpaceActorV3
+
+type CleanupSpaceActorV3 interface {
+	DeletePackage(guid string) (v3action.Warnings, error)
+	GetFailedOrExpiredPackagesBySpace(spaceGUID string) ([]v3action.ApplicationPackage, v3action.Warnings, error)
+}
+
+type CleanupSpaceCommand struct {
+	Routes           bool        `long:"routes" description:"Delete routes that are not mapped to any app"`
+	ServiceInstances bool        `long:"service-instances" description:"Delete service instances that are not bound to any app and have no service keys"`
+	ServiceKeys      bool        `long:"service-keys" description:"Delete service keys of service instances that are not bound to any app"`
+	StoppedApps      bool        `long:"stopped-apps" description:"Delete apps that have been stopped for longer than --days"`
+	Packages         bool        `long:"packages" description:"Delete packages that failed to process or have expired"`
+	Days             int         `long:"days" default:"30" description:"Number of days an app must have been stopped for to be deleted"`
+	Force            bool        `short:"f" description:"Force deletion without confirmation"`
+	usage            interface{} `usage:"CF_NAME cleanup-space [--routes] [--service-instances] [--service-keys] [--stopped-apps] [--packages] [--days DAYS] [-f]\n\n   Finds resources in the targeted space that are no longer used and deletes them.\n   When no category is given, every category except service keys is cleaned up.\n   Service keys can be used outside of CF, so they are only deleted when --service-keys\n   is given, and a service instance with service keys is in use unless its keys are\n   deleted as well.\n\nEXAMPLES:\n   CF_NAME cleanup-space\n   CF_NAME cleanup-space --stopped-apps --days 90\n   CF_NAME cleanup-space --routes --service-instances --service-keys -f"`
+	relatedCommands  interface{} `related_commands:"delete-orphaned-routes, delete-service, delete-service-key, delete"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CleanupSpaceActor
+	ActorV3     CleanupSpaceActorV3
+}
+
+// spaceCleanup is the set of unused resources found in a space.
+type spaceCleanup struct {
+	routes           []v2action.Route
+	serviceInstances []v2action.ServiceInstance
+	serviceKeys      []v2action.ServiceKey
+	apps             []v2action.Application
+	packages         []v3action.ApplicationPackage
+
+	// serviceInstanceNames maps the GUIDs of unbound service instances to
+	// their names, for displaying service keys.
+	serviceInstanceNames map[string]string
+}
+
+func (cleanup spaceCleanup) count() int {
+	return len(cleanup.routes) + len(cleanup.serviceInstances) + len(cleanup.serviceKeys) + len(cleanup.apps) + len(cleanup.packages)
+}
+
+func (cmd *CleanupSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	ccClientV3, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(sharedV3.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
+	return nil
+}
+
+func (cmd CleanupSpaceCommand) Execute(args []string) error {
+	if cmd.Days < 0 {
+		return command.ParseArgumentError{
+			ArgumentName: "--days",
+			ExpectedType: "a non-negative integer",
+		}
+	}
+
+	if !cmd.Routes && !cmd.ServiceInstances && !cmd.ServiceKeys && !cmd.StoppedApps && !cmd.Packages {
+		cmd.Routes = true
+		cmd.ServiceInstances = true
+		cmd.StoppedApps = true
+		cmd.Packages = true
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Finding unused resources in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	cleanup, err := cmd.findUnusedResources()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.displayReport(cleanup)
+
+	if cleanup.count() == 0 {
+		cmd.UI.DisplayText("No unused resources found.")
+		return nil
+	}
+
+	if !cmd.Force {
+		deleteResources, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete these {{.Count}} resources?", map[string]interface{}{
+			"Count": cleanup.count(),
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteResources {
+			cmd.UI.DisplayText("No resources were deleted.")
+			return nil
+		}
+	}
+
+	err = cmd.deleteUnusedResources(cleanup)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd CleanupSpaceCommand) findUnusedResources() (spaceCleanup, error) {
+	var cleanup spaceCleanup
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	if cmd.Routes {
+		routes, warnings, err := cmd.Actor.GetOrphanedRoutesBySpace(spaceGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if _, ok := err.(v2action.OrphanedRoutesNotFoundError); !ok && err != nil {
+			return spaceCleanup{}, err
+		}
+		cleanup.routes = routes
+	}
+
+	if cmd.ServiceInstances || cmd.ServiceKeys {
+		serviceInstances, warnings, err := cmd.Actor.GetUnboundServiceInstancesBySpace(spaceGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return spaceCleanup{}, err
+		}
+
+		cleanup.serviceInstanceNames = map[string]string{}
+		for _, serviceInstance := range serviceInstances {
+			cleanup.serviceInstanceNames[serviceInstance.GUID] = serviceInstance.Name
+
+			serviceKeys, warnings, err := cmd.Actor.GetServiceKeysByServiceInstance(serviceInstance.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return spaceCleanup{}, err
+			}
+
+			if cmd.ServiceKeys {
+				cleanup.serviceKeys = append(cleanup.serviceKeys, serviceKeys...)
+			}
+
+			// Service keys are used outside of CF, so an unbound service
+			// instance with service keys is still in use unless its keys are
+			// being deleted as well.
+			if cmd.ServiceInstances && (len(serviceKeys) == 0 || cmd.ServiceKeys) {
+				cleanup.serviceInstances = append(cleanup.serviceInstances, serviceInstance)
+			}
+		}
+	}
+
+	if cmd.StoppedApps {
+		notUpdatedSince := time.Now().AddDate(0, 0, -cmd.Days)
+		apps, warnings, err := cmd.Actor.GetStoppedApplicationsBySpace(spaceGUID, notUpdatedSince)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return spaceCleanup{}, err
+		}
+		cleanup.apps = apps
+	}
+
+	if cmd.Packages {
+		if cmd.ActorV3 == nil {
+			cmd.UI.DisplayWarning("Packages were not checked because the targeted API does not support the v3 API.")
+		} else {
+			pkgs, warnings, err := cmd.ActorV3.GetFailedOrExpiredPackagesBySpace(spaceGUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return spaceCleanup{}, err
+			}
+			cleanup.packages = pkgs
+		}
+	}
+
+	return cleanup, nil
+}
+
+func (cmd CleanupSpaceCommand) displayReport(cleanup spaceCleanup) {
+	if cmd.Routes {
+		table := [][]string{{cmd.UI.TranslateText("route")}}
+		for _, route := range cleanup.routes {
+			table = append(table, []string{route.String()})
+		}
+		cmd.displayCategory("Orphaned routes:", table)
+	}
+
+	if cmd.ServiceInstances {
+		table := [][]string{{cmd.UI.TranslateText("name"), cmd.UI.TranslateText("type")}}
+		for _, serviceInstance := range cleanup.serviceInstances {
+			serviceType := cmd.UI.TranslateText("managed")
+			if ccv2.ServiceInstance(serviceInstance).UserProvided() {
+				serviceType = cmd.UI.TranslateText("user provided")
+			}
+			table = append(table, []string{serviceInstance.Name, serviceType})
+		}
+		if cmd.ServiceKeys {
+			cmd.displayCategory("Service instances with no bindings:", table)
+		} else {
+			cmd.displayCategory("Service instances with no bindings or service keys:", table)
+		}
+	}
+
+	if cmd.ServiceKeys {
+		table := [][]string{{cmd.UI.TranslateText("name"), cmd.UI.TranslateText("service instance")}}
+		for _, serviceKey := range cleanup.serviceKeys {
+			table = append(table, []string{serviceKey.Name, cleanup.serviceInstanceNames[serviceKey.ServiceInstanceGUID]})
+		}
+		cmd.displayCategory("Service keys of service instances with no bindings:", table)
+	}
+
+	if cmd.StoppedApps {
+		table := [][]string{{cmd.UI.TranslateText("name"), cmd.UI.TranslateText("last updated")}}
+		for _, app := range cleanup.apps {
+			table = append(table, []string{app.Name, cmd.UI.UserFriendlyDate(app.UpdatedAt)})
+		}
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayHeader(cmd.UI.TranslateText("Apps stopped for more than {{.Days}} days:", map[string]interface{}{
+			"Days": cmd.Days,
+		}))
+		cmd.displayTable(table)
+	}
+
+	if cmd.Packages && cmd.ActorV3 != nil {
+		table := [][]string{{cmd.UI.TranslateText("guid"), cmd.UI.TranslateText("app"), cmd.UI.TranslateText("state"), cmd.UI.TranslateText("created")}}
+		for _, pkg := range cleanup.packages {
+			created := pkg.CreatedAt
+			if createdAt, err := time.Parse(time.RFC3339, pkg.CreatedAt); err == nil {
+				created = cmd.UI.UserFriendlyDate(createdAt)
+			}
+			table = append(table, []string{pkg.GUID, pkg.ApplicationName, string(pkg.State), created})
+		}
+		cmd.displayCategory("Failed or expired packages:", table)
+	}
+
+	cmd.UI.DisplayNewline()
+}
+
+func (cmd CleanupSpaceCommand) displayCategory(header string, table [][]string) {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayHeader(header)
+	cmd.displayTable(table)
+}
+
+func (cmd CleanupSpaceCommand) displayTable(table [][]string) {
+	if len(table) == 1 {
+		cmd.UI.DisplayText("none")
+		return
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
+
+// deleteUnusedResources deletes service keys before service instances, since
+// a service instance cannot be deleted while it has service keys.
+func (cmd CleanupSpaceCommand) deleteUnusedResources(cleanup spaceCleanup) error {
+	for _, serviceKey := range cleanup.serviceKeys {
+		cmd.UI.DisplayText("Deleting service key {{.ServiceKeyName}}...", map[string]interface{}{
+			"ServiceKeyName": serviceKey.Name,
+		})
+		warnings, err := cmd.Actor.DeleteServiceKey(serviceKey.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, serviceInstance := range cleanup.serviceInstances {
+		cmd.UI.DisplayText("Deleting service instance {{.ServiceInstanceName}}...", map[string]interface{}{
+			"ServiceInstanceName": serviceInstance.Name,
+		})
+		deletedInstance, warnings, err := cmd.Actor.DeleteServiceInstance(serviceInstance.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		if deletedInstance.OperationInProgress() {
+			cmd.UI.DisplayText("Delete in progress. Use '{{.ServicesCommand}}' or '{{.ServiceCommand}}' to check operation status.", map[string]interface{}{
+				"ServicesCommand": cmd.Config.BinaryName() + " services",
+				"ServiceCommand":  cmd.Config.BinaryName() + " service " + serviceInstance.Name,
+			})
+		}
+	}
+
+	for _, route := range cleanup.routes {
+		cmd.UI.DisplayText("Deleting route {{.Route}}...", map[string]interface{}{
+			"Route": route.String(),
+		})
+		warnings, err := cmd.Actor.DeleteRoute(route.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, app := range cleanup.apps {
+		cmd.UI.DisplayText("Deleting app {{.AppName}}...", map[string]interface{}{
+			"AppName": app.Name,
+		})
+		warnings, err := cmd.Actor.DeleteApplication(app.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, pkg := range cleanup.packages {
+		cmd.UI.DisplayText("Deleting package {{.PackageGUID}}...", map[string]interface{}{
+			"PackageGUID": pkg.GUID,
+		})
+		warnings, err := cmd.ActorV3.DeletePackage(pkg.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,355 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("cleanup-space Command", func() {
+	var (
+		cmd             CleanupSpaceCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCleanupSpaceActor
+		fakeActorV3     *v2fakes.FakeCleanupSpaceActorV3
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCleanupSpaceActor)
+		fakeActorV3 = new(v2fakes.FakeCleanupSpaceActorV3)
+
+		cmd = CleanupSpaceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV3:     fakeActorV3,
+			Days:        30,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+		fakeActor.GetOrphanedRoutesBySpaceReturns(
+			[]v2action.Route{{GUID: "route-guid", Host: "some-host", Domain: v2action.Domain{Name: "some-domain.com"}}},
+			v2action.Warnings{"routes-warning"}, nil)
+		fakeActor.GetUnboundServiceInstancesBySpaceReturns(
+			[]v2action.ServiceInstance{
+				{GUID: "instance-guid", Name: "some-instance", Type: ccv2.ManagedService},
+				{GUID: "ups-guid", Name: "some-ups", Type: ccv2.UserProvidedService},
+				{GUID: "keyed-instance-guid", Name: "keyed-instance", Type: ccv2.ManagedService},
+			},
+			v2action.Warnings{"instances-warning"}, nil)
+		fakeActor.GetServiceKeysByServiceInstanceStub = func(serviceInstanceGUID string) ([]v2action.ServiceKey, v2action.Warnings, error) {
+			if serviceInstanceGUID == "keyed-instance-guid" {
+				return []v2action.ServiceKey{{GUID: "key-guid", Name: "some-key", ServiceInstanceGUID: "keyed-instance-guid"}}, v2action.Warnings{"keys-warning"}, nil
+			}
+			return nil, v2action.Warnings{"keys-warning"}, nil
+		}
+		fakeActor.GetStoppedApplicationsBySpaceReturns(
+			[]v2action.Application{{GUID: "app-guid", Name: "some-app", UpdatedAt: time.Now().AddDate(0, 0, -45)}},
+			v2action.Warnings{"apps-warning"}, nil)
+		fakeActorV3.GetFailedOrExpiredPackagesBySpaceReturns(
+			[]v3action.ApplicationPackage{{
+				Package:         v3action.Package{GUID: "package-guid", State: ccv3.PackageStateFailed},
+				ApplicationName: "package-app",
+			}},
+			v3action.Warnings{"packages-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --days is negative", func() {
+		BeforeEach(func() {
+			cmd.Days = -1
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "--days",
+				ExpectedType: "a non-negative integer",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			_, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrganizationRequired).To(BeTrue())
+			Expect(targetedSpaceRequired).To(BeTrue())
+		})
+	})
+
+	Context("when no category is given", func() {
+		Context("when the user confirms", func() {
+			BeforeEach(func() {
+				input.Write([]byte("y\n"))
+			})
+
+			It("reports every category and deletes the resources", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Finding unused resources in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("Orphaned routes:"))
+				Expect(testUI.Out).To(Say("some-host.some-domain.com"))
+				Expect(testUI.Out).To(Say("Service instances with no bindings or service keys:"))
+				Expect(testUI.Out).To(Say(`some-instance\s+managed`))
+				Expect(testUI.Out).To(Say(`some-ups\s+user provided`))
+				Expect(testUI.Out).To(Say("Apps stopped for more than 30 days:"))
+				Expect(testUI.Out).To(Say("some-app"))
+				Expect(testUI.Out).To(Say("Failed or expired packages:"))
+				Expect(testUI.Out).To(Say(`package-guid\s+package-app\s+FAILED`))
+				Expect(testUI.Out).To(Say(`Really delete these 5 resources\?`))
+				Expect(testUI.Out).To(Say("Deleting service instance some-instance..."))
+				Expect(testUI.Out).To(Say("Deleting service instance some-ups..."))
+				Expect(testUI.Out).To(Say("Deleting route some-host.some-domain.com..."))
+				Expect(testUI.Out).To(Say("Deleting app some-app..."))
+				Expect(testUI.Out).To(Say("Deleting package package-guid..."))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(testUI.Err).To(Say("routes-warning"))
+				Expect(testUI.Err).To(Say("instances-warning"))
+				Expect(testUI.Err).To(Say("keys-warning"))
+				Expect(testUI.Err).To(Say("apps-warning"))
+				Expect(testUI.Err).To(Say("packages-warning"))
+
+				Expect(fakeActor.GetOrphanedRoutesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				spaceGUID, notUpdatedSince := fakeActor.GetStoppedApplicationsBySpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(notUpdatedSince).To(BeTemporally("~", time.Now().AddDate(0, 0, -30), time.Minute))
+
+				Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(2))
+				Expect(fakeActor.DeleteServiceInstanceArgsForCall(0)).To(Equal("instance-guid"))
+				Expect(fakeActor.DeleteServiceInstanceArgsForCall(1)).To(Equal("ups-guid"))
+				Expect(fakeActor.DeleteRouteArgsForCall(0)).To(Equal("route-guid"))
+				Expect(fakeActor.DeleteApplicationArgsForCall(0)).To(Equal("app-guid"))
+				Expect(fakeActorV3.DeletePackageArgsForCall(0)).To(Equal("package-guid"))
+				Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user declines", func() {
+			BeforeEach(func() {
+				input.Write([]byte("n\n"))
+			})
+
+			It("does not delete anything", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No resources were deleted."))
+
+				Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+				Expect(fakeActor.DeleteRouteCallCount()).To(Equal(0))
+				Expect(fakeActor.DeleteApplicationCallCount()).To(Equal(0))
+				Expect(fakeActorV3.DeletePackageCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when only some categories are given", func() {
+		BeforeEach(func() {
+			cmd.StoppedApps = true
+			cmd.Days = 7
+			cmd.Force = true
+		})
+
+		It("only reports and deletes those categories, without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Really delete"))
+			Expect(testUI.Out).ToNot(Say("Orphaned routes:"))
+			Expect(fakeActor.GetOrphanedRoutesBySpaceCallCount()).To(Equal(0))
+			Expect(fakeActorV3.GetFailedOrExpiredPackagesBySpaceCallCount()).To(Equal(0))
+
+			_, notUpdatedSince := fakeActor.GetStoppedApplicationsBySpaceArgsForCall(0)
+			Expect(notUpdatedSince).To(BeTemporally("~", time.Now().AddDate(0, 0, -7), time.Minute))
+
+			Expect(fakeActor.GetUnboundServiceInstancesBySpaceCallCount()).To(Equal(0))
+			Expect(fakeActor.DeleteApplicationCallCount()).To(Equal(1))
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+			Expect(fakeActor.DeleteRouteCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when an unbound service instance has service keys", func() {
+		BeforeEach(func() {
+			cmd.ServiceInstances = true
+			cmd.Force = true
+			fakeActor.GetUnboundServiceInstancesBySpaceReturns(
+				[]v2action.ServiceInstance{{GUID: "keyed-instance-guid", Name: "keyed-instance", Type: ccv2.ManagedService}},
+				nil, nil)
+		})
+
+		It("treats the service instance as in use", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("keyed-instance"))
+			Expect(testUI.Out).To(Say("No unused resources found."))
+
+			Expect(fakeActor.GetServiceKeysByServiceInstanceArgsForCall(0)).To(Equal("keyed-instance-guid"))
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --service-keys is given", func() {
+		BeforeEach(func() {
+			cmd.ServiceKeys = true
+			cmd.Force = true
+		})
+
+		It("reports and deletes the service keys of unbound service instances", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Service instances with no bindings"))
+			Expect(testUI.Out).To(Say("Service keys of service instances with no bindings:"))
+			Expect(testUI.Out).To(Say(`some-key\s+keyed-instance`))
+			Expect(testUI.Out).To(Say("Deleting service key some-key..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.GetServiceKeysByServiceInstanceCallCount()).To(Equal(3))
+			Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(1))
+			Expect(fakeActor.DeleteServiceKeyArgsForCall(0)).To(Equal("key-guid"))
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+		})
+
+		Context("when --service-instances is given as well", func() {
+			BeforeEach(func() {
+				cmd.ServiceInstances = true
+			})
+
+			It("deletes the service keys before every unbound service instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Service instances with no bindings:"))
+				Expect(testUI.Out).To(Say(`keyed-instance\s+managed`))
+				Expect(testUI.Out).To(Say("Service keys of service instances with no bindings:"))
+				Expect(testUI.Out).To(Say("Deleting service key some-key..."))
+				Expect(testUI.Out).To(Say("Deleting service instance some-instance..."))
+				Expect(testUI.Out).To(Say("Deleting service instance some-ups..."))
+				Expect(testUI.Out).To(Say("Deleting service instance keyed-instance..."))
+
+				Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(1))
+				Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(3))
+				Expect(fakeActor.DeleteServiceInstanceArgsForCall(2)).To(Equal("keyed-instance-guid"))
+			})
+		})
+	})
+
+	Context("when the service broker deletes a service instance asynchronously", func() {
+		BeforeEach(func() {
+			cmd.ServiceInstances = true
+			cmd.Force = true
+			fakeActor.DeleteServiceInstanceReturns(
+				v2action.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "delete", State: ccv2.LastOperationInProgress}},
+				nil, nil)
+		})
+
+		It("displays that the delete is in progress", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Deleting service instance some-instance..."))
+			Expect(testUI.Out).To(Say("Delete in progress. Use 'faceman services' or 'faceman service some-instance' to check operation status."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Context("when getting the service keys fails", func() {
+		BeforeEach(func() {
+			cmd.ServiceInstances = true
+			fakeActor.GetServiceKeysByServiceInstanceStub = nil
+			fakeActor.GetServiceKeysByServiceInstanceReturns(nil, v2action.Warnings{"keys-warning"}, errors.New("keys error"))
+		})
+
+		It("returns the error without deleting anything", func() {
+			Expect(executeErr).To(MatchError("keys error"))
+			Expect(testUI.Err).To(Say("keys-warning"))
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when there is nothing to clean up", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrphanedRoutesBySpaceReturns(nil, nil, v2action.OrphanedRoutesNotFoundError{})
+			fakeActor.GetUnboundServiceInstancesBySpaceReturns(nil, nil, nil)
+			fakeActor.GetStoppedApplicationsBySpaceReturns(nil, nil, nil)
+			fakeActorV3.GetFailedOrExpiredPackagesBySpaceReturns(nil, nil, nil)
+		})
+
+		It("displays that no unused resources were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Orphaned routes:"))
+			Expect(testUI.Out).To(Say("none"))
+			Expect(testUI.Out).To(Say("No unused resources found."))
+			Expect(testUI.Out).ToNot(Say("Really delete"))
+		})
+	})
+
+	Context("when the v3 API is not available", func() {
+		BeforeEach(func() {
+			cmd.ActorV3 = nil
+			cmd.Force = true
+		})
+
+		It("warns that packages were not checked", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("Packages were not checked because the targeted API does not support the v3 API."))
+			Expect(testUI.Out).ToNot(Say("Failed or expired packages:"))
+		})
+	})
+
+	Context("when finding resources fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetUnboundServiceInstancesBySpaceReturns(nil, v2action.Warnings{"instances-warning"}, errors.New("instances error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("instances error"))
+			Expect(testUI.Err).To(Say("instances-warning"))
+			Expect(fakeActor.DeleteRouteCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when deleting a resource fails", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+			fakeActor.DeleteServiceInstanceReturns(v2action.ServiceInstance{}, v2action.Warnings{"delete-warning"}, errors.New("delete error"))
+		})
+
+		It("stops and returns the error", func() {
+			Expect(executeErr).To(MatchError("delete error"))
+			Expect(testUI.Err).To(Say("delete-warning"))
+			Expect(fakeActor.DeleteServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeActor.DeleteRouteCallCount()).To(Equal(0))
+		})
+	})
+})
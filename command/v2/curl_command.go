@@ -9,13 +9,19 @@ import (
 )
 
 type CurlCommand struct {
-	RequiredArgs          flag.APIPath    `positional-args:"yes"`
+	OptionalArgs          flag.APIPath    `positional-args:"yes"`
 	CustomHeaders         []string        `short:"H" description:"Custom headers to include in the request, flag can be specified multiple times"`
 	HTTPMethod            string          `short:"X" description:"HTTP method (GET,POST,PUT,DELETE,etc)"`
 	HTTPData              flag.PathWithAt `short:"d" description:"HTTP data to include in the request body, or '@' followed by a file name to read the data from"`
 	IncludeReponseHeaders bool            `short:"i" description:"Include response headers in the output"`
 	OutputFile            flag.Path       `long:"output" description:"Write curl body to FILE instead of stdout"`
-	usage                 interface{}     `usage:"CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE]\n\n   By default 'CF_NAME curl' will perform a GET to the specified PATH. If data\n   is provided via -d, a POST will be performed instead, and the Content-Type\n   will be set to application/json. You may override headers with -H and the\n   request method with -X.\n\n   For API documentation, please visit http://apidocs.cloudfoundry.org.\n\nEXAMPLES:\n   CF_NAME curl \"/v2/apps\" -X GET -H \"Content-Type: application/x-www-form-urlencoded\" -d 'q=name:myapp'\n   CF_NAME curl \"/v2/apps\" -d @/path/to/file"`
+	AllPages              bool            `long:"all-pages" description:"Follow the pagination links of a GET request and merge the resources of every page into a single response"`
+	Select                string          `long:"select" description:"Print only the values matching a path expression, e.g. '.resources[].entity.name'"`
+	Template              string          `long:"template" description:"Run the request saved under the given template name, any other flags are applied on top of it"`
+	SaveTemplate          string          `long:"save-template" description:"Save the request under the given template name instead of running it"`
+	DeleteTemplate        string          `long:"delete-template" description:"Delete the saved request template with the given name"`
+	ListTemplates         bool            `long:"list-templates" description:"List the saved request templates"`
+	usage                 interface{}     `usage:"CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE] [--all-pages] [--select EXPRESSION] [--save-template NAME]\n   CF_NAME curl --template NAME [PATH] [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE] [--all-pages] [--select EXPRESSION]\n   CF_NAME curl --list-templates\n   CF_NAME curl --delete-template NAME\n\n   By default 'CF_NAME curl' will perform a GET to the specified PATH. If data\n   is provided via -d, a POST will be performed instead, and the Content-Type\n   will be set to application/json. You may override headers with -H and the\n   request method with -X.\n\n   With --all-pages, the next_url (v2) or pagination.next (v3) links are\n   followed and the resources of every page are merged into one response.\n\n   Requests saved with --save-template are stored in the CLI config and can\n   be rerun with --template.\n\n   For API documentation, please visit http://apidocs.cloudfoundry.org.\n\nEXAMPLES:\n   CF_NAME curl \"/v2/apps\" -X GET -H \"Content-Type: application/x-www-form-urlencoded\" -d 'q=name:myapp'\n   CF_NAME curl \"/v2/apps\" -d @/path/to/file\n   CF_NAME curl \"/v3/apps\" --all-pages --select '.resources[].name'\n   CF_NAME curl \"/v2/apps\" --all-pages --select '.resources[].entity.name' --save-template app-names\n   CF_NAME curl --template app-names"`
 }
 
 func (_ CurlCommand) Setup(config command.Config, ui command.UI) error {
@@ -11,7 +11,8 @@ import (
 type DeleteServiceCommand struct {
 	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
 	Force           bool                 `short:"f" description:"Force deletion without confirmation"`
-	usage           interface{}          `usage:"CF_NAME delete-service SERVICE_INSTANCE [-f]"`
+	Wait            bool                 `long:"wait" description:"Wait for the broker to finish deprovisioning the service instance"`
+	usage           interface{}          `usage:"CF_NAME delete-service SERVICE_INSTANCE [-f] [--wait]"`
 	relatedCommands interface{}          `related_commands:"unbind-service, services"`
 }
 
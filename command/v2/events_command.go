@@ -10,7 +10,10 @@ import (
 
 type EventsCommand struct {
 	RequiredArgs flag.AppName `positional-args:"yes"`
-	usage        interface{}  `usage:"CF_NAME events APP_NAME"`
+	Follow       bool         `long:"follow" description:"Keep polling for new events and show them as they arrive"`
+	Notify       string       `long:"notify" description:"Local command to run for every new event while following; the event is passed in CF_EVENT_* environment variables"`
+	NotifyOn     []string     `long:"notify-on" description:"Only run the notify command for events of this type, wildcards such as audit.app.* are allowed, flag can be specified multiple times"`
+	usage        interface{}  `usage:"CF_NAME events APP_NAME [--follow [--notify COMMAND] [--notify-on EVENT_TYPE]...]\n\nEXAMPLES:\n   CF_NAME events my-app --follow --notify 'notify-send \"$CF_EVENT_TYPE\" \"$CF_EVENT_DESCRIPTION\"' --notify-on app.crash"`
 }
 
 func (_ EventsCommand) Setup(config command.Config, ui command.UI) error {
@@ -0,0 +1,130 @@
+package v2
+
+import (
+	"fmt"
+	"net"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . ExplainEgressActor
+
+type ExplainEgressActor interface {
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	ExplainEgress(spaceGUID string, protocol string, ip net.IP, port int) (v2action.EgressExplanation, v2action.Warnings, error)
+}
+
+type ExplainEgressCommand struct {
+	RequiredArgs    flag.ExplainEgressArgs `positional-args:"yes"`
+	Protocol        flag.EgressProtocol    `long:"protocol" default:"tcp" description:"Protocol of the traffic: tcp or udp"`
+	usage           interface{}            `usage:"CF_NAME explain-egress SPACE DEST:PORT [--protocol (tcp | udp)]\n\nEXAMPLES:\n   CF_NAME explain-egress my-space 10.0.0.5:5432\n   CF_NAME explain-egress my-space 8.8.8.8:53 --protocol udp"`
+	relatedCommands interface{}            `related_commands:"running-security-groups, security-group, space, staging-security-groups"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ExplainEgressActor
+}
+
+func (cmd *ExplainEgressCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, nil)
+
+	return nil
+}
+
+func (cmd ExplainEgressCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	destination := fmt.Sprintf("%s:%d", cmd.RequiredArgs.Destination.IP, cmd.RequiredArgs.Destination.Port)
+	cmd.UI.DisplayTextWithFlavor("Explaining {{.Protocol}} egress to {{.Destination}} from space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"Protocol":    cmd.Protocol.Protocol,
+		"Destination": destination,
+		"SpaceName":   cmd.RequiredArgs.Space,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"Username":    user.Name,
+	})
+
+	space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(cmd.Config.TargetedOrganization().GUID, cmd.RequiredArgs.Space)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	explanation, warnings, err := cmd.Actor.ExplainEgress(space.GUID, cmd.Protocol.Protocol, cmd.RequiredArgs.Destination.IP, cmd.RequiredArgs.Destination.Port)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("lifecycle"),
+			cmd.UI.TranslateText("result"),
+			cmd.UI.TranslateText("security group"),
+			cmd.UI.TranslateText("bound to"),
+			cmd.UI.TranslateText("destination"),
+			cmd.UI.TranslateText("ports"),
+			cmd.UI.TranslateText("protocol"),
+			cmd.UI.TranslateText("description"),
+		},
+	}
+	table = append(table, cmd.lifecycleRows("running", explanation.Running)...)
+	table = append(table, cmd.lifecycleRows("staging", explanation.Staging)...)
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
+
+func (cmd ExplainEgressCommand) lifecycleRows(lifecycle string, rules []v2action.EgressRule) [][]string {
+	if len(rules) == 0 {
+		return [][]string{{
+			lifecycle,
+			cmd.UI.TranslateText("denied"),
+			"", "", "", "", "",
+			cmd.UI.TranslateText("no security group rule allows this traffic"),
+		}}
+	}
+
+	var rows [][]string
+	for _, rule := range rules {
+		boundTo := cmd.UI.TranslateText("space")
+		if rule.Global {
+			boundTo = cmd.UI.TranslateText("all spaces")
+		}
+
+		rows = append(rows, []string{
+			lifecycle,
+			cmd.UI.TranslateText("allowed"),
+			rule.Name,
+			boundTo,
+			rule.Destination,
+			rule.Ports,
+			rule.Protocol,
+			rule.Description,
+		})
+	}
+	return rows
+}
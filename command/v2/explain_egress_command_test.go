@@ -0,0 +1,164 @@
+package v2_test
+
+import (
+	"errors"
+	"net"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("explain-egress Command", func() {
+	var (
+		cmd             ExplainEgressCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeExplainEgressActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeExplainEgressActor)
+
+		cmd = ExplainEgressCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd.RequiredArgs.Space = "some-space"
+		cmd.RequiredArgs.Destination = flag.EgressDestination{IP: net.ParseIP("10.0.0.5"), Port: 5432}
+		cmd.Protocol = flag.EgressProtocol{Protocol: "tcp"}
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeActor.GetSpaceByOrganizationAndNameReturns(
+			v2action.Space{Name: "some-space", GUID: "some-space-guid"},
+			v2action.Warnings{"get space warning"},
+			nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error if the check fails", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the space does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceByOrganizationAndNameReturns(
+				v2action.Space{},
+				v2action.Warnings{"get space warning"},
+				v2action.SpaceNotFoundError{Name: "some-space"})
+		})
+
+		It("returns a SpaceNotFoundError and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(shared.SpaceNotFoundError{Name: "some-space"}))
+			Expect(testUI.Err).To(Say("get space warning"))
+
+			orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("some-space"))
+		})
+	})
+
+	Context("when explaining the egress fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("explain error")
+			fakeActor.ExplainEgressReturns(
+				v2action.EgressExplanation{},
+				v2action.Warnings{"explain warning"},
+				expectedErr)
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("get space warning"))
+			Expect(testUI.Err).To(Say("explain warning"))
+		})
+	})
+
+	Context("when the egress is explained", func() {
+		BeforeEach(func() {
+			fakeActor.ExplainEgressReturns(
+				v2action.EgressExplanation{
+					Running: []v2action.EgressRule{
+						{
+							SecurityGroupRule: v2action.SecurityGroupRule{
+								Name:        "database",
+								Destination: "10.0.0.0/24",
+								Ports:       "5432",
+								Protocol:    "tcp",
+								Description: "postgres",
+								Lifecycle:   "running",
+							},
+						},
+						{
+							SecurityGroupRule: v2action.SecurityGroupRule{
+								Name:        "private_networks",
+								Destination: "10.0.0.0-10.255.255.255",
+								Protocol:    "all",
+								Lifecycle:   "running",
+							},
+							Global: true,
+						},
+					},
+				},
+				v2action.Warnings{"explain warning"},
+				nil)
+		})
+
+		It("displays the rules allowing the traffic for each lifecycle", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Explaining tcp egress to 10\.0\.0\.5:5432 from space some-space in org some-org as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`lifecycle\s+result\s+security group\s+bound to\s+destination\s+ports\s+protocol\s+description`))
+			Expect(testUI.Out).To(Say(`running\s+allowed\s+database\s+space\s+10\.0\.0\.0/24\s+5432\s+tcp\s+postgres`))
+			Expect(testUI.Out).To(Say(`running\s+allowed\s+private_networks\s+all spaces\s+10\.0\.0\.0-10\.255\.255\.255\s+all`))
+			Expect(testUI.Out).To(Say(`staging\s+denied\s+no security group rule allows this traffic`))
+			Expect(testUI.Err).To(Say("explain warning"))
+
+			Expect(fakeActor.ExplainEgressCallCount()).To(Equal(1))
+			spaceGUID, protocol, ip, port := fakeActor.ExplainEgressArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(protocol).To(Equal("tcp"))
+			Expect(ip.Equal(net.ParseIP("10.0.0.5"))).To(BeTrue())
+			Expect(port).To(Equal(5432))
+		})
+	})
+})
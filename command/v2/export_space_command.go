@@ -0,0 +1,24 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type ExportSpaceCommand struct {
+	RequiredArgs    flag.SpaceBundleArgs `positional-args:"yes"`
+	usage           interface{}          `usage:"CF_NAME export-space BUNDLE_DIR\n\nTIP: Credentials of service instances are not exported. Recreate the space from the bundle with 'CF_NAME import-space'."`
+	relatedCommands interface{}          `related_commands:"create-app-manifest, import-space, space"`
+}
+
+func (_ ExportSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (_ ExportSpaceCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
@@ -0,0 +1,25 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type ImportSpaceCommand struct {
+	RequiredArgs        flag.SpaceBundleArgs `positional-args:"yes"`
+	IncludeEnvVarGroups bool                 `long:"include-env-var-groups" description:"Also replace the running and staging environment variable groups, which apply to every app in the foundation"`
+	usage               interface{}          `usage:"CF_NAME import-space BUNDLE_DIR [--include-env-var-groups]\n\nTIP: Apps are created without application bits. Push them with 'CF_NAME push -f BUNDLE_DIR/manifest.yml'."`
+	relatedCommands     interface{}          `related_commands:"export-space, push, target"`
+}
+
+func (_ ImportSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (_ ImportSpaceCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
@@ -0,0 +1,141 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . ImportUsersActor
+
+type ImportUsersActor interface {
+	ImportUsers(rows []v2action.UserImportRow, removeUnlisted bool, dryRun bool) ([]v2action.UserImportResult, v2action.Warnings)
+}
+
+type ImportUsersCommand struct {
+	RequiredArgs    flag.ImportUsersArgs `positional-args:"yes"`
+	RemoveUnlisted  bool                 `long:"remove-unlisted" description:"Remove roles from users not listed with them, in the orgs and spaces the file assigns roles in"`
+	DryRun          bool                 `long:"dry-run" description:"Show what would change without changing anything"`
+	usage           interface{}          `usage:"CF_NAME import-users FILE.csv [--remove-unlisted] [--dry-run]\n\n   The first line of the file names its columns. The username, org and role\n   columns are required; password, origin and space are optional. Users missing\n   from UAA are created, which requires a password unless the origin is not uaa.\n\nEXAMPLES:\n   username,password,origin,org,space,role\n   j.smith@example.com,S3cr3t,,my-org,,OrgManager\n   j.doe@example.com,,ldap,my-org,dev,SpaceDeveloper"`
+	relatedCommands interface{}          `related_commands:"create-user, org-users, set-org-role, set-space-role, space-users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ImportUsersActor
+}
+
+func (cmd *ImportUsersCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	return nil
+}
+
+func (cmd ImportUsersCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	rows, err := v2action.ReadUserImportFile(string(cmd.RequiredArgs.PathToCSV))
+	if err != nil {
+		return err
+	}
+
+	message := "Importing users from {{.Path}} as {{.Username}}..."
+	if cmd.DryRun {
+		message = "Checking users from {{.Path}} as {{.Username}}..."
+	}
+	cmd.UI.DisplayTextWithFlavor(message, map[string]interface{}{
+		"Path":     cmd.RequiredArgs.PathToCSV,
+		"Username": user.Name,
+	})
+
+	results, warnings := cmd.Actor.ImportUsers(rows, cmd.RemoveUnlisted, cmd.DryRun)
+	cmd.UI.DisplayWarnings(warnings)
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("username"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("role"),
+			cmd.UI.TranslateText("outcome"),
+		},
+	}
+
+	var failed int
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+		table = append(table, []string{
+			result.Username,
+			result.Organization,
+			result.Space,
+			result.Role(),
+			cmd.outcome(result),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	if failed > 0 {
+		return shared.UserImportFailedError{FailedRows: failed}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ImportUsersCommand) outcome(result v2action.UserImportResult) string {
+	if result.Err != nil {
+		return cmd.UI.TranslateText("failed: {{.Error}}", map[string]interface{}{
+			"Error": result.Err.Error(),
+		})
+	}
+
+	var outcome string
+	switch result.Action {
+	case v2action.UserImportAssignRole:
+		outcome = "assigned role"
+		if cmd.DryRun {
+			outcome = "would assign role"
+		}
+	case v2action.UserImportRemoveRole:
+		outcome = "removed role"
+		if cmd.DryRun {
+			outcome = "would remove role"
+		}
+	default:
+		outcome = "no change"
+	}
+
+	if result.CreatedUser {
+		if cmd.DryRun {
+			return cmd.UI.TranslateText("would create user, {{.Outcome}}", map[string]interface{}{
+				"Outcome": cmd.UI.TranslateText(outcome),
+			})
+		}
+		return cmd.UI.TranslateText("created user, {{.Outcome}}", map[string]interface{}{
+			"Outcome": cmd.UI.TranslateText(outcome),
+		})
+	}
+	return cmd.UI.TranslateText(outcome)
+}
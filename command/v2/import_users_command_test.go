@@ -0,0 +1,160 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("import-users Command", func() {
+	var (
+		cmd             ImportUsersCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeImportUsersActor
+		binaryName      string
+		path            string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeImportUsersActor)
+
+		file, err := ioutil.TempFile("", "users")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = file.WriteString("username,org,space,role\nsome-user,some-org,,OrgManager\nother-user,some-org,some-space,SpaceDeveloper\n")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(file.Close()).To(Succeed())
+		path = file.Name()
+
+		cmd = ImportUsersCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.PathToCSV = flag.PathWithExistenceCheck(path)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "admin"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(path)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.ImportUsersCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the file is invalid", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(path, []byte("username,role\n"), 0600)).To(Succeed())
+		})
+
+		It("returns the error without importing anything", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(v2action.InvalidUserImportFileError{}))
+			Expect(fakeActor.ImportUsersCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when every row imports", func() {
+		BeforeEach(func() {
+			cmd.RemoveUnlisted = true
+			fakeActor.ImportUsersStub = func(rows []v2action.UserImportRow, removeUnlisted bool, dryRun bool) ([]v2action.UserImportResult, v2action.Warnings) {
+				return []v2action.UserImportResult{
+					{UserImportRow: rows[0], Action: v2action.UserImportNoChange},
+					{UserImportRow: rows[1], Action: v2action.UserImportAssignRole, CreatedUser: true},
+					{UserImportRow: v2action.UserImportRow{Username: "old-user", Organization: "some-org", OrganizationRole: v2action.OrganizationAuditorRole}, Action: v2action.UserImportRemoveRole},
+				}, v2action.Warnings{"import-warning"}
+			}
+		})
+
+		It("displays the outcome of each row", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Importing users from %s as admin...", path))
+			Expect(testUI.Out).To(Say("username\\s+org\\s+space\\s+role\\s+outcome"))
+			Expect(testUI.Out).To(Say("some-user\\s+some-org\\s+OrgManager\\s+no change"))
+			Expect(testUI.Out).To(Say("other-user\\s+some-org\\s+some-space\\s+SpaceDeveloper\\s+created user, assigned role"))
+			Expect(testUI.Out).To(Say("old-user\\s+some-org\\s+OrgAuditor\\s+removed role"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("import-warning"))
+
+			Expect(fakeActor.ImportUsersCallCount()).To(Equal(1))
+			rows, removeUnlisted, dryRun := fakeActor.ImportUsersArgsForCall(0)
+			Expect(rows).To(HaveLen(2))
+			Expect(rows[1].Username).To(Equal("other-user"))
+			Expect(removeUnlisted).To(BeTrue())
+			Expect(dryRun).To(BeFalse())
+		})
+	})
+
+	Context("when doing a dry run", func() {
+		BeforeEach(func() {
+			cmd.DryRun = true
+			fakeActor.ImportUsersStub = func(rows []v2action.UserImportRow, removeUnlisted bool, dryRun bool) ([]v2action.UserImportResult, v2action.Warnings) {
+				return []v2action.UserImportResult{
+					{UserImportRow: rows[1], Action: v2action.UserImportAssignRole, CreatedUser: true},
+				}, nil
+			}
+		})
+
+		It("describes what would change", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("Checking users from %s as admin...", path))
+			Expect(testUI.Out).To(Say("other-user\\s+some-org\\s+some-space\\s+SpaceDeveloper\\s+would create user, would assign role"))
+
+			_, _, dryRun := fakeActor.ImportUsersArgsForCall(0)
+			Expect(dryRun).To(BeTrue())
+		})
+	})
+
+	Context("when some rows fail", func() {
+		BeforeEach(func() {
+			fakeActor.ImportUsersStub = func(rows []v2action.UserImportRow, removeUnlisted bool, dryRun bool) ([]v2action.UserImportResult, v2action.Warnings) {
+				return []v2action.UserImportResult{
+					{UserImportRow: rows[0], Action: v2action.UserImportAssignRole},
+					{UserImportRow: rows[1], Action: v2action.UserImportAssignRole, Err: errors.New("some-error")},
+				}, nil
+			}
+		})
+
+		It("displays every row and returns a UserImportFailedError", func() {
+			Expect(executeErr).To(MatchError(shared.UserImportFailedError{FailedRows: 1}))
+			Expect(testUI.Out).To(Say("some-user\\s+some-org\\s+OrgManager\\s+assigned role"))
+			Expect(testUI.Out).To(Say("other-user\\s+some-org\\s+some-space\\s+SpaceDeveloper\\s+failed: some-error"))
+			Expect(testUI.Out).NotTo(Say("OK"))
+		})
+	})
+})
@@ -0,0 +1,112 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . OrgUsageActor
+
+type OrgUsageActor interface {
+	GetOrganizationUsageByName(orgName string) (v2action.QuotaUsage, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . OrgUsageActorV3
+
+type OrgUsageActorV3 interface {
+	GetRunningTasksByOrganization(orgGUID string) ([]v3action.Task, v3action.Warnings, error)
+}
+
+type OrgUsageCommand struct {
+	RequiredArgs    flag.Organization `positional-args:"yes"`
+	Threshold       int               `long:"threshold" default:"80" description:"Highlight resources using at least this percentage of their quota limit"`
+	usage           interface{}       `usage:"CF_NAME org-usage ORG [--threshold PERCENT]"`
+	relatedCommands interface{}       `related_commands:"org, quota, space-usage"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       OrgUsageActor
+	ActorV3     OrgUsageActorV3
+}
+
+func (cmd *OrgUsageCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, nil)
+
+	ccClientV3, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(sharedV3.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
+	return nil
+}
+
+func (cmd OrgUsageCommand) Execute(args []string) error {
+	if cmd.Threshold < 0 || cmd.Threshold > 100 {
+		return command.ParseArgumentError{
+			ArgumentName: "--threshold",
+			ExpectedType: "an integer between 0 and 100",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting quota usage for org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  cmd.RequiredArgs.Organization,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	usage, warnings, err := cmd.Actor.GetOrganizationUsageByName(cmd.RequiredArgs.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.ActorV3 != nil {
+		tasks, v3Warnings, err := cmd.ActorV3.GetRunningTasksByOrganization(usage.GUID)
+		cmd.UI.DisplayWarnings(v3Warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		addRunningTasks(&usage, tasks)
+	}
+
+	shared.DisplayQuotaUsage(cmd.UI, usage, cmd.ActorV3 != nil, cmd.Threshold)
+
+	return nil
+}
+
+// addRunningTasks counts running tasks against the app task limit. Their
+// memory counts against the memory limit alongside app instances.
+func addRunningTasks(usage *v2action.QuotaUsage, tasks []v3action.Task) {
+	usage.AppTasks.Used = len(tasks)
+	for _, task := range tasks {
+		usage.Memory.Used += int(task.MemoryInMB)
+	}
+}
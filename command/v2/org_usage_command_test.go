@@ -0,0 +1,169 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("org-usage Command", func() {
+	var (
+		cmd             OrgUsageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeOrgUsageActor
+		fakeActorV3     *v2fakes.FakeOrgUsageActorV3
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeOrgUsageActor)
+		fakeActorV3 = new(v2fakes.FakeOrgUsageActorV3)
+
+		cmd = OrgUsageCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV3:     fakeActorV3,
+			Threshold:   80,
+		}
+		cmd.RequiredArgs.Organization = "some-org"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the threshold is out of range", func() {
+		BeforeEach(func() {
+			cmd.Threshold = 101
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "--threshold",
+				ExpectedType: "an integer between 0 and 100",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the org does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationUsageByNameReturns(v2action.QuotaUsage{}, v2action.Warnings{"usage-warning"}, v2action.OrganizationNotFoundError{Name: "some-org"})
+		})
+
+		It("returns an OrganizationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(shared.OrganizationNotFoundError{Name: "some-org"}))
+			Expect(testUI.Err).To(Say("usage-warning"))
+		})
+	})
+
+	Context("when getting the usage succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationUsageByNameReturns(
+				v2action.QuotaUsage{
+					GUID:             "some-org-guid",
+					QuotaName:        "some-quota",
+					Memory:           v2action.ResourceUsage{Used: 3584, Limit: 4096},
+					Instances:        v2action.ResourceUsage{Used: 3, Limit: -1},
+					Routes:           v2action.ResourceUsage{Used: 2, Limit: 10},
+					ServiceInstances: v2action.ResourceUsage{Used: 2, Limit: 2},
+					AppTasks:         v2action.ResourceUsage{Limit: 5},
+				},
+				v2action.Warnings{"usage-warning"},
+				nil,
+			)
+			fakeActorV3.GetRunningTasksByOrganizationReturns(
+				[]v3action.Task{{MemoryInMB: 256}, {MemoryInMB: 256}},
+				v3action.Warnings{"tasks-warning"},
+				nil,
+			)
+		})
+
+		It("displays the usage of each resource and highlights those over the threshold", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting quota usage for org some-org as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("quota:\\s+some-quota"))
+			Expect(testUI.Out).To(Say("threshold:\\s+%d%%", 80))
+			Expect(testUI.Out).To(Say("resource\\s+used\\s+limit\\s+percent used"))
+			Expect(testUI.Out).To(Say("memory\\s+4G\\s+4G\\s+%d%% !", 100))
+			Expect(testUI.Out).To(Say("app instances\\s+3\\s+unlimited"))
+			Expect(testUI.Out).To(Say("routes\\s+2\\s+10\\s+%d%%\n", 20))
+			Expect(testUI.Out).To(Say("service instances\\s+2\\s+2\\s+%d%% !", 100))
+			Expect(testUI.Out).To(Say("app tasks\\s+2\\s+5\\s+%d%%\n", 40))
+
+			Expect(testUI.Err).To(Say("usage-warning"))
+			Expect(testUI.Err).To(Say("tasks-warning"))
+			Expect(testUI.Err).To(Say("memory usage is at or above %d%% of the quota limit\\.", 80))
+			Expect(testUI.Err).To(Say("service instances usage is at or above %d%% of the quota limit\\.", 80))
+			Expect(testUI.Err).NotTo(Say("routes usage"))
+
+			Expect(fakeActor.GetOrganizationUsageByNameArgsForCall(0)).To(Equal("some-org"))
+			Expect(fakeActorV3.GetRunningTasksByOrganizationArgsForCall(0)).To(Equal("some-org-guid"))
+		})
+
+		Context("when getting the running tasks fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("tasks error")
+				fakeActorV3.GetRunningTasksByOrganizationReturns(nil, v3action.Warnings{"tasks-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("tasks-warning"))
+			})
+		})
+
+		Context("when the V3 API is not available", func() {
+			BeforeEach(func() {
+				cmd.ActorV3 = nil
+			})
+
+			It("does not display app tasks", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("memory\\s+3.5G\\s+4G\\s+%d%% !", 87))
+				Expect(testUI.Out).NotTo(Say("app tasks"))
+			})
+		})
+	})
+})
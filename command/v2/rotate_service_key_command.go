@@ -0,0 +1,28 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type RotateServiceKeyCommand struct {
+	RequiredArgs        flag.ServiceInstanceKey `positional-args:"yes"`
+	ParametersAsJSON    flag.Path               `short:"c" description:"Valid JSON object containing service-specific configuration parameters for the new key, provided either in-line or in a file"`
+	UserProvidedService string                  `long:"user-provided-service" description:"User-provided service instance embedding the key's credentials; it is updated with the new credentials and its bound apps are restaged"`
+	GracePeriod         int                     `long:"grace-period" description:"Seconds to wait before deleting the old key, instead of asking for confirmation"`
+	Force               bool                    `short:"f" description:"Delete the old key without confirmation"`
+	usage               interface{}             `usage:"CF_NAME rotate-service-key SERVICE_INSTANCE SERVICE_KEY [-c PARAMETERS_AS_JSON] [--user-provided-service USER_PROVIDED_SERVICE] [--grace-period SECONDS] [-f]\n\n   The new key is named after SERVICE_KEY with a timestamp appended, for example mykey-20170102150405.\n   Rotating a previously rotated key replaces its timestamp instead of appending another one.\n\nEXAMPLES:\n   CF_NAME rotate-service-key mydb mykey\n   CF_NAME rotate-service-key mydb mykey --user-provided-service mydb-creds --grace-period 300"`
+	relatedCommands     interface{}             `related_commands:"create-service-key, delete-service-key, service-keys, update-user-provided-service"`
+}
+
+func (_ RotateServiceKeyCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (_ RotateServiceKeyCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
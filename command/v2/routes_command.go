@@ -9,7 +9,10 @@ import (
 
 type RoutesCommand struct {
 	OrgLevel        bool        `long:"orglevel" description:"List all the routes for all spaces of current organization"`
-	usage           interface{} `usage:"CF_NAME routes [--orglevel]"`
+	Check           bool        `long:"check" description:"Request every HTTP route and report its status code, latency, certificate expiry and the apps backing it"`
+	CheckPath       string      `long:"check-path" description:"Path to request on each route when checking (Default: /)"`
+	Timeout         int         `long:"timeout" description:"Seconds to wait for each route to respond when checking (Default: 5)"`
+	usage           interface{} `usage:"CF_NAME routes [--orglevel] [--check [--check-path PATH] [--timeout SECONDS]]\n\nEXAMPLES:\n   CF_NAME routes --check\n   CF_NAME routes --orglevel --check --check-path /health --timeout 10"`
 	relatedCommands interface{} `related_commands:"check-route, domains, map-route, unmap-route"`
 }
 
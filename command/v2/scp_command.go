@@ -0,0 +1,27 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type SCPCommand struct {
+	RequiredArgs       flag.SCPArgs `positional-args:"yes"`
+	AppInstanceIndex   int          `long:"app-instance-index" short:"i" description:"Application instance index"`
+	Recursive          bool         `long:"recursive" short:"r" description:"Recursively copy entire directories"`
+	SkipHostValidation bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
+	usage              interface{}  `usage:"CF_NAME scp [APP_NAME:]SOURCE [APP_NAME:]DESTINATION [-i app-instance-index] [-r] [--skip-host-validation]\n\nEXAMPLES:\n   CF_NAME scp my-app:/home/vcap/app/heap.hprof ./heap.hprof\n   CF_NAME scp -i 2 -r ./config my-app:/home/vcap/app"`
+	relatedCommands    interface{}  `related_commands:"ssh, ssh-enabled"`
+}
+
+func (_ SCPCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (_ SCPCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
@@ -0,0 +1,166 @@
+package v2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+// RedactedCredential replaces every credential and parameter value unless the
+// user asks for the credentials to be shown.
+const RedactedCredential = "[PRIVATE DATA HIDDEN]"
+
+//go:generate counterfeiter . ServiceBindingActor
+
+type ServiceBindingActor interface {
+	GetServiceBindingByApplicationAndServiceInstanceNames(appName string, serviceInstanceName string, spaceGUID string) (v2action.ServiceBinding, v2action.Warnings, error)
+	GetServiceBindingParameters(serviceBindingGUID string) (map[string]interface{}, v2action.Warnings, error)
+}
+
+type ServiceBindingCommand struct {
+	RequiredArgs    flag.BindServiceArgs `positional-args:"yes"`
+	ShowCredentials bool                 `long:"show-credentials" description:"Display the credentials and parameters of the binding instead of redacting them"`
+	usage           interface{}          `usage:"CF_NAME service-binding APP_NAME SERVICE_INSTANCE [--show-credentials]"`
+	relatedCommands interface{}          `related_commands:"bind-service, service, unbind-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServiceBindingActor
+}
+
+func (cmd *ServiceBindingCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	return nil
+}
+
+func (cmd ServiceBindingCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting binding between app {{.AppName}} and service {{.ServiceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"ServiceName": cmd.RequiredArgs.ServiceInstanceName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	serviceBinding, warnings, err := cmd.Actor.GetServiceBindingByApplicationAndServiceInstanceNames(cmd.RequiredArgs.AppName, cmd.RequiredArgs.ServiceInstanceName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.ServiceBindingNotFoundError); ok {
+			return command.ServiceBindingNotFoundError{
+				AppName:             cmd.RequiredArgs.AppName,
+				ServiceInstanceName: cmd.RequiredArgs.ServiceInstanceName,
+			}
+		}
+		return shared.HandleError(err)
+	}
+
+	parameters, warnings, err := cmd.Actor.GetServiceBindingParameters(serviceBinding.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v2action.ServiceBindingParametersNotRetrievableError); !ok {
+			return shared.HandleError(err)
+		}
+		cmd.UI.DisplayWarning("Unable to retrieve the binding parameters: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		parameters = nil
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("binding guid:"), serviceBinding.GUID},
+	}, 3)
+
+	if parameters != nil {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("parameters:")
+		err = cmd.displayJSON(cmd.redact(parameters))
+		if err != nil {
+			return err
+		}
+	}
+
+	credentials := serviceBinding.Credentials
+	if credentials == nil {
+		credentials = map[string]interface{}{}
+	}
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("credentials:")
+	err = cmd.displayJSON(cmd.redact(credentials))
+	if err != nil {
+		return err
+	}
+
+	if !cmd.ShowCredentials && (len(credentials) > 0 || len(parameters) > 0) {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("TIP: Use '{{.Command}}' to display the credentials and parameters.", map[string]interface{}{
+			"Command": cmd.Config.BinaryName() + " service-binding " + cmd.RequiredArgs.AppName + " " + cmd.RequiredArgs.ServiceInstanceName + " --show-credentials",
+		})
+	}
+
+	return nil
+}
+
+func (cmd ServiceBindingCommand) displayJSON(value interface{}) error {
+	output, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayText("{{.JSON}}", map[string]interface{}{"JSON": string(output)})
+	return nil
+}
+
+// redact returns the value unchanged when the user asked for the credentials
+// to be shown, and redacted otherwise.
+func (cmd ServiceBindingCommand) redact(value map[string]interface{}) interface{} {
+	if cmd.ShowCredentials {
+		return value
+	}
+	return redactValues(value)
+}
+
+// redactValues returns a copy of the value with every leaf value replaced,
+// keeping the keys so that users can see what the binding provides.
+func redactValues(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(typed))
+		for key, nested := range typed {
+			redacted[key] = redactValues(nested)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(typed))
+		for i, nested := range typed {
+			redacted[i] = redactValues(nested)
+		}
+		return redacted
+	default:
+		return RedactedCredential
+	}
+}
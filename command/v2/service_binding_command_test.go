@@ -0,0 +1,166 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-binding Command", func() {
+	var (
+		cmd             ServiceBindingCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeServiceBindingActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeServiceBindingActor)
+
+		cmd = ServiceBindingCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.ServiceInstanceName = "some-service"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the user is logged in, and an org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+			fakeActor.GetServiceBindingByApplicationAndServiceInstanceNamesReturns(
+				v2action.ServiceBinding{
+					GUID: "some-binding-guid",
+					Credentials: map[string]interface{}{
+						"password": "some-password",
+						"hosts":    []interface{}{"host-1"},
+					},
+				},
+				v2action.Warnings{"binding-warning"},
+				nil,
+			)
+			fakeActor.GetServiceBindingParametersReturns(
+				map[string]interface{}{"permissions": "read-only"},
+				v2action.Warnings{"parameters-warning"},
+				nil,
+			)
+		})
+
+		It("displays the binding with its redacted parameters and credentials", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting binding between app some-app and service some-service in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`binding guid:\s+some-binding-guid`))
+			Expect(testUI.Out).To(Say("parameters:"))
+			Expect(testUI.Out).To(Say(`"permissions": "\[PRIVATE DATA HIDDEN\]"`))
+			Expect(testUI.Out).To(Say("credentials:"))
+			Expect(testUI.Out).To(Say(`"hosts": \[`))
+			Expect(testUI.Out).To(Say(`"\[PRIVATE DATA HIDDEN\]"`))
+			Expect(testUI.Out).To(Say(`"password": "\[PRIVATE DATA HIDDEN\]"`))
+			Expect(testUI.Out).To(Say("TIP: Use 'faceman service-binding some-app some-service --show-credentials' to display the credentials and parameters."))
+			Expect(testUI.Out).NotTo(Say("some-password"))
+			Expect(testUI.Out).NotTo(Say("read-only"))
+			Expect(testUI.Err).To(Say("binding-warning"))
+			Expect(testUI.Err).To(Say("parameters-warning"))
+
+			appName, serviceInstanceName, spaceGUID := fakeActor.GetServiceBindingByApplicationAndServiceInstanceNamesArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(serviceInstanceName).To(Equal("some-service"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(fakeActor.GetServiceBindingParametersArgsForCall(0)).To(Equal("some-binding-guid"))
+		})
+
+		Context("when --show-credentials is provided", func() {
+			BeforeEach(func() {
+				cmd.ShowCredentials = true
+			})
+
+			It("displays the parameters and credentials", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("parameters:"))
+				Expect(testUI.Out).To(Say(`"permissions": "read-only"`))
+				Expect(testUI.Out).To(Say("credentials:"))
+				Expect(testUI.Out).To(Say(`"password": "some-password"`))
+				Expect(testUI.Out).NotTo(Say("TIP"))
+			})
+		})
+
+		Context("when the broker does not allow the parameters to be retrieved", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceBindingParametersReturns(nil, nil, v2action.ServiceBindingParametersNotRetrievableError{Message: "not supported"})
+			})
+
+			It("warns and still displays the credentials", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Unable to retrieve the binding parameters: not supported"))
+				Expect(testUI.Out).NotTo(Say("parameters:"))
+				Expect(testUI.Out).To(Say("credentials:"))
+			})
+		})
+
+		Context("when getting the parameters fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("parameters error")
+				fakeActor.GetServiceBindingParametersReturns(nil, nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when the app is not bound to the service", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceBindingByApplicationAndServiceInstanceNamesReturns(v2action.ServiceBinding{}, nil, v2action.ServiceBindingNotFoundError{})
+			})
+
+			It("returns a ServiceBindingNotFoundError", func() {
+				Expect(executeErr).To(MatchError(command.ServiceBindingNotFoundError{
+					AppName:             "some-app",
+					ServiceInstanceName: "some-service",
+				}))
+			})
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type ServiceBrokerCatalogCommand struct {
+	RequiredArgs    flag.ServiceBroker `positional-args:"yes"`
+	JSON            bool               `long:"json" description:"Output the catalog as JSON"`
+	usage           interface{}        `usage:"CF_NAME service-broker-catalog SERVICE_BROKER [--json]"`
+	relatedCommands interface{}        `related_commands:"service-access, service-brokers, update-service-broker"`
+}
+
+func (_ ServiceBrokerCatalogCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (_ ServiceBrokerCatalogCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -36,6 +37,22 @@ func (e JobTimeoutError) Translate(translate func(string, ...interface{}) string
 	})
 }
 
+type CanaryAbortedError struct {
+	CanaryAppName string
+	AppName       string
+}
+
+func (e CanaryAbortedError) Error() string {
+	return "Canary release of {{.CanaryAppName}} was aborted. {{.AppName}} is serving all traffic."
+}
+
+func (e CanaryAbortedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"CanaryAppName": e.CanaryAppName,
+		"AppName":       e.AppName,
+	})
+}
+
 type NoOrganizationTargetedError struct{}
 
 func (e NoOrganizationTargetedError) Error() string {
@@ -60,6 +77,20 @@ func (e OrganizationNotFoundError) Translate(translate func(string, ...interface
 	})
 }
 
+type OrganizationQuotaNotFoundError struct {
+	Name string
+}
+
+func (e OrganizationQuotaNotFoundError) Error() string {
+	return "Quota '{{.Name}}' not found."
+}
+
+func (e OrganizationQuotaNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
 type SecurityGroupNotFoundError struct {
 	Name string
 }
@@ -88,6 +119,96 @@ func (e SpaceNotFoundError) Translate(translate func(string, ...interface{}) str
 	})
 }
 
+type DomainNotFoundError struct {
+	Name string
+}
+
+func (e DomainNotFoundError) Error() string {
+	return "Domain {{.Name}} not found"
+}
+
+func (e DomainNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type StackNotFoundError struct {
+	Name string
+}
+
+func (e StackNotFoundError) Error() string {
+	return "Stack {{.Name}} not found"
+}
+
+func (e StackNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type PropertyCombinationError struct {
+	AppName    string
+	Properties []string
+}
+
+func (e PropertyCombinationError) Error() string {
+	return "Application {{.AppName}} cannot use the combination of properties: {{.Properties}}"
+}
+
+func (e PropertyCombinationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":    e.AppName,
+		"Properties": strings.Join(e.Properties, ", "),
+	})
+}
+
+type PortNotAllowedWithHTTPDomainError struct {
+	Domain string
+}
+
+func (e PortNotAllowedWithHTTPDomainError) Error() string {
+	return "Port not allowed in HTTP domain {{.Domain}}"
+}
+
+func (e PortNotAllowedWithHTTPDomainError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Domain": e.Domain,
+	})
+}
+
+type HostAndPathNotAllowedWithTCPDomainError struct {
+	Domain string
+}
+
+func (e HostAndPathNotAllowedWithTCPDomainError) Error() string {
+	return "Host and path not allowed in route with TCP domain {{.Domain}}"
+}
+
+func (e HostAndPathNotAllowedWithTCPDomainError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Domain": e.Domain,
+	})
+}
+
+type PortNotReservableInTCPDomainError struct {
+	Domain          string
+	Port            int
+	ReservablePorts string
+}
+
+func (e PortNotReservableInTCPDomainError) Error() string {
+	return "Port {{.Port}} is not available in TCP domain {{.Domain}}, reservable ports are {{.ReservablePorts}}"
+}
+
+func (e PortNotReservableInTCPDomainError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Domain":          e.Domain,
+		"Port":            e.Port,
+		"ReservablePorts": e.ReservablePorts,
+	})
+}
+
 type HTTPHealthCheckInvalidError struct {
 }
 
@@ -156,6 +277,46 @@ func (e StagingTimeoutError) Translate(translate func(string, ...interface{}) st
 	})
 }
 
+type StackChangeRevertedError struct {
+	AppNames []string
+	Stack    string
+}
+
+func (e StackChangeRevertedError) Error() string {
+	return "Restaging on stack {{.Stack}} failed for: {{.AppNames}}. Their original stacks were restored."
+}
+
+func (e StackChangeRevertedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, ", "),
+		"Stack":    e.Stack,
+	})
+}
+
+// StackChangeFailedError is returned when the stack of some apps could not
+// be changed. RevertedAppNames are the apps that were moved back to their
+// original stacks.
+type StackChangeFailedError struct {
+	AppNames         []string
+	RevertedAppNames []string
+	Stack            string
+}
+
+func (e StackChangeFailedError) Error() string {
+	if len(e.RevertedAppNames) > 0 {
+		return "Changing the stack to {{.Stack}} failed for: {{.AppNames}}.\nRestaging on stack {{.Stack}} failed for: {{.RevertedAppNames}}. Their original stacks were restored."
+	}
+	return "Changing the stack to {{.Stack}} failed for: {{.AppNames}}."
+}
+
+func (e StackChangeFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames":         strings.Join(e.AppNames, ", "),
+		"RevertedAppNames": strings.Join(e.RevertedAppNames, ", "),
+		"Stack":            e.Stack,
+	})
+}
+
 type UnsuccessfulStartError struct {
 	AppName    string
 	BinaryName string
@@ -187,3 +348,17 @@ func (e StartupTimeoutError) Translate(translate func(string, ...interface{}) st
 		"BinaryName": e.BinaryName,
 	})
 }
+
+type UserImportFailedError struct {
+	FailedRows int
+}
+
+func (e UserImportFailedError) Error() string {
+	return "{{.FailedRows}} rows failed to import."
+}
+
+func (e UserImportFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FailedRows": e.FailedRows,
+	})
+}
@@ -39,17 +39,25 @@ var _ = Describe("Translatable Errors", func() {
 		// Actor errors.
 		Entry("JobFailedError", JobFailedError{}),
 		Entry("JobTimeoutError", JobTimeoutError{}),
+		Entry("DomainNotFoundError", DomainNotFoundError{}),
+		Entry("HostAndPathNotAllowedWithTCPDomainError", HostAndPathNotAllowedWithTCPDomainError{}),
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
+		Entry("PortNotAllowedWithHTTPDomainError", PortNotAllowedWithHTTPDomainError{}),
+		Entry("PropertyCombinationError", PropertyCombinationError{}),
 		Entry("StagingFailedError", StagingFailedError{}),
 		Entry("StagingFailedNoAppDetectedError", StagingFailedNoAppDetectedError{}),
 		Entry("StagingTimeoutError", StagingTimeoutError{}),
 		Entry("StartupTimeoutError", StartupTimeoutError{}),
 
 		// Command errors.
+		Entry("CanaryAbortedError", CanaryAbortedError{}),
 		Entry("NoOrgTargetedError", NoOrganizationTargetedError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
+		Entry("OrganizationQuotaNotFoundError", OrganizationQuotaNotFoundError{}),
 		Entry("SecurityGroupNotFoundError", SecurityGroupNotFoundError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
+		Entry("StackChangeFailedError", StackChangeFailedError{}),
+		Entry("StackChangeRevertedError", StackChangeRevertedError{}),
 		Entry("UnsuccessfulStartError", UnsuccessfulStartError{}),
 	)
 })
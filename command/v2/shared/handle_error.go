@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -38,6 +39,8 @@ func HandleError(err error) error {
 		return command.ApplicationNotFoundError{Name: e.Name}
 	case v2action.OrganizationNotFoundError:
 		return OrganizationNotFoundError{Name: e.Name}
+	case v2action.OrganizationQuotaNotFoundError:
+		return OrganizationQuotaNotFoundError{Name: e.Name}
 	case v2action.SecurityGroupNotFoundError:
 		return SecurityGroupNotFoundError{Name: e.Name}
 	case v2action.ServiceInstanceNotFoundError:
@@ -46,6 +49,23 @@ func HandleError(err error) error {
 		return SpaceNotFoundError{Name: e.Name}
 	case v2action.HTTPHealthCheckInvalidError:
 		return HTTPHealthCheckInvalidError{}
+	case v2action.DomainNotFoundError:
+		if e.Name != "" {
+			return DomainNotFoundError{Name: e.Name}
+		}
+	case v2action.StackNotFoundError:
+		if e.Name != "" {
+			return StackNotFoundError{Name: e.Name}
+		}
+
+	case pushaction.PropertyCombinationError:
+		return PropertyCombinationError{AppName: e.AppName, Properties: e.Properties}
+	case pushaction.InvalidHTTPRouteSettings:
+		return PortNotAllowedWithHTTPDomainError{Domain: e.Domain}
+	case pushaction.InvalidTCPRouteSettings:
+		return HostAndPathNotAllowedWithTCPDomainError{Domain: e.Domain}
+	case pushaction.InvalidTCPRoutePort:
+		return PortNotReservableInTCPDomainError{Domain: e.Domain, Port: e.Port, ReservablePorts: e.ReservablePorts}
 	}
 
 	return err
@@ -3,6 +3,7 @@ package shared_test
 import (
 	"errors"
 
+	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -51,6 +52,30 @@ var _ = Describe("HandleError", func() {
 			v2action.ServiceInstanceNotFoundError{Name: "some-service-instance"},
 			command.ServiceInstanceNotFoundError{Name: "some-service-instance"}),
 
+		Entry("v2action.DomainNotFoundError -> DomainNotFoundError",
+			v2action.DomainNotFoundError{Name: "some-domain.com"},
+			DomainNotFoundError{Name: "some-domain.com"}),
+
+		Entry("v2action.StackNotFoundError -> StackNotFoundError",
+			v2action.StackNotFoundError{Name: "some-stack"},
+			StackNotFoundError{Name: "some-stack"}),
+
+		Entry("pushaction.PropertyCombinationError -> PropertyCombinationError",
+			pushaction.PropertyCombinationError{AppName: "some-app", Properties: []string{"hostname", "no-route"}},
+			PropertyCombinationError{AppName: "some-app", Properties: []string{"hostname", "no-route"}}),
+
+		Entry("pushaction.InvalidHTTPRouteSettings -> PortNotAllowedWithHTTPDomainError",
+			pushaction.InvalidHTTPRouteSettings{Domain: "some-domain.com"},
+			PortNotAllowedWithHTTPDomainError{Domain: "some-domain.com"}),
+
+		Entry("pushaction.InvalidTCPRouteSettings -> HostAndPathNotAllowedWithTCPDomainError",
+			pushaction.InvalidTCPRouteSettings{Domain: "tcp.some-domain.com"},
+			HostAndPathNotAllowedWithTCPDomainError{Domain: "tcp.some-domain.com"}),
+
+		Entry("pushaction.InvalidTCPRoutePort -> PortNotReservableInTCPDomainError",
+			pushaction.InvalidTCPRoutePort{Domain: "tcp.some-domain.com", Port: 1025, ReservablePorts: "2000-3000"},
+			PortNotReservableInTCPDomainError{Domain: "tcp.some-domain.com", Port: 1025, ReservablePorts: "2000-3000"}),
+
 		Entry("ccerror.JobFailedError -> JobFailedError",
 			ccerror.JobFailedError{JobGUID: "some-job-guid"},
 			JobFailedError{JobGUID: "some-job-guid"}),
@@ -63,6 +88,10 @@ var _ = Describe("HandleError", func() {
 			v2action.OrganizationNotFoundError{Name: "some-org"},
 			OrganizationNotFoundError{Name: "some-org"}),
 
+		Entry("v2action.OrganizationQuotaNotFoundError -> OrganizationQuotaNotFoundError",
+			v2action.OrganizationQuotaNotFoundError{Name: "some-quota"},
+			OrganizationQuotaNotFoundError{Name: "some-quota"}),
+
 		Entry("v2action.SpaceNotFoundError -> SpaceNotFoundError",
 			v2action.SpaceNotFoundError{Name: "some-space"},
 			SpaceNotFoundError{Name: "some-space"}),
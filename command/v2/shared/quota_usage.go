@@ -0,0 +1,85 @@
+package shared
+
+import (
+	"fmt"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+type quotaResource struct {
+	name   string
+	usage  v2action.ResourceUsage
+	format func(int) string
+}
+
+// DisplayQuotaUsage displays the usage of each quota limited resource next to
+// its limit, followed by a warning for every resource whose usage is at or
+// above threshold percent of its limit. App tasks are only displayed when
+// displayAppTasks is set, since running tasks are counted with the V3 API.
+func DisplayQuotaUsage(ui command.UI, usage v2action.QuotaUsage, displayAppTasks bool, threshold int) {
+	resources := []quotaResource{
+		{"memory", usage.Memory, formatMegabytes},
+		{"app instances", usage.Instances, strconv.Itoa},
+		{"routes", usage.Routes, strconv.Itoa},
+		{"service instances", usage.ServiceInstances, strconv.Itoa},
+	}
+	if displayAppTasks {
+		resources = append(resources, quotaResource{"app tasks", usage.AppTasks, strconv.Itoa})
+	}
+
+	quotaName := usage.QuotaName
+	if quotaName == "" {
+		quotaName = ui.TranslateText("none")
+	}
+	ui.DisplayKeyValueTable("", [][]string{
+		{ui.TranslateText("quota:"), quotaName},
+		{ui.TranslateText("threshold:"), fmt.Sprintf("%d%%", threshold)},
+	}, 3)
+	ui.DisplayNewline()
+
+	table := [][]string{
+		{
+			ui.TranslateText("resource"),
+			ui.TranslateText("used"),
+			ui.TranslateText("limit"),
+			ui.TranslateText("percent used"),
+		},
+	}
+
+	var overThreshold []string
+	for _, resource := range resources {
+		limit := ui.TranslateText("unlimited")
+		percent := ""
+		if !resource.usage.Unlimited() {
+			limit = resource.format(resource.usage.Limit)
+			percent = fmt.Sprintf("%d%%", resource.usage.PercentUsed())
+			if resource.usage.PercentUsed() >= threshold {
+				percent += " !"
+				overThreshold = append(overThreshold, resource.name)
+			}
+		}
+
+		table = append(table, []string{
+			ui.TranslateText(resource.name),
+			resource.format(resource.usage.Used),
+			limit,
+			percent,
+		})
+	}
+
+	ui.DisplayTableWithHeader("", table, 3)
+
+	for _, name := range overThreshold {
+		ui.DisplayWarning("{{.Resource}} usage is at or above {{.Threshold}}% of the quota limit.", map[string]interface{}{
+			"Resource":  ui.TranslateText(name),
+			"Threshold": threshold,
+		})
+	}
+}
+
+func formatMegabytes(megabytes int) string {
+	return bytefmt.ByteSize(uint64(megabytes) * bytefmt.MEGABYTE)
+}
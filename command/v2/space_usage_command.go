@@ -0,0 +1,104 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . SpaceUsageActor
+
+type SpaceUsageActor interface {
+	GetSpaceUsageByOrganizationAndName(orgGUID string, spaceName string) (v2action.QuotaUsage, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . SpaceUsageActorV3
+
+type SpaceUsageActorV3 interface {
+	GetRunningTasksBySpace(spaceGUID string) ([]v3action.Task, v3action.Warnings, error)
+}
+
+type SpaceUsageCommand struct {
+	RequiredArgs    flag.Space  `positional-args:"yes"`
+	Threshold       int         `long:"threshold" default:"80" description:"Highlight resources using at least this percentage of their quota limit"`
+	usage           interface{} `usage:"CF_NAME space-usage SPACE [--threshold PERCENT]"`
+	relatedCommands interface{} `related_commands:"org-usage, space, space-quota"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SpaceUsageActor
+	ActorV3     SpaceUsageActorV3
+}
+
+func (cmd *SpaceUsageCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, nil)
+
+	ccClientV3, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(sharedV3.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
+	return nil
+}
+
+func (cmd SpaceUsageCommand) Execute(args []string) error {
+	if cmd.Threshold < 0 || cmd.Threshold > 100 {
+		return command.ParseArgumentError{
+			ArgumentName: "--threshold",
+			ExpectedType: "an integer between 0 and 100",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting quota usage for space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"SpaceName": cmd.RequiredArgs.Space,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	usage, warnings, err := cmd.Actor.GetSpaceUsageByOrganizationAndName(cmd.Config.TargetedOrganization().GUID, cmd.RequiredArgs.Space)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.ActorV3 != nil {
+		tasks, v3Warnings, err := cmd.ActorV3.GetRunningTasksBySpace(usage.GUID)
+		cmd.UI.DisplayWarnings(v3Warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		addRunningTasks(&usage, tasks)
+	}
+
+	shared.DisplayQuotaUsage(cmd.UI, usage, cmd.ActorV3 != nil, cmd.Threshold)
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("space-usage Command", func() {
+	var (
+		cmd             SpaceUsageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSpaceUsageActor
+		fakeActorV3     *v2fakes.FakeSpaceUsageActorV3
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSpaceUsageActor)
+		fakeActorV3 = new(v2fakes.FakeSpaceUsageActorV3)
+
+		cmd = SpaceUsageCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV3:     fakeActorV3,
+			Threshold:   50,
+		}
+		cmd.RequiredArgs.Space = "some-space"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoTargetedOrganizationError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NoTargetedOrganizationError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the space does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceUsageByOrganizationAndNameReturns(v2action.QuotaUsage{}, v2action.Warnings{"usage-warning"}, v2action.SpaceNotFoundError{Name: "some-space"})
+		})
+
+		It("returns a SpaceNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(shared.SpaceNotFoundError{Name: "some-space"}))
+			Expect(testUI.Err).To(Say("usage-warning"))
+		})
+	})
+
+	Context("when the space has no space quota", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceUsageByOrganizationAndNameReturns(
+				v2action.QuotaUsage{
+					GUID:             "some-space-guid",
+					Memory:           v2action.ResourceUsage{Used: 512, Limit: -1},
+					Instances:        v2action.ResourceUsage{Used: 1, Limit: -1},
+					Routes:           v2action.ResourceUsage{Limit: -1},
+					ServiceInstances: v2action.ResourceUsage{Limit: -1},
+					AppTasks:         v2action.ResourceUsage{Limit: -1},
+				},
+				v2action.Warnings{"usage-warning"},
+				nil,
+			)
+			fakeActorV3.GetRunningTasksBySpaceReturns([]v3action.Task{{MemoryInMB: 512}}, v3action.Warnings{"tasks-warning"}, nil)
+		})
+
+		It("displays every resource as unlimited", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting quota usage for space some-space in org some-org as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("quota:\\s+none"))
+			Expect(testUI.Out).To(Say("memory\\s+1G\\s+unlimited"))
+			Expect(testUI.Out).To(Say("app tasks\\s+1\\s+unlimited"))
+			Expect(testUI.Err).To(Say("usage-warning"))
+			Expect(testUI.Err).To(Say("tasks-warning"))
+			Expect(testUI.Err).NotTo(Say("of the quota limit"))
+
+			orgGUID, spaceName := fakeActor.GetSpaceUsageByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(fakeActorV3.GetRunningTasksBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+		})
+	})
+})
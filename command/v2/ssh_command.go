@@ -10,16 +10,22 @@ import (
 
 type SSHCommand struct {
 	RequiredArgs        flag.AppName `positional-args:"yes"`
+	AllInstances        bool         `long:"all-instances" description:"Run the command on every running instance of the application"`
 	AppInstanceIndex    int          `long:"app-instance-index" short:"i" description:"Application instance index"`
 	Command             string       `long:"command" short:"c" description:"Command to run. This flag can be defined more than once."`
+	Concurrency         int          `long:"concurrency" description:"Maximum number of instances to run the command on at once when using --all-instances (Default: 10)"`
 	DisablePseudoTTY    bool         `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	DynamicPort         string       `short:"D" description:"Dynamic SOCKS5 port forward specification. This flag can be defined more than once."`
 	ForcePseudoTTY      bool         `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
 	LocalPort           string       `short:"L" description:"Local port forward specification. This flag can be defined more than once."`
+	Record              string       `long:"record" description:"Record the session to an asciinema compatible file in DIR"`
+	RemotePort          string       `short:"R" description:"Remote port forward specification. This flag can be defined more than once."`
 	RemotePseudoTTY     bool         `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
 	SkipHostValidation  bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
 	SkipRemoteExecution bool         `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-	usage               interface{}  `usage:"CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [--skip-host-validation] [--skip-remote-execution] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty]"`
-	relatedCommands     interface{}  `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
+	Timeout             int          `long:"timeout" description:"Time in seconds each instance has to complete the command when using --all-instances (Default: 300)"`
+	usage               interface{}  `usage:"CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [-R [bind_address:]port:host:hostport] [-D [bind_address:]port] [--skip-host-validation] [--skip-remote-execution] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty] [--record DIR]\n   CF_NAME ssh APP_NAME --all-instances -c command [--concurrency max-instances] [--timeout seconds] [--skip-host-validation]"`
+	relatedCommands     interface{}  `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled, ssh-replay"`
 }
 
 func (_ SSHCommand) Setup(config command.Config, ui command.UI) error {
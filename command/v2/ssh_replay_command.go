@@ -0,0 +1,25 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type SSHReplayCommand struct {
+	RequiredArgs    flag.SSHReplayArgs `positional-args:"yes"`
+	Speed           float64            `long:"speed" description:"Playback speed multiplier (Default: 1)"`
+	usage           interface{}        `usage:"CF_NAME ssh-replay FILE [--speed SPEED]\n\nEXAMPLES:\n   CF_NAME ssh-replay ./recordings/my-app-0-20170101T120000Z.cast\n   CF_NAME ssh-replay ./recordings/my-app-0-20170101T120000Z.cast --speed 2"`
+	relatedCommands interface{}        `related_commands:"ssh"`
+}
+
+func (_ SSHReplayCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (_ SSHReplayCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
@@ -15,8 +15,9 @@ type UpdateBuildpackCommand struct {
 	Order           int                              `short:"i" description:"The order in which the buildpacks are checked during buildpack auto-detection"`
 	Lock            bool                             `long:"lock" description:"Lock the buildpack to prevent updates"`
 	Path            flag.PathWithExistenceCheckOrURL `short:"p" description:"Path to directory or zip file"`
+	Stack           string                           `long:"stack" description:"Stack of the buildpack to update, when buildpacks with the same name exist for several stacks"`
 	Unlock          bool                             `long:"unlock" description:"Unlock the buildpack to enable updates"`
-	usage           interface{}                      `usage:"CF_NAME update-buildpack BUILDPACK [-p PATH] [-i POSITION] [--enable|--disable] [--lock|--unlock]\n\nTIP:\n   Path should be a zip file, a url to a zip file, or a local directory. Position is a positive integer, sets priority, and is sorted from lowest to highest."`
+	usage           interface{}                      `usage:"CF_NAME update-buildpack BUILDPACK [-p PATH] [-i POSITION] [--enable|--disable] [--lock|--unlock] [--stack STACK]\n\nTIP:\n   Path should be a zip file, a url to a zip file, or a local directory. Position is a positive integer, sets priority, and is sorted from lowest to highest."`
 	relatedCommands interface{}                      `related_commands:"buildpacks, rename-buildpack"`
 }
 
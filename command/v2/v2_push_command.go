@@ -41,10 +41,11 @@ type V2PushCommand struct {
 	DirectoryPath        flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
 	RandomRoute          bool                        `long:"random-route" description:"Create a random route for this app"`
 	RoutePath            string                      `long:"route-path" description:"Path for the route"`
+	RoutePort            int                         `long:"route-port" description:"Port for the TCP route"`
 	Stack                string                      `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	ApplicationStartTime int                         `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
 
-	usage               interface{} `usage:"Push a single app (with or without a manifest):\n   CF_NAME v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-d DOMAIN] [-f MANIFEST_PATH] [--docker-image DOCKER_IMAGE]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [--hostname HOST] [-p PATH] [-s STACK] [-t TIMEOUT] [-u (process | port | http)] [--route-path ROUTE_PATH]\n   [--no-hostname] [--no-manifest] [--no-route] [--no-start] [--random-route]\n\n   Push multiple apps with a manifest:\n   cf v2-push [-f MANIFEST_PATH]"`
+	usage               interface{} `usage:"Push a single app (with or without a manifest):\n   CF_NAME v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-d DOMAIN] [-f MANIFEST_PATH] [--docker-image DOCKER_IMAGE]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [--hostname HOST] [-p PATH] [-s STACK] [-t TIMEOUT] [-u (process | port | http)] [--route-path ROUTE_PATH]\n   [--route-port ROUTE_PORT] [--no-hostname] [--no-manifest] [--no-route] [--no-start] [--random-route]\n\n   Push multiple apps with a manifest:\n   cf v2-push [-f MANIFEST_PATH]"`
 	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 	relatedCommands     interface{} `related_commands:"apps, create-app-manifest, logs, ssh, start"`
@@ -129,8 +130,15 @@ func (cmd V2PushCommand) GetCommandLineSettings() (pushaction.CommandLineSetting
 	}
 
 	config := pushaction.CommandLineSettings{
-		Name: cmd.OptionalArgs.AppName,
-		Path: pwd,
+		Name:        cmd.OptionalArgs.AppName,
+		Path:        pwd,
+		Domain:      cmd.Domain,
+		Hostname:    cmd.Hostname,
+		NoHostname:  cmd.NoHostname,
+		NoRoute:     cmd.NoRoute,
+		RandomRoute: cmd.RandomRoute,
+		RoutePath:   cmd.RoutePath,
+		RoutePort:   cmd.RoutePort,
 	}
 
 	log.Debugf("%#v", config)
@@ -215,6 +223,8 @@ func (cmd V2PushCommand) processEvent(appConfig pushaction.ApplicationConfig, ev
 		cmd.UI.DisplayText("Creating routes...")
 	case pushaction.RouteBound:
 		cmd.UI.DisplayText("Binding routes...")
+	case pushaction.RoutesUnbound:
+		cmd.UI.DisplayText("Unbinding routes...")
 	case pushaction.UploadingApplication:
 		cmd.UI.DisplayText("Uploading application...")
 	case pushaction.UploadComplete:
@@ -134,6 +134,7 @@ var _ = Describe("v2-push Command", func() {
 							Eventually(eventStream).Should(BeSent(pushaction.ApplicationUpdated))
 							Eventually(eventStream).Should(BeSent(pushaction.RouteCreated))
 							Eventually(eventStream).Should(BeSent(pushaction.RouteBound))
+							Eventually(eventStream).Should(BeSent(pushaction.RoutesUnbound))
 							Eventually(eventStream).Should(BeSent(pushaction.UploadingApplication))
 							Eventually(eventStream).Should(BeSent(pushaction.UploadComplete))
 							Eventually(eventStream).Should(BeSent(pushaction.Complete))
@@ -161,6 +162,35 @@ var _ = Describe("v2-push Command", func() {
 						}))
 					})
 
+					Context("when route flags are provided", func() {
+						BeforeEach(func() {
+							cmd.Domain = "some-domain.com"
+							cmd.Hostname = "some-host"
+							cmd.RoutePath = "/some-path"
+							cmd.RoutePort = 1234
+							cmd.NoHostname = true
+							cmd.NoRoute = true
+							cmd.RandomRoute = true
+						})
+
+						It("passes them to the actor", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							cmdSettings, _ := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+							Expect(cmdSettings).To(Equal(pushaction.CommandLineSettings{
+								Name:        appName,
+								Path:        pwd,
+								Domain:      "some-domain.com",
+								Hostname:    "some-host",
+								NoHostname:  true,
+								NoRoute:     true,
+								RandomRoute: true,
+								RoutePath:   "/some-path",
+								RoutePort:   1234,
+							}))
+						})
+					})
+
 					It("converts the manifests to app configs and outputs config warnings", func() {
 						Expect(executeErr).ToNot(HaveOccurred())
 
@@ -192,6 +222,7 @@ var _ = Describe("v2-push Command", func() {
 						Expect(testUI.Out).To(Say("Updating app %s in org %s / space %s as %s...", appName, "some-org", "some-space", "some-user"))
 						Expect(testUI.Out).To(Say("Creating routes..."))
 						Expect(testUI.Out).To(Say("Binding routes..."))
+						Expect(testUI.Out).To(Say("Unbinding routes..."))
 						Expect(testUI.Out).To(Say("Uploading application..."))
 						Expect(testUI.Out).To(Say("Upload complete"))
 
@@ -0,0 +1,171 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/foundationaction"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeApplyFoundationConfigActor struct {
+	CreatePlanStub        func(config foundationaction.Config) ([]foundationaction.Change, foundationaction.Warnings, error)
+	createPlanMutex       sync.RWMutex
+	createPlanArgsForCall []struct {
+		config foundationaction.Config
+	}
+	createPlanReturns struct {
+		result1 []foundationaction.Change
+		result2 foundationaction.Warnings
+		result3 error
+	}
+	createPlanReturnsOnCall map[int]struct {
+		result1 []foundationaction.Change
+		result2 foundationaction.Warnings
+		result3 error
+	}
+	ApplyChangeStub        func(change foundationaction.Change) (foundationaction.Warnings, error)
+	applyChangeMutex       sync.RWMutex
+	applyChangeArgsForCall []struct {
+		change foundationaction.Change
+	}
+	applyChangeReturns struct {
+		result1 foundationaction.Warnings
+		result2 error
+	}
+	applyChangeReturnsOnCall map[int]struct {
+		result1 foundationaction.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeApplyFoundationConfigActor) CreatePlan(config foundationaction.Config) ([]foundationaction.Change, foundationaction.Warnings, error) {
+	fake.createPlanMutex.Lock()
+	ret, specificReturn := fake.createPlanReturnsOnCall[len(fake.createPlanArgsForCall)]
+	fake.createPlanArgsForCall = append(fake.createPlanArgsForCall, struct {
+		config foundationaction.Config
+	}{config})
+	fake.recordInvocation("CreatePlan", []interface{}{config})
+	fake.createPlanMutex.Unlock()
+	if fake.CreatePlanStub != nil {
+		return fake.CreatePlanStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createPlanReturns.result1, fake.createPlanReturns.result2, fake.createPlanReturns.result3
+}
+
+func (fake *FakeApplyFoundationConfigActor) CreatePlanCallCount() int {
+	fake.createPlanMutex.RLock()
+	defer fake.createPlanMutex.RUnlock()
+	return len(fake.createPlanArgsForCall)
+}
+
+func (fake *FakeApplyFoundationConfigActor) CreatePlanArgsForCall(i int) foundationaction.Config {
+	fake.createPlanMutex.RLock()
+	defer fake.createPlanMutex.RUnlock()
+	return fake.createPlanArgsForCall[i].config
+}
+
+func (fake *FakeApplyFoundationConfigActor) CreatePlanReturns(result1 []foundationaction.Change, result2 foundationaction.Warnings, result3 error) {
+	fake.CreatePlanStub = nil
+	fake.createPlanReturns = struct {
+		result1 []foundationaction.Change
+		result2 foundationaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApplyFoundationConfigActor) CreatePlanReturnsOnCall(i int, result1 []foundationaction.Change, result2 foundationaction.Warnings, result3 error) {
+	fake.CreatePlanStub = nil
+	if fake.createPlanReturnsOnCall == nil {
+		fake.createPlanReturnsOnCall = make(map[int]struct {
+			result1 []foundationaction.Change
+			result2 foundationaction.Warnings
+			result3 error
+		})
+	}
+	fake.createPlanReturnsOnCall[i] = struct {
+		result1 []foundationaction.Change
+		result2 foundationaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApplyFoundationConfigActor) ApplyChange(change foundationaction.Change) (foundationaction.Warnings, error) {
+	fake.applyChangeMutex.Lock()
+	ret, specificReturn := fake.applyChangeReturnsOnCall[len(fake.applyChangeArgsForCall)]
+	fake.applyChangeArgsForCall = append(fake.applyChangeArgsForCall, struct {
+		change foundationaction.Change
+	}{change})
+	fake.recordInvocation("ApplyChange", []interface{}{change})
+	fake.applyChangeMutex.Unlock()
+	if fake.ApplyChangeStub != nil {
+		return fake.ApplyChangeStub(change)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.applyChangeReturns.result1, fake.applyChangeReturns.result2
+}
+
+func (fake *FakeApplyFoundationConfigActor) ApplyChangeCallCount() int {
+	fake.applyChangeMutex.RLock()
+	defer fake.applyChangeMutex.RUnlock()
+	return len(fake.applyChangeArgsForCall)
+}
+
+func (fake *FakeApplyFoundationConfigActor) ApplyChangeArgsForCall(i int) foundationaction.Change {
+	fake.applyChangeMutex.RLock()
+	defer fake.applyChangeMutex.RUnlock()
+	return fake.applyChangeArgsForCall[i].change
+}
+
+func (fake *FakeApplyFoundationConfigActor) ApplyChangeReturns(result1 foundationaction.Warnings, result2 error) {
+	fake.ApplyChangeStub = nil
+	fake.applyChangeReturns = struct {
+		result1 foundationaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApplyFoundationConfigActor) ApplyChangeReturnsOnCall(i int, result1 foundationaction.Warnings, result2 error) {
+	fake.ApplyChangeStub = nil
+	if fake.applyChangeReturnsOnCall == nil {
+		fake.applyChangeReturnsOnCall = make(map[int]struct {
+			result1 foundationaction.Warnings
+			result2 error
+		})
+	}
+	fake.applyChangeReturnsOnCall[i] = struct {
+		result1 foundationaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApplyFoundationConfigActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createPlanMutex.RLock()
+	defer fake.createPlanMutex.RUnlock()
+	fake.applyChangeMutex.RLock()
+	defer fake.applyChangeMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeApplyFoundationConfigActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.ApplyFoundationConfigActor = new(FakeApplyFoundationConfigActor)
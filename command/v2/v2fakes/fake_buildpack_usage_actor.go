@@ -0,0 +1,95 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeBuildpackUsageActor struct {
+	GetBuildpackUsageStub        func() ([]v2action.BuildpackUsage, v2action.Warnings, error)
+	getBuildpackUsageMutex       sync.RWMutex
+	getBuildpackUsageArgsForCall []struct{}
+	getBuildpackUsageReturns     struct {
+		result1 []v2action.BuildpackUsage
+		result2 v2action.Warnings
+		result3 error
+	}
+	getBuildpackUsageReturnsOnCall map[int]struct {
+		result1 []v2action.BuildpackUsage
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBuildpackUsageActor) GetBuildpackUsage() ([]v2action.BuildpackUsage, v2action.Warnings, error) {
+	fake.getBuildpackUsageMutex.Lock()
+	ret, specificReturn := fake.getBuildpackUsageReturnsOnCall[len(fake.getBuildpackUsageArgsForCall)]
+	fake.getBuildpackUsageArgsForCall = append(fake.getBuildpackUsageArgsForCall, struct{}{})
+	fake.recordInvocation("GetBuildpackUsage", []interface{}{})
+	fake.getBuildpackUsageMutex.Unlock()
+	if fake.GetBuildpackUsageStub != nil {
+		return fake.GetBuildpackUsageStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getBuildpackUsageReturns.result1, fake.getBuildpackUsageReturns.result2, fake.getBuildpackUsageReturns.result3
+}
+
+func (fake *FakeBuildpackUsageActor) GetBuildpackUsageCallCount() int {
+	fake.getBuildpackUsageMutex.RLock()
+	defer fake.getBuildpackUsageMutex.RUnlock()
+	return len(fake.getBuildpackUsageArgsForCall)
+}
+
+func (fake *FakeBuildpackUsageActor) GetBuildpackUsageReturns(result1 []v2action.BuildpackUsage, result2 v2action.Warnings, result3 error) {
+	fake.GetBuildpackUsageStub = nil
+	fake.getBuildpackUsageReturns = struct {
+		result1 []v2action.BuildpackUsage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuildpackUsageActor) GetBuildpackUsageReturnsOnCall(i int, result1 []v2action.BuildpackUsage, result2 v2action.Warnings, result3 error) {
+	fake.GetBuildpackUsageStub = nil
+	if fake.getBuildpackUsageReturnsOnCall == nil {
+		fake.getBuildpackUsageReturnsOnCall = make(map[int]struct {
+			result1 []v2action.BuildpackUsage
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getBuildpackUsageReturnsOnCall[i] = struct {
+		result1 []v2action.BuildpackUsage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuildpackUsageActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getBuildpackUsageMutex.RLock()
+	defer fake.getBuildpackUsageMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeBuildpackUsageActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.BuildpackUsageActor = new(FakeBuildpackUsageActor)
@@ -0,0 +1,453 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeCanaryActor struct {
+	AbortCanaryStub        func(config pushaction.CanaryConfig) (pushaction.Warnings, error)
+	abortCanaryMutex       sync.RWMutex
+	abortCanaryArgsForCall []struct {
+		config pushaction.CanaryConfig
+	}
+	abortCanaryReturns struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	abortCanaryReturnsOnCall map[int]struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	ConvertToCanaryConfigStub        func(currentAppName string, canaryAppName string, spaceGUID string, totalInstances int) (pushaction.CanaryConfig, pushaction.Warnings, error)
+	convertToCanaryConfigMutex       sync.RWMutex
+	convertToCanaryConfigArgsForCall []struct {
+		currentAppName string
+		canaryAppName  string
+		spaceGUID      string
+		totalInstances int
+	}
+	convertToCanaryConfigReturns struct {
+		result1 pushaction.CanaryConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
+	convertToCanaryConfigReturnsOnCall map[int]struct {
+		result1 pushaction.CanaryConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
+	GetCanaryHealthStub        func(config pushaction.CanaryConfig) (pushaction.CanaryHealth, pushaction.Warnings, error)
+	getCanaryHealthMutex       sync.RWMutex
+	getCanaryHealthArgsForCall []struct {
+		config pushaction.CanaryConfig
+	}
+	getCanaryHealthReturns struct {
+		result1 pushaction.CanaryHealth
+		result2 pushaction.Warnings
+		result3 error
+	}
+	getCanaryHealthReturnsOnCall map[int]struct {
+		result1 pushaction.CanaryHealth
+		result2 pushaction.Warnings
+		result3 error
+	}
+	MapCanaryRoutesStub        func(config pushaction.CanaryConfig) (pushaction.Warnings, error)
+	mapCanaryRoutesMutex       sync.RWMutex
+	mapCanaryRoutesArgsForCall []struct {
+		config pushaction.CanaryConfig
+	}
+	mapCanaryRoutesReturns struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	mapCanaryRoutesReturnsOnCall map[int]struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	PromoteCanaryStub        func(config pushaction.CanaryConfig) (pushaction.Warnings, error)
+	promoteCanaryMutex       sync.RWMutex
+	promoteCanaryArgsForCall []struct {
+		config pushaction.CanaryConfig
+	}
+	promoteCanaryReturns struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	promoteCanaryReturnsOnCall map[int]struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	ScaleCanaryStub        func(config pushaction.CanaryConfig, percentage int) (pushaction.CanaryStep, pushaction.Warnings, error)
+	scaleCanaryMutex       sync.RWMutex
+	scaleCanaryArgsForCall []struct {
+		config     pushaction.CanaryConfig
+		percentage int
+	}
+	scaleCanaryReturns struct {
+		result1 pushaction.CanaryStep
+		result2 pushaction.Warnings
+		result3 error
+	}
+	scaleCanaryReturnsOnCall map[int]struct {
+		result1 pushaction.CanaryStep
+		result2 pushaction.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCanaryActor) AbortCanary(config pushaction.CanaryConfig) (pushaction.Warnings, error) {
+	fake.abortCanaryMutex.Lock()
+	ret, specificReturn := fake.abortCanaryReturnsOnCall[len(fake.abortCanaryArgsForCall)]
+	fake.abortCanaryArgsForCall = append(fake.abortCanaryArgsForCall, struct {
+		config pushaction.CanaryConfig
+	}{config})
+	fake.recordInvocation("AbortCanary", []interface{}{config})
+	fake.abortCanaryMutex.Unlock()
+	if fake.AbortCanaryStub != nil {
+		return fake.AbortCanaryStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.abortCanaryReturns.result1, fake.abortCanaryReturns.result2
+}
+
+func (fake *FakeCanaryActor) AbortCanaryCallCount() int {
+	fake.abortCanaryMutex.RLock()
+	defer fake.abortCanaryMutex.RUnlock()
+	return len(fake.abortCanaryArgsForCall)
+}
+
+func (fake *FakeCanaryActor) AbortCanaryArgsForCall(i int) pushaction.CanaryConfig {
+	fake.abortCanaryMutex.RLock()
+	defer fake.abortCanaryMutex.RUnlock()
+	return fake.abortCanaryArgsForCall[i].config
+}
+
+func (fake *FakeCanaryActor) AbortCanaryReturns(result1 pushaction.Warnings, result2 error) {
+	fake.AbortCanaryStub = nil
+	fake.abortCanaryReturns = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCanaryActor) AbortCanaryReturnsOnCall(i int, result1 pushaction.Warnings, result2 error) {
+	fake.AbortCanaryStub = nil
+	if fake.abortCanaryReturnsOnCall == nil {
+		fake.abortCanaryReturnsOnCall = make(map[int]struct {
+			result1 pushaction.Warnings
+			result2 error
+		})
+	}
+	fake.abortCanaryReturnsOnCall[i] = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCanaryActor) ConvertToCanaryConfig(currentAppName string, canaryAppName string, spaceGUID string, totalInstances int) (pushaction.CanaryConfig, pushaction.Warnings, error) {
+	fake.convertToCanaryConfigMutex.Lock()
+	ret, specificReturn := fake.convertToCanaryConfigReturnsOnCall[len(fake.convertToCanaryConfigArgsForCall)]
+	fake.convertToCanaryConfigArgsForCall = append(fake.convertToCanaryConfigArgsForCall, struct {
+		currentAppName string
+		canaryAppName  string
+		spaceGUID      string
+		totalInstances int
+	}{currentAppName, canaryAppName, spaceGUID, totalInstances})
+	fake.recordInvocation("ConvertToCanaryConfig", []interface{}{currentAppName, canaryAppName, spaceGUID, totalInstances})
+	fake.convertToCanaryConfigMutex.Unlock()
+	if fake.ConvertToCanaryConfigStub != nil {
+		return fake.ConvertToCanaryConfigStub(currentAppName, canaryAppName, spaceGUID, totalInstances)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.convertToCanaryConfigReturns.result1, fake.convertToCanaryConfigReturns.result2, fake.convertToCanaryConfigReturns.result3
+}
+
+func (fake *FakeCanaryActor) ConvertToCanaryConfigCallCount() int {
+	fake.convertToCanaryConfigMutex.RLock()
+	defer fake.convertToCanaryConfigMutex.RUnlock()
+	return len(fake.convertToCanaryConfigArgsForCall)
+}
+
+func (fake *FakeCanaryActor) ConvertToCanaryConfigArgsForCall(i int) (string, string, string, int) {
+	fake.convertToCanaryConfigMutex.RLock()
+	defer fake.convertToCanaryConfigMutex.RUnlock()
+	return fake.convertToCanaryConfigArgsForCall[i].currentAppName, fake.convertToCanaryConfigArgsForCall[i].canaryAppName, fake.convertToCanaryConfigArgsForCall[i].spaceGUID, fake.convertToCanaryConfigArgsForCall[i].totalInstances
+}
+
+func (fake *FakeCanaryActor) ConvertToCanaryConfigReturns(result1 pushaction.CanaryConfig, result2 pushaction.Warnings, result3 error) {
+	fake.ConvertToCanaryConfigStub = nil
+	fake.convertToCanaryConfigReturns = struct {
+		result1 pushaction.CanaryConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCanaryActor) ConvertToCanaryConfigReturnsOnCall(i int, result1 pushaction.CanaryConfig, result2 pushaction.Warnings, result3 error) {
+	fake.ConvertToCanaryConfigStub = nil
+	if fake.convertToCanaryConfigReturnsOnCall == nil {
+		fake.convertToCanaryConfigReturnsOnCall = make(map[int]struct {
+			result1 pushaction.CanaryConfig
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.convertToCanaryConfigReturnsOnCall[i] = struct {
+		result1 pushaction.CanaryConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCanaryActor) GetCanaryHealth(config pushaction.CanaryConfig) (pushaction.CanaryHealth, pushaction.Warnings, error) {
+	fake.getCanaryHealthMutex.Lock()
+	ret, specificReturn := fake.getCanaryHealthReturnsOnCall[len(fake.getCanaryHealthArgsForCall)]
+	fake.getCanaryHealthArgsForCall = append(fake.getCanaryHealthArgsForCall, struct {
+		config pushaction.CanaryConfig
+	}{config})
+	fake.recordInvocation("GetCanaryHealth", []interface{}{config})
+	fake.getCanaryHealthMutex.Unlock()
+	if fake.GetCanaryHealthStub != nil {
+		return fake.GetCanaryHealthStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getCanaryHealthReturns.result1, fake.getCanaryHealthReturns.result2, fake.getCanaryHealthReturns.result3
+}
+
+func (fake *FakeCanaryActor) GetCanaryHealthCallCount() int {
+	fake.getCanaryHealthMutex.RLock()
+	defer fake.getCanaryHealthMutex.RUnlock()
+	return len(fake.getCanaryHealthArgsForCall)
+}
+
+func (fake *FakeCanaryActor) GetCanaryHealthArgsForCall(i int) pushaction.CanaryConfig {
+	fake.getCanaryHealthMutex.RLock()
+	defer fake.getCanaryHealthMutex.RUnlock()
+	return fake.getCanaryHealthArgsForCall[i].config
+}
+
+func (fake *FakeCanaryActor) GetCanaryHealthReturns(result1 pushaction.CanaryHealth, result2 pushaction.Warnings, result3 error) {
+	fake.GetCanaryHealthStub = nil
+	fake.getCanaryHealthReturns = struct {
+		result1 pushaction.CanaryHealth
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCanaryActor) GetCanaryHealthReturnsOnCall(i int, result1 pushaction.CanaryHealth, result2 pushaction.Warnings, result3 error) {
+	fake.GetCanaryHealthStub = nil
+	if fake.getCanaryHealthReturnsOnCall == nil {
+		fake.getCanaryHealthReturnsOnCall = make(map[int]struct {
+			result1 pushaction.CanaryHealth
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.getCanaryHealthReturnsOnCall[i] = struct {
+		result1 pushaction.CanaryHealth
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCanaryActor) MapCanaryRoutes(config pushaction.CanaryConfig) (pushaction.Warnings, error) {
+	fake.mapCanaryRoutesMutex.Lock()
+	ret, specificReturn := fake.mapCanaryRoutesReturnsOnCall[len(fake.mapCanaryRoutesArgsForCall)]
+	fake.mapCanaryRoutesArgsForCall = append(fake.mapCanaryRoutesArgsForCall, struct {
+		config pushaction.CanaryConfig
+	}{config})
+	fake.recordInvocation("MapCanaryRoutes", []interface{}{config})
+	fake.mapCanaryRoutesMutex.Unlock()
+	if fake.MapCanaryRoutesStub != nil {
+		return fake.MapCanaryRoutesStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.mapCanaryRoutesReturns.result1, fake.mapCanaryRoutesReturns.result2
+}
+
+func (fake *FakeCanaryActor) MapCanaryRoutesCallCount() int {
+	fake.mapCanaryRoutesMutex.RLock()
+	defer fake.mapCanaryRoutesMutex.RUnlock()
+	return len(fake.mapCanaryRoutesArgsForCall)
+}
+
+func (fake *FakeCanaryActor) MapCanaryRoutesArgsForCall(i int) pushaction.CanaryConfig {
+	fake.mapCanaryRoutesMutex.RLock()
+	defer fake.mapCanaryRoutesMutex.RUnlock()
+	return fake.mapCanaryRoutesArgsForCall[i].config
+}
+
+func (fake *FakeCanaryActor) MapCanaryRoutesReturns(result1 pushaction.Warnings, result2 error) {
+	fake.MapCanaryRoutesStub = nil
+	fake.mapCanaryRoutesReturns = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCanaryActor) MapCanaryRoutesReturnsOnCall(i int, result1 pushaction.Warnings, result2 error) {
+	fake.MapCanaryRoutesStub = nil
+	if fake.mapCanaryRoutesReturnsOnCall == nil {
+		fake.mapCanaryRoutesReturnsOnCall = make(map[int]struct {
+			result1 pushaction.Warnings
+			result2 error
+		})
+	}
+	fake.mapCanaryRoutesReturnsOnCall[i] = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCanaryActor) PromoteCanary(config pushaction.CanaryConfig) (pushaction.Warnings, error) {
+	fake.promoteCanaryMutex.Lock()
+	ret, specificReturn := fake.promoteCanaryReturnsOnCall[len(fake.promoteCanaryArgsForCall)]
+	fake.promoteCanaryArgsForCall = append(fake.promoteCanaryArgsForCall, struct {
+		config pushaction.CanaryConfig
+	}{config})
+	fake.recordInvocation("PromoteCanary", []interface{}{config})
+	fake.promoteCanaryMutex.Unlock()
+	if fake.PromoteCanaryStub != nil {
+		return fake.PromoteCanaryStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.promoteCanaryReturns.result1, fake.promoteCanaryReturns.result2
+}
+
+func (fake *FakeCanaryActor) PromoteCanaryCallCount() int {
+	fake.promoteCanaryMutex.RLock()
+	defer fake.promoteCanaryMutex.RUnlock()
+	return len(fake.promoteCanaryArgsForCall)
+}
+
+func (fake *FakeCanaryActor) PromoteCanaryArgsForCall(i int) pushaction.CanaryConfig {
+	fake.promoteCanaryMutex.RLock()
+	defer fake.promoteCanaryMutex.RUnlock()
+	return fake.promoteCanaryArgsForCall[i].config
+}
+
+func (fake *FakeCanaryActor) PromoteCanaryReturns(result1 pushaction.Warnings, result2 error) {
+	fake.PromoteCanaryStub = nil
+	fake.promoteCanaryReturns = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCanaryActor) PromoteCanaryReturnsOnCall(i int, result1 pushaction.Warnings, result2 error) {
+	fake.PromoteCanaryStub = nil
+	if fake.promoteCanaryReturnsOnCall == nil {
+		fake.promoteCanaryReturnsOnCall = make(map[int]struct {
+			result1 pushaction.Warnings
+			result2 error
+		})
+	}
+	fake.promoteCanaryReturnsOnCall[i] = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCanaryActor) ScaleCanary(config pushaction.CanaryConfig, percentage int) (pushaction.CanaryStep, pushaction.Warnings, error) {
+	fake.scaleCanaryMutex.Lock()
+	ret, specificReturn := fake.scaleCanaryReturnsOnCall[len(fake.scaleCanaryArgsForCall)]
+	fake.scaleCanaryArgsForCall = append(fake.scaleCanaryArgsForCall, struct {
+		config     pushaction.CanaryConfig
+		percentage int
+	}{config, percentage})
+	fake.recordInvocation("ScaleCanary", []interface{}{config, percentage})
+	fake.scaleCanaryMutex.Unlock()
+	if fake.ScaleCanaryStub != nil {
+		return fake.ScaleCanaryStub(config, percentage)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.scaleCanaryReturns.result1, fake.scaleCanaryReturns.result2, fake.scaleCanaryReturns.result3
+}
+
+func (fake *FakeCanaryActor) ScaleCanaryCallCount() int {
+	fake.scaleCanaryMutex.RLock()
+	defer fake.scaleCanaryMutex.RUnlock()
+	return len(fake.scaleCanaryArgsForCall)
+}
+
+func (fake *FakeCanaryActor) ScaleCanaryArgsForCall(i int) (pushaction.CanaryConfig, int) {
+	fake.scaleCanaryMutex.RLock()
+	defer fake.scaleCanaryMutex.RUnlock()
+	return fake.scaleCanaryArgsForCall[i].config, fake.scaleCanaryArgsForCall[i].percentage
+}
+
+func (fake *FakeCanaryActor) ScaleCanaryReturns(result1 pushaction.CanaryStep, result2 pushaction.Warnings, result3 error) {
+	fake.ScaleCanaryStub = nil
+	fake.scaleCanaryReturns = struct {
+		result1 pushaction.CanaryStep
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCanaryActor) ScaleCanaryReturnsOnCall(i int, result1 pushaction.CanaryStep, result2 pushaction.Warnings, result3 error) {
+	fake.ScaleCanaryStub = nil
+	if fake.scaleCanaryReturnsOnCall == nil {
+		fake.scaleCanaryReturnsOnCall = make(map[int]struct {
+			result1 pushaction.CanaryStep
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.scaleCanaryReturnsOnCall[i] = struct {
+		result1 pushaction.CanaryStep
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCanaryActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.abortCanaryMutex.RLock()
+	defer fake.abortCanaryMutex.RUnlock()
+	fake.convertToCanaryConfigMutex.RLock()
+	defer fake.convertToCanaryConfigMutex.RUnlock()
+	fake.getCanaryHealthMutex.RLock()
+	defer fake.getCanaryHealthMutex.RUnlock()
+	fake.mapCanaryRoutesMutex.RLock()
+	defer fake.mapCanaryRoutesMutex.RUnlock()
+	fake.promoteCanaryMutex.RLock()
+	defer fake.promoteCanaryMutex.RUnlock()
+	fake.scaleCanaryMutex.RLock()
+	defer fake.scaleCanaryMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCanaryActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.CanaryActor = new(FakeCanaryActor)
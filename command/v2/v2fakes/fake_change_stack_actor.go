@@ -0,0 +1,476 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeChangeStackActor struct {
+	GetApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationsBySpaceStub        func(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetStackStub        func(guid string) (v2action.Stack, v2action.Warnings, error)
+	getStackMutex       sync.RWMutex
+	getStackArgsForCall []struct {
+		guid string
+	}
+	getStackReturns struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	getStackReturnsOnCall map[int]struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetStackByNameStub        func(name string) (v2action.Stack, v2action.Warnings, error)
+	getStackByNameMutex       sync.RWMutex
+	getStackByNameArgsForCall []struct {
+		name string
+	}
+	getStackByNameReturns struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	getStackByNameReturnsOnCall map[int]struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	StartApplicationStub        func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error)
+	startApplicationMutex       sync.RWMutex
+	startApplicationArgsForCall []struct {
+		app    v2action.Application
+		client v2action.NOAAClient
+		config v2action.Config
+	}
+	startApplicationReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}
+	startApplicationReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}
+	UpdateApplicationStub        func(application v2action.Application) (v2action.Application, v2action.Warnings, error)
+	updateApplicationMutex       sync.RWMutex
+	updateApplicationArgsForCall []struct {
+		application v2action.Application
+	}
+	updateApplicationReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	updateApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeChangeStackActor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeChangeStackActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeChangeStackActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeChangeStackActor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeChangeStackActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeChangeStackActor) GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{spaceGUID})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if fake.GetApplicationsBySpaceStub != nil {
+		return fake.GetApplicationsBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationsBySpaceReturns.result1, fake.getApplicationsBySpaceReturns.result2, fake.getApplicationsBySpaceReturns.result3
+}
+
+func (fake *FakeChangeStackActor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeChangeStackActor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return fake.getApplicationsBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeChangeStackActor) GetApplicationsBySpaceReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeChangeStackActor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	if fake.getApplicationsBySpaceReturnsOnCall == nil {
+		fake.getApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeChangeStackActor) GetStack(guid string) (v2action.Stack, v2action.Warnings, error) {
+	fake.getStackMutex.Lock()
+	ret, specificReturn := fake.getStackReturnsOnCall[len(fake.getStackArgsForCall)]
+	fake.getStackArgsForCall = append(fake.getStackArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetStack", []interface{}{guid})
+	fake.getStackMutex.Unlock()
+	if fake.GetStackStub != nil {
+		return fake.GetStackStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStackReturns.result1, fake.getStackReturns.result2, fake.getStackReturns.result3
+}
+
+func (fake *FakeChangeStackActor) GetStackCallCount() int {
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	return len(fake.getStackArgsForCall)
+}
+
+func (fake *FakeChangeStackActor) GetStackArgsForCall(i int) string {
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	return fake.getStackArgsForCall[i].guid
+}
+
+func (fake *FakeChangeStackActor) GetStackReturns(result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackStub = nil
+	fake.getStackReturns = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeChangeStackActor) GetStackReturnsOnCall(i int, result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackStub = nil
+	if fake.getStackReturnsOnCall == nil {
+		fake.getStackReturnsOnCall = make(map[int]struct {
+			result1 v2action.Stack
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getStackReturnsOnCall[i] = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeChangeStackActor) GetStackByName(name string) (v2action.Stack, v2action.Warnings, error) {
+	fake.getStackByNameMutex.Lock()
+	ret, specificReturn := fake.getStackByNameReturnsOnCall[len(fake.getStackByNameArgsForCall)]
+	fake.getStackByNameArgsForCall = append(fake.getStackByNameArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetStackByName", []interface{}{name})
+	fake.getStackByNameMutex.Unlock()
+	if fake.GetStackByNameStub != nil {
+		return fake.GetStackByNameStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStackByNameReturns.result1, fake.getStackByNameReturns.result2, fake.getStackByNameReturns.result3
+}
+
+func (fake *FakeChangeStackActor) GetStackByNameCallCount() int {
+	fake.getStackByNameMutex.RLock()
+	defer fake.getStackByNameMutex.RUnlock()
+	return len(fake.getStackByNameArgsForCall)
+}
+
+func (fake *FakeChangeStackActor) GetStackByNameArgsForCall(i int) string {
+	fake.getStackByNameMutex.RLock()
+	defer fake.getStackByNameMutex.RUnlock()
+	return fake.getStackByNameArgsForCall[i].name
+}
+
+func (fake *FakeChangeStackActor) GetStackByNameReturns(result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackByNameStub = nil
+	fake.getStackByNameReturns = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeChangeStackActor) GetStackByNameReturnsOnCall(i int, result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackByNameStub = nil
+	if fake.getStackByNameReturnsOnCall == nil {
+		fake.getStackByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Stack
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getStackByNameReturnsOnCall[i] = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeChangeStackActor) StartApplication(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+	fake.startApplicationMutex.Lock()
+	ret, specificReturn := fake.startApplicationReturnsOnCall[len(fake.startApplicationArgsForCall)]
+	fake.startApplicationArgsForCall = append(fake.startApplicationArgsForCall, struct {
+		app    v2action.Application
+		client v2action.NOAAClient
+		config v2action.Config
+	}{app, client, config})
+	fake.recordInvocation("StartApplication", []interface{}{app, client, config})
+	fake.startApplicationMutex.Unlock()
+	if fake.StartApplicationStub != nil {
+		return fake.StartApplicationStub(app, client, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
+	}
+	return fake.startApplicationReturns.result1, fake.startApplicationReturns.result2, fake.startApplicationReturns.result3, fake.startApplicationReturns.result4, fake.startApplicationReturns.result5
+}
+
+func (fake *FakeChangeStackActor) StartApplicationCallCount() int {
+	fake.startApplicationMutex.RLock()
+	defer fake.startApplicationMutex.RUnlock()
+	return len(fake.startApplicationArgsForCall)
+}
+
+func (fake *FakeChangeStackActor) StartApplicationArgsForCall(i int) (v2action.Application, v2action.NOAAClient, v2action.Config) {
+	fake.startApplicationMutex.RLock()
+	defer fake.startApplicationMutex.RUnlock()
+	return fake.startApplicationArgsForCall[i].app, fake.startApplicationArgsForCall[i].client, fake.startApplicationArgsForCall[i].config
+}
+
+func (fake *FakeChangeStackActor) StartApplicationReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 <-chan bool, result4 <-chan string, result5 <-chan error) {
+	fake.StartApplicationStub = nil
+	fake.startApplicationReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeChangeStackActor) StartApplicationReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 <-chan bool, result4 <-chan string, result5 <-chan error) {
+	fake.StartApplicationStub = nil
+	if fake.startApplicationReturnsOnCall == nil {
+		fake.startApplicationReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 <-chan bool
+			result4 <-chan string
+			result5 <-chan error
+		})
+	}
+	fake.startApplicationReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeChangeStackActor) UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error) {
+	fake.updateApplicationMutex.Lock()
+	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
+	fake.updateApplicationArgsForCall = append(fake.updateApplicationArgsForCall, struct {
+		application v2action.Application
+	}{application})
+	fake.recordInvocation("UpdateApplication", []interface{}{application})
+	fake.updateApplicationMutex.Unlock()
+	if fake.UpdateApplicationStub != nil {
+		return fake.UpdateApplicationStub(application)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateApplicationReturns.result1, fake.updateApplicationReturns.result2, fake.updateApplicationReturns.result3
+}
+
+func (fake *FakeChangeStackActor) UpdateApplicationCallCount() int {
+	fake.updateApplicationMutex.RLock()
+	defer fake.updateApplicationMutex.RUnlock()
+	return len(fake.updateApplicationArgsForCall)
+}
+
+func (fake *FakeChangeStackActor) UpdateApplicationArgsForCall(i int) v2action.Application {
+	fake.updateApplicationMutex.RLock()
+	defer fake.updateApplicationMutex.RUnlock()
+	return fake.updateApplicationArgsForCall[i].application
+}
+
+func (fake *FakeChangeStackActor) UpdateApplicationReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.UpdateApplicationStub = nil
+	fake.updateApplicationReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeChangeStackActor) UpdateApplicationReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.UpdateApplicationStub = nil
+	if fake.updateApplicationReturnsOnCall == nil {
+		fake.updateApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.updateApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeChangeStackActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	fake.getStackMutex.RLock()
+	defer fake.getStackMutex.RUnlock()
+	fake.getStackByNameMutex.RLock()
+	defer fake.getStackByNameMutex.RUnlock()
+	fake.startApplicationMutex.RLock()
+	defer fake.startApplicationMutex.RUnlock()
+	fake.updateApplicationMutex.RLock()
+	defer fake.updateApplicationMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeChangeStackActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.ChangeStackActor = new(FakeChangeStackActor)
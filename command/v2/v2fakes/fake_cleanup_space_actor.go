@@ -0,0 +1,590 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeCleanupSpaceActor struct {
+	DeleteApplicationStub        func(guid string) (v2action.Warnings, error)
+	deleteApplicationMutex       sync.RWMutex
+	deleteApplicationArgsForCall []struct {
+		guid string
+	}
+	deleteApplicationReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	deleteApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	DeleteRouteStub        func(routeGUID string) (v2action.Warnings, error)
+	deleteRouteMutex       sync.RWMutex
+	deleteRouteArgsForCall []struct {
+		routeGUID string
+	}
+	deleteRouteReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	deleteRouteReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	DeleteServiceInstanceStub        func(serviceInstanceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	deleteServiceInstanceMutex       sync.RWMutex
+	deleteServiceInstanceArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	deleteServiceInstanceReturns struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	deleteServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	DeleteServiceKeyStub        func(serviceKeyGUID string) (v2action.Warnings, error)
+	deleteServiceKeyMutex       sync.RWMutex
+	deleteServiceKeyArgsForCall []struct {
+		serviceKeyGUID string
+	}
+	deleteServiceKeyReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	deleteServiceKeyReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	GetOrphanedRoutesBySpaceStub        func(spaceGUID string) ([]v2action.Route, v2action.Warnings, error)
+	getOrphanedRoutesBySpaceMutex       sync.RWMutex
+	getOrphanedRoutesBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getOrphanedRoutesBySpaceReturns struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrphanedRoutesBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetServiceKeysByServiceInstanceStub        func(serviceInstanceGUID string) ([]v2action.ServiceKey, v2action.Warnings, error)
+	getServiceKeysByServiceInstanceMutex       sync.RWMutex
+	getServiceKeysByServiceInstanceArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	getServiceKeysByServiceInstanceReturns struct {
+		result1 []v2action.ServiceKey
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceKeysByServiceInstanceReturnsOnCall map[int]struct {
+		result1 []v2action.ServiceKey
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetStoppedApplicationsBySpaceStub        func(spaceGUID string, notUpdatedSince time.Time) ([]v2action.Application, v2action.Warnings, error)
+	getStoppedApplicationsBySpaceMutex       sync.RWMutex
+	getStoppedApplicationsBySpaceArgsForCall []struct {
+		spaceGUID       string
+		notUpdatedSince time.Time
+	}
+	getStoppedApplicationsBySpaceReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getStoppedApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetUnboundServiceInstancesBySpaceStub        func(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+	getUnboundServiceInstancesBySpaceMutex       sync.RWMutex
+	getUnboundServiceInstancesBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getUnboundServiceInstancesBySpaceReturns struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	getUnboundServiceInstancesBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteApplication(guid string) (v2action.Warnings, error) {
+	fake.deleteApplicationMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationReturnsOnCall[len(fake.deleteApplicationArgsForCall)]
+	fake.deleteApplicationArgsForCall = append(fake.deleteApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("DeleteApplication", []interface{}{guid})
+	fake.deleteApplicationMutex.Unlock()
+	if fake.DeleteApplicationStub != nil {
+		return fake.DeleteApplicationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteApplicationReturns.result1, fake.deleteApplicationReturns.result2
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteApplicationCallCount() int {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return len(fake.deleteApplicationArgsForCall)
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteApplicationArgsForCall(i int) string {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return fake.deleteApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteApplicationReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	fake.deleteApplicationReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	if fake.deleteApplicationReturnsOnCall == nil {
+		fake.deleteApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteRoute(routeGUID string) (v2action.Warnings, error) {
+	fake.deleteRouteMutex.Lock()
+	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
+	fake.deleteRouteArgsForCall = append(fake.deleteRouteArgsForCall, struct {
+		routeGUID string
+	}{routeGUID})
+	fake.recordInvocation("DeleteRoute", []interface{}{routeGUID})
+	fake.deleteRouteMutex.Unlock()
+	if fake.DeleteRouteStub != nil {
+		return fake.DeleteRouteStub(routeGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteRouteReturns.result1, fake.deleteRouteReturns.result2
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteRouteCallCount() int {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	return len(fake.deleteRouteArgsForCall)
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteRouteArgsForCall(i int) string {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	return fake.deleteRouteArgsForCall[i].routeGUID
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteRouteReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteRouteStub = nil
+	fake.deleteRouteReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteRouteReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DeleteRouteStub = nil
+	if fake.deleteRouteReturnsOnCall == nil {
+		fake.deleteRouteReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteRouteReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteServiceInstance(serviceInstanceGUID string) (v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.deleteServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteServiceInstanceReturnsOnCall[len(fake.deleteServiceInstanceArgsForCall)]
+	fake.deleteServiceInstanceArgsForCall = append(fake.deleteServiceInstanceArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("DeleteServiceInstance", []interface{}{serviceInstanceGUID})
+	fake.deleteServiceInstanceMutex.Unlock()
+	if fake.DeleteServiceInstanceStub != nil {
+		return fake.DeleteServiceInstanceStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteServiceInstanceReturns.result1, fake.deleteServiceInstanceReturns.result2, fake.deleteServiceInstanceReturns.result3
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteServiceInstanceCallCount() int {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return len(fake.deleteServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteServiceInstanceArgsForCall(i int) string {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return fake.deleteServiceInstanceArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteServiceInstanceReturns(result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.DeleteServiceInstanceStub = nil
+	fake.deleteServiceInstanceReturns = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteServiceInstanceReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.DeleteServiceInstanceStub = nil
+	if fake.deleteServiceInstanceReturnsOnCall == nil {
+		fake.deleteServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.deleteServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteServiceKey(serviceKeyGUID string) (v2action.Warnings, error) {
+	fake.deleteServiceKeyMutex.Lock()
+	ret, specificReturn := fake.deleteServiceKeyReturnsOnCall[len(fake.deleteServiceKeyArgsForCall)]
+	fake.deleteServiceKeyArgsForCall = append(fake.deleteServiceKeyArgsForCall, struct {
+		serviceKeyGUID string
+	}{serviceKeyGUID})
+	fake.recordInvocation("DeleteServiceKey", []interface{}{serviceKeyGUID})
+	fake.deleteServiceKeyMutex.Unlock()
+	if fake.DeleteServiceKeyStub != nil {
+		return fake.DeleteServiceKeyStub(serviceKeyGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteServiceKeyReturns.result1, fake.deleteServiceKeyReturns.result2
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteServiceKeyCallCount() int {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return len(fake.deleteServiceKeyArgsForCall)
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteServiceKeyArgsForCall(i int) string {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return fake.deleteServiceKeyArgsForCall[i].serviceKeyGUID
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteServiceKeyReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteServiceKeyStub = nil
+	fake.deleteServiceKeyReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCleanupSpaceActor) DeleteServiceKeyReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DeleteServiceKeyStub = nil
+	if fake.deleteServiceKeyReturnsOnCall == nil {
+		fake.deleteServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceKeyReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCleanupSpaceActor) GetOrphanedRoutesBySpace(spaceGUID string) ([]v2action.Route, v2action.Warnings, error) {
+	fake.getOrphanedRoutesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getOrphanedRoutesBySpaceReturnsOnCall[len(fake.getOrphanedRoutesBySpaceArgsForCall)]
+	fake.getOrphanedRoutesBySpaceArgsForCall = append(fake.getOrphanedRoutesBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetOrphanedRoutesBySpace", []interface{}{spaceGUID})
+	fake.getOrphanedRoutesBySpaceMutex.Unlock()
+	if fake.GetOrphanedRoutesBySpaceStub != nil {
+		return fake.GetOrphanedRoutesBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrphanedRoutesBySpaceReturns.result1, fake.getOrphanedRoutesBySpaceReturns.result2, fake.getOrphanedRoutesBySpaceReturns.result3
+}
+
+func (fake *FakeCleanupSpaceActor) GetOrphanedRoutesBySpaceCallCount() int {
+	fake.getOrphanedRoutesBySpaceMutex.RLock()
+	defer fake.getOrphanedRoutesBySpaceMutex.RUnlock()
+	return len(fake.getOrphanedRoutesBySpaceArgsForCall)
+}
+
+func (fake *FakeCleanupSpaceActor) GetOrphanedRoutesBySpaceArgsForCall(i int) string {
+	fake.getOrphanedRoutesBySpaceMutex.RLock()
+	defer fake.getOrphanedRoutesBySpaceMutex.RUnlock()
+	return fake.getOrphanedRoutesBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCleanupSpaceActor) GetOrphanedRoutesBySpaceReturns(result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetOrphanedRoutesBySpaceStub = nil
+	fake.getOrphanedRoutesBySpaceReturns = struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCleanupSpaceActor) GetOrphanedRoutesBySpaceReturnsOnCall(i int, result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetOrphanedRoutesBySpaceStub = nil
+	if fake.getOrphanedRoutesBySpaceReturnsOnCall == nil {
+		fake.getOrphanedRoutesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Route
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrphanedRoutesBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCleanupSpaceActor) GetServiceKeysByServiceInstance(serviceInstanceGUID string) ([]v2action.ServiceKey, v2action.Warnings, error) {
+	fake.getServiceKeysByServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.getServiceKeysByServiceInstanceReturnsOnCall[len(fake.getServiceKeysByServiceInstanceArgsForCall)]
+	fake.getServiceKeysByServiceInstanceArgsForCall = append(fake.getServiceKeysByServiceInstanceArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("GetServiceKeysByServiceInstance", []interface{}{serviceInstanceGUID})
+	fake.getServiceKeysByServiceInstanceMutex.Unlock()
+	if fake.GetServiceKeysByServiceInstanceStub != nil {
+		return fake.GetServiceKeysByServiceInstanceStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceKeysByServiceInstanceReturns.result1, fake.getServiceKeysByServiceInstanceReturns.result2, fake.getServiceKeysByServiceInstanceReturns.result3
+}
+
+func (fake *FakeCleanupSpaceActor) GetServiceKeysByServiceInstanceCallCount() int {
+	fake.getServiceKeysByServiceInstanceMutex.RLock()
+	defer fake.getServiceKeysByServiceInstanceMutex.RUnlock()
+	return len(fake.getServiceKeysByServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCleanupSpaceActor) GetServiceKeysByServiceInstanceArgsForCall(i int) string {
+	fake.getServiceKeysByServiceInstanceMutex.RLock()
+	defer fake.getServiceKeysByServiceInstanceMutex.RUnlock()
+	return fake.getServiceKeysByServiceInstanceArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCleanupSpaceActor) GetServiceKeysByServiceInstanceReturns(result1 []v2action.ServiceKey, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceKeysByServiceInstanceStub = nil
+	fake.getServiceKeysByServiceInstanceReturns = struct {
+		result1 []v2action.ServiceKey
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCleanupSpaceActor) GetServiceKeysByServiceInstanceReturnsOnCall(i int, result1 []v2action.ServiceKey, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceKeysByServiceInstanceStub = nil
+	if fake.getServiceKeysByServiceInstanceReturnsOnCall == nil {
+		fake.getServiceKeysByServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ServiceKey
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeysByServiceInstanceReturnsOnCall[i] = struct {
+		result1 []v2action.ServiceKey
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCleanupSpaceActor) GetStoppedApplicationsBySpace(spaceGUID string, notUpdatedSince time.Time) ([]v2action.Application, v2action.Warnings, error) {
+	fake.getStoppedApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getStoppedApplicationsBySpaceReturnsOnCall[len(fake.getStoppedApplicationsBySpaceArgsForCall)]
+	fake.getStoppedApplicationsBySpaceArgsForCall = append(fake.getStoppedApplicationsBySpaceArgsForCall, struct {
+		spaceGUID       string
+		notUpdatedSince time.Time
+	}{spaceGUID, notUpdatedSince})
+	fake.recordInvocation("GetStoppedApplicationsBySpace", []interface{}{spaceGUID, notUpdatedSince})
+	fake.getStoppedApplicationsBySpaceMutex.Unlock()
+	if fake.GetStoppedApplicationsBySpaceStub != nil {
+		return fake.GetStoppedApplicationsBySpaceStub(spaceGUID, notUpdatedSince)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStoppedApplicationsBySpaceReturns.result1, fake.getStoppedApplicationsBySpaceReturns.result2, fake.getStoppedApplicationsBySpaceReturns.result3
+}
+
+func (fake *FakeCleanupSpaceActor) GetStoppedApplicationsBySpaceCallCount() int {
+	fake.getStoppedApplicationsBySpaceMutex.RLock()
+	defer fake.getStoppedApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getStoppedApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeCleanupSpaceActor) GetStoppedApplicationsBySpaceArgsForCall(i int) (string, time.Time) {
+	fake.getStoppedApplicationsBySpaceMutex.RLock()
+	defer fake.getStoppedApplicationsBySpaceMutex.RUnlock()
+	return fake.getStoppedApplicationsBySpaceArgsForCall[i].spaceGUID, fake.getStoppedApplicationsBySpaceArgsForCall[i].notUpdatedSince
+}
+
+func (fake *FakeCleanupSpaceActor) GetStoppedApplicationsBySpaceReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetStoppedApplicationsBySpaceStub = nil
+	fake.getStoppedApplicationsBySpaceReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCleanupSpaceActor) GetStoppedApplicationsBySpaceReturnsOnCall(i int, result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetStoppedApplicationsBySpaceStub = nil
+	if fake.getStoppedApplicationsBySpaceReturnsOnCall == nil {
+		fake.getStoppedApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getStoppedApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCleanupSpaceActor) GetUnboundServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.getUnboundServiceInstancesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getUnboundServiceInstancesBySpaceReturnsOnCall[len(fake.getUnboundServiceInstancesBySpaceArgsForCall)]
+	fake.getUnboundServiceInstancesBySpaceArgsForCall = append(fake.getUnboundServiceInstancesBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetUnboundServiceInstancesBySpace", []interface{}{spaceGUID})
+	fake.getUnboundServiceInstancesBySpaceMutex.Unlock()
+	if fake.GetUnboundServiceInstancesBySpaceStub != nil {
+		return fake.GetUnboundServiceInstancesBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getUnboundServiceInstancesBySpaceReturns.result1, fake.getUnboundServiceInstancesBySpaceReturns.result2, fake.getUnboundServiceInstancesBySpaceReturns.result3
+}
+
+func (fake *FakeCleanupSpaceActor) GetUnboundServiceInstancesBySpaceCallCount() int {
+	fake.getUnboundServiceInstancesBySpaceMutex.RLock()
+	defer fake.getUnboundServiceInstancesBySpaceMutex.RUnlock()
+	return len(fake.getUnboundServiceInstancesBySpaceArgsForCall)
+}
+
+func (fake *FakeCleanupSpaceActor) GetUnboundServiceInstancesBySpaceArgsForCall(i int) string {
+	fake.getUnboundServiceInstancesBySpaceMutex.RLock()
+	defer fake.getUnboundServiceInstancesBySpaceMutex.RUnlock()
+	return fake.getUnboundServiceInstancesBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCleanupSpaceActor) GetUnboundServiceInstancesBySpaceReturns(result1 []v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetUnboundServiceInstancesBySpaceStub = nil
+	fake.getUnboundServiceInstancesBySpaceReturns = struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCleanupSpaceActor) GetUnboundServiceInstancesBySpaceReturnsOnCall(i int, result1 []v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetUnboundServiceInstancesBySpaceStub = nil
+	if fake.getUnboundServiceInstancesBySpaceReturnsOnCall == nil {
+		fake.getUnboundServiceInstancesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getUnboundServiceInstancesBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCleanupSpaceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	fake.getOrphanedRoutesBySpaceMutex.RLock()
+	defer fake.getOrphanedRoutesBySpaceMutex.RUnlock()
+	fake.getServiceKeysByServiceInstanceMutex.RLock()
+	defer fake.getServiceKeysByServiceInstanceMutex.RUnlock()
+	fake.getStoppedApplicationsBySpaceMutex.RLock()
+	defer fake.getStoppedApplicationsBySpaceMutex.RUnlock()
+	fake.getUnboundServiceInstancesBySpaceMutex.RLock()
+	defer fake.getUnboundServiceInstancesBySpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCleanupSpaceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.CleanupSpaceActor = new(FakeCleanupSpaceActor)
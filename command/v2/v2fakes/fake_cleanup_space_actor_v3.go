@@ -0,0 +1,171 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeCleanupSpaceActorV3 struct {
+	DeletePackageStub        func(guid string) (v3action.Warnings, error)
+	deletePackageMutex       sync.RWMutex
+	deletePackageArgsForCall []struct {
+		guid string
+	}
+	deletePackageReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	deletePackageReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	GetFailedOrExpiredPackagesBySpaceStub        func(spaceGUID string) ([]v3action.ApplicationPackage, v3action.Warnings, error)
+	getFailedOrExpiredPackagesBySpaceMutex       sync.RWMutex
+	getFailedOrExpiredPackagesBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getFailedOrExpiredPackagesBySpaceReturns struct {
+		result1 []v3action.ApplicationPackage
+		result2 v3action.Warnings
+		result3 error
+	}
+	getFailedOrExpiredPackagesBySpaceReturnsOnCall map[int]struct {
+		result1 []v3action.ApplicationPackage
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCleanupSpaceActorV3) DeletePackage(guid string) (v3action.Warnings, error) {
+	fake.deletePackageMutex.Lock()
+	ret, specificReturn := fake.deletePackageReturnsOnCall[len(fake.deletePackageArgsForCall)]
+	fake.deletePackageArgsForCall = append(fake.deletePackageArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("DeletePackage", []interface{}{guid})
+	fake.deletePackageMutex.Unlock()
+	if fake.DeletePackageStub != nil {
+		return fake.DeletePackageStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deletePackageReturns.result1, fake.deletePackageReturns.result2
+}
+
+func (fake *FakeCleanupSpaceActorV3) DeletePackageCallCount() int {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return len(fake.deletePackageArgsForCall)
+}
+
+func (fake *FakeCleanupSpaceActorV3) DeletePackageArgsForCall(i int) string {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return fake.deletePackageArgsForCall[i].guid
+}
+
+func (fake *FakeCleanupSpaceActorV3) DeletePackageReturns(result1 v3action.Warnings, result2 error) {
+	fake.DeletePackageStub = nil
+	fake.deletePackageReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCleanupSpaceActorV3) DeletePackageReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.DeletePackageStub = nil
+	if fake.deletePackageReturnsOnCall == nil {
+		fake.deletePackageReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.deletePackageReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCleanupSpaceActorV3) GetFailedOrExpiredPackagesBySpace(spaceGUID string) ([]v3action.ApplicationPackage, v3action.Warnings, error) {
+	fake.getFailedOrExpiredPackagesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getFailedOrExpiredPackagesBySpaceReturnsOnCall[len(fake.getFailedOrExpiredPackagesBySpaceArgsForCall)]
+	fake.getFailedOrExpiredPackagesBySpaceArgsForCall = append(fake.getFailedOrExpiredPackagesBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetFailedOrExpiredPackagesBySpace", []interface{}{spaceGUID})
+	fake.getFailedOrExpiredPackagesBySpaceMutex.Unlock()
+	if fake.GetFailedOrExpiredPackagesBySpaceStub != nil {
+		return fake.GetFailedOrExpiredPackagesBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getFailedOrExpiredPackagesBySpaceReturns.result1, fake.getFailedOrExpiredPackagesBySpaceReturns.result2, fake.getFailedOrExpiredPackagesBySpaceReturns.result3
+}
+
+func (fake *FakeCleanupSpaceActorV3) GetFailedOrExpiredPackagesBySpaceCallCount() int {
+	fake.getFailedOrExpiredPackagesBySpaceMutex.RLock()
+	defer fake.getFailedOrExpiredPackagesBySpaceMutex.RUnlock()
+	return len(fake.getFailedOrExpiredPackagesBySpaceArgsForCall)
+}
+
+func (fake *FakeCleanupSpaceActorV3) GetFailedOrExpiredPackagesBySpaceArgsForCall(i int) string {
+	fake.getFailedOrExpiredPackagesBySpaceMutex.RLock()
+	defer fake.getFailedOrExpiredPackagesBySpaceMutex.RUnlock()
+	return fake.getFailedOrExpiredPackagesBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCleanupSpaceActorV3) GetFailedOrExpiredPackagesBySpaceReturns(result1 []v3action.ApplicationPackage, result2 v3action.Warnings, result3 error) {
+	fake.GetFailedOrExpiredPackagesBySpaceStub = nil
+	fake.getFailedOrExpiredPackagesBySpaceReturns = struct {
+		result1 []v3action.ApplicationPackage
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCleanupSpaceActorV3) GetFailedOrExpiredPackagesBySpaceReturnsOnCall(i int, result1 []v3action.ApplicationPackage, result2 v3action.Warnings, result3 error) {
+	fake.GetFailedOrExpiredPackagesBySpaceStub = nil
+	if fake.getFailedOrExpiredPackagesBySpaceReturnsOnCall == nil {
+		fake.getFailedOrExpiredPackagesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v3action.ApplicationPackage
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getFailedOrExpiredPackagesBySpaceReturnsOnCall[i] = struct {
+		result1 []v3action.ApplicationPackage
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCleanupSpaceActorV3) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	fake.getFailedOrExpiredPackagesBySpaceMutex.RLock()
+	defer fake.getFailedOrExpiredPackagesBySpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCleanupSpaceActorV3) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.CleanupSpaceActorV3 = new(FakeCleanupSpaceActorV3)
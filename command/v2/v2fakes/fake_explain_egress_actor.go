@@ -0,0 +1,185 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"net"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeExplainEgressActor struct {
+	GetSpaceByOrganizationAndNameStub        func(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	getSpaceByOrganizationAndNameMutex       sync.RWMutex
+	getSpaceByOrganizationAndNameArgsForCall []struct {
+		orgGUID   string
+		spaceName string
+	}
+	getSpaceByOrganizationAndNameReturns struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceByOrganizationAndNameReturnsOnCall map[int]struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	ExplainEgressStub        func(spaceGUID string, protocol string, ip net.IP, port int) (v2action.EgressExplanation, v2action.Warnings, error)
+	explainEgressMutex       sync.RWMutex
+	explainEgressArgsForCall []struct {
+		spaceGUID string
+		protocol  string
+		ip        net.IP
+		port      int
+	}
+	explainEgressReturns struct {
+		result1 v2action.EgressExplanation
+		result2 v2action.Warnings
+		result3 error
+	}
+	explainEgressReturnsOnCall map[int]struct {
+		result1 v2action.EgressExplanation
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeExplainEgressActor) GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error) {
+	fake.getSpaceByOrganizationAndNameMutex.Lock()
+	ret, specificReturn := fake.getSpaceByOrganizationAndNameReturnsOnCall[len(fake.getSpaceByOrganizationAndNameArgsForCall)]
+	fake.getSpaceByOrganizationAndNameArgsForCall = append(fake.getSpaceByOrganizationAndNameArgsForCall, struct {
+		orgGUID   string
+		spaceName string
+	}{orgGUID, spaceName})
+	fake.recordInvocation("GetSpaceByOrganizationAndName", []interface{}{orgGUID, spaceName})
+	fake.getSpaceByOrganizationAndNameMutex.Unlock()
+	if fake.GetSpaceByOrganizationAndNameStub != nil {
+		return fake.GetSpaceByOrganizationAndNameStub(orgGUID, spaceName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceByOrganizationAndNameReturns.result1, fake.getSpaceByOrganizationAndNameReturns.result2, fake.getSpaceByOrganizationAndNameReturns.result3
+}
+
+func (fake *FakeExplainEgressActor) GetSpaceByOrganizationAndNameCallCount() int {
+	fake.getSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	return len(fake.getSpaceByOrganizationAndNameArgsForCall)
+}
+
+func (fake *FakeExplainEgressActor) GetSpaceByOrganizationAndNameArgsForCall(i int) (string, string) {
+	fake.getSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	return fake.getSpaceByOrganizationAndNameArgsForCall[i].orgGUID, fake.getSpaceByOrganizationAndNameArgsForCall[i].spaceName
+}
+
+func (fake *FakeExplainEgressActor) GetSpaceByOrganizationAndNameReturns(result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceByOrganizationAndNameStub = nil
+	fake.getSpaceByOrganizationAndNameReturns = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExplainEgressActor) GetSpaceByOrganizationAndNameReturnsOnCall(i int, result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceByOrganizationAndNameStub = nil
+	if fake.getSpaceByOrganizationAndNameReturnsOnCall == nil {
+		fake.getSpaceByOrganizationAndNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Space
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceByOrganizationAndNameReturnsOnCall[i] = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExplainEgressActor) ExplainEgress(spaceGUID string, protocol string, ip net.IP, port int) (v2action.EgressExplanation, v2action.Warnings, error) {
+	fake.explainEgressMutex.Lock()
+	ret, specificReturn := fake.explainEgressReturnsOnCall[len(fake.explainEgressArgsForCall)]
+	fake.explainEgressArgsForCall = append(fake.explainEgressArgsForCall, struct {
+		spaceGUID string
+		protocol  string
+		ip        net.IP
+		port      int
+	}{spaceGUID, protocol, ip, port})
+	fake.recordInvocation("ExplainEgress", []interface{}{spaceGUID, protocol, ip, port})
+	fake.explainEgressMutex.Unlock()
+	if fake.ExplainEgressStub != nil {
+		return fake.ExplainEgressStub(spaceGUID, protocol, ip, port)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.explainEgressReturns.result1, fake.explainEgressReturns.result2, fake.explainEgressReturns.result3
+}
+
+func (fake *FakeExplainEgressActor) ExplainEgressCallCount() int {
+	fake.explainEgressMutex.RLock()
+	defer fake.explainEgressMutex.RUnlock()
+	return len(fake.explainEgressArgsForCall)
+}
+
+func (fake *FakeExplainEgressActor) ExplainEgressArgsForCall(i int) (string, string, net.IP, int) {
+	fake.explainEgressMutex.RLock()
+	defer fake.explainEgressMutex.RUnlock()
+	return fake.explainEgressArgsForCall[i].spaceGUID, fake.explainEgressArgsForCall[i].protocol, fake.explainEgressArgsForCall[i].ip, fake.explainEgressArgsForCall[i].port
+}
+
+func (fake *FakeExplainEgressActor) ExplainEgressReturns(result1 v2action.EgressExplanation, result2 v2action.Warnings, result3 error) {
+	fake.ExplainEgressStub = nil
+	fake.explainEgressReturns = struct {
+		result1 v2action.EgressExplanation
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExplainEgressActor) ExplainEgressReturnsOnCall(i int, result1 v2action.EgressExplanation, result2 v2action.Warnings, result3 error) {
+	fake.ExplainEgressStub = nil
+	if fake.explainEgressReturnsOnCall == nil {
+		fake.explainEgressReturnsOnCall = make(map[int]struct {
+			result1 v2action.EgressExplanation
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.explainEgressReturnsOnCall[i] = struct {
+		result1 v2action.EgressExplanation
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExplainEgressActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	fake.explainEgressMutex.RLock()
+	defer fake.explainEgressMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeExplainEgressActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.ExplainEgressActor = new(FakeExplainEgressActor)
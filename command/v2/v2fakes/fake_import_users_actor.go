@@ -0,0 +1,109 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeImportUsersActor struct {
+	ImportUsersStub        func(rows []v2action.UserImportRow, removeUnlisted bool, dryRun bool) ([]v2action.UserImportResult, v2action.Warnings)
+	importUsersMutex       sync.RWMutex
+	importUsersArgsForCall []struct {
+		rows           []v2action.UserImportRow
+		removeUnlisted bool
+		dryRun         bool
+	}
+	importUsersReturns struct {
+		result1 []v2action.UserImportResult
+		result2 v2action.Warnings
+	}
+	importUsersReturnsOnCall map[int]struct {
+		result1 []v2action.UserImportResult
+		result2 v2action.Warnings
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeImportUsersActor) ImportUsers(rows []v2action.UserImportRow, removeUnlisted bool, dryRun bool) ([]v2action.UserImportResult, v2action.Warnings) {
+	var rowsCopy []v2action.UserImportRow
+	if rows != nil {
+		rowsCopy = make([]v2action.UserImportRow, len(rows))
+		copy(rowsCopy, rows)
+	}
+	fake.importUsersMutex.Lock()
+	ret, specificReturn := fake.importUsersReturnsOnCall[len(fake.importUsersArgsForCall)]
+	fake.importUsersArgsForCall = append(fake.importUsersArgsForCall, struct {
+		rows           []v2action.UserImportRow
+		removeUnlisted bool
+		dryRun         bool
+	}{rowsCopy, removeUnlisted, dryRun})
+	fake.recordInvocation("ImportUsers", []interface{}{rowsCopy, removeUnlisted, dryRun})
+	fake.importUsersMutex.Unlock()
+	if fake.ImportUsersStub != nil {
+		return fake.ImportUsersStub(rows, removeUnlisted, dryRun)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.importUsersReturns.result1, fake.importUsersReturns.result2
+}
+
+func (fake *FakeImportUsersActor) ImportUsersCallCount() int {
+	fake.importUsersMutex.RLock()
+	defer fake.importUsersMutex.RUnlock()
+	return len(fake.importUsersArgsForCall)
+}
+
+func (fake *FakeImportUsersActor) ImportUsersArgsForCall(i int) ([]v2action.UserImportRow, bool, bool) {
+	fake.importUsersMutex.RLock()
+	defer fake.importUsersMutex.RUnlock()
+	return fake.importUsersArgsForCall[i].rows, fake.importUsersArgsForCall[i].removeUnlisted, fake.importUsersArgsForCall[i].dryRun
+}
+
+func (fake *FakeImportUsersActor) ImportUsersReturns(result1 []v2action.UserImportResult, result2 v2action.Warnings) {
+	fake.ImportUsersStub = nil
+	fake.importUsersReturns = struct {
+		result1 []v2action.UserImportResult
+		result2 v2action.Warnings
+	}{result1, result2}
+}
+
+func (fake *FakeImportUsersActor) ImportUsersReturnsOnCall(i int, result1 []v2action.UserImportResult, result2 v2action.Warnings) {
+	fake.ImportUsersStub = nil
+	if fake.importUsersReturnsOnCall == nil {
+		fake.importUsersReturnsOnCall = make(map[int]struct {
+			result1 []v2action.UserImportResult
+			result2 v2action.Warnings
+		})
+	}
+	fake.importUsersReturnsOnCall[i] = struct {
+		result1 []v2action.UserImportResult
+		result2 v2action.Warnings
+	}{result1, result2}
+}
+
+func (fake *FakeImportUsersActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.importUsersMutex.RLock()
+	defer fake.importUsersMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeImportUsersActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.ImportUsersActor = new(FakeImportUsersActor)
@@ -0,0 +1,105 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeOrgUsageActor struct {
+	GetOrganizationUsageByNameStub        func(orgName string) (v2action.QuotaUsage, v2action.Warnings, error)
+	getOrganizationUsageByNameMutex       sync.RWMutex
+	getOrganizationUsageByNameArgsForCall []struct {
+		orgName string
+	}
+	getOrganizationUsageByNameReturns struct {
+		result1 v2action.QuotaUsage
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationUsageByNameReturnsOnCall map[int]struct {
+		result1 v2action.QuotaUsage
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOrgUsageActor) GetOrganizationUsageByName(orgName string) (v2action.QuotaUsage, v2action.Warnings, error) {
+	fake.getOrganizationUsageByNameMutex.Lock()
+	ret, specificReturn := fake.getOrganizationUsageByNameReturnsOnCall[len(fake.getOrganizationUsageByNameArgsForCall)]
+	fake.getOrganizationUsageByNameArgsForCall = append(fake.getOrganizationUsageByNameArgsForCall, struct {
+		orgName string
+	}{orgName})
+	fake.recordInvocation("GetOrganizationUsageByName", []interface{}{orgName})
+	fake.getOrganizationUsageByNameMutex.Unlock()
+	if fake.GetOrganizationUsageByNameStub != nil {
+		return fake.GetOrganizationUsageByNameStub(orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationUsageByNameReturns.result1, fake.getOrganizationUsageByNameReturns.result2, fake.getOrganizationUsageByNameReturns.result3
+}
+
+func (fake *FakeOrgUsageActor) GetOrganizationUsageByNameCallCount() int {
+	fake.getOrganizationUsageByNameMutex.RLock()
+	defer fake.getOrganizationUsageByNameMutex.RUnlock()
+	return len(fake.getOrganizationUsageByNameArgsForCall)
+}
+
+func (fake *FakeOrgUsageActor) GetOrganizationUsageByNameArgsForCall(i int) string {
+	fake.getOrganizationUsageByNameMutex.RLock()
+	defer fake.getOrganizationUsageByNameMutex.RUnlock()
+	return fake.getOrganizationUsageByNameArgsForCall[i].orgName
+}
+
+func (fake *FakeOrgUsageActor) GetOrganizationUsageByNameReturns(result1 v2action.QuotaUsage, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationUsageByNameStub = nil
+	fake.getOrganizationUsageByNameReturns = struct {
+		result1 v2action.QuotaUsage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeOrgUsageActor) GetOrganizationUsageByNameReturnsOnCall(i int, result1 v2action.QuotaUsage, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationUsageByNameStub = nil
+	if fake.getOrganizationUsageByNameReturnsOnCall == nil {
+		fake.getOrganizationUsageByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.QuotaUsage
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationUsageByNameReturnsOnCall[i] = struct {
+		result1 v2action.QuotaUsage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeOrgUsageActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getOrganizationUsageByNameMutex.RLock()
+	defer fake.getOrganizationUsageByNameMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeOrgUsageActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.OrgUsageActor = new(FakeOrgUsageActor)
@@ -0,0 +1,105 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeOrgUsageActorV3 struct {
+	GetRunningTasksByOrganizationStub        func(orgGUID string) ([]v3action.Task, v3action.Warnings, error)
+	getRunningTasksByOrganizationMutex       sync.RWMutex
+	getRunningTasksByOrganizationArgsForCall []struct {
+		orgGUID string
+	}
+	getRunningTasksByOrganizationReturns struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	getRunningTasksByOrganizationReturnsOnCall map[int]struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOrgUsageActorV3) GetRunningTasksByOrganization(orgGUID string) ([]v3action.Task, v3action.Warnings, error) {
+	fake.getRunningTasksByOrganizationMutex.Lock()
+	ret, specificReturn := fake.getRunningTasksByOrganizationReturnsOnCall[len(fake.getRunningTasksByOrganizationArgsForCall)]
+	fake.getRunningTasksByOrganizationArgsForCall = append(fake.getRunningTasksByOrganizationArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetRunningTasksByOrganization", []interface{}{orgGUID})
+	fake.getRunningTasksByOrganizationMutex.Unlock()
+	if fake.GetRunningTasksByOrganizationStub != nil {
+		return fake.GetRunningTasksByOrganizationStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRunningTasksByOrganizationReturns.result1, fake.getRunningTasksByOrganizationReturns.result2, fake.getRunningTasksByOrganizationReturns.result3
+}
+
+func (fake *FakeOrgUsageActorV3) GetRunningTasksByOrganizationCallCount() int {
+	fake.getRunningTasksByOrganizationMutex.RLock()
+	defer fake.getRunningTasksByOrganizationMutex.RUnlock()
+	return len(fake.getRunningTasksByOrganizationArgsForCall)
+}
+
+func (fake *FakeOrgUsageActorV3) GetRunningTasksByOrganizationArgsForCall(i int) string {
+	fake.getRunningTasksByOrganizationMutex.RLock()
+	defer fake.getRunningTasksByOrganizationMutex.RUnlock()
+	return fake.getRunningTasksByOrganizationArgsForCall[i].orgGUID
+}
+
+func (fake *FakeOrgUsageActorV3) GetRunningTasksByOrganizationReturns(result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetRunningTasksByOrganizationStub = nil
+	fake.getRunningTasksByOrganizationReturns = struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeOrgUsageActorV3) GetRunningTasksByOrganizationReturnsOnCall(i int, result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetRunningTasksByOrganizationStub = nil
+	if fake.getRunningTasksByOrganizationReturnsOnCall == nil {
+		fake.getRunningTasksByOrganizationReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getRunningTasksByOrganizationReturnsOnCall[i] = struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeOrgUsageActorV3) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getRunningTasksByOrganizationMutex.RLock()
+	defer fake.getRunningTasksByOrganizationMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeOrgUsageActorV3) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.OrgUsageActorV3 = new(FakeOrgUsageActorV3)
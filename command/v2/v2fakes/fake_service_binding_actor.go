@@ -0,0 +1,180 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeServiceBindingActor struct {
+	GetServiceBindingByApplicationAndServiceInstanceNamesStub        func(appName string, serviceInstanceName string, spaceGUID string) (v2action.ServiceBinding, v2action.Warnings, error)
+	getServiceBindingByApplicationAndServiceInstanceNamesMutex       sync.RWMutex
+	getServiceBindingByApplicationAndServiceInstanceNamesArgsForCall []struct {
+		appName             string
+		serviceInstanceName string
+		spaceGUID           string
+	}
+	getServiceBindingByApplicationAndServiceInstanceNamesReturns struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceBindingByApplicationAndServiceInstanceNamesReturnsOnCall map[int]struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetServiceBindingParametersStub        func(serviceBindingGUID string) (map[string]interface{}, v2action.Warnings, error)
+	getServiceBindingParametersMutex       sync.RWMutex
+	getServiceBindingParametersArgsForCall []struct {
+		serviceBindingGUID string
+	}
+	getServiceBindingParametersReturns struct {
+		result1 map[string]interface{}
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceBindingParametersReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeServiceBindingActor) GetServiceBindingByApplicationAndServiceInstanceNames(appName string, serviceInstanceName string, spaceGUID string) (v2action.ServiceBinding, v2action.Warnings, error) {
+	fake.getServiceBindingByApplicationAndServiceInstanceNamesMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingByApplicationAndServiceInstanceNamesReturnsOnCall[len(fake.getServiceBindingByApplicationAndServiceInstanceNamesArgsForCall)]
+	fake.getServiceBindingByApplicationAndServiceInstanceNamesArgsForCall = append(fake.getServiceBindingByApplicationAndServiceInstanceNamesArgsForCall, struct {
+		appName             string
+		serviceInstanceName string
+		spaceGUID           string
+	}{appName, serviceInstanceName, spaceGUID})
+	fake.recordInvocation("GetServiceBindingByApplicationAndServiceInstanceNames", []interface{}{appName, serviceInstanceName, spaceGUID})
+	fake.getServiceBindingByApplicationAndServiceInstanceNamesMutex.Unlock()
+	if fake.GetServiceBindingByApplicationAndServiceInstanceNamesStub != nil {
+		return fake.GetServiceBindingByApplicationAndServiceInstanceNamesStub(appName, serviceInstanceName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBindingByApplicationAndServiceInstanceNamesReturns.result1, fake.getServiceBindingByApplicationAndServiceInstanceNamesReturns.result2, fake.getServiceBindingByApplicationAndServiceInstanceNamesReturns.result3
+}
+
+func (fake *FakeServiceBindingActor) GetServiceBindingByApplicationAndServiceInstanceNamesCallCount() int {
+	fake.getServiceBindingByApplicationAndServiceInstanceNamesMutex.RLock()
+	defer fake.getServiceBindingByApplicationAndServiceInstanceNamesMutex.RUnlock()
+	return len(fake.getServiceBindingByApplicationAndServiceInstanceNamesArgsForCall)
+}
+
+func (fake *FakeServiceBindingActor) GetServiceBindingByApplicationAndServiceInstanceNamesArgsForCall(i int) (string, string, string) {
+	fake.getServiceBindingByApplicationAndServiceInstanceNamesMutex.RLock()
+	defer fake.getServiceBindingByApplicationAndServiceInstanceNamesMutex.RUnlock()
+	return fake.getServiceBindingByApplicationAndServiceInstanceNamesArgsForCall[i].appName, fake.getServiceBindingByApplicationAndServiceInstanceNamesArgsForCall[i].serviceInstanceName, fake.getServiceBindingByApplicationAndServiceInstanceNamesArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeServiceBindingActor) GetServiceBindingByApplicationAndServiceInstanceNamesReturns(result1 v2action.ServiceBinding, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceBindingByApplicationAndServiceInstanceNamesStub = nil
+	fake.getServiceBindingByApplicationAndServiceInstanceNamesReturns = struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceBindingActor) GetServiceBindingByApplicationAndServiceInstanceNamesReturnsOnCall(i int, result1 v2action.ServiceBinding, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceBindingByApplicationAndServiceInstanceNamesStub = nil
+	if fake.getServiceBindingByApplicationAndServiceInstanceNamesReturnsOnCall == nil {
+		fake.getServiceBindingByApplicationAndServiceInstanceNamesReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceBinding
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingByApplicationAndServiceInstanceNamesReturnsOnCall[i] = struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceBindingActor) GetServiceBindingParameters(serviceBindingGUID string) (map[string]interface{}, v2action.Warnings, error) {
+	fake.getServiceBindingParametersMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingParametersReturnsOnCall[len(fake.getServiceBindingParametersArgsForCall)]
+	fake.getServiceBindingParametersArgsForCall = append(fake.getServiceBindingParametersArgsForCall, struct {
+		serviceBindingGUID string
+	}{serviceBindingGUID})
+	fake.recordInvocation("GetServiceBindingParameters", []interface{}{serviceBindingGUID})
+	fake.getServiceBindingParametersMutex.Unlock()
+	if fake.GetServiceBindingParametersStub != nil {
+		return fake.GetServiceBindingParametersStub(serviceBindingGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBindingParametersReturns.result1, fake.getServiceBindingParametersReturns.result2, fake.getServiceBindingParametersReturns.result3
+}
+
+func (fake *FakeServiceBindingActor) GetServiceBindingParametersCallCount() int {
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
+	return len(fake.getServiceBindingParametersArgsForCall)
+}
+
+func (fake *FakeServiceBindingActor) GetServiceBindingParametersArgsForCall(i int) string {
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
+	return fake.getServiceBindingParametersArgsForCall[i].serviceBindingGUID
+}
+
+func (fake *FakeServiceBindingActor) GetServiceBindingParametersReturns(result1 map[string]interface{}, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceBindingParametersStub = nil
+	fake.getServiceBindingParametersReturns = struct {
+		result1 map[string]interface{}
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceBindingActor) GetServiceBindingParametersReturnsOnCall(i int, result1 map[string]interface{}, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceBindingParametersStub = nil
+	if fake.getServiceBindingParametersReturnsOnCall == nil {
+		fake.getServiceBindingParametersReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingParametersReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceBindingActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getServiceBindingByApplicationAndServiceInstanceNamesMutex.RLock()
+	defer fake.getServiceBindingByApplicationAndServiceInstanceNamesMutex.RUnlock()
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeServiceBindingActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.ServiceBindingActor = new(FakeServiceBindingActor)
@@ -0,0 +1,107 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeSpaceUsageActor struct {
+	GetSpaceUsageByOrganizationAndNameStub        func(orgGUID string, spaceName string) (v2action.QuotaUsage, v2action.Warnings, error)
+	getSpaceUsageByOrganizationAndNameMutex       sync.RWMutex
+	getSpaceUsageByOrganizationAndNameArgsForCall []struct {
+		orgGUID   string
+		spaceName string
+	}
+	getSpaceUsageByOrganizationAndNameReturns struct {
+		result1 v2action.QuotaUsage
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceUsageByOrganizationAndNameReturnsOnCall map[int]struct {
+		result1 v2action.QuotaUsage
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSpaceUsageActor) GetSpaceUsageByOrganizationAndName(orgGUID string, spaceName string) (v2action.QuotaUsage, v2action.Warnings, error) {
+	fake.getSpaceUsageByOrganizationAndNameMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsageByOrganizationAndNameReturnsOnCall[len(fake.getSpaceUsageByOrganizationAndNameArgsForCall)]
+	fake.getSpaceUsageByOrganizationAndNameArgsForCall = append(fake.getSpaceUsageByOrganizationAndNameArgsForCall, struct {
+		orgGUID   string
+		spaceName string
+	}{orgGUID, spaceName})
+	fake.recordInvocation("GetSpaceUsageByOrganizationAndName", []interface{}{orgGUID, spaceName})
+	fake.getSpaceUsageByOrganizationAndNameMutex.Unlock()
+	if fake.GetSpaceUsageByOrganizationAndNameStub != nil {
+		return fake.GetSpaceUsageByOrganizationAndNameStub(orgGUID, spaceName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceUsageByOrganizationAndNameReturns.result1, fake.getSpaceUsageByOrganizationAndNameReturns.result2, fake.getSpaceUsageByOrganizationAndNameReturns.result3
+}
+
+func (fake *FakeSpaceUsageActor) GetSpaceUsageByOrganizationAndNameCallCount() int {
+	fake.getSpaceUsageByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceUsageByOrganizationAndNameMutex.RUnlock()
+	return len(fake.getSpaceUsageByOrganizationAndNameArgsForCall)
+}
+
+func (fake *FakeSpaceUsageActor) GetSpaceUsageByOrganizationAndNameArgsForCall(i int) (string, string) {
+	fake.getSpaceUsageByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceUsageByOrganizationAndNameMutex.RUnlock()
+	return fake.getSpaceUsageByOrganizationAndNameArgsForCall[i].orgGUID, fake.getSpaceUsageByOrganizationAndNameArgsForCall[i].spaceName
+}
+
+func (fake *FakeSpaceUsageActor) GetSpaceUsageByOrganizationAndNameReturns(result1 v2action.QuotaUsage, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceUsageByOrganizationAndNameStub = nil
+	fake.getSpaceUsageByOrganizationAndNameReturns = struct {
+		result1 v2action.QuotaUsage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSpaceUsageActor) GetSpaceUsageByOrganizationAndNameReturnsOnCall(i int, result1 v2action.QuotaUsage, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceUsageByOrganizationAndNameStub = nil
+	if fake.getSpaceUsageByOrganizationAndNameReturnsOnCall == nil {
+		fake.getSpaceUsageByOrganizationAndNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.QuotaUsage
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceUsageByOrganizationAndNameReturnsOnCall[i] = struct {
+		result1 v2action.QuotaUsage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSpaceUsageActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getSpaceUsageByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceUsageByOrganizationAndNameMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSpaceUsageActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.SpaceUsageActor = new(FakeSpaceUsageActor)
@@ -0,0 +1,105 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeSpaceUsageActorV3 struct {
+	GetRunningTasksBySpaceStub        func(spaceGUID string) ([]v3action.Task, v3action.Warnings, error)
+	getRunningTasksBySpaceMutex       sync.RWMutex
+	getRunningTasksBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getRunningTasksBySpaceReturns struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	getRunningTasksBySpaceReturnsOnCall map[int]struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSpaceUsageActorV3) GetRunningTasksBySpace(spaceGUID string) ([]v3action.Task, v3action.Warnings, error) {
+	fake.getRunningTasksBySpaceMutex.Lock()
+	ret, specificReturn := fake.getRunningTasksBySpaceReturnsOnCall[len(fake.getRunningTasksBySpaceArgsForCall)]
+	fake.getRunningTasksBySpaceArgsForCall = append(fake.getRunningTasksBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetRunningTasksBySpace", []interface{}{spaceGUID})
+	fake.getRunningTasksBySpaceMutex.Unlock()
+	if fake.GetRunningTasksBySpaceStub != nil {
+		return fake.GetRunningTasksBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRunningTasksBySpaceReturns.result1, fake.getRunningTasksBySpaceReturns.result2, fake.getRunningTasksBySpaceReturns.result3
+}
+
+func (fake *FakeSpaceUsageActorV3) GetRunningTasksBySpaceCallCount() int {
+	fake.getRunningTasksBySpaceMutex.RLock()
+	defer fake.getRunningTasksBySpaceMutex.RUnlock()
+	return len(fake.getRunningTasksBySpaceArgsForCall)
+}
+
+func (fake *FakeSpaceUsageActorV3) GetRunningTasksBySpaceArgsForCall(i int) string {
+	fake.getRunningTasksBySpaceMutex.RLock()
+	defer fake.getRunningTasksBySpaceMutex.RUnlock()
+	return fake.getRunningTasksBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeSpaceUsageActorV3) GetRunningTasksBySpaceReturns(result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetRunningTasksBySpaceStub = nil
+	fake.getRunningTasksBySpaceReturns = struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSpaceUsageActorV3) GetRunningTasksBySpaceReturnsOnCall(i int, result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetRunningTasksBySpaceStub = nil
+	if fake.getRunningTasksBySpaceReturnsOnCall == nil {
+		fake.getRunningTasksBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getRunningTasksBySpaceReturnsOnCall[i] = struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSpaceUsageActorV3) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getRunningTasksBySpaceMutex.RLock()
+	defer fake.getRunningTasksBySpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSpaceUsageActorV3) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.SpaceUsageActorV3 = new(FakeSpaceUsageActorV3)
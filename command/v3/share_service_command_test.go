@@ -0,0 +1,191 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("share-service Command", func() {
+	var (
+		cmd             v3.ShareServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeShareServiceActor
+		fakeActorV2     *v3fakes.FakeShareServiceActorV2
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeShareServiceActor)
+		fakeActorV2 = new(v3fakes.FakeShareServiceActorV2)
+
+		cmd = v3.ShareServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV2:     fakeActorV2,
+		}
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+		cmd.SpaceName = "other-space"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns("3.36.0")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.35.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "3.35.0",
+				MinimumVersion: "3.36.0",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in and a space is targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+			fakeActorV2.GetServiceInstanceByNameAndSpaceReturns(
+				v2action.ServiceInstance{Name: "some-service-instance", GUID: "some-instance-guid"},
+				v2action.Warnings{"instance-warning"},
+				nil)
+			fakeActorV2.GetSpaceByOrganizationAndNameReturns(
+				v2action.Space{Name: "other-space", GUID: "other-space-guid"},
+				v2action.Warnings{"space-warning"},
+				nil)
+			fakeActor.ShareServiceInstanceToSpaceReturns(v3action.Warnings{"share-warning"}, nil)
+		})
+
+		It("shares the service instance into the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Sharing service instance some-service-instance into org some-org / space other-space as banana..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("instance-warning"))
+			Expect(testUI.Err).To(Say("space-warning"))
+			Expect(testUI.Err).To(Say("share-warning"))
+
+			name, spaceGUID := fakeActorV2.GetServiceInstanceByNameAndSpaceArgsForCall(0)
+			Expect(name).To(Equal("some-service-instance"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeActorV2.GetOrganizationByNameCallCount()).To(Equal(0))
+			orgGUID, spaceName := fakeActorV2.GetSpaceByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("other-space"))
+
+			instanceGUID, sharedSpaceGUID := fakeActor.ShareServiceInstanceToSpaceArgsForCall(0)
+			Expect(instanceGUID).To(Equal("some-instance-guid"))
+			Expect(sharedSpaceGUID).To(Equal("other-space-guid"))
+		})
+
+		Context("when an org is provided", func() {
+			BeforeEach(func() {
+				cmd.OrgName = "other-org"
+				fakeActorV2.GetOrganizationByNameReturns(
+					v2action.Organization{Name: "other-org", GUID: "other-org-guid"},
+					v2action.Warnings{"org-warning"},
+					nil)
+			})
+
+			It("looks up the space in that org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Sharing service instance some-service-instance into org other-org / space other-space as banana..."))
+				Expect(testUI.Err).To(Say("org-warning"))
+
+				Expect(fakeActorV2.GetOrganizationByNameArgsForCall(0)).To(Equal("other-org"))
+				orgGUID, _ := fakeActorV2.GetSpaceByOrganizationAndNameArgsForCall(0)
+				Expect(orgGUID).To(Equal("other-org-guid"))
+			})
+		})
+
+		Context("when the service instance cannot be found", func() {
+			BeforeEach(func() {
+				fakeActorV2.GetServiceInstanceByNameAndSpaceReturns(
+					v2action.ServiceInstance{},
+					v2action.Warnings{"instance-warning"},
+					v2action.ServiceInstanceNotFoundError{Name: "some-service-instance"})
+			})
+
+			It("returns a ServiceInstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(command.ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(testUI.Err).To(Say("instance-warning"))
+				Expect(fakeActor.ShareServiceInstanceToSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the space cannot be found", func() {
+			BeforeEach(func() {
+				fakeActorV2.GetSpaceByOrganizationAndNameReturns(
+					v2action.Space{},
+					v2action.Warnings{"space-warning"},
+					v2action.SpaceNotFoundError{Name: "other-space"})
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(sharedV2.SpaceNotFoundError{Name: "other-space"}))
+				Expect(fakeActor.ShareServiceInstanceToSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when sharing fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("share failed")
+				fakeActor.ShareServiceInstanceToSpaceReturns(v3action.Warnings{"share-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("share-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,125 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . UnshareServiceActor
+
+type UnshareServiceActor interface {
+	CloudControllerAPIVersion() string
+	UnshareServiceInstanceFromSpace(serviceInstanceGUID string, spaceGUID string) (v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . UnshareServiceActorV2
+
+type UnshareServiceActorV2 interface {
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+}
+
+type UnshareServiceCommand struct {
+	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
+	SpaceName       string               `short:"s" required:"true" description:"Space to unshare the service instance from"`
+	OrgName         string               `short:"o" description:"Org of the other space (Default: targeted org)"`
+	Force           bool                 `short:"f" description:"Force unshare without confirmation"`
+	usage           interface{}          `usage:"CF_NAME unshare-service SERVICE_INSTANCE -s OTHER_SPACE [-o OTHER_ORG] [-f]"`
+	relatedCommands interface{}          `related_commands:"delete-service, service, services, share-service, unbind-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UnshareServiceActor
+	ActorV2     UnshareServiceActorV2
+}
+
+func (cmd *UnshareServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.ActorV2 = v2action.NewActor(ccClientV2, uaaClientV2)
+
+	return nil
+}
+
+func (cmd UnshareServiceCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.36.0")
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		cmd.UI.DisplayWarning("WARNING: Unsharing this service instance will remove any service bindings that exist in the space it is unshared from. This could cause apps to stop working.")
+		cmd.UI.DisplayNewline()
+
+		unshare, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really unshare the service instance?")
+		if promptErr != nil {
+			return promptErr
+		}
+		if !unshare {
+			cmd.UI.DisplayText("Unshare cancelled")
+			return nil
+		}
+	}
+
+	orgName := cmd.OrgName
+	if orgName == "" {
+		orgName = cmd.Config.TargetedOrganization().Name
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Unsharing service instance {{.ServiceInstanceName}} from org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":             orgName,
+		"SpaceName":           cmd.SpaceName,
+		"Username":            user.Name,
+	})
+
+	serviceInstance, space, err := findServiceInstanceAndSharingSpace(cmd.ActorV2, cmd.Config, cmd.UI, cmd.RequiredArgs.ServiceInstance, cmd.OrgName, cmd.SpaceName)
+	if err != nil {
+		return sharedV2.HandleError(err)
+	}
+
+	warnings, err := cmd.Actor.UnshareServiceInstanceFromSpace(serviceInstance.GUID, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(v3action.ServiceInstanceNotSharedError); ok {
+		cmd.UI.DisplayWarning("Service instance {{.ServiceInstanceName}} is not shared with org {{.OrgName}} / space {{.SpaceName}}.", map[string]interface{}{
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			"OrgName":             orgName,
+			"SpaceName":           cmd.SpaceName,
+		})
+	} else if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unshare-service Command", func() {
+	var (
+		cmd             v3.UnshareServiceCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeUnshareServiceActor
+		fakeActorV2     *v3fakes.FakeUnshareServiceActorV2
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeUnshareServiceActor)
+		fakeActorV2 = new(v3fakes.FakeUnshareServiceActorV2)
+
+		cmd = v3.UnshareServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV2:     fakeActorV2,
+		}
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+		cmd.SpaceName = "other-space"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns("3.36.0")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the user is logged in and a space is targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+			fakeActorV2.GetServiceInstanceByNameAndSpaceReturns(
+				v2action.ServiceInstance{Name: "some-service-instance", GUID: "some-instance-guid"},
+				v2action.Warnings{"instance-warning"},
+				nil)
+			fakeActorV2.GetSpaceByOrganizationAndNameReturns(
+				v2action.Space{Name: "other-space", GUID: "other-space-guid"},
+				v2action.Warnings{"space-warning"},
+				nil)
+			fakeActor.UnshareServiceInstanceFromSpaceReturns(v3action.Warnings{"unshare-warning"}, nil)
+		})
+
+		Context("when the user confirms", func() {
+			BeforeEach(func() {
+				input.Write([]byte("y\n"))
+			})
+
+			It("unshares the service instance from the space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Err).To(Say("WARNING: Unsharing this service instance will remove any service bindings"))
+				Expect(testUI.Out).To(Say(`Really unshare the service instance\?`))
+				Expect(testUI.Out).To(Say("Unsharing service instance some-service-instance from org some-org / space other-space as banana..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("instance-warning"))
+				Expect(testUI.Err).To(Say("space-warning"))
+				Expect(testUI.Err).To(Say("unshare-warning"))
+
+				instanceGUID, spaceGUID := fakeActor.UnshareServiceInstanceFromSpaceArgsForCall(0)
+				Expect(instanceGUID).To(Equal("some-instance-guid"))
+				Expect(spaceGUID).To(Equal("other-space-guid"))
+			})
+		})
+
+		Context("when the user declines", func() {
+			BeforeEach(func() {
+				input.Write([]byte("n\n"))
+			})
+
+			It("does not unshare the service instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Unshare cancelled"))
+				Expect(fakeActorV2.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(0))
+				Expect(fakeActor.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the force flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			It("does not prompt", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).NotTo(Say("Really unshare"))
+				Expect(fakeActor.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(1))
+			})
+
+			Context("when the service instance is not shared with the space", func() {
+				BeforeEach(func() {
+					fakeActor.UnshareServiceInstanceFromSpaceReturns(
+						v3action.Warnings{"unshare-warning"},
+						v3action.ServiceInstanceNotSharedError{ServiceInstanceGUID: "some-instance-guid", SpaceGUID: "other-space-guid"})
+				})
+
+				It("warns and displays OK", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("Service instance some-service-instance is not shared with org some-org / space other-space."))
+					Expect(testUI.Out).To(Say("OK"))
+				})
+			})
+
+			Context("when unsharing fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("unshare failed")
+					fakeActor.UnshareServiceInstanceFromSpaceReturns(v3action.Warnings{"unshare-warning"}, expectedErr)
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("unshare-warning"))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,153 @@
+// This file was generated by counterfeiter
+package v3fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v3"
+)
+
+type FakeShareServiceActor struct {
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct{}
+	cloudControllerAPIVersionReturns     struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ShareServiceInstanceToSpaceStub        func(serviceInstanceGUID string, spaceGUID string) (v3action.Warnings, error)
+	shareServiceInstanceToSpaceMutex       sync.RWMutex
+	shareServiceInstanceToSpaceArgsForCall []struct {
+		serviceInstanceGUID string
+		spaceGUID           string
+	}
+	shareServiceInstanceToSpaceReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	shareServiceInstanceToSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeShareServiceActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cloudControllerAPIVersionReturns.result1
+}
+
+func (fake *FakeShareServiceActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeShareServiceActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeShareServiceActor) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeShareServiceActor) ShareServiceInstanceToSpace(serviceInstanceGUID string, spaceGUID string) (v3action.Warnings, error) {
+	fake.shareServiceInstanceToSpaceMutex.Lock()
+	ret, specificReturn := fake.shareServiceInstanceToSpaceReturnsOnCall[len(fake.shareServiceInstanceToSpaceArgsForCall)]
+	fake.shareServiceInstanceToSpaceArgsForCall = append(fake.shareServiceInstanceToSpaceArgsForCall, struct {
+		serviceInstanceGUID string
+		spaceGUID           string
+	}{serviceInstanceGUID, spaceGUID})
+	fake.recordInvocation("ShareServiceInstanceToSpace", []interface{}{serviceInstanceGUID, spaceGUID})
+	fake.shareServiceInstanceToSpaceMutex.Unlock()
+	if fake.ShareServiceInstanceToSpaceStub != nil {
+		return fake.ShareServiceInstanceToSpaceStub(serviceInstanceGUID, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.shareServiceInstanceToSpaceReturns.result1, fake.shareServiceInstanceToSpaceReturns.result2
+}
+
+func (fake *FakeShareServiceActor) ShareServiceInstanceToSpaceCallCount() int {
+	fake.shareServiceInstanceToSpaceMutex.RLock()
+	defer fake.shareServiceInstanceToSpaceMutex.RUnlock()
+	return len(fake.shareServiceInstanceToSpaceArgsForCall)
+}
+
+func (fake *FakeShareServiceActor) ShareServiceInstanceToSpaceArgsForCall(i int) (string, string) {
+	fake.shareServiceInstanceToSpaceMutex.RLock()
+	defer fake.shareServiceInstanceToSpaceMutex.RUnlock()
+	return fake.shareServiceInstanceToSpaceArgsForCall[i].serviceInstanceGUID, fake.shareServiceInstanceToSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeShareServiceActor) ShareServiceInstanceToSpaceReturns(result1 v3action.Warnings, result2 error) {
+	fake.ShareServiceInstanceToSpaceStub = nil
+	fake.shareServiceInstanceToSpaceReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeShareServiceActor) ShareServiceInstanceToSpaceReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.ShareServiceInstanceToSpaceStub = nil
+	if fake.shareServiceInstanceToSpaceReturnsOnCall == nil {
+		fake.shareServiceInstanceToSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.shareServiceInstanceToSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeShareServiceActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.shareServiceInstanceToSpaceMutex.RLock()
+	defer fake.shareServiceInstanceToSpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeShareServiceActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v3.ShareServiceActor = new(FakeShareServiceActor)
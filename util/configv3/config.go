@@ -213,6 +213,7 @@ type CFConfig struct {
 	ColorEnabled             string             `json:"ColorEnabled"`
 	Locale                   string             `json:"Locale"`
 	PluginRepositories       []PluginRepository `json:"PluginRepos"`
+	CurlTemplates            []CurlTemplate     `json:"CurlTemplates"`
 	MinCLIVersion            string             `json:"MinCLIVersion"`
 	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
 }
@@ -0,0 +1,13 @@
+package configv3
+
+// CurlTemplate is a saved 'cf curl' request. It is only used by the legacy
+// curl command, but is kept here so that writing the config does not drop it.
+type CurlTemplate struct {
+	Name     string   `json:"Name"`
+	Method   string   `json:"Method"`
+	Path     string   `json:"Path"`
+	Headers  []string `json:"Headers"`
+	Body     string   `json:"Body"`
+	AllPages bool     `json:"AllPages"`
+	Select   string   `json:"Select"`
+}
@@ -9,6 +9,10 @@ import (
 	"strings"
 )
 
+// defaultFilename is the name a download is saved under when neither the
+// response nor the URL provide one.
+const defaultFilename = "download"
+
 type Downloader interface {
 	DownloadFile(string) (int64, string, error)
 	RemoveFile() error
@@ -19,6 +23,7 @@ type downloader struct {
 	saveDir    string
 	filename   string
 	downloaded bool
+	transport  http.RoundTripper
 }
 
 func NewDownloader(saveDir string) Downloader {
@@ -28,9 +33,20 @@ func NewDownloader(saveDir string) Downloader {
 	}
 }
 
+// NewDownloaderWithTransport returns a Downloader that makes its requests
+// with the given transport, e.g. to trust additional certificates.
+func NewDownloaderWithTransport(saveDir string, transport http.RoundTripper) Downloader {
+	return &downloader{
+		saveDir:    saveDir,
+		downloaded: false,
+		transport:  transport,
+	}
+}
+
 //this func returns byte written, filename and error
 func (d *downloader) DownloadFile(url string) (int64, string, error) {
 	c := http.Client{
+		Transport: d.transport,
 		CheckRedirect: func(r *http.Request, via []*http.Request) error {
 			r.URL.Opaque = r.URL.Path
 
@@ -56,6 +72,7 @@ func (d *downloader) DownloadFile(url string) (int64, string, error) {
 		if d.filename == "" {
 			d.filename = getFilenameFromURL(url)
 		}
+		d.filename = safeFilename(d.filename)
 
 		f, err := os.Create(filepath.Join(d.saveDir, d.filename))
 		if err != nil {
@@ -83,6 +100,17 @@ func (d *downloader) RemoveFile() error {
 	return os.Remove(filepath.Join(d.saveDir, d.filename))
 }
 
+// safeFilename strips any directories from the filename so that the file is
+// always saved in the save directory, falling back to defaultFilename when
+// no usable name is left.
+func safeFilename(filename string) string {
+	filename = filepath.Base(filepath.FromSlash(strings.Replace(filename, `\`, "/", -1)))
+	if filename == "." || filename == ".." || filename == string(filepath.Separator) {
+		return defaultFilename
+	}
+	return filename
+}
+
 func getFilenameFromHeader(h string) string {
 	if h == "" {
 		return ""
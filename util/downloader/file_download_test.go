@@ -1,6 +1,8 @@
 package downloader_test
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -112,6 +114,50 @@ var _ = Describe("Downloader", func() {
 			})
 		})
 
+		Context("when the filename in the header contains directories", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/abc.zip"),
+						ghttp.RespondWith(http.StatusOK, "abc123", http.Header{
+							"Content-Disposition": []string{`attachment; filename="../../header.zip"`},
+						}),
+					),
+				)
+			})
+
+			It("saves the file in the provided dir", func() {
+				_, name, err := d.DownloadFile(server.URL() + "/abc.zip")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(name).To(Equal("header.zip"))
+
+				_, err = os.Stat(path.Join(tempDir, "header.zip"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when neither the header nor the URL provide a filename", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/"),
+						ghttp.RespondWith(http.StatusOK, "abc123", http.Header{
+							"Content-Disposition": []string{`attachment; filename=".."`},
+						}),
+					),
+				)
+			})
+
+			It("saves the file under a default name in the provided dir", func() {
+				_, name, err := d.DownloadFile(server.URL() + "/")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(name).To(Equal("download"))
+
+				_, err = os.Stat(path.Join(tempDir, "download"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
 		Context("when the server returns a redirect to a file", func() {
 			BeforeEach(func() {
 				server.AppendHandlers(
@@ -160,6 +206,44 @@ var _ = Describe("Downloader", func() {
 		})
 	})
 
+	Describe("NewDownloaderWithTransport", func() {
+		var server *ghttp.Server
+
+		BeforeEach(func() {
+			server = ghttp.NewTLSServer()
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/abc.zip"),
+					ghttp.RespondWith(http.StatusOK, "abc123"),
+				),
+			)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("downloads using the given transport", func() {
+			certPool := x509.NewCertPool()
+			certPool.AddCert(server.HTTPTestServer.Certificate())
+			d = downloader.NewDownloaderWithTransport(tempDir, &http.Transport{
+				TLSClientConfig: &tls.Config{RootCAs: certPool},
+			})
+
+			_, name, err := d.DownloadFile(server.URL() + "/abc.zip")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(name).To(Equal("abc.zip"))
+
+			_, err = os.Stat(path.Join(tempDir, "abc.zip"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("fails when the transport does not trust the server's certificate", func() {
+			_, _, err := d.DownloadFile(server.URL() + "/abc.zip")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Describe("RemoveFile", func() {
 		var server *ghttp.Server
 
@@ -0,0 +1,160 @@
+package json
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type selectStep struct {
+	field   string
+	index   int
+	isIndex bool
+	iterate bool
+}
+
+// Select evaluates a path expression against unmarshalled JSON and returns
+// every value it matches. Expressions are made of '.field' lookups, '[N]'
+// array indexes and '[]' to iterate over all array elements, for example
+// '.resources[].entity.name'. A lone '.' returns the value itself; missing
+// fields select null.
+func Select(value interface{}, expression string) ([]interface{}, error) {
+	steps, err := parseSelectExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []interface{}{value}
+	for _, step := range steps {
+		next := []interface{}{}
+		for _, result := range results {
+			selected, err := step.apply(result)
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, selected...)
+		}
+		results = next
+	}
+
+	return results, nil
+}
+
+func (step selectStep) apply(value interface{}) ([]interface{}, error) {
+	if value == nil {
+		if step.iterate {
+			return nil, nil
+		}
+		return []interface{}{nil}, nil
+	}
+
+	switch {
+	case step.iterate:
+		switch typed := value.(type) {
+		case []interface{}:
+			return typed, nil
+		case map[string]interface{}:
+			keys := make([]string, 0, len(typed))
+			for key := range typed {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			values := make([]interface{}, 0, len(keys))
+			for _, key := range keys {
+				values = append(values, typed[key])
+			}
+			return values, nil
+		}
+		return nil, fmt.Errorf("Cannot iterate over %s", describeJSONValue(value))
+	case step.isIndex:
+		array, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Cannot index %s with %d", describeJSONValue(value), step.index)
+		}
+		index := step.index
+		if index < 0 {
+			index += len(array)
+		}
+		if index < 0 || index >= len(array) {
+			return []interface{}{nil}, nil
+		}
+		return []interface{}{array[index]}, nil
+	default:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Cannot select field '%s' from %s", step.field, describeJSONValue(value))
+		}
+		return []interface{}{object[step.field]}, nil
+	}
+}
+
+func parseSelectExpression(expression string) ([]selectStep, error) {
+	expression = strings.TrimSpace(expression)
+	if expression == "" || expression == "." {
+		return nil, nil
+	}
+
+	if expression[0] != '.' && expression[0] != '[' {
+		expression = "." + expression
+	}
+
+	steps := []selectStep{}
+	for i := 0; i < len(expression); {
+		switch expression[i] {
+		case '.':
+			end := i + 1
+			for end < len(expression) && expression[end] != '.' && expression[end] != '[' {
+				end++
+			}
+			if end == i+1 {
+				if end < len(expression) && expression[end] == '[' {
+					i = end
+					continue
+				}
+				return nil, fmt.Errorf("Invalid select expression '%s': missing field name", expression)
+			}
+			steps = append(steps, selectStep{field: expression[i+1 : end]})
+			i = end
+		case '[':
+			end := strings.IndexByte(expression[i:], ']')
+			if end == -1 {
+				return nil, fmt.Errorf("Invalid select expression '%s': missing ']'", expression)
+			}
+			end += i
+
+			inner := strings.TrimSpace(expression[i+1 : end])
+			if inner == "" {
+				steps = append(steps, selectStep{iterate: true})
+			} else if index, err := strconv.Atoi(inner); err == nil {
+				steps = append(steps, selectStep{index: index, isIndex: true})
+			} else if unquoted, err := strconv.Unquote(inner); err == nil {
+				steps = append(steps, selectStep{field: unquoted})
+			} else {
+				return nil, fmt.Errorf("Invalid select expression '%s': bad index '%s'", expression, inner)
+			}
+			i = end + 1
+		default:
+			return nil, fmt.Errorf("Invalid select expression '%s'", expression)
+		}
+	}
+
+	return steps, nil
+}
+
+func describeJSONValue(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "an array"
+	case string:
+		return "a string"
+	case bool:
+		return "a boolean"
+	case float64:
+		return "a number"
+	}
+	return "null"
+}
@@ -0,0 +1,77 @@
+package json_test
+
+import (
+	"encoding/json"
+
+	cfjson "code.cloudfoundry.org/cli/util/json"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Select", func() {
+	var document interface{}
+
+	BeforeEach(func() {
+		err := json.Unmarshal([]byte(`{
+			"total_results": 2,
+			"resources": [
+				{"metadata": {"guid": "guid-1"}, "entity": {"name": "app-1", "instances": 1}},
+				{"metadata": {"guid": "guid-2"}, "entity": {"name": "app-2", "instances": 3}}
+			]
+		}`), &document)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("returns the whole document for '.'", func() {
+		results, err := cfjson.Select(document, ".")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(Equal([]interface{}{document}))
+	})
+
+	It("selects nested fields", func() {
+		results, err := cfjson.Select(document, ".total_results")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(Equal([]interface{}{float64(2)}))
+	})
+
+	It("selects array elements by index", func() {
+		results, err := cfjson.Select(document, ".resources[1].entity.name")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(Equal([]interface{}{"app-2"}))
+
+		results, err = cfjson.Select(document, ".resources[-1].metadata.guid")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(Equal([]interface{}{"guid-2"}))
+	})
+
+	It("iterates over arrays with '[]'", func() {
+		results, err := cfjson.Select(document, ".resources[].entity.name")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(Equal([]interface{}{"app-1", "app-2"}))
+	})
+
+	It("accepts quoted field names and a missing leading dot", func() {
+		results, err := cfjson.Select(document, `resources[0]["metadata"].guid`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(Equal([]interface{}{"guid-1"}))
+	})
+
+	It("selects null for missing fields and indexes", func() {
+		results, err := cfjson.Select(document, ".resources[5].entity.name")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(Equal([]interface{}{nil}))
+	})
+
+	It("returns an error when selecting a field from a non-object", func() {
+		_, err := cfjson.Select(document, ".resources.name")
+		Expect(err).To(MatchError("Cannot select field 'name' from an array"))
+	})
+
+	It("returns an error for malformed expressions", func() {
+		_, err := cfjson.Select(document, ".resources[")
+		Expect(err).To(HaveOccurred())
+
+		_, err = cfjson.Select(document, ".resources..name")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,291 @@
+// Package securitygroup checks application security group rules locally,
+// before they are sent to the Cloud Controller, and evaluates whether a set
+// of rules allows egress traffic to a destination.
+package securitygroup
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	ProtocolAll  = "all"
+	ProtocolICMP = "icmp"
+	ProtocolTCP  = "tcp"
+	ProtocolUDP  = "udp"
+
+	// ICMPAny matches every ICMP type or code.
+	ICMPAny = -1
+)
+
+// Rule is a single egress rule of a security group.
+type Rule struct {
+	Protocol    string
+	Destination string
+	Ports       string
+	Type        int
+	Code        int
+}
+
+// Allows reports whether the rule permits traffic over protocol to ip and
+// port. Rules that cannot be parsed never allow traffic.
+func (rule Rule) Allows(protocol string, ip net.IP, port int) bool {
+	parsed, err := parseRule(rule)
+	if err != nil {
+		return false
+	}
+
+	address, ok := ipv4ToUint(ip)
+	if !ok {
+		return false
+	}
+
+	if !parsed.destination.contains(ipRange{start: address, end: address}) {
+		return false
+	}
+
+	switch parsed.protocol {
+	case ProtocolAll:
+		return true
+	case protocol:
+		if protocol == ProtocolICMP {
+			return true
+		}
+		return parsed.ports.contains(portRanges{{start: port, end: port}})
+	default:
+		return false
+	}
+}
+
+type ipRange struct {
+	start uint32
+	end   uint32
+}
+
+func (r ipRange) contains(other ipRange) bool {
+	return r.start <= other.start && other.end <= r.end
+}
+
+func (r ipRange) overlaps(other ipRange) bool {
+	return r.start <= other.end && other.start <= r.end
+}
+
+func (r ipRange) isEverything() bool {
+	return r.start == 0 && r.end == ^uint32(0)
+}
+
+type portRange struct {
+	start int
+	end   int
+}
+
+type portRanges []portRange
+
+func (ranges portRanges) contains(other portRanges) bool {
+	for _, o := range other {
+		for port := o.start; port <= o.end; port++ {
+			if !ranges.containsPort(port) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (ranges portRanges) containsPort(port int) bool {
+	for _, r := range ranges {
+		if r.start <= port && port <= r.end {
+			return true
+		}
+	}
+	return false
+}
+
+func (ranges portRanges) overlaps(other portRanges) bool {
+	for _, r := range ranges {
+		for _, o := range other {
+			if r.start <= o.end && o.start <= r.end {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type parsedRule struct {
+	protocol    string
+	destination ipRange
+	ports       portRanges
+	icmpType    int
+	icmpCode    int
+}
+
+// covers reports whether every packet allowed by other is also allowed by
+// rule.
+func (rule parsedRule) covers(other parsedRule) bool {
+	if !rule.destination.contains(other.destination) {
+		return false
+	}
+
+	switch {
+	case rule.protocol == ProtocolAll:
+		return true
+	case rule.protocol != other.protocol:
+		return false
+	case rule.protocol == ProtocolICMP:
+		return icmpValueCovers(rule.icmpType, other.icmpType) && icmpValueCovers(rule.icmpCode, other.icmpCode)
+	default:
+		return rule.ports.contains(other.ports)
+	}
+}
+
+func (rule parsedRule) overlaps(other parsedRule) bool {
+	if !rule.destination.overlaps(other.destination) {
+		return false
+	}
+
+	switch {
+	case rule.protocol == ProtocolAll || other.protocol == ProtocolAll:
+		return true
+	case rule.protocol != other.protocol:
+		return false
+	case rule.protocol == ProtocolICMP:
+		return icmpValueOverlaps(rule.icmpType, other.icmpType) && icmpValueOverlaps(rule.icmpCode, other.icmpCode)
+	default:
+		return rule.ports.overlaps(other.ports)
+	}
+}
+
+func icmpValueCovers(value int, other int) bool {
+	return value == ICMPAny || value == other
+}
+
+func icmpValueOverlaps(value int, other int) bool {
+	return value == ICMPAny || other == ICMPAny || value == other
+}
+
+func parseRule(rule Rule) (parsedRule, error) {
+	parsed := parsedRule{
+		protocol: rule.Protocol,
+		icmpType: rule.Type,
+		icmpCode: rule.Code,
+	}
+
+	switch rule.Protocol {
+	case ProtocolAll, ProtocolICMP, ProtocolTCP, ProtocolUDP:
+	default:
+		return parsedRule{}, fmt.Errorf("protocol %q must be one of tcp, udp, icmp or all", rule.Protocol)
+	}
+
+	var err error
+	parsed.destination, err = parseDestination(rule.Destination)
+	if err != nil {
+		return parsedRule{}, err
+	}
+
+	if rule.Protocol == ProtocolTCP || rule.Protocol == ProtocolUDP {
+		parsed.ports, err = parsePorts(rule.Ports)
+		if err != nil {
+			return parsedRule{}, err
+		}
+	}
+
+	return parsed, nil
+}
+
+// parseDestination accepts a single IPv4 address, a CIDR block or a range of
+// addresses separated by a hyphen.
+func parseDestination(destination string) (ipRange, error) {
+	invalid := fmt.Errorf("destination %q must be an IPv4 address, CIDR block or address range", destination)
+
+	if strings.Contains(destination, "/") {
+		_, network, err := net.ParseCIDR(destination)
+		if err != nil {
+			return ipRange{}, invalid
+		}
+
+		start, ok := ipv4ToUint(network.IP)
+		if !ok {
+			return ipRange{}, invalid
+		}
+		ones, bits := network.Mask.Size()
+		return ipRange{start: start, end: start | uint32(uint64(1)<<uint(bits-ones)-1)}, nil
+	}
+
+	if strings.Contains(destination, "-") {
+		parts := strings.SplitN(destination, "-", 2)
+		start, startOK := ipv4ToUint(net.ParseIP(strings.TrimSpace(parts[0])))
+		end, endOK := ipv4ToUint(net.ParseIP(strings.TrimSpace(parts[1])))
+		if !startOK || !endOK {
+			return ipRange{}, invalid
+		}
+		if start > end {
+			return ipRange{}, fmt.Errorf("destination %q starts after it ends", destination)
+		}
+		return ipRange{start: start, end: end}, nil
+	}
+
+	address, ok := ipv4ToUint(net.ParseIP(destination))
+	if !ok {
+		return ipRange{}, invalid
+	}
+	return ipRange{start: address, end: address}, nil
+}
+
+// parsePorts accepts a single port, a range of ports separated by a hyphen or
+// a comma separated list of ports.
+func parsePorts(ports string) (portRanges, error) {
+	invalid := fmt.Errorf("ports %q must be a port, a range such as 8080-8090 or a list such as 80,443", ports)
+
+	if ports == "" {
+		return nil, invalid
+	}
+
+	if strings.Contains(ports, "-") {
+		parts := strings.SplitN(ports, "-", 2)
+		start, err := parsePort(parts[0])
+		if err != nil {
+			return nil, invalid
+		}
+		end, err := parsePort(parts[1])
+		if err != nil {
+			return nil, invalid
+		}
+		if start > end {
+			return nil, fmt.Errorf("ports %q starts after it ends", ports)
+		}
+		return portRanges{{start: start, end: end}}, nil
+	}
+
+	var ranges portRanges
+	for _, part := range strings.Split(ports, ",") {
+		port, err := parsePort(part)
+		if err != nil {
+			return nil, invalid
+		}
+		ranges = append(ranges, portRange{start: port, end: port})
+	}
+	return ranges, nil
+}
+
+func parsePort(port string) (int, error) {
+	value, err := strconv.Atoi(strings.TrimSpace(port))
+	if err != nil {
+		return 0, err
+	}
+	if value < 1 || value > 65535 {
+		return 0, fmt.Errorf("port %d is out of range", value)
+	}
+	return value, nil
+}
+
+func ipv4ToUint(ip net.IP) (uint32, bool) {
+	ip = ip.To4()
+	if ip == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(ip), true
+}
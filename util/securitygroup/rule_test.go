@@ -0,0 +1,29 @@
+package securitygroup_test
+
+import (
+	"net"
+
+	. "code.cloudfoundry.org/cli/util/securitygroup"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rule", func() {
+	DescribeTable("Allows",
+		func(rule Rule, protocol string, ip string, port int, allowed bool) {
+			Expect(rule.Allows(protocol, net.ParseIP(ip), port)).To(Equal(allowed))
+		},
+		Entry("matching address and port", Rule{Protocol: "tcp", Destination: "10.0.0.1", Ports: "443"}, "tcp", "10.0.0.1", 443, true),
+		Entry("other port", Rule{Protocol: "tcp", Destination: "10.0.0.1", Ports: "443"}, "tcp", "10.0.0.1", 80, false),
+		Entry("other protocol", Rule{Protocol: "udp", Destination: "10.0.0.1", Ports: "443"}, "tcp", "10.0.0.1", 443, false),
+		Entry("address in CIDR", Rule{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "1-65535"}, "tcp", "10.200.3.4", 5432, true),
+		Entry("address outside CIDR", Rule{Protocol: "tcp", Destination: "10.0.0.0/8", Ports: "1-65535"}, "tcp", "11.0.0.1", 5432, false),
+		Entry("address in range", Rule{Protocol: "udp", Destination: "10.0.0.1-10.0.0.9", Ports: "53,123"}, "udp", "10.0.0.9", 123, true),
+		Entry("port in list", Rule{Protocol: "udp", Destination: "10.0.0.1-10.0.0.9", Ports: "53,123"}, "udp", "10.0.0.2", 54, false),
+		Entry("protocol all", Rule{Protocol: "all", Destination: "0.0.0.0/0"}, "udp", "8.8.8.8", 53, true),
+		Entry("IPv6 destination", Rule{Protocol: "all", Destination: "0.0.0.0/0"}, "tcp", "::1", 80, false),
+		Entry("invalid rule", Rule{Protocol: "tcp", Destination: "nowhere", Ports: "80"}, "tcp", "10.0.0.1", 80, false),
+	)
+})
@@ -0,0 +1,244 @@
+package securitygroup
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+type Severity string
+
+const (
+	// SeverityError marks a rule the Cloud Controller would reject.
+	SeverityError Severity = "error"
+	// SeverityWarning marks a valid rule that is probably not what was meant.
+	SeverityWarning Severity = "warning"
+)
+
+// Problem describes an issue with the rule at index Rule (zero based) of a
+// rules file.
+type Problem struct {
+	Rule     int
+	Severity Severity
+	Message  string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("rule %d: %s", p.Rule+1, p.Message)
+}
+
+// Problems is a list of problems sorted by the rule they apply to.
+type Problems []Problem
+
+func (problems Problems) Len() int           { return len(problems) }
+func (problems Problems) Swap(i, j int)      { problems[i], problems[j] = problems[j], problems[i] }
+func (problems Problems) Less(i, j int) bool { return problems[i].Rule < problems[j].Rule }
+
+// Errors returns the problems that would cause the rules to be rejected.
+func (problems Problems) Errors() Problems {
+	return problems.withSeverity(SeverityError)
+}
+
+// Warnings returns the problems that should be reviewed but do not make the
+// rules invalid.
+func (problems Problems) Warnings() Problems {
+	return problems.withSeverity(SeverityWarning)
+}
+
+func (problems Problems) withSeverity(severity Severity) Problems {
+	var filtered Problems
+	for _, problem := range problems {
+		if problem.Severity == severity {
+			filtered = append(filtered, problem)
+		}
+	}
+	return filtered
+}
+
+var knownRuleFields = map[string]bool{
+	"protocol":    true,
+	"destination": true,
+	"ports":       true,
+	"type":        true,
+	"code":        true,
+	"log":         true,
+	"description": true,
+}
+
+// ValidateRules checks the rules parsed from a security group rules file.
+// Syntax errors are reported as SeverityError. Rules that allow traffic to
+// every address, and rules that are shadowed by or overlap another rule, are
+// reported as SeverityWarning.
+func ValidateRules(rules []map[string]interface{}) Problems {
+	var problems Problems
+	parsed := map[int]parsedRule{}
+
+	for i, raw := range rules {
+		rule, ruleProblems := validateRule(i, raw)
+		problems = append(problems, ruleProblems...)
+		if len(ruleProblems) > 0 {
+			continue
+		}
+
+		parsedRule, err := parseRule(rule)
+		if err != nil {
+			problems = append(problems, Problem{Rule: i, Severity: SeverityError, Message: err.Error()})
+			continue
+		}
+		parsed[i] = parsedRule
+
+		if parsedRule.destination.isEverything() {
+			problems = append(problems, Problem{
+				Rule:     i,
+				Severity: SeverityWarning,
+				Message:  fmt.Sprintf("destination %s allows %s traffic to every IPv4 address", rule.Destination, rule.Protocol),
+			})
+		}
+	}
+
+	for j := range rules {
+		later, ok := parsed[j]
+		if !ok {
+			continue
+		}
+
+		for i := 0; i < j; i++ {
+			earlier, ok := parsed[i]
+			if !ok {
+				continue
+			}
+
+			var message string
+			rule := j
+			switch {
+			case earlier.covers(later) && later.covers(earlier):
+				message = fmt.Sprintf("duplicates rule %d", i+1)
+			case earlier.covers(later):
+				message = fmt.Sprintf("is shadowed by rule %d", i+1)
+			case later.covers(earlier):
+				rule = i
+				message = fmt.Sprintf("is shadowed by rule %d", j+1)
+			case earlier.overlaps(later):
+				message = fmt.Sprintf("overlaps rule %d", i+1)
+			default:
+				continue
+			}
+
+			problems = append(problems, Problem{Rule: rule, Severity: SeverityWarning, Message: message})
+		}
+	}
+
+	sort.Stable(problems)
+	return problems
+}
+
+func validateRule(index int, raw map[string]interface{}) (Rule, Problems) {
+	var problems Problems
+	addError := func(format string, args ...interface{}) {
+		problems = append(problems, Problem{Rule: index, Severity: SeverityError, Message: fmt.Sprintf(format, args...)})
+	}
+
+	var unknownFields []string
+	for field := range raw {
+		if !knownRuleFields[field] {
+			unknownFields = append(unknownFields, field)
+		}
+	}
+	sort.Strings(unknownFields)
+	for _, field := range unknownFields {
+		addError("unknown field %q", field)
+	}
+
+	rule := Rule{Type: ICMPAny, Code: ICMPAny}
+
+	protocol, ok := raw["protocol"].(string)
+	switch {
+	case raw["protocol"] == nil:
+		addError("protocol is required")
+	case !ok:
+		addError("protocol must be a string")
+	default:
+		rule.Protocol = protocol
+	}
+
+	destination, ok := raw["destination"].(string)
+	switch {
+	case raw["destination"] == nil:
+		addError("destination is required")
+	case !ok:
+		addError("destination must be a string")
+	default:
+		rule.Destination = destination
+	}
+
+	_, hasPorts := raw["ports"]
+	_, hasType := raw["type"]
+	_, hasCode := raw["code"]
+
+	switch rule.Protocol {
+	case ProtocolTCP, ProtocolUDP:
+		ports, ok := raw["ports"].(string)
+		switch {
+		case !hasPorts:
+			addError("ports are required for protocol %s", rule.Protocol)
+		case !ok:
+			addError("ports must be a string")
+		default:
+			rule.Ports = ports
+		}
+		if hasType || hasCode {
+			addError("type and code are only allowed for protocol icmp")
+		}
+	case ProtocolICMP:
+		if hasPorts {
+			addError("ports are not allowed for protocol icmp")
+		}
+		for _, field := range []string{"type", "code"} {
+			value, err := icmpValue(raw, field)
+			if err != nil {
+				addError("%s", err.Error())
+				continue
+			}
+			if field == "type" {
+				rule.Type = value
+			} else {
+				rule.Code = value
+			}
+		}
+	case ProtocolAll:
+		if hasPorts {
+			addError("ports are not allowed for protocol all")
+		}
+		if hasType || hasCode {
+			addError("type and code are only allowed for protocol icmp")
+		}
+	}
+
+	if value, ok := raw["log"]; ok {
+		if _, isBool := value.(bool); !isBool {
+			addError("log must be true or false")
+		}
+	}
+
+	if value, ok := raw["description"]; ok {
+		if _, isString := value.(string); !isString {
+			addError("description must be a string")
+		}
+	}
+
+	return rule, problems
+}
+
+func icmpValue(raw map[string]interface{}, field string) (int, error) {
+	value, ok := raw[field]
+	if !ok {
+		return 0, fmt.Errorf("%s is required for protocol icmp", field)
+	}
+
+	number, ok := value.(float64)
+	if !ok || number != math.Trunc(number) || number < ICMPAny || number > 255 {
+		return 0, fmt.Errorf("%s must be an integer between -1 and 255", field)
+	}
+
+	return int(number), nil
+}
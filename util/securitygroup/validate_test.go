@@ -0,0 +1,85 @@
+package securitygroup_test
+
+import (
+	"encoding/json"
+
+	. "code.cloudfoundry.org/cli/util/securitygroup"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateRules", func() {
+	parse := func(rules string) []map[string]interface{} {
+		var parsed []map[string]interface{}
+		Expect(json.Unmarshal([]byte(rules), &parsed)).To(Succeed())
+		return parsed
+	}
+
+	It("accepts valid rules", func() {
+		problems := ValidateRules(parse(`[
+			{"protocol": "tcp", "destination": "10.0.0.1", "ports": "443", "log": true, "description": "api"},
+			{"protocol": "udp", "destination": "10.0.1.0/24", "ports": "53,123"},
+			{"protocol": "tcp", "destination": "10.0.2.1-10.0.2.9", "ports": "8080-8090"},
+			{"protocol": "icmp", "destination": "10.0.3.0/24", "type": 0, "code": -1},
+			{"protocol": "all", "destination": "10.0.4.0/24"}
+		]`))
+		Expect(problems).To(BeEmpty())
+	})
+
+	DescribeTable("syntax errors",
+		func(rule string, message string) {
+			problems := ValidateRules(parse("[" + rule + "]"))
+			Expect(problems.Errors()).To(ConsistOf(Problem{Rule: 0, Severity: SeverityError, Message: message}))
+		},
+		Entry("unknown field", `{"protocol": "tcp", "destination": "10.0.0.1", "ports": "80", "port": "80"}`, `unknown field "port"`),
+		Entry("missing protocol", `{"destination": "10.0.0.1"}`, "protocol is required"),
+		Entry("unsupported protocol", `{"protocol": "sctp", "destination": "10.0.0.1"}`, `protocol "sctp" must be one of tcp, udp, icmp or all`),
+		Entry("missing destination", `{"protocol": "all"}`, "destination is required"),
+		Entry("invalid destination", `{"protocol": "all", "destination": "10.0.0"}`, `destination "10.0.0" must be an IPv4 address, CIDR block or address range`),
+		Entry("invalid CIDR", `{"protocol": "all", "destination": "10.0.0.0/33"}`, `destination "10.0.0.0/33" must be an IPv4 address, CIDR block or address range`),
+		Entry("backwards range", `{"protocol": "all", "destination": "10.0.0.9-10.0.0.1"}`, `destination "10.0.0.9-10.0.0.1" starts after it ends`),
+		Entry("missing ports", `{"protocol": "tcp", "destination": "10.0.0.1"}`, "ports are required for protocol tcp"),
+		Entry("invalid ports", `{"protocol": "tcp", "destination": "10.0.0.1", "ports": "http"}`, `ports "http" must be a port, a range such as 8080-8090 or a list such as 80,443`),
+		Entry("out of range port", `{"protocol": "udp", "destination": "10.0.0.1", "ports": "70000"}`, `ports "70000" must be a port, a range such as 8080-8090 or a list such as 80,443`),
+		Entry("backwards ports", `{"protocol": "udp", "destination": "10.0.0.1", "ports": "90-80"}`, `ports "90-80" starts after it ends`),
+		Entry("ports with all", `{"protocol": "all", "destination": "10.0.0.1", "ports": "80"}`, "ports are not allowed for protocol all"),
+		Entry("type with tcp", `{"protocol": "tcp", "destination": "10.0.0.1", "ports": "80", "type": 0}`, "type and code are only allowed for protocol icmp"),
+		Entry("missing icmp code", `{"protocol": "icmp", "destination": "10.0.0.1", "type": 0}`, "code is required for protocol icmp"),
+		Entry("invalid icmp type", `{"protocol": "icmp", "destination": "10.0.0.1", "type": 1.5, "code": 0}`, "type must be an integer between -1 and 255"),
+		Entry("non boolean log", `{"protocol": "all", "destination": "10.0.0.1", "log": "yes"}`, "log must be true or false"),
+	)
+
+	It("warns about rules that allow traffic to every address", func() {
+		problems := ValidateRules(parse(`[{"protocol": "all", "destination": "0.0.0.0/0"}]`))
+		Expect(problems).To(ConsistOf(Problem{Rule: 0, Severity: SeverityWarning, Message: "destination 0.0.0.0/0 allows all traffic to every IPv4 address"}))
+	})
+
+	It("warns about duplicate, shadowed and overlapping rules", func() {
+		problems := ValidateRules(parse(`[
+			{"protocol": "tcp", "destination": "10.0.0.0/24", "ports": "80-90"},
+			{"protocol": "tcp", "destination": "10.0.0.5", "ports": "85"},
+			{"protocol": "udp", "destination": "10.0.0.5", "ports": "85"},
+			{"protocol": "tcp", "destination": "10.0.0.250-10.0.1.5", "ports": "90,100"},
+			{"protocol": "tcp", "destination": "10.0.0.0/24", "ports": "80-90"},
+			{"protocol": "all", "destination": "10.0.0.5"}
+		]`))
+
+		Expect(problems).To(Equal(Problems{
+			{Rule: 1, Severity: SeverityWarning, Message: "is shadowed by rule 1"},
+			{Rule: 1, Severity: SeverityWarning, Message: "is shadowed by rule 5"},
+			{Rule: 1, Severity: SeverityWarning, Message: "is shadowed by rule 6"},
+			{Rule: 2, Severity: SeverityWarning, Message: "is shadowed by rule 6"},
+			{Rule: 3, Severity: SeverityWarning, Message: "overlaps rule 1"},
+			{Rule: 4, Severity: SeverityWarning, Message: "duplicates rule 1"},
+			{Rule: 4, Severity: SeverityWarning, Message: "overlaps rule 4"},
+			{Rule: 5, Severity: SeverityWarning, Message: "overlaps rule 1"},
+			{Rule: 5, Severity: SeverityWarning, Message: "overlaps rule 5"},
+		}))
+	})
+
+	It("formats problems with one based rule numbers", func() {
+		Expect(Problem{Rule: 2, Message: "protocol is required"}.String()).To(Equal("rule 3: protocol is required"))
+	})
+})